@@ -1,37 +1,79 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/opd-ai/vania/internal/audio"
 	"github.com/opd-ai/vania/internal/engine"
+	"github.com/opd-ai/vania/internal/entity"
 	"github.com/opd-ai/vania/internal/menu"
+	"github.com/opd-ai/vania/internal/pcg"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/render"
+	"github.com/opd-ai/vania/internal/settings"
+	"github.com/opd-ai/vania/internal/world"
 )
 
+// Attract mode timing, in frames at 60 FPS
+const (
+	attractIdleFrames = 600  // Main-menu idle time before the demo starts
+	attractDemoFrames = 3600 // Length of one demo before a new world is shown
+)
+
+// qualityThreshold is the minimum score for the exit quality report
+const qualityThreshold = 5.0
+
 // GameApp represents the main application with menu integration
 type GameApp struct {
 	menuManager *menu.MenuManager
 	gameRunner  *engine.GameRunner
 	currentGame *engine.Game
 	inMenu      bool
+	metrics     *engine.MetricsCollector // quality metrics for the current game
+	sfx         *audio.AudioPlayer       // plays sound cues; nil when audio is unavailable
+
+	// Attract mode: a demo AI plays while the main menu sits idle
+	idleFrames int
+	demoGame   *engine.Game
+	demoRunner *engine.GameRunner
+	demoAI     *engine.DemoAI
+	demoFrames int
 
 	// Command line options
 	directPlay bool
 	fixedSeed  int64
 	genre      string
+	loadout    string
+	enemyDefs  []entity.EnemyDefinition // hand-authored enemies from -enemies
+	density    world.Density            // world density from the -*-density flags
+	devMode    bool                     // developer controls from -dev
+	combatLog  *engine.CombatLog        // damage events, when -combat-log is set
 }
 
 // NewGameApp creates a new game application
-func NewGameApp(directPlay bool, fixedSeed int64, genre string) *GameApp {
+func NewGameApp(directPlay bool, fixedSeed int64, genre, loadout string, enemyDefs []entity.EnemyDefinition, density world.Density) *GameApp {
 	app := &GameApp{
 		menuManager: menu.NewMenuManager(),
 		inMenu:      !directPlay,
 		directPlay:  directPlay,
 		fixedSeed:   fixedSeed,
 		genre:       genre,
+		loadout:     loadout,
+		enemyDefs:   enemyDefs,
+		density:     density,
+	}
+
+	if player, err := audio.NewAudioPlayer(); err != nil {
+		fmt.Fprintf(os.Stderr, "Sound effects disabled: %v\n", err)
+	} else {
+		app.sfx = player
 	}
 
 	// Set up menu callbacks
@@ -43,6 +85,16 @@ func NewGameApp(directPlay bool, fixedSeed int64, genre string) *GameApp {
 		app.onResumeGame, // Resume
 	)
 
+	app.menuManager.SetPresetCallbacks(
+		app.listPresets,    // Saved presets
+		app.onLoadPreset,   // Load preset
+		app.onExportPreset, // Export preset
+	)
+
+	app.menuManager.SetVictoryCallback(app.onNewGamePlus)
+	app.menuManager.SetBossRushCallback(app.startBossRush)
+	app.menuManager.SetSaveCallback(app.onSaveGame)
+
 	// Set genre theme on menu system
 	app.menuManager.SetGenre(genre)
 
@@ -67,6 +119,18 @@ func NewGameApp(directPlay bool, fixedSeed int64, genre string) *GameApp {
 // Update implements ebiten.Game interface
 func (app *GameApp) Update() error {
 	if app.inMenu {
+		if app.demoRunner != nil {
+			return app.updateDemo()
+		}
+		if app.menuManager.GetCurrentMenu() == menu.MainMenu && !anyInputPressed() {
+			app.idleFrames++
+			if app.idleFrames >= attractIdleFrames {
+				app.startDemo()
+				return nil
+			}
+		} else {
+			app.idleFrames = 0
+		}
 		return app.menuManager.Update()
 	} else if app.gameRunner != nil {
 		err := app.gameRunner.Update()
@@ -77,6 +141,18 @@ func (app *GameApp) Update() error {
 			return nil
 		}
 
+		// A finished boss rush shows its results instead of the ending
+		if rush := app.gameRunner.BossRush(); app.currentGame != nil && rush != nil && rush.Finished() {
+			app.showBossRushResults()
+			return nil
+		}
+
+		// Every boss defeated: offer the recap and New Game Plus
+		if app.currentGame != nil && app.gameRunner.IsRunComplete() {
+			app.showVictory()
+			return nil
+		}
+
 		return err
 	}
 	return nil
@@ -84,7 +160,10 @@ func (app *GameApp) Update() error {
 
 // Draw implements ebiten.Game interface
 func (app *GameApp) Draw(screen *ebiten.Image) {
-	if app.inMenu {
+	if app.inMenu && app.demoRunner != nil {
+		app.demoRunner.Draw(screen)
+		ebitenutil.DebugPrintAt(screen, "DEMO - press any key", 400, 16)
+	} else if app.inMenu {
 		app.menuManager.Draw(screen)
 	} else if app.gameRunner != nil {
 		app.gameRunner.Draw(screen)
@@ -142,6 +221,14 @@ func (app *GameApp) onResumeGame() error {
 	return nil
 }
 
+// onSaveGame quicksaves the running game from the pause menu
+func (app *GameApp) onSaveGame() error {
+	if app.gameRunner != nil {
+		app.gameRunner.ConfirmQuickSave()
+	}
+	return nil
+}
+
 // startGame creates and starts a new game
 func (app *GameApp) startGame(seed int64) error {
 	fmt.Println("╔════════════════════════════════════════════════════════╗")
@@ -153,13 +240,27 @@ func (app *GameApp) startGame(seed int64) error {
 	fmt.Println()
 	fmt.Printf("Master Seed: %d\n", seed)
 	fmt.Printf("Genre:       %s\n", app.genre)
+	fmt.Printf("Loadout:     %s\n", app.loadout)
 	fmt.Println("Generating game world...")
 
-	// Create game generator with genre
+	// Create game generator with genre and starting loadout
 	generator := engine.NewGameGeneratorWithGenre(seed, app.genre)
+	if err := generator.SetLoadout(app.loadout); err != nil {
+		return err
+	}
+	generator.EnemyDefinitions = app.enemyDefs
+	if err := generator.SetDensity(app.density); err != nil {
+		return err
+	}
 
-	// Generate complete game
-	game, err := generator.GenerateCompleteGame()
+	return app.launchGame(generator)
+}
+
+// launchGame generates a world from generator and switches to playing it
+func (app *GameApp) launchGame(generator *engine.GameGenerator) error {
+	// Generate complete game, timing it for the quality report
+	metrics := engine.NewMetricsCollector()
+	game, err := metrics.TimeGeneration(generator)
 	if err != nil {
 		return fmt.Errorf("error generating game: %v", err)
 	}
@@ -169,6 +270,9 @@ func (app *GameApp) startGame(seed int64) error {
 	// Create game runner
 	app.currentGame = game
 	app.gameRunner = engine.NewGameRunner(game)
+	app.metrics = metrics
+	app.gameRunner.SetMetricsCollector(metrics)
+	app.applyGameplaySettings()
 
 	// Switch to game mode
 	app.inMenu = false
@@ -177,12 +281,311 @@ func (app *GameApp) startGame(seed int64) error {
 	return nil
 }
 
+// applyGameplaySettings passes the persisted gameplay and HUD settings, and
+// the dev flag, to the current game runner
+func (app *GameApp) applyGameplaySettings() {
+	app.gameRunner.SetDevMode(app.devMode)
+	app.gameRunner.SetCombatLog(app.combatLog)
+	gameplay := app.menuManager.GetGameplaySettings()
+	mode, err := engine.ParseRespawnMode(gameplay.EnemyRespawn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Ignoring respawn setting: %v\n", err)
+	}
+	app.gameRunner.SetRespawnPolicy(engine.RespawnPolicy{
+		Mode:           mode,
+		IntervalFrames: int64(gameplay.RespawnSeconds) * 60,
+	})
+	if gameplay.InstantMovement {
+		app.gameRunner.SetMovementConfig(physics.DefaultMovementConfig())
+	}
+	if gameplay.DisableHitStop {
+		app.gameRunner.SetHitStopConfig(engine.HitStopConfig{})
+	}
+	app.gameRunner.SetAutoRun(gameplay.AutoRun)
+	app.gameRunner.SetGoreEnabled(!gameplay.DisableGore)
+	app.gameRunner.SetShowHitboxes(gameplay.ShowHitboxes)
+	app.gameRunner.SetLocalizer(app.menuManager.Localizer())
+	if gameplay.SkipTutorial {
+		app.gameRunner.SkipTutorial()
+	}
+	app.gameRunner.SetTutorialBindings(app.menuManager.GetSettings().KeyBindings)
+	if gameplay.AssistMode {
+		app.gameRunner.SetAssistMode(engine.DefaultAssistConfig())
+	}
+	app.gameRunner.SetRewindOnDeath(gameplay.RewindOnDeath)
+	aimAssist, err := engine.ParseAimAssist(gameplay.AimAssist)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Ignoring aim assist setting: %v\n", err)
+	}
+	app.gameRunner.SetAimAssist(aimAssist)
+	app.gameRunner.SetInvulnerabilityDuration(engine.InvulnerabilityFramesForDifficulty(gameplay.Difficulty))
+	graphics := app.menuManager.GetGraphicsSettings()
+	app.gameRunner.SetParticleLifetimeScale(graphics.Quality.ParticleLifetimeScale())
+	app.gameRunner.SetHUDLayout(hudLayout(graphics.HUD))
+	app.gameRunner.SetSmoothScaling(graphics.SmoothScaling)
+	app.gameRunner.SetMaxActiveEnemies(graphics.MaxEnemies)
+	if app.currentGame != nil {
+		app.currentGame.SetPlayerPalette(graphics.PlayerPalette)
+	}
+	app.applySoundSettings()
+}
+
+// applySoundSettings loads the current game's sound effects, sets their
+// volume from the settings menu and turns sound captions on or off
+func (app *GameApp) applySoundSettings() {
+	if app.sfx != nil && app.currentGame != nil && app.currentGame.Audio != nil {
+		for name, sample := range app.currentGame.Audio.Sounds {
+			if err := app.sfx.LoadSound(name, sample); err != nil {
+				fmt.Fprintf(os.Stderr, "Skipping sound %q: %v\n", name, err)
+			}
+		}
+		volumes := app.menuManager.GetSettings()
+		app.sfx.SetVolumes(volumes.MasterVolume, volumes.SFXVolume, volumes.MusicVolume)
+		app.gameRunner.SetSoundPlayer(app.sfx)
+	}
+	volumes := app.menuManager.GetSettings()
+	app.gameRunner.SetMusicVolume(volumes.MusicVolume * volumes.MasterVolume)
+	app.gameRunner.SetCaptions(app.menuManager.GetAudioSettings().Captions)
+}
+
+// hudLayout converts the persisted HUD settings into a renderer layout.
+// Unknown anchors fall back to each element's usual corner.
+func hudLayout(hud settings.HUDSettings) render.HUDLayout {
+	anchor := func(name string) render.HUDAnchor {
+		a, err := render.ParseHUDAnchor(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Ignoring HUD setting: %v\n", err)
+		}
+		return a
+	}
+	return render.HUDLayout{
+		HideHealthBar:    hud.HideHealthBar,
+		HideAbilityIcons: hud.HideAbilityIcons,
+		HideDebugInfo:    hud.HideDebugInfo,
+		HideMinimap:      hud.HideMinimap,
+		HealthBar:        anchor(hud.HealthBarAnchor),
+		AbilityIcons:     anchor(hud.AbilityIconsAnchor),
+		DebugInfo:        anchor(hud.DebugInfoAnchor),
+		Minimap:          anchor(hud.MinimapAnchor),
+	}
+}
+
+// listPresets returns the names of the saved generation presets
+func (app *GameApp) listPresets() ([]string, error) {
+	dir, err := engine.DefaultPresetDir()
+	if err != nil {
+		return nil, err
+	}
+	return engine.ListPresets(dir)
+}
+
+// onLoadPreset regenerates and starts the world recorded in a preset
+func (app *GameApp) onLoadPreset(name string) error {
+	dir, err := engine.DefaultPresetDir()
+	if err != nil {
+		return err
+	}
+	preset, err := engine.LoadPreset(dir, name)
+	if err != nil {
+		return err
+	}
+	generator, err := preset.Generator()
+	if err != nil {
+		return err
+	}
+	generator.EnemyDefinitions = app.enemyDefs
+
+	fmt.Printf("Loading preset %q (seed %d, %s)\n", preset.Name, preset.Seed, preset.Genre)
+	app.genre = preset.Genre
+	if preset.Loadout != "" {
+		app.loadout = preset.Loadout
+	}
+	return app.launchGame(generator)
+}
+
+// onExportPreset saves the current world as a preset named after its seed
+func (app *GameApp) onExportPreset() error {
+	if app.currentGame == nil {
+		return nil
+	}
+	dir, err := engine.DefaultPresetDir()
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%d", app.currentGame.Genre, app.currentGame.Seed)
+	if err := engine.SavePreset(dir, engine.NewPresetFromGame(name, app.currentGame)); err != nil {
+		return fmt.Errorf("failed to export preset: %w", err)
+	}
+	fmt.Printf("Saved world preset %q to %s\n", name, dir)
+	return nil
+}
+
+// onNewGamePlus leaves the victory menu for the next NG+ cycle
+func (app *GameApp) onNewGamePlus() error {
+	if err := app.startNewGamePlus(); err != nil {
+		return err
+	}
+	app.inMenu = false
+	app.menuManager.Hide()
+	return nil
+}
+
+// startNewGamePlus begins the next NG+ cycle on a fresh seed, carrying the
+// completed run's abilities forward
+func (app *GameApp) startNewGamePlus() error {
+	prev := app.currentGame
+	seed := time.Now().UnixNano()
+	if app.fixedSeed != 0 {
+		seed = prev.Seed // Keep the same world when a seed was requested
+	}
+
+	fmt.Printf("Run complete! Starting New Game+ %d (seed %d)...\n", prev.NGPlusLevel+1, seed)
+
+	generator := engine.NewGamePlusGenerator(prev, seed)
+	metrics := engine.NewMetricsCollector()
+	game, err := metrics.TimeGeneration(generator)
+	if err != nil {
+		return fmt.Errorf("error generating new game plus: %v", err)
+	}
+
+	app.currentGame = game
+	app.gameRunner = engine.NewGameRunner(game)
+	app.metrics = metrics
+	app.gameRunner.SetMetricsCollector(metrics)
+	app.applyGameplaySettings()
+	return nil
+}
+
+// startPractice generates a sandbox room from seed and drops the player
+// into it with every ability
+func (app *GameApp) startPractice(seed int64) error {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	fmt.Printf("Generating practice room (seed %d)...\n", seed)
+
+	game, err := engine.NewPracticeGame(seed, app.genre)
+	if err != nil {
+		return fmt.Errorf("error generating practice room: %v", err)
+	}
+
+	app.currentGame = game
+	app.gameRunner = engine.NewPracticeRunner(game)
+	app.applyGameplaySettings()
+
+	app.inMenu = false
+	app.menuManager.Hide()
+	return nil
+}
+
+// startBossRush generates a world from seed and starts its bosses back to
+// back
+func (app *GameApp) startBossRush(seed int64) error {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	fmt.Printf("Generating boss rush (seed %d)...\n", seed)
+
+	game, err := engine.NewBossRushGame(seed, app.genre)
+	if err != nil {
+		return fmt.Errorf("error generating boss rush: %v", err)
+	}
+
+	app.currentGame = game
+	app.gameRunner = engine.NewBossRushRunner(game)
+	app.applyGameplaySettings()
+
+	app.inMenu = false
+	app.menuManager.Hide()
+	return nil
+}
+
+// startDemo generates a world and hands it to the demo AI. A failed
+// generation just leaves the main menu up.
+func (app *GameApp) startDemo() {
+	generator := engine.NewGameGeneratorWithGenre(time.Now().UnixNano(), app.genre)
+	game, err := generator.GenerateCompleteGame()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating demo: %v\n", err)
+		app.stopDemo()
+		return
+	}
+
+	app.demoGame = game
+	app.demoRunner = engine.NewDemoRunner(game)
+	app.demoAI = engine.NewDemoAI()
+	app.demoFrames = 0
+	app.idleFrames = 0
+}
+
+// updateDemo steps the demo one frame. Any input returns to the main menu;
+// a dead or stalled player or an expired timer starts a fresh demo world.
+func (app *GameApp) updateDemo() error {
+	if anyInputPressed() {
+		app.stopDemo()
+		return nil
+	}
+
+	if err := app.demoRunner.Step(app.demoAI.NextInput(app.demoRunner)); err != nil {
+		app.stopDemo()
+		return nil
+	}
+
+	app.demoFrames++
+	if app.demoFrames >= attractDemoFrames || app.demoGame.Player.Health <= 0 || app.demoAI.Stalled() {
+		app.startDemo()
+	}
+	return nil
+}
+
+// stopDemo ends attract mode and shows the main menu again
+func (app *GameApp) stopDemo() {
+	app.demoGame = nil
+	app.demoRunner = nil
+	app.demoAI = nil
+	app.idleFrames = 0
+	app.menuManager.ShowMainMenu()
+}
+
+// anyInputPressed reports whether a key or mouse button is held
+func anyInputPressed() bool {
+	return len(inpututil.AppendPressedKeys(nil)) > 0 ||
+		ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+}
+
 // showGameOver displays game over screen
 func (app *GameApp) showGameOver() {
 	app.inMenu = true
+	app.captureRunStats()
 	app.menuManager.ShowGameOverMenu()
 }
 
+// showVictory displays the victory screen once every boss is defeated
+func (app *GameApp) showVictory() {
+	app.inMenu = true
+	app.captureRunStats()
+	app.menuManager.SetEpilogue(app.gameRunner.Epilogue())
+	app.menuManager.ShowVictoryMenu()
+}
+
+// showBossRushResults displays the time and deaths of a finished boss rush,
+// skipping the epilogue and New Game Plus of a full run
+func (app *GameApp) showBossRushResults() {
+	app.inMenu = true
+	app.menuManager.ShowBossRushResults(app.gameRunner.BossRushResults(), app.currentGame.Seed)
+}
+
+// captureRunStats hands the finished run's statistics to the menu
+func (app *GameApp) captureRunStats() {
+	if app.currentGame == nil || app.currentGame.Achievements == nil {
+		app.menuManager.SetRunStats(nil)
+		return
+	}
+	stats := menu.NewRunStats(app.currentGame.Achievements, app.gameRunner.PlayTime())
+	app.menuManager.SetRunStats(&stats)
+}
+
 // showPauseMenu displays pause menu
 func (app *GameApp) showPauseMenu() {
 	app.inMenu = true
@@ -204,7 +607,16 @@ func main() {
 	playFlag := flag.Bool("play", false, "Launch the game with rendering (default: show main menu)")
 	noMenuFlag := flag.Bool("no-menu", false, "Skip menu and go directly to gameplay")
 	statsOnlyFlag := flag.Bool("stats-only", false, "Generate and show stats only (original behavior)")
+	statsJSONFlag := flag.Bool("stats-json", false, "Generate and print the content summary as JSON")
 	genreFlag := flag.String("genre", "fantasy", "Game genre (fantasy|scifi|horror|cyberpunk|postapoc)")
+	loadoutFlag := flag.String("loadout", engine.DefaultLoadoutName, "Starting loadout (balanced|glass_cannon|tank|agile)")
+	enemiesFlag := flag.String("enemies", "", "JSON file of hand-authored enemy definitions to add")
+	devFlag := flag.Bool("dev", false, "Enable developer controls (F6/F7 frame stepping)")
+	practiceFlag := flag.Bool("practice", false, "Start in a practice room with every ability (F1 spawn enemy, F2 toggle hazards, F4 reset)")
+	enemyDensityFlag := flag.Float64("enemy-density", 1.0, "Enemies per combat room, as a multiple of normal (0-4)")
+	itemDensityFlag := flag.Float64("item-density", 1.0, "Items per treasure room, as a multiple of normal (0-4)")
+	hazardDensityFlag := flag.Float64("hazard-density", 1.0, "Hazards per room, as a multiple of normal (0-4)")
+	combatLogFlag := flag.String("combat-log", "", "Record every damage event and write them to this JSON file on exit")
 	flag.Parse()
 
 	// Validate genre flag
@@ -221,9 +633,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	if _, err := engine.GetLoadout(*loadoutFlag); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid loadout: %v\n", err)
+		os.Exit(1)
+	}
+
+	density := world.Density{Enemies: *enemyDensityFlag, Items: *itemDensityFlag, Hazards: *hazardDensityFlag}
+	if err := density.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid density: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *statsJSONFlag {
+		runStatsJSONMode(*seedFlag, *genreFlag, density)
+		return
+	}
+
 	// Handle legacy stats-only mode
 	if *statsOnlyFlag {
-		runStatsOnlyMode(*seedFlag, *genreFlag)
+		runStatsOnlyMode(*seedFlag, *genreFlag, density)
 		return
 	}
 
@@ -231,22 +659,91 @@ func main() {
 	directPlay := *playFlag && *noMenuFlag
 
 	// Create and run the application
-	app := NewGameApp(directPlay, *seedFlag, *genreFlag)
+	var enemyDefs []entity.EnemyDefinition
+	if *enemiesFlag != "" {
+		defs, err := entity.LoadEnemyDefinitions(*enemiesFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid enemy definitions: %v\n", err)
+			os.Exit(1)
+		}
+		enemyDefs = defs
+	}
+
+	app := NewGameApp(directPlay && !*practiceFlag, *seedFlag, *genreFlag, *loadoutFlag, enemyDefs, density)
+	app.devMode = *devFlag
+	if *combatLogFlag != "" {
+		app.combatLog = engine.NewCombatLog()
+	}
+
+	if *practiceFlag {
+		if err := app.startPractice(*seedFlag); err != nil {
+			fmt.Fprintf(os.Stderr, "Error starting practice: %v\n", err)
+			os.Exit(1)
+		}
+	}
 
-	if err := app.Run(); err != nil {
-		fmt.Fprintf(os.Stderr, "Game error: %v\n", err)
+	runErr := app.Run()
+	if app.combatLog != nil {
+		if err := app.combatLog.WriteFile(*combatLogFlag); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving combat log: %v\n", err)
+		}
+	}
+	if runErr != nil {
+		fmt.Fprintf(os.Stderr, "Game error: %v\n", runErr)
 		os.Exit(1)
 	}
+
+	app.printQualityReport()
 }
 
-// runStatsOnlyMode provides the original stats-only behavior
-func runStatsOnlyMode(seedFlag int64, genre string) {
-	var masterSeed int64
+// printQualityReport summarizes the metrics collected for the last game
+func (app *GameApp) printQualityReport() {
+	if app.metrics == nil {
+		return
+	}
+
+	report := app.metrics.Report(pcg.NewValidator(qualityThreshold))
+	fmt.Println()
+	fmt.Println("📊 QUALITY REPORT")
+	fmt.Printf("  Generation Time:   %d ms\n", report.Metrics.GenerationTime)
+	fmt.Printf("  Average FPS:       %d (%d samples)\n", report.Metrics.PerformanceFPS, app.metrics.Samples())
+	fmt.Printf("  Average TPS:       %.1f\n", app.metrics.AverageTPS())
+	fmt.Printf("  Content Diversity: %.0f\n", report.Metrics.ContentDiversity)
+	fmt.Printf("  Quality Score:     %.2f (passed: %v)\n", report.Score, report.Passed)
+}
+
+// statsSeed resolves the seed flag, using the current time when it is unset
+func statsSeed(seedFlag int64) int64 {
 	if seedFlag == 0 {
-		masterSeed = time.Now().UnixNano()
-	} else {
-		masterSeed = seedFlag
+		return time.Now().UnixNano()
 	}
+	return seedFlag
+}
+
+// runStatsJSONMode prints the generated content summary as JSON
+func runStatsJSONMode(seedFlag int64, genre string, density world.Density) {
+	generator := engine.NewGameGeneratorWithGenre(statsSeed(seedFlag), genre)
+	if err := generator.SetDensity(density); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid density: %v\n", err)
+		os.Exit(1)
+	}
+	game, err := generator.GenerateCompleteGame()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating game: %v\n", err)
+		os.Exit(1)
+	}
+
+	data, err := json.MarshalIndent(engine.NewContentSummary(game), "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error encoding summary: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+}
+
+// runStatsOnlyMode provides the original stats-only behavior
+func runStatsOnlyMode(seedFlag int64, genre string, density world.Density) {
+	masterSeed := statsSeed(seedFlag)
 
 	fmt.Println("╔════════════════════════════════════════════════════════╗")
 	fmt.Println("║                                                        ║")
@@ -264,6 +761,10 @@ func runStatsOnlyMode(seedFlag int64, genre string) {
 
 	// Create game generator with genre
 	generator := engine.NewGameGeneratorWithGenre(masterSeed, genre)
+	if err := generator.SetDensity(density); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid density: %v\n", err)
+		os.Exit(1)
+	}
 
 	// Generate complete game
 	game, err := generator.GenerateCompleteGame()
@@ -279,7 +780,8 @@ func runStatsOnlyMode(seedFlag int64, genre string) {
 	fmt.Println()
 
 	// Display game statistics
-	displayGameStats(game)
+	summary := engine.NewContentSummary(game)
+	displayGameStats(summary)
 
 	fmt.Println()
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
@@ -288,11 +790,11 @@ func runStatsOnlyMode(seedFlag int64, genre string) {
 	fmt.Println()
 
 	// Display achievement info
-	if game.Achievements != nil {
+	if summary.Achievements != nil {
 		fmt.Println("🏆 ACHIEVEMENTS")
 		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-		fmt.Printf("  Total Achievements: %d\n", len(game.Achievements.GetAllAchievements()))
-		fmt.Printf("  Max Points:         %d\n", game.Achievements.GetMaxPoints())
+		fmt.Printf("  Total Achievements: %d\n", summary.Achievements.Total)
+		fmt.Printf("  Max Points:         %d\n", summary.Achievements.MaxPoints)
 		fmt.Println("  Play the game to unlock achievements!")
 		fmt.Println()
 	}
@@ -301,25 +803,28 @@ func runStatsOnlyMode(seedFlag int64, genre string) {
 	game.Run()
 }
 
-func displayGameStats(game *engine.Game) {
+func displayGameStats(summary engine.ContentSummary) {
 	fmt.Println("📖 NARRATIVE")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Printf("  Theme:              %s\n", game.Narrative.Theme)
-	fmt.Printf("  Mood:               %s\n", game.Narrative.Mood)
-	fmt.Printf("  Civilization:       %s\n", game.Narrative.CivilizationType)
-	fmt.Printf("  Catastrophe:        %s\n", game.Narrative.Catastrophe)
-	fmt.Printf("  Player Motivation:  %s\n", game.Narrative.PlayerMotivation)
+	fmt.Printf("  Theme:              %s\n", summary.Narrative.Theme)
+	fmt.Printf("  Mood:               %s\n", summary.Narrative.Mood)
+	fmt.Printf("  Civilization:       %s\n", summary.Narrative.Civilization)
+	fmt.Printf("  Catastrophe:        %s\n", summary.Narrative.Catastrophe)
+	fmt.Printf("  Player Motivation:  %s\n", summary.Narrative.PlayerMotivation)
 	fmt.Println()
 
 	fmt.Println("🌍 WORLD")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Printf("  Total Rooms:        %d\n", len(game.World.Rooms))
-	fmt.Printf("  Boss Rooms:         %d\n", len(game.World.BossRooms))
-	fmt.Printf("  Biomes:             %d\n", len(game.World.Biomes))
-	fmt.Printf("  Grid Size:          %dx%d\n", game.World.Width, game.World.Height)
+	fmt.Printf("  Total Rooms:        %d\n", summary.World.Rooms)
+	fmt.Printf("  Boss Rooms:         %d\n", summary.World.BossRooms)
+	fmt.Printf("  Biomes:             %d\n", len(summary.World.Biomes))
+	fmt.Printf("  Grid Size:          %dx%d\n", summary.World.Width, summary.World.Height)
+	fmt.Printf("  Critical Path:      %d rooms (danger %d, peak %d at room %d)\n",
+		summary.World.Pacing.Length, summary.World.Pacing.TotalDanger,
+		summary.World.Pacing.PeakDanger, summary.World.Pacing.PeakIndex+1)
 	fmt.Println()
 	fmt.Println("  Biome List:")
-	for i, biome := range game.World.Biomes {
+	for i, biome := range summary.World.Biomes {
 		fmt.Printf("    %d. %s (Danger: %d, Temp: %d°C)\n",
 			i+1, biome.Name, biome.DangerLevel, biome.Temperature)
 	}
@@ -327,27 +832,27 @@ func displayGameStats(game *engine.Game) {
 
 	fmt.Println("👾 ENTITIES")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Printf("  Regular Enemies:    %d\n", len(game.Entities))
-	fmt.Printf("  Boss Enemies:       %d\n", len(game.Bosses))
-	fmt.Printf("  Items:              %d\n", len(game.Items))
-	fmt.Printf("  Abilities:          %d\n", len(game.Abilities))
+	fmt.Printf("  Regular Enemies:    %d\n", summary.Entities.Enemies)
+	fmt.Printf("  Boss Enemies:       %d\n", len(summary.Entities.Bosses))
+	fmt.Printf("  Items:              %d\n", summary.Entities.Items)
+	fmt.Printf("  Abilities:          %d\n", len(summary.Entities.Abilities))
 	fmt.Println()
 
-	if len(game.Bosses) > 0 {
+	if len(summary.Entities.Bosses) > 0 {
 		fmt.Println("  Boss Preview:")
-		for i, boss := range game.Bosses {
+		for i, boss := range summary.Entities.Bosses {
 			if i >= 3 {
 				break
 			}
 			fmt.Printf("    - %s (HP: %d, Phases: %d)\n",
-				boss.Name, boss.Health, len(boss.Phases))
+				boss.Name, boss.Health, boss.Phases)
 		}
 		fmt.Println()
 	}
 
-	if len(game.Abilities) > 0 {
+	if len(summary.Entities.Abilities) > 0 {
 		fmt.Println("  Ability Progression:")
-		for i, ability := range game.Abilities {
+		for i, ability := range summary.Entities.Abilities {
 			if i >= 5 {
 				fmt.Println("    ...")
 				break
@@ -360,21 +865,21 @@ func displayGameStats(game *engine.Game) {
 
 	fmt.Println("🎨 GRAPHICS")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Printf("  Sprites Generated:  %d\n", len(game.Graphics.Sprites))
-	fmt.Printf("  Tilesets:           %d\n", len(game.Graphics.Tilesets))
+	fmt.Printf("  Sprites Generated:  %d\n", summary.Graphics.Sprites)
+	fmt.Printf("  Tilesets:           %d\n", summary.Graphics.Tilesets)
 	fmt.Println("  All graphics procedurally generated at runtime!")
 	fmt.Println()
 
 	fmt.Println("🎵 AUDIO")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Printf("  Sound Effects:      %d\n", len(game.Audio.Sounds))
-	fmt.Printf("  Music Tracks:       %d\n", len(game.Audio.Music))
+	fmt.Printf("  Sound Effects:      %d\n", summary.Audio.Sounds)
+	fmt.Printf("  Music Tracks:       %d\n", summary.Audio.Music)
 	fmt.Println("  All audio synthesized at runtime!")
 	fmt.Println()
 
 	fmt.Println("🏛️ FACTIONS")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	for i, faction := range game.Narrative.Factions {
+	for i, faction := range summary.Factions {
 		fmt.Printf("  %d. %s\n", i+1, faction.Name)
 		fmt.Printf("     %s (%s)\n", faction.Description, faction.Relationship)
 	}
@@ -90,6 +90,7 @@ type Statistics struct {
 	TotalDamageDealt int
 	DamageTaken      int
 	PerfectKills     int // Enemies killed without taking damage
+	HazardKills      int // Enemies killed by hazards or falls they were knocked into
 
 	// Exploration
 	RoomsVisited   int
@@ -395,6 +396,12 @@ func (at *AchievementTracker) RecordEnemyKill(wasPerfect bool) {
 	at.checkAchievements()
 }
 
+// RecordHazardKill records an enemy killed by the environment; the kill
+// itself is recorded with RecordEnemyKill
+func (at *AchievementTracker) RecordHazardKill() {
+	at.stats.HazardKills++
+}
+
 // RecordBossKill records a boss defeat
 func (at *AchievementTracker) RecordBossKill(timeTaken int64, wasPerfect bool) {
 	at.stats.BossesDefeated++
@@ -419,6 +426,12 @@ func (at *AchievementTracker) RecordRoomVisit(isPerfect bool) {
 	at.checkAchievements()
 }
 
+// RecordSecretFound records discovering a secret room
+func (at *AchievementTracker) RecordSecretFound() {
+	at.stats.SecretsFound++
+	at.checkAchievements()
+}
+
 // RecordItemCollected records collecting an item
 func (at *AchievementTracker) RecordItemCollected() {
 	at.stats.ItemsCollected++
@@ -210,6 +210,21 @@ func TestRecordCombo(t *testing.T) {
 	}
 }
 
+// TestRecordSecretFound tests that finding a secret room unlocks the
+// secret achievement
+func TestRecordSecretFound(t *testing.T) {
+	tracker := NewAchievementTracker()
+
+	tracker.RecordSecretFound()
+
+	if stats := tracker.GetStatistics(); stats.SecretsFound != 1 {
+		t.Errorf("Expected 1 secret found, got %d", stats.SecretsFound)
+	}
+	if !tracker.IsUnlocked("secret_finder") {
+		t.Error("Expected 'secret_finder' achievement to be unlocked after a secret")
+	}
+}
+
 // TestPerfectRoomTracking tests perfect room tracking
 func TestPerfectRoomTracking(t *testing.T) {
 	tracker := NewAchievementTracker()
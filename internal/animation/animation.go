@@ -109,6 +109,16 @@ func (ac *AnimationController) GetCurrentAnimation() string {
 	return ac.currentAnim
 }
 
+// GetCurrentFrameIndex returns the index of the current animation's
+// current frame, or -1 when there is no current animation
+func (ac *AnimationController) GetCurrentFrameIndex() int {
+	anim, exists := ac.animations[ac.currentAnim]
+	if !exists {
+		return -1
+	}
+	return anim.currentFrame
+}
+
 // IsPlaying returns whether an animation is currently playing
 func (ac *AnimationController) IsPlaying() bool {
 	return ac.playing
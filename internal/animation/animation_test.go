@@ -654,3 +654,19 @@ func TestEnemyAnimationDeterminism(t *testing.T) {
 		}
 	}
 }
+
+func TestControllerGetCurrentFrameIndex(t *testing.T) {
+	frames := []*graphics.Sprite{createTestSprite(1), createTestSprite(2), createTestSprite(3)}
+	controller := NewAnimationController("attack")
+	if got := controller.GetCurrentFrameIndex(); got != -1 {
+		t.Errorf("GetCurrentFrameIndex() = %d with no animation, want -1", got)
+	}
+
+	controller.AddAnimation(NewAnimation("attack", frames, 2, false))
+	for i := 0; i < 2; i++ {
+		controller.Update()
+	}
+	if got := controller.GetCurrentFrameIndex(); got != 1 {
+		t.Errorf("GetCurrentFrameIndex() = %d after one frame's time, want 1", got)
+	}
+}
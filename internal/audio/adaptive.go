@@ -34,6 +34,14 @@ type AdaptiveMusicTrack struct {
 	TargetIntensity  MusicIntensity
 	TransitionSpeed  float64            // How fast to transition (0-1 per update)
 	CurrentMix       map[string]float64 // Current volume per layer
+
+	// ChaseLayer plays while the player is being hunted, whatever the
+	// intensity. It is kept out of Layers so the intensity stack is unchanged.
+	ChaseLayer *MusicLayer
+	Chasing    bool
+
+	// Motif is how the track plays the soundtrack motif, nil without one
+	Motif *MotifVoicing
 }
 
 // NewAdaptiveMusicTrack creates a new adaptive music track
@@ -53,6 +61,17 @@ func (amt *AdaptiveMusicTrack) AddLayer(layer *MusicLayer) {
 	amt.CurrentMix[layer.Name] = 0.0
 }
 
+// SetChaseLayer sets the layer played while the player is being chased
+func (amt *AdaptiveMusicTrack) SetChaseLayer(layer *MusicLayer) {
+	amt.ChaseLayer = layer
+	amt.CurrentMix[layer.Name] = 0.0
+}
+
+// SetChasing turns the chase layer on or off
+func (amt *AdaptiveMusicTrack) SetChasing(chasing bool) {
+	amt.Chasing = chasing
+}
+
 // SetIntensity updates the target intensity level
 func (amt *AdaptiveMusicTrack) SetIntensity(intensity MusicIntensity) {
 	amt.TargetIntensity = intensity
@@ -76,6 +95,16 @@ func (amt *AdaptiveMusicTrack) Update() {
 		diff := targetVolume - currentVolume
 		amt.CurrentMix[layer.Name] = currentVolume + diff*amt.TransitionSpeed
 	}
+
+	// The chase layer fades on the chase flag alone
+	if amt.ChaseLayer != nil {
+		targetVolume := 0.0
+		if amt.Chasing {
+			targetVolume = amt.ChaseLayer.BaseVolume
+		}
+		currentVolume := amt.CurrentMix[amt.ChaseLayer.Name]
+		amt.CurrentMix[amt.ChaseLayer.Name] = currentVolume + (targetVolume-currentVolume)*amt.TransitionSpeed
+	}
 }
 
 // calculateLayerVolume determines the appropriate volume for a layer
@@ -115,8 +144,17 @@ type MusicContext struct {
 	NearbyEnemyCount int
 	PlayerHealthPct  float64
 	RoomDangerLevel  int
+
+	// ChasingEnemyCount is how many enemies are hunting the player, and
+	// ChaseThreshold how many it takes to switch on the chase music
+	ChasingEnemyCount int
+	ChaseThreshold    int
 }
 
+// DefaultChaseThreshold is the number of chasing enemies that starts the
+// chase music
+const DefaultChaseThreshold = 1
+
 // NewMusicContext creates a new music context
 func NewMusicContext() *MusicContext {
 	return &MusicContext{
@@ -125,7 +163,18 @@ func NewMusicContext() *MusicContext {
 		NearbyEnemyCount: 0,
 		PlayerHealthPct:  1.0,
 		RoomDangerLevel:  0,
+		ChaseThreshold:   DefaultChaseThreshold,
+	}
+}
+
+// IsChased reports whether enough enemies are chasing the player for the
+// chase music. It is independent of CalculateIntensity, except that a boss
+// fight keeps its own music.
+func (mc *MusicContext) IsChased() bool {
+	if mc.IsBossFight {
+		return false
 	}
+	return mc.ChasingEnemyCount >= max(mc.ChaseThreshold, 1)
 }
 
 // CalculateIntensity determines the appropriate music intensity
@@ -157,8 +206,14 @@ func (mg *MusicGenerator) GenerateAdaptiveMusicTrack(seed int64, duration float6
 	rng := rand.New(rand.NewSource(seed))
 	progression := mg.generateProgression(rng, 4)
 
-	// Layer 1: Ambient pads (always present at low intensity)
+	// Layer 1: Ambient pads (always present at low intensity), carrying
+	// the soundtrack motif
 	pads := mg.generatePads(progression, rng)
+	if voicing := mg.voiceMotif(); voicing != nil {
+		motif := mg.generateMotifPhrase(voicing, len(pads.Data))
+		pads = mg.Synth.Mix([]*AudioSample{pads, motif}, []float64{1, motifMix})
+		track.Motif = voicing
+	}
 	track.AddLayer(&MusicLayer{
 		Name:         "pads",
 		Audio:        pads,
@@ -202,9 +257,34 @@ func (mg *MusicGenerator) GenerateAdaptiveMusicTrack(seed int64, duration float6
 		MinIntensity: IntensityBoss,
 	})
 
+	// Chase layer: a driving pulse toggled by the chase flag, not intensity
+	track.SetChaseLayer(&MusicLayer{
+		Name:       "chase",
+		Audio:      mg.generateChasePulse(progression),
+		BaseVolume: 0.30,
+	})
+
 	return track
 }
 
+// generateChasePulse creates a low, urgent eighth-note pulse on each chord's
+// root for chase music
+func (mg *MusicGenerator) generateChasePulse(progression ChordProgression) *AudioSample {
+	beatDuration := 60.0 / float64(mg.BPM)
+	noteDuration := beatDuration / 2
+
+	var allSamples []*AudioSample
+	for _, chord := range progression {
+		freq := mg.midiToFreq(chord.Root - 12)
+		for i := 0; i < 8; i++ {
+			note := mg.Synth.GenerateWave(SquareWave, freq, noteDuration)
+			envelope := ADSR{Attack: 0.005, Decay: 0.08, Sustain: 0.4, Release: 0.05}
+			allSamples = append(allSamples, mg.Synth.ApplyEnvelope(note, envelope))
+		}
+	}
+	return mg.concatenateSamples(allSamples)
+}
+
 // generateIntenseLead creates an intense lead melody for boss fights
 func (mg *MusicGenerator) generateIntenseLead(progression ChordProgression, rng *rand.Rand) *AudioSample {
 	beatDuration := 60.0 / float64(mg.BPM)
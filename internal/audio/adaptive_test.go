@@ -321,3 +321,54 @@ func TestMusicContextUpdate(t *testing.T) {
 		t.Error("Expected boss intensity when IsBossFight is true")
 	}
 }
+
+func TestMusicContextChase(t *testing.T) {
+	context := NewMusicContext()
+	if context.IsChased() {
+		t.Error("Expected no chase by default")
+	}
+
+	context.ChasingEnemyCount = 1
+	if !context.IsChased() {
+		t.Error("Expected chase with one chasing enemy")
+	}
+	if context.CalculateIntensity() != IntensityCalm {
+		t.Error("Expected chase to leave intensity unchanged")
+	}
+
+	context.ChaseThreshold = 2
+	if context.IsChased() {
+		t.Error("Expected no chase below the threshold")
+	}
+
+	context.ChasingEnemyCount = 0
+	context.ChaseThreshold = DefaultChaseThreshold
+	if context.IsChased() {
+		t.Error("Expected chase to clear when enemies disengage")
+	}
+}
+
+func TestChaseLayerIgnoresIntensity(t *testing.T) {
+	generator := NewMusicGenerator(44100, 120, 60, MinorScale)
+	track := generator.GenerateAdaptiveMusicTrack(12345, 4.0)
+	if track.ChaseLayer == nil || len(track.ChaseLayer.Audio.Data) == 0 {
+		t.Fatal("Expected a chase layer with audio")
+	}
+
+	track.SetChasing(true)
+	for i := 0; i < 200; i++ {
+		track.Update()
+	}
+	if track.CurrentMix["chase"] < track.ChaseLayer.BaseVolume*0.9 {
+		t.Errorf("Expected chase layer audible while calm, got %v", track.CurrentMix["chase"])
+	}
+
+	track.SetChasing(false)
+	track.SetIntensity(IntensityBoss)
+	for i := 0; i < 200; i++ {
+		track.Update()
+	}
+	if track.CurrentMix["chase"] > 0.01 {
+		t.Errorf("Expected chase layer silent without a chase, got %v", track.CurrentMix["chase"])
+	}
+}
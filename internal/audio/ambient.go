@@ -0,0 +1,240 @@
+package audio
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Ambient bed tuning. Beds loop beneath the music at a fraction of its
+// volume and crossfade when the player crosses into another biome.
+const (
+	AmbientMusicRatio        = 0.35 // Ambient volume relative to the music volume
+	DefaultAmbientFadeFrames = 90   // Frames to crossfade between beds
+	DefaultAmbientDuration   = 6.0  // Seconds of generated audio per looping bed
+)
+
+// AmbientKind is the texture of an ambient bed
+type AmbientKind string
+
+const (
+	AmbientDrips   AmbientKind = "drips"   // Water dripping in the dark
+	AmbientBirds   AmbientKind = "birds"   // Breeze with distant birdsong
+	AmbientDrone   AmbientKind = "drone"   // Slow, hollow swells
+	AmbientShimmer AmbientKind = "shimmer" // High, glassy tones
+	AmbientRumble  AmbientKind = "rumble"  // Deep, uneasy rumble
+	AmbientGale    AmbientKind = "gale"    // Open-air wind gusts
+	AmbientHum     AmbientKind = "hum"     // Steady low hum
+)
+
+// ambientKinds maps each biome music mood to its ambient bed
+var ambientKinds = map[string]AmbientKind{
+	"dark_ambient": AmbientDrips,
+	"peaceful":     AmbientBirds,
+	"mysterious":   AmbientDrone,
+	"ethereal":     AmbientShimmer,
+	"horror":       AmbientRumble,
+	"uplifting":    AmbientGale,
+	"neutral":      AmbientHum,
+}
+
+// ambientVolumes is each bed's base volume, evening out how loud the
+// textures sound
+var ambientVolumes = map[AmbientKind]float64{
+	AmbientDrips:   0.6,
+	AmbientBirds:   0.5,
+	AmbientDrone:   0.5,
+	AmbientShimmer: 0.4,
+	AmbientRumble:  0.7,
+	AmbientGale:    0.6,
+	AmbientHum:     0.4,
+}
+
+// AmbientKindForMood returns the ambient bed for a biome music mood (see
+// world.Biome.GetMusicMood); unknown moods get a plain hum
+func AmbientKindForMood(mood string) AmbientKind {
+	if kind, ok := ambientKinds[mood]; ok {
+		return kind
+	}
+	return AmbientHum
+}
+
+// AmbientGenerator generates looping ambient beds
+type AmbientGenerator struct {
+	Synth *Synthesizer
+}
+
+// NewAmbientGenerator creates a new ambient generator
+func NewAmbientGenerator(sampleRate int) *AmbientGenerator {
+	return &AmbientGenerator{
+		Synth: NewSynthesizer(sampleRate),
+	}
+}
+
+// Generate creates the ambient bed for a biome music mood as a music
+// layer named after its kind
+func (ag *AmbientGenerator) Generate(mood string, seed int64, duration float64) *MusicLayer {
+	rng := rand.New(rand.NewSource(seed))
+	kind := AmbientKindForMood(mood)
+
+	var sample *AudioSample
+	switch kind {
+	case AmbientDrips:
+		sample = ag.generateDrips(rng, duration)
+	case AmbientBirds:
+		sample = ag.Synth.Mix([]*AudioSample{
+			ag.generateWind(rng, duration, 400, 0.1),
+			ag.generateChirps(rng, duration),
+		}, []float64{0.6, 0.4})
+	case AmbientDrone:
+		sample = ag.generateSwell(duration, TriangleWave, []float64{73.4, 110}, 0.08)
+	case AmbientShimmer:
+		sample = ag.generateSwell(duration, SineWave, []float64{1318.5, 1760, 2217.5}, 0.5)
+	case AmbientRumble:
+		sample = ag.Synth.Mix([]*AudioSample{
+			ag.generateWind(rng, duration, 80, 0.05),
+			ag.generateSwell(duration, SineWave, []float64{41.2}, 0.2),
+		}, []float64{0.7, 0.5})
+	case AmbientGale:
+		sample = ag.generateWind(rng, duration, 900, 0.25)
+	default:
+		sample = ag.generateSwell(duration, SineWave, []float64{55, 110.5}, 0.05)
+	}
+
+	return &MusicLayer{
+		Name:         "ambient_" + string(kind),
+		Audio:        sample,
+		BaseVolume:   ambientVolumes[kind],
+		MinIntensity: IntensityCalm,
+	}
+}
+
+// generateWind creates low-passed noise whose loudness gusts at gustRate
+// times a second
+func (ag *AmbientGenerator) generateWind(rng *rand.Rand, duration, cutoff, gustRate float64) *AudioSample {
+	numSamples := int(duration * float64(ag.Synth.SampleRate))
+	noise := &AudioSample{Data: make([]float64, numSamples), SampleRate: ag.Synth.SampleRate, Duration: duration}
+	for i := range noise.Data {
+		noise.Data[i] = rng.Float64()*2.0 - 1.0
+	}
+	wind := ag.Synth.ApplyLowPassFilter(noise, cutoff)
+
+	gustPhase := rng.Float64() * 2 * math.Pi
+	for i := range wind.Data {
+		t := float64(i) / float64(ag.Synth.SampleRate)
+		wind.Data[i] *= 0.6 + 0.4*math.Sin(2*math.Pi*gustRate*t+gustPhase)
+	}
+	return wind
+}
+
+// generateDrips scatters short, falling plinks over silence
+func (ag *AmbientGenerator) generateDrips(rng *rand.Rand, duration float64) *AudioSample {
+	numSamples := int(duration * float64(ag.Synth.SampleRate))
+	data := make([]float64, numSamples)
+
+	for t := rng.Float64() * 0.5; t < duration-0.2; t += 0.4 + rng.Float64()*1.2 {
+		drip := ag.Synth.FrequencySweep(SineWave, 1800+rng.Float64()*800, 900, 0.12)
+		drip = ag.Synth.ApplyEnvelope(drip, ADSR{Attack: 0.002, Decay: 0.08, Sustain: 0.1, Release: 0.03})
+		start := int(t * float64(ag.Synth.SampleRate))
+		for i, v := range drip.Data {
+			if start+i < numSamples {
+				data[start+i] += v * 0.5
+			}
+		}
+	}
+	return &AudioSample{Data: data, SampleRate: ag.Synth.SampleRate, Duration: duration}
+}
+
+// generateChirps scatters quick rising birdcalls
+func (ag *AmbientGenerator) generateChirps(rng *rand.Rand, duration float64) *AudioSample {
+	numSamples := int(duration * float64(ag.Synth.SampleRate))
+	data := make([]float64, numSamples)
+
+	for t := rng.Float64(); t < duration-0.3; t += 0.8 + rng.Float64()*1.5 {
+		base := 2000 + rng.Float64()*1500
+		for n := 0; n < 2+rng.Intn(3); n++ {
+			chirp := ag.Synth.FrequencySweep(SineWave, base, base*1.3, 0.06)
+			chirp = ag.Synth.ApplyEnvelope(chirp, ADSR{Attack: 0.01, Decay: 0.02, Sustain: 0.6, Release: 0.02})
+			start := int((t + float64(n)*0.09) * float64(ag.Synth.SampleRate))
+			for i, v := range chirp.Data {
+				if start+i < numSamples {
+					data[start+i] += v * 0.3
+				}
+			}
+		}
+	}
+	return &AudioSample{Data: data, SampleRate: ag.Synth.SampleRate, Duration: duration}
+}
+
+// generateSwell layers steady tones whose loudness swells swellRate times a
+// second
+func (ag *AmbientGenerator) generateSwell(duration float64, wave WaveType, freqs []float64, swellRate float64) *AudioSample {
+	tones := make([]*AudioSample, len(freqs))
+	for i, freq := range freqs {
+		tones[i] = ag.Synth.GenerateWave(wave, freq, duration)
+	}
+	swell := ag.Synth.Mix(tones, nil)
+	for i := range swell.Data {
+		t := float64(i) / float64(ag.Synth.SampleRate)
+		swell.Data[i] *= 0.5 + 0.5*math.Sin(2*math.Pi*swellRate*t)
+	}
+	return swell
+}
+
+// AmbientMixer crossfades between ambient beds as the player moves between
+// biomes, keeping them beneath the music volume
+type AmbientMixer struct {
+	current    *MusicLayer
+	previous   *MusicLayer
+	fade       float64 // Crossfade progress, from 0 (previous bed) to 1 (current bed)
+	fadeFrames int
+	volume     float64 // Music volume the beds are scaled by
+}
+
+// NewAmbientMixer creates a silent mixer that takes fadeFrames to
+// crossfade between beds
+func NewAmbientMixer(fadeFrames int) *AmbientMixer {
+	return &AmbientMixer{fadeFrames: max(fadeFrames, 1), fade: 1, volume: 1}
+}
+
+// SetLayer starts a crossfade to layer; nil fades the ambience out.
+// Setting the bed already playing does nothing.
+func (am *AmbientMixer) SetLayer(layer *MusicLayer) {
+	if layer == am.current {
+		return
+	}
+	am.previous = am.current
+	am.current = layer
+	am.fade = 0
+}
+
+// SetVolume sets the music volume, from 0 to 1, the beds are scaled by
+func (am *AmbientMixer) SetVolume(musicVolume float64) {
+	am.volume = math.Max(0, math.Min(1, musicVolume))
+}
+
+// Current returns the bed being faded to or played, or nil
+func (am *AmbientMixer) Current() *MusicLayer {
+	return am.current
+}
+
+// Update advances the crossfade by a frame
+func (am *AmbientMixer) Update() {
+	if am.fade >= 1 {
+		am.previous = nil
+		return
+	}
+	am.fade = math.Min(1, am.fade+1/float64(am.fadeFrames))
+}
+
+// Mix returns the volume of each audible bed by layer name
+func (am *AmbientMixer) Mix() map[string]float64 {
+	mix := make(map[string]float64, 2)
+	scale := am.volume * AmbientMusicRatio
+	if am.previous != nil && am.fade < 1 {
+		mix[am.previous.Name] = am.previous.BaseVolume * (1 - am.fade) * scale
+	}
+	if am.current != nil {
+		mix[am.current.Name] += am.current.BaseVolume * am.fade * scale
+	}
+	return mix
+}
@@ -0,0 +1,85 @@
+package audio
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAmbientBedsDifferPerMood(t *testing.T) {
+	moods := []string{"dark_ambient", "peaceful", "mysterious", "ethereal", "horror", "uplifting", "neutral"}
+	ag := NewAmbientGenerator(8000)
+
+	names := make(map[string]string)
+	for _, mood := range moods {
+		layer := ag.Generate(mood, 7, 1.0)
+		if other, dup := names[layer.Name]; dup {
+			t.Errorf("moods %q and %q share ambient bed %q", mood, other, layer.Name)
+		}
+		names[layer.Name] = mood
+
+		if layer.Audio == nil || len(layer.Audio.Data) != 8000 {
+			t.Fatalf("mood %q: bed should hold one second of audio", mood)
+		}
+		peak := 0.0
+		for _, v := range layer.Audio.Data {
+			peak = math.Max(peak, math.Abs(v))
+		}
+		if peak == 0 || peak > 1 {
+			t.Errorf("mood %q: bed peak = %v, want audible and unclipped", mood, peak)
+		}
+		if layer.BaseVolume <= 0 {
+			t.Errorf("mood %q: bed has no base volume", mood)
+		}
+	}
+
+	if AmbientKindForMood("unknown") != AmbientHum {
+		t.Error("unknown moods should fall back to the hum bed")
+	}
+	a, b := ag.Generate("peaceful", 3, 0.5), ag.Generate("peaceful", 3, 0.5)
+	for i := range a.Audio.Data {
+		if a.Audio.Data[i] != b.Audio.Data[i] {
+			t.Fatal("beds from the same seed should be identical")
+		}
+	}
+}
+
+func TestAmbientMixerScalesAndCrossfades(t *testing.T) {
+	cave := &MusicLayer{Name: "ambient_drips", BaseVolume: 0.6}
+	sky := &MusicLayer{Name: "ambient_gale", BaseVolume: 0.5}
+
+	am := NewAmbientMixer(10)
+	am.SetVolume(0.5)
+	am.SetLayer(cave)
+	for i := 0; i < 10; i++ {
+		am.Update()
+	}
+	want := 0.6 * 0.5 * AmbientMusicRatio
+	if got := am.Mix()[cave.Name]; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("faded-in bed volume = %v, want %v", got, want)
+	}
+
+	am.SetVolume(0)
+	if got := am.Mix()[cave.Name]; got != 0 {
+		t.Errorf("bed volume with music muted = %v, want 0", got)
+	}
+	am.SetVolume(1)
+
+	am.SetLayer(sky)
+	for i := 0; i < 5; i++ {
+		am.Update()
+	}
+	mix := am.Mix()
+	if mix[cave.Name] <= 0 || mix[sky.Name] <= 0 {
+		t.Fatalf("halfway through a crossfade both beds should play, got %v", mix)
+	}
+	for i := 0; i < 6; i++ {
+		am.Update()
+	}
+	mix = am.Mix()
+	if _, ok := mix[cave.Name]; ok {
+		t.Errorf("old bed should be gone after the crossfade, got %v", mix)
+	}
+	if want := 0.5 * AmbientMusicRatio; math.Abs(mix[sky.Name]-want) > 1e-9 {
+		t.Errorf("new bed volume = %v, want %v", mix[sky.Name], want)
+	}
+}
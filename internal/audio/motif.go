@@ -0,0 +1,92 @@
+package audio
+
+import "math/rand"
+
+// Motif tuning
+const (
+	// MotifLength is the number of notes in the soundtrack motif
+	MotifLength = 5
+
+	// motifMaxDegree is the highest scale degree the motif reaches, a step
+	// into the octave above the root
+	motifMaxDegree = 8
+
+	// motifMix is the motif's volume against the pads it is woven into
+	motifMix = 0.6
+)
+
+// motifBeats are the note lengths, in beats, a motif is built from
+var motifBeats = []float64{0.5, 1, 1, 1.5, 2}
+
+// Motif is the soundtrack's signature phrase, shared by every biome track.
+// It is written in scale degrees and beats, so each track can play it in
+// its own key, scale and tempo, on its own instrument.
+type Motif struct {
+	Degrees []int     // Scale degrees above the track's root; past the scale climbs an octave
+	Beats   []float64 // Length of each note in beats
+}
+
+// GenerateMotif derives the soundtrack motif from a seed. It opens on the
+// root so every voicing of it is anchored in its track's key.
+func GenerateMotif(seed int64) Motif {
+	rng := rand.New(rand.NewSource(seed))
+	motif := Motif{
+		Degrees: make([]int, MotifLength),
+		Beats:   make([]float64, MotifLength),
+	}
+	for i := range motif.Degrees {
+		if i > 0 {
+			motif.Degrees[i] = rng.Intn(motifMaxDegree + 1)
+		}
+		motif.Beats[i] = motifBeats[rng.Intn(len(motifBeats))]
+	}
+	return motif
+}
+
+// MotifVoicing is how one track plays the soundtrack motif
+type MotifVoicing struct {
+	Motif Motif
+	Notes []int // MIDI notes in the track's key and scale
+	Wave  WaveType
+	BPM   int
+}
+
+// SetMotif has the generator's tracks carry motif, played on wave
+func (mg *MusicGenerator) SetMotif(motif Motif, wave WaveType) {
+	mg.Motif = &motif
+	mg.MotifWave = wave
+}
+
+// voiceMotif transposes the generator's motif into its key and scale, an
+// octave above the root, or returns nil when it has no motif
+func (mg *MusicGenerator) voiceMotif() *MotifVoicing {
+	if mg.Motif == nil {
+		return nil
+	}
+	notes := make([]int, len(mg.Motif.Degrees))
+	for i, degree := range mg.Motif.Degrees {
+		octave := degree / len(mg.Scale)
+		notes[i] = mg.RootNote + 12*(octave+1) + mg.Scale[degree%len(mg.Scale)]
+	}
+	return &MotifVoicing{Motif: *mg.Motif, Notes: notes, Wave: mg.MotifWave, BPM: mg.BPM}
+}
+
+// generateMotifPhrase renders the voicing once at the start of the
+// progression, padded with silence to length samples so it loops with the
+// other layers
+func (mg *MusicGenerator) generateMotifPhrase(voicing *MotifVoicing, length int) *AudioSample {
+	beatDuration := 60.0 / float64(voicing.BPM)
+	var notes []*AudioSample
+	for i, note := range voicing.Notes {
+		sample := mg.Synth.GenerateWave(voicing.Wave, mg.midiToFreq(note), voicing.Motif.Beats[i]*beatDuration)
+		envelope := ADSR{Attack: 0.02, Decay: 0.1, Sustain: 0.7, Release: 0.15}
+		notes = append(notes, mg.Synth.ApplyEnvelope(sample, envelope))
+	}
+	phrase := mg.concatenateSamples(notes)
+	if len(phrase.Data) < length {
+		phrase.Data = append(phrase.Data, make([]float64, length-len(phrase.Data))...)
+	}
+	phrase.Data = phrase.Data[:length]
+	phrase.Duration = float64(length) / float64(mg.Synth.SampleRate)
+	return phrase
+}
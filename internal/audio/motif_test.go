@@ -0,0 +1,63 @@
+package audio
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerateMotifDeterministic(t *testing.T) {
+	a, b := GenerateMotif(7), GenerateMotif(7)
+	if !reflect.DeepEqual(a, b) {
+		t.Fatalf("GenerateMotif(7) = %+v then %+v, want the same motif", a, b)
+	}
+	if len(a.Degrees) != MotifLength || len(a.Beats) != MotifLength {
+		t.Fatalf("motif has %d degrees and %d beats, want %d", len(a.Degrees), len(a.Beats), MotifLength)
+	}
+	if a.Degrees[0] != 0 {
+		t.Errorf("motif opens on degree %d, want the root", a.Degrees[0])
+	}
+	if reflect.DeepEqual(a, GenerateMotif(8)) {
+		t.Error("different seeds gave the same motif")
+	}
+}
+
+func TestBiomeTracksShareMotif(t *testing.T) {
+	motif := GenerateMotif(42)
+	dark := NewMusicGenerator(8000, 70, 60, MinorScale)
+	dark.SetMotif(motif, SawtoothWave)
+	bright := NewMusicGenerator(8000, 80, 60, MajorScale)
+	bright.SetMotif(motif, TriangleWave)
+
+	a := dark.GenerateAdaptiveMusicTrack(100, 4)
+	b := bright.GenerateAdaptiveMusicTrack(200, 4)
+	if a.Motif == nil || b.Motif == nil {
+		t.Fatal("track generated without its motif")
+	}
+	if !reflect.DeepEqual(a.Motif.Motif, b.Motif.Motif) {
+		t.Errorf("tracks carry motifs %+v and %+v, want the shared one", a.Motif.Motif, b.Motif.Motif)
+	}
+	if a.Motif.Wave == b.Motif.Wave || a.Motif.BPM == b.Motif.BPM {
+		t.Error("tracks play the motif on the same instrument and tempo")
+	}
+	if reflect.DeepEqual(a.Motif.Notes, b.Motif.Notes) && hasThird(motif) {
+		t.Errorf("minor and major tracks voice the motif alike: %v", a.Motif.Notes)
+	}
+}
+
+// hasThird reports whether the motif touches the third, where major and
+// minor voicings part ways
+func hasThird(m Motif) bool {
+	for _, d := range m.Degrees {
+		if d%len(MinorScale) == 2 {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTrackWithoutMotif(t *testing.T) {
+	track := NewMusicGenerator(8000, 90, 60, MinorScale).GenerateAdaptiveMusicTrack(1, 4)
+	if track.Motif != nil {
+		t.Errorf("track without a motif set carries %+v", track.Motif)
+	}
+}
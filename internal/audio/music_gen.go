@@ -33,6 +33,10 @@ type MusicGenerator struct {
 	BPM      int
 	Scale    Scale
 	RootNote int // MIDI note
+
+	// Soundtrack motif woven into adaptive tracks (see motif.go)
+	Motif     *Motif
+	MotifWave WaveType
 }
 
 // NewMusicGenerator creates a new music generator
@@ -409,6 +409,7 @@ func (ap *AudioPlayer) PreloadGameSounds(sfxGen *SFXGenerator) error {
 		"door":   DoorSFX,
 		"damage": DamageSFX,
 		"land":   LandSFX,
+		"windup": WindupSFX,
 	}
 
 	for name, soundType := range soundTypes {
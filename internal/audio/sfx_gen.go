@@ -16,6 +16,7 @@ const (
 	PickupSFX
 	DoorSFX
 	DamageSFX
+	WindupSFX // Enemy attack wind-up warning
 )
 
 // SFXGenerator generates sound effects
@@ -49,6 +50,8 @@ func (sg *SFXGenerator) Generate(sfxType SFXType, seed int64) *AudioSample {
 		return sg.generateDoor(rng)
 	case DamageSFX:
 		return sg.generateDamage(rng)
+	case WindupSFX:
+		return sg.generateWindup(rng)
 	default:
 		return sg.generateJump(rng)
 	}
@@ -219,6 +222,28 @@ func (sg *SFXGenerator) generateDamage(rng *rand.Rand) *AudioSample {
 	return sg.Synth.ApplyEnvelope(mixed, envelope)
 }
 
+// generateWindup creates the enemy wind-up warning (slow rising growl),
+// distinct from the player's own attack and hit sounds
+func (sg *SFXGenerator) generateWindup(rng *rand.Rand) *AudioSample {
+	startFreq := 80.0 + rng.Float64()*30.0
+	duration := 0.3 + rng.Float64()*0.05
+
+	sweep := sg.Synth.FrequencySweep(SawtoothWave, startFreq, startFreq*2.5, duration)
+	noise := sg.Synth.GenerateWave(NoiseWave, 0, duration)
+	noise = sg.Synth.ApplyLowPassFilter(noise, 200.0)
+
+	mixed := sg.Synth.Mix([]*AudioSample{sweep, noise}, []float64{0.7, 0.3})
+
+	envelope := ADSR{
+		Attack:  0.15,
+		Decay:   0.05,
+		Sustain: 0.7,
+		Release: 0.05,
+	}
+
+	return sg.Synth.ApplyEnvelope(mixed, envelope)
+}
+
 // GenerateExplosion creates explosion sound
 func (sg *SFXGenerator) GenerateExplosion(seed int64) *AudioSample {
 	rng := rand.New(rand.NewSource(seed))
@@ -92,6 +92,7 @@ func TestSFXGeneration(t *testing.T) {
 		{"Pickup", PickupSFX},
 		{"Door", DoorSFX},
 		{"Damage", DamageSFX},
+		{"Windup", WindupSFX},
 	}
 
 	for _, tc := range testCases {
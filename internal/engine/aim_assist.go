@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+)
+
+// AimAssistStrength is how strongly ranged shots are pulled toward enemies
+type AimAssistStrength int
+
+const (
+	// AimAssistOff fires every shot straight ahead
+	AimAssistOff AimAssistStrength = iota
+	// AimAssistSoft bends shots partway toward enemies close to the line
+	// of fire
+	AimAssistSoft
+	// AimAssistStrong snaps shots onto enemies within a wide angle
+	AimAssistStrong
+)
+
+// AimAssistRange is how far ahead, in pixels, aim assist looks for a target
+const AimAssistRange = 320.0
+
+// aimAssistNames are the settings-file names of each strength
+var aimAssistNames = map[AimAssistStrength]string{
+	AimAssistOff:    "off",
+	AimAssistSoft:   "soft",
+	AimAssistStrong: "strong",
+}
+
+// String returns the settings-file name of the strength
+func (s AimAssistStrength) String() string {
+	if name, ok := aimAssistNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseAimAssist converts a settings-file name back to a strength
+func ParseAimAssist(name string) (AimAssistStrength, error) {
+	for strength, strengthName := range aimAssistNames {
+		if strengthName == name {
+			return strength, nil
+		}
+	}
+	return AimAssistOff, fmt.Errorf("unknown aim assist strength %q", name)
+}
+
+// Threshold returns the largest angle, in radians, between the facing
+// direction and an enemy that the assist will aim at
+func (s AimAssistStrength) Threshold() float64 {
+	switch s {
+	case AimAssistSoft:
+		return math.Pi / 9 // 20 degrees
+	case AimAssistStrong:
+		return math.Pi * 2 / 9 // 40 degrees
+	default:
+		return 0
+	}
+}
+
+// pull returns how far a shot turns toward its target: 0 leaves it
+// straight, 1 aims it dead on
+func (s AimAssistStrength) pull() float64 {
+	switch s {
+	case AimAssistSoft:
+		return 0.5
+	case AimAssistStrong:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SetAimAssist sets how strongly ranged shots are pulled toward enemies
+func (gr *GameRunner) SetAimAssist(strength AimAssistStrength) {
+	gr.aimAssist = strength
+}
+
+// rangedAimDirection returns the direction to fire a ranged shot: straight
+// in the facing direction, turned toward the nearest live enemy within
+// AimAssistRange and the assist's angle of it
+func (gr *GameRunner) rangedAimDirection() (dirX, dirY float64) {
+	dirX, dirY = gr.playerFacingDir, 0
+	if gr.aimAssist == AimAssistOff {
+		return dirX, dirY
+	}
+
+	cone := entity.VisionCone{HalfAngle: gr.aimAssist.Threshold(), Range: AimAssistRange}
+	px := gr.game.Player.X + physics.PlayerWidth/2
+	py := gr.game.Player.Y + gr.playerHeight()/2
+	best := math.Inf(1)
+	var targetX, targetY float64
+	for _, enemy := range gr.enemyInstances {
+		if enemy.IsDead() {
+			continue
+		}
+		ex, ey, ew, eh := enemy.GetBounds()
+		dx, dy := ex+ew/2-px, ey+eh/2-py
+		if !cone.Contains(dx, dy, gr.playerFacingDir) {
+			continue
+		}
+		if dist := math.Hypot(dx, dy); dist > 0 && dist < best {
+			best = dist
+			targetX, targetY = dx/dist, dy/dist
+		}
+	}
+	if math.IsInf(best, 1) {
+		return dirX, dirY
+	}
+
+	pull := gr.aimAssist.pull()
+	return dirX + (targetX-dirX)*pull, dirY + (targetY-dirY)*pull
+}
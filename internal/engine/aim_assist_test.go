@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+)
+
+// newAimTestRunner faces the player right with one enemy whose centre is
+// offset (dx, dy) from the player's
+func newAimTestRunner(t *testing.T, dx, dy float64) (*GameRunner, *entity.EnemyInstance) {
+	t.Helper()
+	gr := newTestRunner(t)
+	gr.playerFacingDir = 1
+	enemy := entity.NewEnemyInstance(&entity.Enemy{Health: 50, Size: entity.MediumEnemy}, 0, 0)
+	_, _, ew, eh := enemy.GetBounds()
+	enemy.X = gr.game.Player.X + physics.PlayerWidth/2 + dx - ew/2
+	enemy.Y = gr.game.Player.Y + physics.PlayerHeight/2 + dy - eh/2
+	gr.enemyInstances = []*entity.EnemyInstance{enemy}
+	return gr, enemy
+}
+
+// fireAndTrack fires a ranged shot and advances it until it hits the enemy
+// or expires, returning whether it hit and its starting velocity
+func fireAndTrack(gr *GameRunner, enemy *entity.EnemyInstance) (hit bool, velX, velY float64) {
+	dirX, dirY := gr.rangedAimDirection()
+	gr.combatSystem.PlayerRangedAttackToward(gr.game.Player.X, gr.game.Player.Y, dirX, dirY, 10)
+	shot := gr.combatSystem.GetProjectiles()[0]
+	for i := 0; i < 60 && len(gr.combatSystem.GetProjectiles()) > 0; i++ {
+		gr.combatSystem.Update()
+		if gr.combatSystem.CheckProjectileEnemyHit(enemy) > 0 {
+			return true, shot.VelX, shot.VelY
+		}
+	}
+	return false, shot.VelX, shot.VelY
+}
+
+func TestStrongAimAssistHomesOnOffAngleEnemy(t *testing.T) {
+	// 25 degrees above the line of fire: a straight shot misses
+	gr, enemy := newAimTestRunner(t, 200, -200*math.Tan(25*math.Pi/180))
+	gr.SetAimAssist(AimAssistStrong)
+
+	hit, velX, velY := fireAndTrack(gr, enemy)
+	if !hit {
+		t.Error("strong aim assist shot missed an enemy 25 degrees off the facing")
+	}
+	if angle := math.Atan2(-velY, velX); angle <= 0 || angle > AimAssistStrong.Threshold() {
+		t.Errorf("shot angle = %.1f degrees, want up toward the enemy within %.1f",
+			angle*180/math.Pi, AimAssistStrong.Threshold()*180/math.Pi)
+	}
+}
+
+func TestAimAssistOffFiresStraight(t *testing.T) {
+	gr, enemy := newAimTestRunner(t, 200, -200*math.Tan(25*math.Pi/180))
+	gr.SetAimAssist(AimAssistOff)
+
+	hit, velX, velY := fireAndTrack(gr, enemy)
+	if velY != 0 || velX <= 0 {
+		t.Errorf("shot velocity = (%v, %v) with aim assist off, want straight ahead", velX, velY)
+	}
+	if hit {
+		t.Error("straight shot hit an enemy 25 degrees off the facing")
+	}
+}
+
+func TestAimAssistIgnoresEnemiesOutsideThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		strength AimAssistStrength
+		dx, dy   float64
+	}{
+		{"soft beyond its angle", AimAssistSoft, 150, -150 * math.Tan(30*math.Pi/180)},
+		{"strong beyond its angle", AimAssistStrong, 150, -150 * math.Tan(60*math.Pi/180)},
+		{"behind the player", AimAssistStrong, -150, 0},
+		{"out of range", AimAssistStrong, AimAssistRange + 50, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr, _ := newAimTestRunner(t, tt.dx, tt.dy)
+			gr.SetAimAssist(tt.strength)
+			if dx, dy := gr.rangedAimDirection(); dx != 1 || dy != 0 {
+				t.Errorf("aim = (%v, %v), want straight ahead", dx, dy)
+			}
+		})
+	}
+}
+
+func TestSoftAimAssistBendsPartway(t *testing.T) {
+	gr, _ := newAimTestRunner(t, 200, -200*math.Tan(15*math.Pi/180))
+	gr.SetAimAssist(AimAssistSoft)
+
+	dx, dy := gr.rangedAimDirection()
+	angle := math.Atan2(-dy, dx)
+	if angle <= 0 || angle >= 15*math.Pi/180 {
+		t.Errorf("soft aim angle = %.1f degrees, want between straight and the enemy at 15", angle*180/math.Pi)
+	}
+}
+
+func TestParseAimAssist(t *testing.T) {
+	for _, strength := range []AimAssistStrength{AimAssistOff, AimAssistSoft, AimAssistStrong} {
+		parsed, err := ParseAimAssist(strength.String())
+		if err != nil || parsed != strength {
+			t.Errorf("ParseAimAssist(%q) = %v, %v", strength.String(), parsed, err)
+		}
+	}
+	if _, err := ParseAimAssist("sticky"); err == nil {
+		t.Error("ParseAimAssist accepted an unknown strength")
+	}
+}
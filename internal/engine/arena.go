@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"strings"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/render"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// ArenaHazardKind is the fight-only hazard a boss arena uses
+type ArenaHazardKind int
+
+const (
+	// FallingDebris drops chunks of ceiling onto marked spots
+	FallingDebris ArenaHazardKind = iota
+	// RisingLava floods the floor in slow waves
+	RisingLava
+	// ElectrifiedFloor arcs through alternating floor segments
+	ElectrifiedFloor
+)
+
+// Arena hazard tuning, in frames and pixels
+const (
+	ArenaLavaPeriod    = 480 // One full rise and fall of the lava
+	ArenaLavaMaxHeight = 64  // How far above the floor the lava peaks
+	ArenaLavaDamage    = 10
+
+	ArenaDebrisInterval = 90 // Frames between debris drops
+	ArenaDebrisWarning  = 45 // Frames a drop spot is marked before it lands
+	ArenaDebrisSize     = 32
+	ArenaDebrisDamage   = 15
+
+	ArenaFloorSegments  = 4   // Electrified floor is split into this many strips
+	ArenaElectricPeriod = 120 // Frames before the live strips swap
+	ArenaElectricWarmup = 40  // Frames strips crackle before going live
+	ArenaElectricHeight = 12
+	ArenaElectricDamage = 12
+)
+
+// ArenaZone is one area of an arena hazard. Warning zones show where the
+// hazard is about to strike and deal no damage.
+type ArenaZone struct {
+	X, Y, W, H float64
+	Warning    bool
+}
+
+// BossArena runs a boss room's arena hazard. It stays inert until the boss
+// notices the player, then runs until the boss is defeated.
+type BossArena struct {
+	kind     ArenaHazardKind
+	floorY   float64
+	active   bool
+	finished bool
+	frame    int
+}
+
+// ArenaHazardKindFor picks the arena hazard to suit a boss's element, or
+// failing that its biome: fire bosses and scorching biomes flood with lava,
+// lightning and energy bring an electrified floor, and the rest drop debris
+func ArenaHazardKindFor(biome *world.Biome, element string) ArenaHazardKind {
+	switch strings.ToLower(element) {
+	case "fire", "lava":
+		return RisingLava
+	case "lightning", "electric", "energy":
+		return ElectrifiedFloor
+	case "earth", "stone":
+		return FallingDebris
+	}
+	if biome == nil {
+		return FallingDebris
+	}
+	if biome.Temperature > 30 {
+		return RisingLava
+	}
+	for _, hazard := range biome.Hazards {
+		if hazard == "lightning" || hazard == "energy" {
+			return ElectrifiedFloor
+		}
+	}
+	return FallingDebris
+}
+
+// NewBossArena creates the arena hazard for a boss fought in room
+func NewBossArena(room *world.Room, boss *entity.Boss) *BossArena {
+	return &BossArena{
+		kind:   ArenaHazardKindFor(room.Biome, boss.Element),
+		floorY: findGroundY(room),
+	}
+}
+
+// Kind returns the arena's hazard
+func (ba *BossArena) Kind() ArenaHazardKind {
+	return ba.kind
+}
+
+// Active reports whether the hazard is running
+func (ba *BossArena) Active() bool {
+	return ba.active
+}
+
+// Update starts the hazard once the boss is alerted to the player and shuts
+// it down for good when the boss dies
+func (ba *BossArena) Update(boss *entity.EnemyInstance) {
+	if ba.finished {
+		return
+	}
+	if boss.IsDead() {
+		ba.active = false
+		ba.finished = true
+		return
+	}
+	if !ba.active && boss.Awareness() == entity.Alerted {
+		ba.active = true
+		ba.frame = 0
+	}
+	if ba.active {
+		ba.frame++
+	}
+}
+
+// Zones returns the hazard's current areas, for drawing and damage
+func (ba *BossArena) Zones() []ArenaZone {
+	if !ba.active {
+		return nil
+	}
+	width := float64(render.ScreenWidth)
+
+	switch ba.kind {
+	case RisingLava:
+		// Rise for the first half of the period, recede for the second
+		phase := ba.frame % ArenaLavaPeriod
+		if phase > ArenaLavaPeriod/2 {
+			phase = ArenaLavaPeriod - phase
+		}
+		height := ArenaLavaMaxHeight * float64(phase) / float64(ArenaLavaPeriod/2)
+		top := ba.floorY - height
+		return []ArenaZone{{X: 0, Y: top, W: width, H: float64(render.ScreenHeight) - top}}
+
+	case ElectrifiedFloor:
+		segment := width / ArenaFloorSegments
+		cycle := ba.frame / ArenaElectricPeriod
+		warning := ba.frame%ArenaElectricPeriod < ArenaElectricWarmup
+		zones := make([]ArenaZone, 0, ArenaFloorSegments/2+1)
+		for i := cycle % 2; i < ArenaFloorSegments; i += 2 {
+			zones = append(zones, ArenaZone{
+				X: float64(i) * segment, Y: ba.floorY - ArenaElectricHeight,
+				W: segment, H: ArenaElectricHeight, Warning: warning,
+			})
+		}
+		return zones
+
+	default: // FallingDebris
+		// Each drop marks a spot on the floor, then falls onto it; spots
+		// step across the arena so drops never repeat in place
+		drop := ba.frame / ArenaDebrisInterval
+		phase := ba.frame % ArenaDebrisInterval
+		columns := int(width / ArenaDebrisSize)
+		x := float64((drop*7+3)%columns) * ArenaDebrisSize
+		if phase < ArenaDebrisWarning {
+			return []ArenaZone{{X: x, Y: ba.floorY - 4, W: ArenaDebrisSize, H: 4, Warning: true}}
+		}
+		fall := float64(phase-ArenaDebrisWarning) / float64(ArenaDebrisInterval-ArenaDebrisWarning)
+		y := fall * (ba.floorY - ArenaDebrisSize)
+		return []ArenaZone{{X: x, Y: y, W: ArenaDebrisSize, H: ArenaDebrisSize}}
+	}
+}
+
+// damage returns how hard the hazard hits
+func (ba *BossArena) damage() int {
+	switch ba.kind {
+	case RisingLava:
+		return ArenaLavaDamage
+	case ElectrifiedFloor:
+		return ArenaElectricDamage
+	default:
+		return ArenaDebrisDamage
+	}
+}
+
+// CheckPlayerHit damages the player if their hurtbox, playerH tall, is in
+// a live zone and returns the damage dealt
+func (ba *BossArena) CheckPlayerHit(player *Player, playerH float64, cs *CombatSystem) int {
+	for _, zone := range ba.Zones() {
+		if zone.Warning {
+			continue
+		}
+		if physics.AABBOverlap(player.X, player.Y, physics.PlayerWidth, playerH, zone.X, zone.Y, zone.W, zone.H) {
+			before := player.Health
+			cs.attributeDamage(CombatLogArena)
+			cs.ApplyDamageToPlayer(player, ba.damage(), zone.X+zone.W/2)
+			return before - player.Health
+		}
+	}
+	return 0
+}
@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestArenaHazardKindFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		biome   *world.Biome
+		element string
+		want    ArenaHazardKind
+	}{
+		{"fire element", &world.Biome{Name: "cave"}, "fire", RisingLava},
+		{"lightning element", &world.Biome{Name: "cave"}, "lightning", ElectrifiedFloor},
+		{"scorching biome", &world.Biome{Name: "abyss", Temperature: 35}, "", RisingLava},
+		{"stormy biome", &world.Biome{Name: "sky", Hazards: []string{"wind", "lightning"}}, "", ElectrifiedFloor},
+		{"plain biome", &world.Biome{Name: "cave", Hazards: []string{"spike"}}, "", FallingDebris},
+		{"element beats biome", &world.Biome{Name: "sky", Hazards: []string{"lightning"}}, "fire", RisingLava},
+	}
+	for _, tt := range tests {
+		if got := ArenaHazardKindFor(tt.biome, tt.element); got != tt.want {
+			t.Errorf("%s: ArenaHazardKindFor() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// arenaFight returns an arena, its boss and a player standing in the arena's
+// first live zone once it is running
+func arenaFight(kind string) (*BossArena, *entity.EnemyInstance, *Player) {
+	room := &world.Room{Biome: &world.Biome{Name: "cave"}}
+	boss := &entity.Boss{Enemy: entity.Enemy{Health: 500, Size: entity.BossEnemy, Element: kind}}
+	arena := NewBossArena(room, boss)
+	instance := entity.NewEnemyInstance(&boss.Enemy, 400, 100)
+	player := &Player{Health: 100, MaxHealth: 100}
+	return arena, instance, player
+}
+
+// standInLiveZone moves the player into the arena's first damaging zone,
+// running the arena until one appears
+func standInLiveZone(t *testing.T, arena *BossArena, boss *entity.EnemyInstance, player *Player) {
+	t.Helper()
+	for i := 0; i < ArenaLavaPeriod; i++ {
+		for _, zone := range arena.Zones() {
+			if !zone.Warning && zone.H > 0 {
+				player.X = zone.X + zone.W/2 - physics.PlayerWidth/2
+				player.Y = zone.Y + zone.H/2 - physics.PlayerHeight/2
+				return
+			}
+		}
+		arena.Update(boss)
+	}
+	t.Fatal("arena never produced a live zone")
+}
+
+func TestArenaHazardsInertBeforeAggro(t *testing.T) {
+	for _, element := range []string{"fire", "lightning", "earth"} {
+		arena, boss, player := arenaFight(element)
+		for i := 0; i < 300; i++ {
+			arena.Update(boss)
+		}
+		if arena.Active() || len(arena.Zones()) != 0 {
+			t.Errorf("%s arena active before the boss noticed the player", element)
+		}
+
+		player.Y = 0
+		for y := 0.0; y < 640; y += 8 {
+			player.Y = y
+			if dmg := arena.CheckPlayerHit(player, physics.PlayerHeight, NewCombatSystem()); dmg != 0 {
+				t.Fatalf("%s arena dealt %d damage before aggro", element, dmg)
+			}
+		}
+	}
+}
+
+func TestArenaHazardsDamageDuringFight(t *testing.T) {
+	for _, element := range []string{"fire", "lightning", "earth"} {
+		arena, boss, player := arenaFight(element)
+		boss.Alarm()
+		arena.Update(boss)
+		if !arena.Active() {
+			t.Fatalf("%s arena not active once the boss is alerted", element)
+		}
+
+		standInLiveZone(t, arena, boss, player)
+		if dmg := arena.CheckPlayerHit(player, physics.PlayerHeight, NewCombatSystem()); dmg <= 0 {
+			t.Errorf("%s arena dealt no damage to a player in a live zone", element)
+		}
+	}
+}
+
+func TestArenaHazardsStopOnVictory(t *testing.T) {
+	arena, boss, _ := arenaFight("fire")
+	boss.Alarm()
+	arena.Update(boss)
+
+	boss.TakeDamage(boss.CurrentHealth)
+	arena.Update(boss)
+	if arena.Active() || len(arena.Zones()) != 0 {
+		t.Error("arena still active after the boss was defeated")
+	}
+	boss.Alarm()
+	arena.Update(boss)
+	if arena.Active() {
+		t.Error("arena restarted after the boss was defeated")
+	}
+}
+
+func TestArenaWarningZonesDealNoDamage(t *testing.T) {
+	arena, boss, player := arenaFight("earth")
+	boss.Alarm()
+	arena.Update(boss)
+
+	zones := arena.Zones()
+	if len(zones) != 1 || !zones[0].Warning {
+		t.Fatalf("zones = %+v, want a single debris warning", zones)
+	}
+	player.X = zones[0].X
+	player.Y = zones[0].Y - physics.PlayerHeight/2
+	if dmg := arena.CheckPlayerHit(player, physics.PlayerHeight, NewCombatSystem()); dmg != 0 {
+		t.Errorf("warning zone dealt %d damage", dmg)
+	}
+}
@@ -0,0 +1,86 @@
+package engine
+
+import "math"
+
+// AssistConfig is the assist-mode accessibility bundle. Each effect is a
+// multiplier on normal play; achievements stay earnable, but runs that
+// enable it are flagged in their saves.
+type AssistConfig struct {
+	Enabled bool
+
+	PlayerHealthMultiplier float64 // Scales the player's max health
+	EnemySpeedMultiplier   float64 // Scales how far enemies move each frame
+	EnemyDamageMultiplier  float64 // Scales damage the player takes
+}
+
+// DefaultAssistConfig returns assist mode with its standard multipliers
+func DefaultAssistConfig() AssistConfig {
+	return AssistConfig{
+		Enabled:                true,
+		PlayerHealthMultiplier: 1.5,
+		EnemySpeedMultiplier:   0.7,
+		EnemyDamageMultiplier:  0.5,
+	}
+}
+
+// playerHealthScale returns the max-health multiplier in effect
+func (ac AssistConfig) playerHealthScale() float64 {
+	if !ac.Enabled || ac.PlayerHealthMultiplier <= 0 {
+		return 1
+	}
+	return ac.PlayerHealthMultiplier
+}
+
+// enemySpeedScale returns the enemy movement multiplier in effect
+func (ac AssistConfig) enemySpeedScale() float64 {
+	if !ac.Enabled || ac.EnemySpeedMultiplier <= 0 {
+		return 1
+	}
+	return ac.EnemySpeedMultiplier
+}
+
+// enemyDamageScale returns the damage-taken multiplier in effect
+func (ac AssistConfig) enemyDamageScale() float64 {
+	if !ac.Enabled || ac.EnemyDamageMultiplier <= 0 {
+		return 1
+	}
+	return ac.EnemyDamageMultiplier
+}
+
+// SetAssistMode applies an assist configuration, rescaling the player's
+// health from whatever configuration was in effect before. Once a run has
+// used assist mode it stays flagged, even if assist is turned off again.
+func (gr *GameRunner) SetAssistMode(config AssistConfig) {
+	if player := gr.game.Player; player != nil {
+		ratio := config.playerHealthScale() / gr.assist.playerHealthScale()
+		player.MaxHealth = int(math.Round(float64(player.MaxHealth) * ratio))
+		player.Health = min(player.MaxHealth, int(math.Round(float64(player.Health)*ratio)))
+	}
+	gr.assist = config
+	gr.combatSystem.SetDamageTakenScale(config.enemyDamageScale())
+	if config.Enabled {
+		gr.assistUsed = true
+	}
+}
+
+// AssistModeUsed reports whether assist mode was on at any point this run
+func (gr *GameRunner) AssistModeUsed() bool {
+	return gr.assistUsed
+}
+
+// SetDamageTakenScale sets the multiplier applied to damage the player
+// takes. Any hit still deals at least 1 damage.
+func (cs *CombatSystem) SetDamageTakenScale(scale float64) {
+	if scale <= 0 {
+		scale = 1
+	}
+	cs.damageTakenScale = scale
+}
+
+// scaleDamageTaken applies the damage-taken multiplier to a hit
+func (cs *CombatSystem) scaleDamageTaken(damage int) int {
+	if cs.damageTakenScale <= 0 || cs.damageTakenScale == 1 || damage <= 0 {
+		return damage
+	}
+	return max(1, int(math.Round(float64(damage)*cs.damageTakenScale)))
+}
@@ -0,0 +1,78 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/save"
+)
+
+func TestAssistModeScalesPlayerHealthAndDamage(t *testing.T) {
+	gr := newTestRunner(t)
+	baseMax := gr.game.Player.MaxHealth
+
+	gr.SetAssistMode(DefaultAssistConfig())
+	wantMax := int(float64(baseMax) * DefaultAssistConfig().PlayerHealthMultiplier)
+	if gr.game.Player.MaxHealth != wantMax {
+		t.Errorf("MaxHealth = %d with assist, want %d", gr.game.Player.MaxHealth, wantMax)
+	}
+
+	health := gr.game.Player.Health
+	gr.combatSystem.ApplyDamageToPlayer(gr.game.Player, 20, gr.game.Player.X+10)
+	if taken := health - gr.game.Player.Health; taken != 10 {
+		t.Errorf("took %d damage from a 20-damage hit with assist, want 10", taken)
+	}
+
+	// Turning assist back off restores normal health
+	gr.SetAssistMode(AssistConfig{})
+	if gr.game.Player.MaxHealth != baseMax {
+		t.Errorf("MaxHealth = %d after disabling assist, want %d", gr.game.Player.MaxHealth, baseMax)
+	}
+}
+
+func TestAssistModeSlowsEnemies(t *testing.T) {
+	move := func(config AssistConfig) float64 {
+		gr := newTestRunner(t)
+		gr.SetAssistMode(config)
+		enemy := entity.NewEnemyInstance(&entity.Enemy{Health: 50, Speed: 2, Size: entity.MediumEnemy}, 200, 100)
+		enemy.VelX = 4
+		startX := enemy.X
+		gr.updateSingleEnemy(enemy)
+		return enemy.X - startX
+	}
+
+	normal := move(AssistConfig{})
+	assisted := move(DefaultAssistConfig())
+	if assisted >= normal {
+		t.Errorf("enemy moved %v with assist, %v without; want slower with assist", assisted, normal)
+	}
+}
+
+func TestAssistModeTagsRun(t *testing.T) {
+	gr := newTestRunner(t)
+	if gr.CreateSaveData().AssistMode {
+		t.Fatal("expected a run without assist to be untagged")
+	}
+
+	gr.SetAssistMode(DefaultAssistConfig())
+	gr.SetAssistMode(AssistConfig{})
+	if !gr.AssistModeUsed() {
+		t.Error("expected the run to stay flagged after assist is turned off")
+	}
+
+	dir := t.TempDir()
+	sm, err := save.NewSaveManager(dir)
+	if err != nil {
+		t.Fatalf("NewSaveManager() error = %v", err)
+	}
+	if err := sm.SaveGame(gr.CreateSaveData(), 0); err != nil {
+		t.Fatalf("SaveGame() error = %v", err)
+	}
+	info, err := sm.GetSaveInfo(0)
+	if err != nil {
+		t.Fatalf("GetSaveInfo() error = %v", err)
+	}
+	if !info.AssistMode {
+		t.Error("expected the saved run to be flagged as assisted")
+	}
+}
@@ -0,0 +1,455 @@
+// Package engine provides the attract-mode demo AI that plays a generated
+// world on its own while the main menu is idle, walking toward unexplored
+// doors, jumping between platforms, and attacking nearby enemies.
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/input"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Demo AI tuning
+const (
+	demoAggroRange     = 200.0 // Horizontal distance at which enemies are engaged
+	demoAttackRange    = 56.0  // Horizontal distance at which the AI swings
+	demoJumpReach      = 140.0 // Highest platform a single jump can reach
+	demoMaxDrop        = 64.0  // Deepest drop taken when hopping between platforms
+	demoTakeoffSlack   = 6.0   // Horizontal slack when lining up a jump
+	demoDoorLeap       = 48.0  // How far short of a raised doorway to jump from
+	demoJumpHoldFrames = 24    // Frames to hold jump (time to apex at -12 / 0.5)
+	demoStuckFrames    = 20    // Frames without progress before jumping
+	demoArriveDistance = 4.0   // Horizontal slack when walking to a target
+	demoRoomWidth      = 960.0 // Playable room width in pixels
+	demoStallFrames    = 180   // Frames with nowhere to go before the demo is stalled
+)
+
+// DemoAI produces input for attract mode. Feed each frame's NextInput to
+// GameRunner.Step.
+type DemoAI struct {
+	frame       int
+	jumpHold    int
+	idleFrames  int // Consecutive frames with no enemy or door to head for
+	lastX       float64
+	stuckFrames int
+	roomID      int
+	lastVisit   map[int]int          // Room ID -> frame the AI last left it
+	unreachable map[*world.Door]bool // Doors in this room found out of reach
+
+	// Platform hop in progress
+	step     world.Platform
+	takeoffX float64
+	hopping  bool
+}
+
+// NewDemoAI creates a demo AI with no exploration history
+func NewDemoAI() *DemoAI {
+	return &DemoAI{
+		roomID:      -1,
+		lastVisit:   make(map[int]int),
+		unreachable: make(map[*world.Door]bool),
+	}
+}
+
+// NewDemoRunner creates a game runner for attract mode. Saving and
+// checkpoints are disabled so the demo never touches the player's saves.
+func NewDemoRunner(game *Game) *GameRunner {
+	gr := NewGameRunner(game)
+	gr.saveManager = nil
+	gr.checkpointManager = nil
+	gr.tutorial = nil
+	return gr
+}
+
+// NextInput decides the input for the next frame of gr
+func (ai *DemoAI) NextInput(gr *GameRunner) input.InputState {
+	ai.frame++
+	var in input.InputState
+
+	room := gr.game.CurrentRoom
+	if room == nil || gr.transitionHandler.IsTransitioning() {
+		return in
+	}
+	if room.ID != ai.roomID {
+		if ai.roomID >= 0 {
+			ai.lastVisit[ai.roomID] = ai.frame
+		}
+		ai.roomID = room.ID
+		ai.stuckFrames = 0
+		ai.hopping = false
+		ai.unreachable = make(map[*world.Door]bool)
+	}
+
+	px, py := gr.game.Player.X, gr.game.Player.Y
+	centerX := px + physics.PlayerWidth/2
+	feetY := py + physics.PlayerHeight
+
+	// Standing still on a platform flickers OnGround between frames, so
+	// count the first frames after leaving the ground as grounded unless
+	// the player is rising from a jump
+	body := gr.playerBody
+	onGround := body.OnGround || (body.FramesSinceGrounded <= 2 && body.Velocity.Y >= 0)
+
+	// Continue a jump in progress: hold for full height, then release
+	if ai.jumpHold > 0 {
+		ai.jumpHold--
+		if ai.jumpHold == 0 {
+			in.JumpRelease = true
+		} else {
+			in.Jump = true
+		}
+	}
+
+	targetX := centerX
+	wantJump := false
+	ai.idleFrames++
+
+	if enemy := ai.nearestEnemy(gr, centerX, py); enemy != nil {
+		ai.idleFrames = 0
+		ex, _, ew, _ := enemy.GetBounds()
+		targetX = ex + ew/2
+		if math.Abs(targetX-centerX) <= demoAttackRange && ai.frame%2 == 0 {
+			in.Attack = true
+			in.AttackPress = true
+		}
+	} else if door := ai.chooseDoor(gr); door != nil {
+		ai.idleFrames = 0
+		targetX = float64(door.X + door.Width/2)
+		if onGround {
+			ai.hopping = false
+			if takeoff, ok := doorTakeoff(room.Platforms, door, centerX, feetY); ok {
+				// Close enough to leap into the doorway
+				if math.Abs(centerX-takeoff) <= demoTakeoffSlack {
+					wantJump = true
+				} else {
+					targetX = takeoff
+				}
+			} else if !canWalkTo(room.Platforms, door, centerX, py) {
+				ai.step, ai.takeoffX, ai.hopping = nextHop(room.Platforms, centerX, feetY, targetX)
+				if !ai.hopping && onLowestPlatform(room.Platforms, centerX, feetY) {
+					// No way up from the bottom of the room; try another door
+					ai.unreachable[door] = true
+				}
+			}
+		}
+		if ai.hopping {
+			left, right := float64(ai.step.X), float64(ai.step.X+ai.step.Width)
+			switch {
+			case !onGround:
+				// Airborne: steer onto the platform
+				targetX = clamp(targetX, left+physics.PlayerWidth/2, right-physics.PlayerWidth/2)
+			case math.Abs(centerX-ai.takeoffX) <= demoTakeoffSlack:
+				// Jump toward the platform so momentum carries the player on
+				targetX = (left + right) / 2
+				wantJump = true
+			default:
+				targetX = ai.takeoffX
+			}
+		}
+	}
+
+	dx := targetX - centerX
+	moving := false
+	if dx < -demoArriveDistance {
+		in.MoveLeft = true
+		moving = true
+	} else if dx > demoArriveDistance {
+		in.MoveRight = true
+		moving = true
+	}
+
+	// Jump over whatever is blocking progress
+	if moving && onGround && math.Abs(px-ai.lastX) < 0.5 {
+		ai.stuckFrames++
+		if ai.stuckFrames >= demoStuckFrames {
+			wantJump = true
+			ai.stuckFrames = 0
+		}
+	} else {
+		ai.stuckFrames = 0
+	}
+	ai.lastX = px
+
+	if wantJump && onGround && ai.jumpHold == 0 {
+		in.Jump = true
+		in.JumpPress = true
+		in.JumpRelease = false
+		ai.jumpHold = demoJumpHoldFrames
+	}
+
+	return in
+}
+
+// Stalled reports whether the AI has run out of places to go in the
+// current room, e.g. because every exit is out of jump reach. Attract mode
+// uses it to move on to a fresh world.
+func (ai *DemoAI) Stalled() bool {
+	return ai.idleFrames >= demoStallFrames
+}
+
+// nearestEnemy returns the closest living enemy on roughly the player's level
+func (ai *DemoAI) nearestEnemy(gr *GameRunner, centerX, playerY float64) *entity.EnemyInstance {
+	var nearest *entity.EnemyInstance
+	best := demoAggroRange
+	for _, enemy := range gr.enemyInstances {
+		if enemy.IsDead() {
+			continue
+		}
+		ex, ey, ew, _ := enemy.GetBounds()
+		dist := math.Abs(ex + ew/2 - centerX)
+		if dist <= best && math.Abs(ey-playerY) < 64 {
+			best = dist
+			nearest = enemy
+		}
+	}
+	return nearest
+}
+
+// chooseDoor picks the door to head for. Doors to unvisited rooms come
+// first, easiest to reach first; otherwise the room left longest ago.
+func (ai *DemoAI) chooseDoor(gr *GameRunner) *world.Door {
+	var best *world.Door
+	bestScore := math.MaxInt
+	for i := range gr.game.CurrentRoom.Doors {
+		door := &gr.game.CurrentRoom.Doors[i]
+		if door.LeadsTo == nil {
+			continue
+		}
+		if door.Locked && !gr.unlockedDoors[gr.transitionHandler.GetDoorKey(door)] {
+			continue
+		}
+		if ai.unreachable[door] {
+			continue
+		}
+
+		var score int
+		if !gr.visitedRooms[door.LeadsTo.ID] {
+			score = doorReachRank(door.Direction)
+		} else {
+			score = 10 + ai.lastVisit[door.LeadsTo.ID]
+		}
+		if score < bestScore {
+			bestScore = score
+			best = door
+		}
+	}
+	return best
+}
+
+// doorReachRank orders door directions by how easy they are to reach on foot
+func doorReachRank(direction string) int {
+	switch direction {
+	case "south":
+		return 0
+	case "east", "west":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// canWalkTo reports whether the player can reach door by walking along the
+// platform they stand on
+func canWalkTo(platforms []world.Platform, door *world.Door, centerX, playerY float64) bool {
+	if playerY >= float64(door.Y+door.Height) || playerY+physics.PlayerHeight <= float64(door.Y) {
+		return false
+	}
+	floor, ok := platformUnder(platforms, centerX, playerY+physics.PlayerHeight)
+	if !ok {
+		return false
+	}
+	return floor.X < door.X+door.Width && floor.X+floor.Width > door.X
+}
+
+// doorTakeoff returns the x on the player's platform to jump from to reach
+// a door that is above walking height but within one jump. Doors that can
+// be walked into, or that are out of jump range, return false.
+func doorTakeoff(platforms []world.Platform, door *world.Door, centerX, feetY float64) (float64, bool) {
+	doorBottom := float64(door.Y + door.Height)
+	playerY := feetY - physics.PlayerHeight
+	if playerY < doorBottom || playerY-demoJumpReach >= doorBottom {
+		return 0, false
+	}
+	floor, ok := platformUnder(platforms, centerX, feetY)
+	if !ok {
+		return 0, false
+	}
+
+	// Take off a little short of the doorway, without leaving the platform
+	doorLeft, doorRight := float64(door.X), float64(door.X+door.Width)
+	takeoff := centerX
+	if centerX < doorLeft {
+		takeoff = doorLeft - demoDoorLeap
+	} else if centerX > doorRight {
+		takeoff = doorRight + demoDoorLeap
+	}
+	takeoff = clamp(takeoff, float64(floor.X), float64(floor.X+floor.Width))
+	if spanGap(takeoff, doorLeft, doorRight) > demoDoorLeap+physics.PlayerWidth {
+		return 0, false
+	}
+	return takeoff, true
+}
+
+// nextHop picks the platform to jump to next: the one quickest to reach
+// from centerX that is either higher or closer to targetX than the platform
+// the player stands on. It returns the platform and the x to jump from.
+func nextHop(platforms []world.Platform, centerX, feetY, targetX float64) (world.Platform, float64, bool) {
+	var best world.Platform
+	var bestTakeoff float64
+	found := false
+
+	floor, ok := platformUnder(platforms, centerX, feetY)
+	if !ok {
+		return best, 0, false
+	}
+	floorGap := spanGap(targetX, float64(floor.X), float64(floor.X+floor.Width))
+
+	bestScore := math.MaxFloat64
+	for _, p := range platforms {
+		if p == floor {
+			continue
+		}
+		rise := feetY - float64(p.Y)
+		if rise > demoJumpReach || rise < -demoMaxDrop {
+			continue
+		}
+		targetGap := spanGap(targetX, float64(p.X), float64(p.X+p.Width))
+		if rise < 8 && targetGap >= floorGap {
+			continue // Neither higher nor closer, so no progress
+		}
+		takeoff, ok := hopTakeoff(platforms, floor, p, centerX, feetY)
+		if !ok {
+			continue
+		}
+		score := math.Abs(centerX-takeoff) + targetGap/2
+		if score < bestScore {
+			best = p
+			bestTakeoff = takeoff
+			bestScore = score
+			found = true
+		}
+	}
+	return best, bestTakeoff, found
+}
+
+// hopTakeoff returns the x on floor to jump from to land on step. The
+// take-off must be far enough out that the player's head clears the
+// underside of step while rising, yet close enough to land before falling
+// back below its top, with nothing overhead in between.
+func hopTakeoff(platforms []world.Platform, floor, step world.Platform, centerX, feetY float64) (float64, bool) {
+	left, right := float64(step.X), float64(step.X+step.Width)
+	minDist, maxDist := hopDistances(feetY - float64(step.Y))
+
+	// Try the side nearest the player first; dir points away from the step
+	edges := [2]float64{left, right}
+	dirs := [2]float64{-1, 1}
+	if centerX > (left+right)/2 {
+		edges[0], edges[1] = edges[1], edges[0]
+		dirs[0], dirs[1] = dirs[1], dirs[0]
+	}
+
+	floorLeft, floorRight := float64(floor.X), float64(floor.X+floor.Width)
+	for i, edge := range edges {
+		// Distances from the edge that keep the take-off on the floor
+		lo, hi := (floorLeft-edge)*dirs[i], (floorRight-edge)*dirs[i]
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		dist := math.Max(minDist, lo)
+		if dist > math.Min(maxDist, hi) {
+			continue
+		}
+		takeoff := edge + dist*dirs[i]
+		if takeoff < physics.PlayerWidth || takeoff > demoRoomWidth-physics.PlayerWidth {
+			continue
+		}
+		if hopPathClear(platforms, floor, step, takeoff, edge, feetY) {
+			return takeoff, true
+		}
+	}
+	return 0, false
+}
+
+// hopDistances returns the range of horizontal distances from a platform
+// edge, measured from the player's centre, over which a full jump at
+// walking speed lands on a platform rise pixels above the feet
+func hopDistances(rise float64) (float64, float64) {
+	v := -physics.PlayerJumpSpeed
+	g := physics.Gravity
+	disc := math.Sqrt(math.Max(0, v*v-2*g*rise))
+
+	// Frames until the jump first clears the platform top, and until it
+	// falls back below it
+	clearFrames := math.Max(0, (v-disc)/g)
+	landFrames := (v + disc) / g
+
+	half := float64(physics.PlayerWidth) / 2
+	return clearFrames*physics.PlayerSpeed + half, landFrames*physics.PlayerSpeed + half
+}
+
+// hopPathClear reports whether no platform other than floor and step hangs
+// over the jump from takeoff to the step edge
+func hopPathClear(platforms []world.Platform, floor, step world.Platform, takeoff, edge, feetY float64) bool {
+	minX := math.Min(takeoff, edge) - physics.PlayerWidth/2
+	maxX := math.Max(takeoff, edge) + physics.PlayerWidth/2
+	ceiling := math.Min(float64(step.Y), feetY) - physics.PlayerHeight
+
+	for _, p := range platforms {
+		if p == floor || p == step {
+			continue
+		}
+		bottom := float64(p.Y + p.Height)
+		if float64(p.X) < maxX && float64(p.X+p.Width) > minX && bottom <= feetY && bottom > ceiling {
+			return false
+		}
+	}
+	return true
+}
+
+// platformUnder returns the platform whose top is at feetY below centerX
+func platformUnder(platforms []world.Platform, centerX, feetY float64) (world.Platform, bool) {
+	half := float64(physics.PlayerWidth) / 2
+	for _, p := range platforms {
+		if math.Abs(float64(p.Y)-feetY) <= 1 &&
+			centerX+half > float64(p.X) && centerX-half < float64(p.X+p.Width) {
+			return p, true
+		}
+	}
+	return world.Platform{}, false
+}
+
+// onLowestPlatform reports whether the player stands on the room's lowest
+// platform, from which there is nowhere further down to go
+func onLowestPlatform(platforms []world.Platform, centerX, feetY float64) bool {
+	floor, ok := platformUnder(platforms, centerX, feetY)
+	if !ok {
+		return false
+	}
+	for _, p := range platforms {
+		if p.Y > floor.Y {
+			return false
+		}
+	}
+	return true
+}
+
+// spanGap returns the horizontal distance from x to the span [left, right]
+func spanGap(x, left, right float64) float64 {
+	if x < left {
+		return left - x
+	}
+	if x > right {
+		return x - right
+	}
+	return 0
+}
+
+func clamp(v, lo, hi float64) float64 {
+	if hi < lo {
+		return (lo + hi) / 2
+	}
+	return math.Max(lo, math.Min(hi, v))
+}
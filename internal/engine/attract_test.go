@@ -0,0 +1,150 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestDemoAI_ProducesValidInput(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	gr := NewDemoRunner(game)
+	ai := NewDemoAI()
+
+	for frame := 0; frame < 1200; frame++ {
+		in := ai.NextInput(gr)
+		if in.MoveLeft && in.MoveRight {
+			t.Fatalf("frame %d: MoveLeft and MoveRight both pressed", frame)
+		}
+		if in.JumpPress && !in.Jump {
+			t.Fatalf("frame %d: JumpPress without Jump held", frame)
+		}
+		if in.JumpPress && in.JumpRelease {
+			t.Fatalf("frame %d: JumpPress and JumpRelease on the same frame", frame)
+		}
+		if in.AttackPress && !in.Attack {
+			t.Fatalf("frame %d: AttackPress without Attack held", frame)
+		}
+		if in.PausePress || in.Pause {
+			t.Fatalf("frame %d: demo AI pressed pause", frame)
+		}
+		if err := gr.Step(in); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+	}
+}
+
+func TestDemoAI_MakesProgress(t *testing.T) {
+	// Seeds whose starting room can be left without abilities
+	for _, seed := range []int64{2, 8, 42} {
+		game, err := NewGameGenerator(seed).GenerateCompleteGame()
+		if err != nil {
+			t.Fatalf("seed %d: GenerateCompleteGame() error = %v", seed, err)
+		}
+		gr := NewDemoRunner(game)
+		ai := NewDemoAI()
+
+		if err := gr.Step(ai.NextInput(gr)); err != nil {
+			t.Fatalf("seed %d: Step() error = %v", seed, err)
+		}
+		start := gr.VisitedRoomCount()
+
+		for frame := 0; frame < 60*60 && gr.VisitedRoomCount() <= start; frame++ {
+			if err := gr.Step(ai.NextInput(gr)); err != nil {
+				t.Fatalf("seed %d: Step() error = %v", seed, err)
+			}
+		}
+		if got := gr.VisitedRoomCount(); got <= start {
+			t.Errorf("seed %d: VisitedRoomCount() = %d after a minute of demo, want > %d", seed, got, start)
+		}
+	}
+}
+
+func TestDemoAI_StallsWithNoReachableDoor(t *testing.T) {
+	// Seed 3's starting room has every exit out of jump reach
+	game, err := NewGameGenerator(3).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	gr := NewDemoRunner(game)
+	ai := NewDemoAI()
+
+	for frame := 0; frame < 60*30 && !ai.Stalled(); frame++ {
+		if err := gr.Step(ai.NextInput(gr)); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+	}
+	if !ai.Stalled() {
+		t.Error("Stalled() = false after 30 seconds stuck in the starting room")
+	}
+}
+
+func TestNextHop(t *testing.T) {
+	platforms := []world.Platform{
+		{X: 0, Y: 600, Width: 960, Height: 32},   // ground the player stands on
+		{X: 100, Y: 480, Width: 100, Height: 32}, // reachable, away from target
+		{X: 600, Y: 480, Width: 100, Height: 32}, // reachable, toward target
+		{X: 700, Y: 300, Width: 100, Height: 32}, // too high
+	}
+
+	step, takeoff, ok := nextHop(platforms, 400, 600, 800)
+	if !ok {
+		t.Fatal("nextHop() found no platform")
+	}
+	if step.X != 600 {
+		t.Errorf("nextHop() picked platform at x=%d, want 600", step.X)
+	}
+	if takeoff >= 600 {
+		t.Errorf("take-off x = %.0f, want left of the platform edge at 600", takeoff)
+	}
+
+	if _, _, ok := nextHop(platforms[:1], 400, 600, 800); ok {
+		t.Error("nextHop() returned a platform with none in reach")
+	}
+}
+
+func TestHopTakeoff_AvoidsOverhang(t *testing.T) {
+	ground := world.Platform{X: 0, Y: 600, Width: 960, Height: 32}
+	step := world.Platform{X: 400, Y: 470, Width: 100, Height: 32}
+	platforms := []world.Platform{
+		ground,
+		step,
+		{X: 200, Y: 468, Width: 130, Height: 32}, // overhangs the left take-off
+	}
+
+	takeoff, ok := hopTakeoff(platforms, ground, step, 350, 600)
+	if !ok {
+		t.Fatal("hopTakeoff() found no take-off point")
+	}
+	if takeoff <= 500 {
+		t.Errorf("take-off x = %.0f, want right of the platform (left side is covered)", takeoff)
+	}
+}
+
+func TestHopTakeoff_StaysOnFloor(t *testing.T) {
+	floor := world.Platform{X: 100, Y: 450, Width: 120, Height: 32}
+	step := world.Platform{X: 300, Y: 420, Width: 100, Height: 32}
+	platforms := []world.Platform{floor, step}
+
+	takeoff, ok := hopTakeoff(platforms, floor, step, 160, 450)
+	if !ok {
+		t.Fatal("hopTakeoff() found no take-off point")
+	}
+	if takeoff < 100 || takeoff > 220 {
+		t.Errorf("take-off x = %.0f, want on the floor platform [100, 220]", takeoff)
+	}
+}
+
+func TestNewDemoRunner_DisablesSaving(t *testing.T) {
+	game, err := NewGameGenerator(7).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	gr := NewDemoRunner(game)
+	if gr.saveManager != nil || gr.checkpointManager != nil {
+		t.Error("demo runner should not save or checkpoint")
+	}
+}
@@ -0,0 +1,147 @@
+// Package engine provides the boss controller that interprets a boss's
+// scripted attack patterns each frame, applying hitboxes and spawning
+// projectiles through the combat system.
+package engine
+
+import (
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+)
+
+// BossPatternCooldownFrames is the pause between two scripted patterns.
+const BossPatternCooldownFrames = 60
+
+// MiniBossSpecialCooldownFrames is the pause between two of a mini-boss's
+// special attacks; it fights as a regular enemy in between.
+const MiniBossSpecialCooldownFrames = 180
+
+// BossController drives one boss instance through its attack patterns.
+type BossController struct {
+	patternFor     func(healthPercent float64) *entity.AttackPattern
+	instance       *entity.EnemyInstance
+	executor       *entity.PatternExecutor
+	cooldown       int
+	cooldownFrames int
+	facingDir      float64
+	frame          entity.PatternFrame
+}
+
+// NewBossController creates a controller for a spawned boss instance.
+func NewBossController(boss *entity.Boss, instance *entity.EnemyInstance) *BossController {
+	return newPatternController(instance, BossPatternCooldownFrames, func(healthPercent float64) *entity.AttackPattern {
+		return boss.PatternForPhase(boss.PhaseIndex(healthPercent))
+	})
+}
+
+// NewMiniBossController creates a controller that runs a spawned
+// mini-boss's special attack.
+func NewMiniBossController(mb *entity.MiniBoss, instance *entity.EnemyInstance) *BossController {
+	return newPatternController(instance, MiniBossSpecialCooldownFrames, func(float64) *entity.AttackPattern {
+		return &mb.Special
+	})
+}
+
+// newPatternController creates a controller that picks its next pattern
+// from patternFor, waiting cooldownFrames between patterns.
+func newPatternController(instance *entity.EnemyInstance, cooldownFrames int, patternFor func(float64) *entity.AttackPattern) *BossController {
+	return &BossController{
+		patternFor:     patternFor,
+		instance:       instance,
+		cooldown:       cooldownFrames,
+		cooldownFrames: cooldownFrames,
+		facingDir:      1.0,
+		frame:          entity.PatternFrame{MoveIndex: -1},
+	}
+}
+
+// Instance returns the enemy instance this controller drives.
+func (bc *BossController) Instance() *entity.EnemyInstance {
+	return bc.instance
+}
+
+// Update advances the current pattern by one frame. Active hitboxes damage
+// the player on overlap with their hurtbox, playerH tall, and projectile
+// spawns are fired into the combat system.
+func (bc *BossController) Update(player *Player, playerH float64, cs *CombatSystem) {
+	bc.frame = entity.PatternFrame{MoveIndex: -1}
+	if bc.instance.IsDead() {
+		return
+	}
+	// A stagger from a broken poise interrupts the pattern in progress
+	if bc.instance.HitStunFrames > 0 {
+		bc.executor = nil
+		return
+	}
+
+	if bc.executor == nil || bc.executor.Done() {
+		if bc.cooldown > 0 {
+			bc.cooldown--
+			return
+		}
+		pattern := bc.patternFor(bc.healthPercent())
+		if pattern == nil || len(pattern.Moves) == 0 {
+			return
+		}
+		bc.executor = entity.NewPatternExecutor(pattern)
+		bc.cooldown = bc.cooldownFrames
+
+		// Lock facing for the whole pattern so the player can read it
+		bc.facingDir = 1.0
+		if player.X < bc.instance.X {
+			bc.facingDir = -1.0
+		}
+	}
+
+	bc.frame = bc.executor.Step()
+	cx, cy := bc.center()
+
+	if bc.frame.Hitbox != nil {
+		hx, hy, hw, hh := bc.frame.Hitbox.WorldRect(cx, cy, bc.facingDir)
+		if physics.AABBOverlap(player.X, player.Y, physics.PlayerWidth, playerH, hx, hy, hw, hh) {
+			cs.attributeDamage(bc.instance.Enemy.Name)
+			if bc.frame.Hitbox.Unblockable {
+				cs.ApplyUnblockableDamageToPlayer(player, bc.frame.Hitbox.Damage, cx)
+			} else {
+				cs.ApplyDamageToPlayer(player, bc.frame.Hitbox.Damage, cx)
+			}
+		}
+	}
+
+	for _, spawn := range bc.frame.Projectiles {
+		cs.SpawnEnemyProjectileFrom(
+			bc.instance,
+			cx+spawn.OffsetX*bc.facingDir,
+			cy+spawn.OffsetY,
+			spawn.VelX*bc.facingDir,
+			spawn.VelY,
+			spawn.Damage,
+		)
+	}
+}
+
+// AttackArea returns the world-space rectangle of the current move's hitbox
+// and whether it is still telegraphing. ok is false when there is nothing to
+// draw this frame.
+func (bc *BossController) AttackArea() (x, y, w, h float64, telegraphing, ok bool) {
+	if bc.frame.Move == nil || bc.frame.Move.Hitbox == nil {
+		return 0, 0, 0, 0, false, false
+	}
+	if !bc.frame.Telegraphing && bc.frame.Hitbox == nil {
+		return 0, 0, 0, 0, false, false // recovering
+	}
+	cx, cy := bc.center()
+	x, y, w, h = bc.frame.Move.Hitbox.WorldRect(cx, cy, bc.facingDir)
+	return x, y, w, h, bc.frame.Telegraphing, true
+}
+
+func (bc *BossController) center() (float64, float64) {
+	ex, ey, ew, eh := bc.instance.GetBounds()
+	return ex + ew/2, ey + eh/2
+}
+
+func (bc *BossController) healthPercent() float64 {
+	if bc.instance.Enemy.Health <= 0 {
+		return 0
+	}
+	return float64(bc.instance.CurrentHealth) / float64(bc.instance.Enemy.Health)
+}
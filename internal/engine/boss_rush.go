@@ -0,0 +1,230 @@
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/pcg"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Boss rush tuning
+const (
+	// BossRushHealFraction is the share of max health restored between fights
+	BossRushHealFraction = 0.5
+
+	// BossRushIntermissionFrames is the pause after a boss falls before the
+	// next arena, so its death plays out
+	BossRushIntermissionFrames = 2 * playFramesPerSecond
+)
+
+// BossRush is the state behind boss-rush mode: every boss in the world,
+// fought back to back in their own arenas, with a running clock and death
+// count. Dying restarts the current fight.
+type BossRush struct {
+	arenas       []*world.Room // One per boss, in fight order
+	current      int
+	frames       int // Play frames since the rush began
+	deaths       int
+	intermission int // Frames left before the next arena
+	finished     bool
+}
+
+// NewBossRushGame generates a world from seed and cuts it down to its boss
+// arenas in a seeded order. The arenas are stripped of exits and items,
+// keep their hazards, and every ability is granted.
+func NewBossRushGame(seed int64, genreID string) (*Game, error) {
+	game, err := NewGameGeneratorWithGenre(seed, genreID).GenerateCompleteGame()
+	if err != nil {
+		return nil, err
+	}
+
+	// Pair each boss room with its boss as BossForRoom does
+	type fight struct {
+		room *world.Room
+		boss *entity.Boss
+	}
+	var fights []fight
+	handler := NewRoomTransitionHandler(game)
+	for _, room := range game.World.Rooms {
+		if boss := handler.BossForRoom(room); boss != nil {
+			fights = append(fights, fight{room, boss})
+		}
+	}
+	if len(fights) == 0 {
+		return nil, fmt.Errorf("world from seed %d has no bosses", seed)
+	}
+	rng := rand.New(rand.NewSource(pcg.HashSeed(seed, "boss-rush")))
+	rng.Shuffle(len(fights), func(i, j int) { fights[i], fights[j] = fights[j], fights[i] })
+
+	arenas := make([]*world.Room, len(fights))
+	bosses := make([]*entity.Boss, len(fights))
+	nodes := make(map[int]*world.GraphNode, len(fights))
+	for i, f := range fights {
+		arena := *f.room
+		arena.Connections = nil
+		arena.Doors = nil
+		arena.Items = nil
+		arena.ItemSpots = nil
+		arena.HealthPickups = 0
+		arena.Puzzle = nil
+		arena.Secret = false
+		arena.BossRole = world.MandatoryBoss
+		arenas[i], bosses[i] = &arena, f.boss
+		nodes[arena.ID] = &world.GraphNode{RoomID: arena.ID, Depth: i, Required: true}
+	}
+
+	game.World = &world.World{
+		Rooms:     arenas,
+		StartRoom: arenas[0],
+		BossRooms: arenas,
+		Biomes:    game.World.Biomes,
+		Width:     1,
+		Height:    1,
+		Graph:     &world.WorldGraph{Nodes: nodes},
+		Density:   game.World.Density,
+	}
+	game.Bosses = bosses
+	game.CurrentRoom = arenas[0]
+	game.AbilityPedestals = nil
+
+	for _, ability := range practiceAbilities {
+		game.Player.Abilities[ability] = true
+	}
+	return game, nil
+}
+
+// NewBossRushRunner creates a game runner for a boss-rush game and starts
+// the first fight. Saving is disabled so a rush never touches saves.
+func NewBossRushRunner(game *Game) *GameRunner {
+	gr := NewGameRunner(game)
+	gr.saveManager = nil
+	gr.checkpointManager = nil
+	gr.tutorial = nil
+	gr.bossRush = &BossRush{arenas: game.World.Rooms}
+	gr.enterBossRushArena()
+	return gr
+}
+
+// BossRush returns the boss rush, or nil outside boss-rush mode
+func (gr *GameRunner) BossRush() *BossRush {
+	return gr.bossRush
+}
+
+// Fight returns the 1-based number of the current fight
+func (br *BossRush) Fight() int {
+	return min(br.current+1, len(br.arenas))
+}
+
+// Fights returns how many bosses the rush holds
+func (br *BossRush) Fights() int {
+	return len(br.arenas)
+}
+
+// Elapsed returns the play time since the rush began
+func (br *BossRush) Elapsed() time.Duration {
+	return time.Duration(br.frames) * time.Second / playFramesPerSecond
+}
+
+// Deaths returns how many times the player has died during the rush
+func (br *BossRush) Deaths() int {
+	return br.deaths
+}
+
+// Finished reports whether every boss in the rush has been defeated
+func (br *BossRush) Finished() bool {
+	return br.finished
+}
+
+// currentRushBoss returns the boss of the current fight
+func (gr *GameRunner) currentRushBoss() *entity.Boss {
+	return gr.transitionHandler.BossForRoom(gr.game.CurrentRoom)
+}
+
+// enterBossRushArena moves the player into the current fight's arena and
+// spawns its boss
+func (gr *GameRunner) enterBossRushArena() {
+	room := gr.bossRush.arenas[gr.bossRush.current]
+	gr.game.CurrentRoom = room
+	gr.populateCurrentRoom()
+	x, y := standingSpot(room, EntryFallbackX, 0, 1)
+	health := gr.game.Player.Health
+	gr.RespawnPlayer(x, y)
+	gr.game.Player.Health = max(1, health)
+}
+
+// updateBossRush advances the rush for one frame: a dead player restarts
+// the fight at full health, and a defeated boss leads, after a short pause
+// and some healing, to the next arena. The pause after the last boss ends
+// the rush.
+func (gr *GameRunner) updateBossRush() {
+	br := gr.bossRush
+	if br == nil || br.finished {
+		return
+	}
+	// The clock stops as the last boss falls
+	won := br.current >= len(br.arenas)
+	if !won {
+		br.frames++
+	}
+
+	player := gr.game.Player
+	if player.Health <= 0 && won {
+		player.Health = 1 // A stray hit after the last boss cannot undo the win
+	} else if player.Health <= 0 {
+		br.deaths++
+		player.Health = player.MaxHealth
+		br.intermission = 0
+		gr.enterBossRushArena()
+		return
+	}
+
+	if br.intermission > 0 {
+		br.intermission--
+		// The last boss's death plays out before the results
+		if br.intermission == 0 && won {
+			br.finished = true
+		} else if br.intermission == 0 {
+			player.Health = min(player.MaxHealth,
+				player.Health+int(float64(player.MaxHealth)*BossRushHealFraction))
+			gr.enterBossRushArena()
+		}
+		return
+	}
+
+	boss := gr.currentRushBoss()
+	if boss == nil || !gr.defeatedBosses[boss.Name] {
+		return
+	}
+	br.current++
+	br.intermission = BossRushIntermissionFrames
+}
+
+// BossRushResults returns the lines of the results screen shown when the
+// rush is finished: the final time, the bosses beaten and the deaths
+func (gr *GameRunner) BossRushResults() []string {
+	br := gr.bossRush
+	if br == nil {
+		return nil
+	}
+	return []string{
+		gr.loc.Text("results.boss_rush_time", formatRushTime(br.Elapsed())),
+		gr.loc.Text("results.boss_rush_bosses", br.Fights()),
+		gr.loc.Text("results.boss_rush_deaths", br.deaths),
+	}
+}
+
+// formatRushTime formats a rush time as minutes, seconds and hundredths
+func formatRushTime(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	seconds := (d % time.Minute).Seconds()
+	return fmt.Sprintf("%d:%05.2f", minutes, seconds)
+}
+
+// bossRushStatus is the HUD line for the rush: fight, clock and deaths
+func (gr *GameRunner) bossRushStatus() string {
+	br := gr.bossRush
+	return gr.loc.Text("hud.boss_rush", br.Fight(), br.Fights(), formatRushTime(br.Elapsed()), br.deaths)
+}
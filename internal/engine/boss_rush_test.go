@@ -0,0 +1,167 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+func newBossRushRunner(t *testing.T) *GameRunner {
+	t.Helper()
+	game, err := NewBossRushGame(42, "fantasy")
+	if err != nil {
+		t.Fatalf("NewBossRushGame() error = %v", err)
+	}
+	return NewBossRushRunner(game)
+}
+
+// killRushBoss defeats the current fight's boss through the normal kill path
+func killRushBoss(t *testing.T, gr *GameRunner) *entity.Boss {
+	t.Helper()
+	boss := gr.currentRushBoss()
+	if boss == nil {
+		t.Fatal("current arena has no boss")
+	}
+	for _, enemy := range gr.enemyInstances {
+		if enemy.Enemy == &boss.Enemy {
+			enemy.CurrentHealth = 0
+			gr.recordEnemyDeath(enemy)
+			return boss
+		}
+	}
+	t.Fatalf("boss %q not spawned in its arena", boss.Name)
+	return nil
+}
+
+func TestBossRushSequencesEveryBossOnce(t *testing.T) {
+	full, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	handler := NewRoomTransitionHandler(full)
+	want := make(map[string]bool)
+	for _, room := range full.World.Rooms {
+		if boss := handler.BossForRoom(room); boss != nil {
+			want[boss.Name] = true
+		}
+	}
+
+	gr := newBossRushRunner(t)
+	seen := make(map[string]bool)
+	for _, arena := range gr.game.World.Rooms {
+		boss := gr.transitionHandler.BossForRoom(arena)
+		if boss == nil {
+			t.Fatalf("arena %d has no boss", arena.ID)
+		}
+		if seen[boss.Name] {
+			t.Errorf("boss %q fought twice", boss.Name)
+		}
+		seen[boss.Name] = true
+		if len(arena.Doors) != 0 {
+			t.Errorf("arena %d keeps %d doors", arena.ID, len(arena.Doors))
+		}
+	}
+	for name := range want {
+		if !seen[name] {
+			t.Errorf("boss %q missing from the rush", name)
+		}
+	}
+	if len(seen) != len(want) {
+		t.Errorf("rush holds %d bosses, want %d", len(seen), len(want))
+	}
+
+	again := newBossRushRunner(t)
+	for i, arena := range again.game.World.Rooms {
+		if arena.ID != gr.game.World.Rooms[i].ID {
+			t.Fatalf("fight %d is room %d, want %d: same seed should give the same order",
+				i+1, arena.ID, gr.game.World.Rooms[i].ID)
+		}
+	}
+}
+
+func TestBossRushAdvancesOnEachBossDefeat(t *testing.T) {
+	gr := newBossRushRunner(t)
+	br := gr.BossRush()
+
+	for fight := 1; fight <= br.Fights(); fight++ {
+		if br.Fight() != fight || gr.game.CurrentRoom != gr.game.World.Rooms[fight-1] {
+			t.Fatalf("on fight %d in room %d, want fight %d in room %d",
+				br.Fight(), gr.game.CurrentRoom.ID, fight, gr.game.World.Rooms[fight-1].ID)
+		}
+		killRushBoss(t, gr)
+		gr.game.Player.Health = 1
+		for frame := 0; frame <= BossRushIntermissionFrames; frame++ {
+			gr.updateBossRush()
+		}
+		if fight < br.Fights() && gr.game.Player.Health <= 1 {
+			t.Errorf("fight %d: player not healed before the next arena", fight)
+		}
+	}
+
+	if !br.Finished() {
+		t.Error("rush not finished after every boss fell")
+	}
+	if gr.IsRunComplete() {
+		t.Error("finished rush completed the run, which would offer New Game Plus")
+	}
+	if lines := gr.BossRushResults(); len(lines) != 3 || !strings.Contains(lines[0], formatRushTime(br.Elapsed())) {
+		t.Errorf("results = %q, want the time, bosses and deaths", lines)
+	}
+	if br.Elapsed() <= 0 {
+		t.Error("rush clock did not run")
+	}
+}
+
+func TestBossRushDeathRestartsFight(t *testing.T) {
+	gr := newBossRushRunner(t)
+	br := gr.BossRush()
+	boss := gr.currentRushBoss()
+
+	gr.game.Player.Health = 0
+	gr.updateBossRush()
+
+	if br.Deaths() != 1 {
+		t.Errorf("deaths = %d, want 1", br.Deaths())
+	}
+	if gr.game.Player.Health != gr.game.Player.MaxHealth {
+		t.Errorf("health = %d after restart, want %d", gr.game.Player.Health, gr.game.Player.MaxHealth)
+	}
+	if gr.currentRushBoss() != boss || br.Fight() != 1 {
+		t.Error("death moved the rush on instead of restarting the fight")
+	}
+}
+
+func TestBossRushFinishesAfterLastBossFalls(t *testing.T) {
+	gr := newBossRushRunner(t)
+	br := gr.BossRush()
+	for br.Fight() < br.Fights() {
+		killRushBoss(t, gr)
+		for frame := 0; frame <= BossRushIntermissionFrames; frame++ {
+			gr.updateBossRush()
+		}
+	}
+
+	killRushBoss(t, gr)
+	gr.updateBossRush()
+	clock := br.Elapsed()
+	if br.Finished() {
+		t.Fatal("rush finished before the last boss's death played out")
+	}
+
+	// Dying during the final pause neither restarts nor fails the rush
+	gr.game.Player.Health = 0
+	for frame := 0; frame < BossRushIntermissionFrames; frame++ {
+		gr.updateBossRush()
+	}
+	if !br.Finished() {
+		t.Fatal("rush not finished after the final pause")
+	}
+	if br.Deaths() != 0 || gr.game.Player.Health <= 0 {
+		t.Errorf("deaths = %d, health = %d after a hit in the final pause; want the win kept",
+			br.Deaths(), gr.game.Player.Health)
+	}
+	if br.Elapsed() != clock {
+		t.Errorf("clock ran on to %v after the last boss fell at %v", br.Elapsed(), clock)
+	}
+}
@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/render"
+)
+
+// CinematicConfig tunes the letterbox bars and camera pan played for
+// cinematic moments such as a boss intro
+type CinematicConfig struct {
+	BossIntro   bool    // Play a cinematic when a boss room is entered
+	BarHeight   float64 // Height of each letterbox bar, in pixels
+	BarFrames   int     // Frames the bars take to slide in or out
+	HoldFrames  int     // Length of a boss intro before the bars retract
+	PanDistance float64 // How far the camera leans toward the focus, in pixels
+}
+
+// DefaultCinematicConfig returns two-second boss intros with bars an eighth
+// of the screen tall
+func DefaultCinematicConfig() CinematicConfig {
+	return CinematicConfig{
+		BossIntro:   true,
+		BarHeight:   render.DefaultLetterboxHeight,
+		BarFrames:   render.DefaultLetterboxFrames,
+		HoldFrames:  120,
+		PanDistance: 48,
+	}
+}
+
+// cinematic is the letterbox and camera pan in progress
+type cinematic struct {
+	config    CinematicConfig
+	letterbox *render.Letterbox
+
+	frame, frames int     // Progress through a timed cinematic; frames is 0 when untimed
+	dirX, dirY    float64 // Unit direction of the pan
+	panX, panY    float64 // Pan offset applied to the camera this frame
+}
+
+func newCinematic(config CinematicConfig) *cinematic {
+	return &cinematic{
+		config:    config,
+		letterbox: render.NewLetterbox(config.BarHeight, config.BarFrames),
+	}
+}
+
+// SetCinematicConfig changes the cinematic look and whether boss rooms play
+// an intro. Any cinematic in progress ends.
+func (gr *GameRunner) SetCinematicConfig(config CinematicConfig) {
+	gr.cinematic = newCinematic(config)
+}
+
+// StartCinematic slides the letterbox bars in and leans the camera toward
+// (focusX, focusY) and back over frames. With frames of 0 the bars stay
+// until EndCinematic and the camera does not pan.
+func (gr *GameRunner) StartCinematic(focusX, focusY float64, frames int) {
+	c := gr.cinematic
+	c.letterbox.Show()
+	c.frame, c.frames = 0, max(frames, 0)
+	c.dirX, c.dirY = 0, 0
+	dx, dy := focusX-gr.game.Player.X, focusY-gr.game.Player.Y
+	if dist := math.Hypot(dx, dy); dist > 0 {
+		c.dirX, c.dirY = dx/dist, dy/dist
+	}
+}
+
+// EndCinematic slides the letterbox bars back out
+func (gr *GameRunner) EndCinematic() {
+	gr.cinematic.letterbox.Hide()
+	gr.cinematic.frames = 0
+}
+
+// LetterboxHeight returns the current height of each letterbox bar
+func (gr *GameRunner) LetterboxHeight() float64 {
+	return gr.cinematic.letterbox.BarHeight()
+}
+
+// startBossIntro plays the boss room cinematic, focused on the boss
+func (gr *GameRunner) startBossIntro(boss *entity.EnemyInstance) {
+	if !gr.cinematic.config.BossIntro {
+		return
+	}
+	x, y, w, h := boss.GetBounds()
+	gr.StartCinematic(x+w/2, y+h/2, gr.cinematic.config.HoldFrames)
+}
+
+// updateCinematic animates the bars and retracts them once a timed
+// cinematic runs out
+func (gr *GameRunner) updateCinematic() {
+	c := gr.cinematic
+	c.letterbox.Update()
+	if c.frames > 0 {
+		c.frame++
+		if c.frame >= c.frames {
+			gr.EndCinematic()
+		}
+	}
+}
+
+// updateCamera follows the player, then adds the cinematic pan on top. The
+// pan is taken back out first so it never feeds into the follow.
+func (gr *GameRunner) updateCamera() {
+	c := gr.cinematic
+	x, y := gr.renderer.CameraPosition()
+	gr.renderer.SetCameraPosition(x-c.panX, y-c.panY)
+	gr.renderer.UpdateCamera(gr.game.Player.X, gr.game.Player.Y)
+
+	c.panX, c.panY = 0, 0
+	if c.frames > 0 {
+		lean := math.Sin(math.Pi*float64(c.frame)/float64(c.frames)) * c.config.PanDistance
+		c.panX, c.panY = c.dirX*lean, c.dirY*lean
+	}
+	x, y = gr.renderer.CameraPosition()
+	gr.renderer.SetCameraPosition(x+c.panX, y+c.panY)
+}
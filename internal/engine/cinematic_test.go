@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestCinematicLetterboxAnimatesAndRetracts(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetCinematicConfig(CinematicConfig{BarHeight: 60, BarFrames: 20, PanDistance: 0})
+
+	gr.StartCinematic(0, 0, 0)
+	for i := 0; i < 10; i++ {
+		gr.updateCinematic()
+	}
+	if h := gr.LetterboxHeight(); h <= 0 || h >= 60 {
+		t.Errorf("bar height halfway in = %v, want between 0 and 60", h)
+	}
+	for i := 0; i < 10; i++ {
+		gr.updateCinematic()
+	}
+	if h := gr.LetterboxHeight(); h != 60 {
+		t.Errorf("bar height after 20 frames = %v, want 60", h)
+	}
+
+	gr.EndCinematic()
+	for i := 0; i < 20; i++ {
+		gr.updateCinematic()
+	}
+	if h := gr.LetterboxHeight(); h != 0 {
+		t.Errorf("bar height after retracting = %v, want 0", h)
+	}
+}
+
+func TestBossRoomPlaysTimedIntro(t *testing.T) {
+	gr := newTestRunner(t)
+	config := DefaultCinematicConfig()
+	config.HoldFrames = 40
+	gr.SetCinematicConfig(config)
+
+	for _, room := range gr.game.World.Rooms {
+		if room.Type == world.BossRoom && gr.transitionHandler.BossForRoom(room) != nil {
+			gr.game.CurrentRoom = room
+			break
+		}
+	}
+	if gr.game.CurrentRoom.Type != world.BossRoom {
+		t.Skip("seed has no boss room with a boss")
+	}
+	gr.enemyInstances = gr.transitionHandler.SpawnEnemiesForRoom(gr.game.CurrentRoom)
+	gr.attachBossController()
+
+	for i := 0; i < config.BarFrames; i++ {
+		gr.updateCinematic()
+	}
+	if h := gr.LetterboxHeight(); h != config.BarHeight {
+		t.Errorf("bar height during the intro = %v, want %v", h, config.BarHeight)
+	}
+	for i := 0; i < config.HoldFrames+config.BarFrames; i++ {
+		gr.updateCinematic()
+	}
+	if h := gr.LetterboxHeight(); h != 0 {
+		t.Errorf("bar height after the intro = %v, want 0", h)
+	}
+}
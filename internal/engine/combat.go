@@ -6,6 +6,7 @@ import (
 	"math"
 
 	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
 )
 
 const (
@@ -18,6 +19,9 @@ const (
 	// ProjectileCooldownFrames is the minimum delay between ranged attacks.
 	ProjectileCooldownFrames = 25
 
+	// EnemyProjectileMaxRange is the travel distance before an enemy projectile expires (pixels).
+	EnemyProjectileMaxRange = 960.0
+
 	// ParryWindowFrames is the active frame window during which parry can deflect attacks.
 	// At 60fps, 8 frames = ~133ms, requiring precise timing from the player.
 	ParryWindowFrames = 8
@@ -28,6 +32,33 @@ const (
 	// StaggerDurationFrames is how long an entity remains staggered after being hit.
 	// During stagger, the entity cannot attack or use abilities.
 	StaggerDurationFrames = 20
+
+	// HeavyChargeMinFrames is how long attack must be held before releasing
+	// it swings a heavy attack.
+	HeavyChargeMinFrames = 30
+
+	// HeavyChargeFullFrames is the hold time for a fully charged heavy attack.
+	HeavyChargeFullFrames = 90
+
+	// LaunchSpeed is the upward speed a fully charged heavy attack gives a
+	// small enemy (pixels per frame). Bigger enemies are launched less.
+	LaunchSpeed = 10.0
+
+	// LaunchStunFrames is the hit-stun from a fully charged launch. Launched
+	// enemies cannot act until it wears off.
+	LaunchStunFrames = 45
+
+	// JuggleStunFrames is the hit-stun a launched enemy is held in after
+	// each further hit while still airborne.
+	JuggleStunFrames = 20
+
+	// KnockbackSpeed is how hard a hit pushes the player along its
+	// direction (pixels per frame).
+	KnockbackSpeed = 8.0
+
+	// KnockbackLift is the upward pop added to every knockback so the
+	// player is lifted clear of the ground.
+	KnockbackLift = -5.0
 )
 
 // DamageNumber represents floating damage text
@@ -47,6 +78,21 @@ type Projectile struct {
 	Damage       int
 	DistTraveled float64
 	Active       bool
+
+	// Hostile projectiles only: the enemy that fired it, if known, and
+	// whether a parry sent it back (see deflect.go)
+	Source    *entity.EnemyInstance
+	Deflected bool
+}
+
+// AreaHazard is a short-lived circular damage zone, such as the ground
+// left shaking by an enemy slam. It damages the player at most once.
+type AreaHazard struct {
+	X, Y     float64 // Center
+	Radius   float64
+	Damage   int
+	LifeTime int
+	HitDone  bool
 }
 
 // CombatSystem manages all combat interactions
@@ -54,14 +100,27 @@ type CombatSystem struct {
 	playerAttackCooldown int
 	playerAttacking      bool
 	playerAttackFrame    int
+	playerAttackCharge   float64 // 0 for a light attack, up to 1 for a heavy one
 	knockbackVelX        float64
 	knockbackVelY        float64
 	invulnerableFrames   int
+	invulnerableDuration int     // Post-hit i-frames (see invuln.go)
+	damageTakenScale     float64 // Multiplier on damage the player takes (see assist.go)
+
+	// Carried melee weapons (see weapons.go)
+	weapons      []Weapon
+	activeWeapon int
 
 	// Ranged attack
 	rangedCooldown int
 	projectiles    []Projectile
 
+	// Hostile projectiles fired by enemies and bosses
+	enemyProjectiles []Projectile
+
+	// Damage zones left by enemy area attacks
+	areaHazards []AreaHazard
+
 	// Parry system
 	playerParrying     bool
 	parryFrame         int
@@ -72,8 +131,21 @@ type CombatSystem struct {
 	playerStaggered   bool
 	playerStaggerTime int
 
+	// Dodge i-frames, which even unblockable attacks respect (see unblockable.go)
+	dodgeFrames int
+
 	// Damage numbers for visual feedback
 	damageNumbers []DamageNumber
+
+	// Hit-stop freeze on impactful hits (see hitstop.go)
+	hitStop          HitStopConfig
+	hitStopFrames    int
+	swingHitStopDone bool
+
+	// Optional damage event log (see combat_log.go), and who the next hit
+	// on the player is credited to
+	log      *CombatLog
+	attacker string
 }
 
 // NewCombatSystem creates a new combat system
@@ -87,6 +159,7 @@ func NewCombatSystem() *CombatSystem {
 		invulnerableFrames:   0,
 		rangedCooldown:       0,
 		projectiles:          make([]Projectile, 0),
+		enemyProjectiles:     make([]Projectile, 0),
 		playerParrying:       false,
 		parryFrame:           0,
 		parryCooldown:        0,
@@ -94,23 +167,42 @@ func NewCombatSystem() *CombatSystem {
 		playerStaggered:      false,
 		playerStaggerTime:    0,
 		damageNumbers:        make([]DamageNumber, 0),
+		hitStop:              DefaultHitStopConfig(),
+		invulnerableDuration: DefaultInvulnerabilityFrames,
+		damageTakenScale:     1,
+		weapons:              []Weapon{DefaultWeapon()},
 	}
 }
 
 // Update updates combat system state
 func (cs *CombatSystem) Update() {
+	if cs.log != nil {
+		cs.log.Tick()
+	}
+
+	// A hit-stopped swing holds its frame until the freeze ends
+	frozen := cs.hitStopFrames > 0
+	if frozen {
+		cs.hitStopFrames--
+	}
+
 	if cs.playerAttackCooldown > 0 {
 		cs.playerAttackCooldown--
 	}
 
-	if cs.playerAttacking {
+	if cs.playerAttacking && !frozen {
 		cs.playerAttackFrame++
 		if cs.playerAttackFrame > 15 { // Attack lasts 15 frames
 			cs.playerAttacking = false
 			cs.playerAttackFrame = 0
+			cs.playerAttackCharge = 0
 		}
 	}
 
+	if cs.dodgeFrames > 0 {
+		cs.dodgeFrames--
+	}
+
 	if cs.invulnerableFrames > 0 {
 		cs.invulnerableFrames--
 	}
@@ -158,6 +250,29 @@ func (cs *CombatSystem) Update() {
 		}
 	}
 
+	// Update enemy projectiles
+	for i := len(cs.enemyProjectiles) - 1; i >= 0; i-- {
+		p := &cs.enemyProjectiles[i]
+		if !p.Active {
+			cs.enemyProjectiles = append(cs.enemyProjectiles[:i], cs.enemyProjectiles[i+1:]...)
+			continue
+		}
+		p.X += p.VelX
+		p.Y += p.VelY
+		p.DistTraveled += math.Sqrt(p.VelX*p.VelX + p.VelY*p.VelY)
+		if p.DistTraveled >= EnemyProjectileMaxRange {
+			p.Active = false
+		}
+	}
+
+	// Expire area hazards
+	for i := len(cs.areaHazards) - 1; i >= 0; i-- {
+		cs.areaHazards[i].LifeTime--
+		if cs.areaHazards[i].LifeTime <= 0 {
+			cs.areaHazards = append(cs.areaHazards[:i], cs.areaHazards[i+1:]...)
+		}
+	}
+
 	// Update damage numbers
 	for i := len(cs.damageNumbers) - 1; i >= 0; i-- {
 		cs.damageNumbers[i].Y -= cs.damageNumbers[i].VelY
@@ -176,12 +291,38 @@ func (cs *CombatSystem) PlayerAttack() bool {
 	if cs.playerAttackCooldown <= 0 && !cs.playerStaggered {
 		cs.playerAttacking = true
 		cs.playerAttackFrame = 0
-		cs.playerAttackCooldown = 20 // 20 frames between attacks
+		cs.playerAttackCharge = 0
+		cs.playerAttackCooldown = cs.ActiveWeapon().CooldownFrames
+		cs.swingHitStopDone = false
 		return true
 	}
 	return false
 }
 
+// PlayerHeavyAttack initiates a charged attack. charge runs from 0 to 1
+// and scales its damage and how far it launches enemies.
+func (cs *CombatSystem) PlayerHeavyAttack(charge float64) bool {
+	if !cs.PlayerAttack() {
+		return false
+	}
+	cs.playerAttackCharge = math.Max(0, math.Min(1, charge))
+	return true
+}
+
+// HeavyCharge converts frames spent holding attack into a charge level,
+// or 0 if the hold was too short for a heavy attack
+func HeavyCharge(heldFrames int) float64 {
+	if heldFrames < HeavyChargeMinFrames {
+		return 0
+	}
+	return math.Min(1, float64(heldFrames)/HeavyChargeFullFrames)
+}
+
+// AttackCharge returns the charge of the current attack (0 when light)
+func (cs *CombatSystem) AttackCharge() float64 {
+	return cs.playerAttackCharge
+}
+
 // PlayerParry initiates a parry attempt
 func (cs *CombatSystem) PlayerParry() bool {
 	if cs.parryCooldown <= 0 && !cs.playerStaggered && !cs.playerAttacking && !cs.playerParrying {
@@ -234,16 +375,17 @@ func (cs *CombatSystem) GetAttackHitbox(playerX, playerY, facingDir float64) (x,
 		return 0, 0, 0, 0 // No hitbox outside active frames
 	}
 
-	// Attack hitbox in front of player
-	width = 40.0
-	height = 32.0
+	// Attack hitbox in front of player, sized by the active weapon
+	weapon := cs.ActiveWeapon()
+	width = weapon.Range
+	height = weapon.Height
 	x = playerX
 	y = playerY
 
 	if facingDir >= 0 {
 		x = playerX + 32 // Right side
 	} else {
-		x = playerX - 40 // Left side
+		x = playerX - width // Left side
 	}
 
 	return x, y, width, height
@@ -257,16 +399,13 @@ func (cs *CombatSystem) CheckEnemyHit(attackX, attackY, attackW, attackH float64
 
 	ex, ey, ew, eh := enemy.GetBounds()
 
-	// AABB collision check
-	return attackX < ex+ew &&
-		attackX+attackW > ex &&
-		attackY < ey+eh &&
-		attackY+attackH > ey
+	return physics.AABBOverlap(attackX, attackY, attackW, attackH, ex, ey, ew, eh)
 }
 
 // ApplyDamageToEnemy applies damage and knockback to enemy
 func (cs *CombatSystem) ApplyDamageToEnemy(enemy *entity.EnemyInstance, damage int, playerX float64) {
 	enemy.TakeDamage(damage)
+	cs.logDamage(CombatLogPlayer, enemy.Enemy.Name, damage, cs.playerAttackCharge > 0, false, enemy.CurrentHealth)
 
 	// Apply knockback
 	knockbackDir := 1.0
@@ -274,65 +413,122 @@ func (cs *CombatSystem) ApplyDamageToEnemy(enemy *entity.EnemyInstance, damage i
 		knockbackDir = -1.0
 	}
 
-	enemy.VelX = knockbackDir * 5.0
-	enemy.VelY = -3.0
+	enemy.ApplyKnockback(knockbackDir*5.0, -3.0)
+
+	// Hits wear down poise, heavy ones faster; only a broken poise staggers.
+	// An enemy already stunned takes no poise damage, so a juggle can't
+	// chain straight into a stagger
+	if enemy.HitStunFrames == 0 {
+		enemy.ApplyPoiseDamage(float64(damage) * (1 + cs.playerAttackCharge))
+	}
+
+	// Heavy attacks launch enemies light enough to lift; further hits on a
+	// launched enemy keep it stunned in the air for juggling
+	if cs.playerAttackCharge > 0 {
+		if impulse := LaunchImpulse(enemy.Enemy.Size, cs.playerAttackCharge); impulse > 0 {
+			enemy.Launch(impulse, int(LaunchStunFrames*cs.playerAttackCharge))
+		}
+	} else if enemy.HitStunFrames > 0 && !enemy.OnGround {
+		enemy.Stun(JuggleStunFrames)
+	}
+
+	// Heavy attacks count as critical for the hit-stop freeze
+	cs.applyHitStop(enemy, damage, cs.playerAttackCharge > 0)
 
 	// Spawn damage number
 	cs.AddDamageNumber(damage, enemy.X, enemy.Y-10, false)
 }
 
+// LaunchImpulse returns the upward speed a heavy attack of the given charge
+// gives an enemy of the given size. Bosses are too heavy to launch.
+func LaunchImpulse(size entity.EnemySize, charge float64) float64 {
+	var weight float64
+	switch size {
+	case entity.SmallEnemy:
+		weight = 1.0
+	case entity.MediumEnemy:
+		weight = 0.7
+	case entity.LargeEnemy:
+		weight = 0.35
+	default:
+		weight = 0
+	}
+	return LaunchSpeed * charge * weight
+}
+
 // CheckPlayerEnemyCollision checks if player touched enemy
 func (cs *CombatSystem) CheckPlayerEnemyCollision(playerX, playerY, playerW, playerH float64, enemy *entity.EnemyInstance) bool {
-	if cs.invulnerableFrames > 0 {
+	if cs.ignoresHits() {
 		return false // Player is invulnerable
 	}
 
 	ex, ey, ew, eh := enemy.GetBounds()
 
-	return playerX < ex+ew &&
-		playerX+playerW > ex &&
-		playerY < ey+eh &&
-		playerY+playerH > ey
+	return physics.AABBOverlap(playerX, playerY, playerW, playerH, ex, ey, ew, eh)
 }
 
-// ApplyDamageToPlayer applies damage and knockback to player
-func (cs *CombatSystem) ApplyDamageToPlayer(player *Player, damage int, enemyX float64) {
-	if cs.invulnerableFrames > 0 {
+// succeedParry ends a parry that caught an attack
+func (cs *CombatSystem) succeedParry(player *Player) {
+	cs.lastParrySucceeded = true
+	cs.playerParrying = false
+	cs.parryFrame = 0
+	cs.parryCooldown = ParryCooldownFrames / 2 // Shorter cooldown on successful parry
+
+	// Spawn damage number showing "PARRY!"
+	cs.AddDamageNumber(0, player.X, player.Y-20, false)
+}
+
+// ApplyDamageToPlayer applies damage and knockback to player, pushing the
+// player horizontally away from a source at sourceX
+func (cs *CombatSystem) ApplyDamageToPlayer(player *Player, damage int, sourceX float64) {
+	dirX := 1.0
+	if player.X < sourceX {
+		dirX = -1.0
+	}
+	cs.ApplyDamageToPlayerFrom(player, damage, dirX, 0)
+}
+
+// ApplyDamageToPlayerFrom applies damage and knocks the player back along
+// the direction (dirX, dirY), e.g. a projectile's velocity. The direction
+// need not be normalized; a zero direction only lifts the player.
+func (cs *CombatSystem) ApplyDamageToPlayerFrom(player *Player, damage int, dirX, dirY float64) {
+	cs.applyDamageToPlayer(player, damage, dirX, dirY, true)
+}
+
+// applyDamageToPlayer hurts the player unless they are invulnerable or
+// dodging. A parry catches the hit only when it is blockable.
+func (cs *CombatSystem) applyDamageToPlayer(player *Player, damage int, dirX, dirY float64, blockable bool) {
+	if cs.ignoresHits() {
 		return // Player is invulnerable
 	}
 
 	// Check for successful parry
-	if cs.IsInParryWindow() {
-		cs.lastParrySucceeded = true
-		cs.playerParrying = false
-		cs.parryFrame = 0
-		cs.parryCooldown = ParryCooldownFrames / 2 // Shorter cooldown on successful parry
-
-		// Spawn damage number showing "PARRY!"
-		cs.AddDamageNumber(0, player.X, player.Y-20, false)
+	if blockable && cs.IsInParryWindow() {
+		cs.logDamage(cs.attacker, CombatLogPlayer, damage, false, true, player.Health)
+		cs.succeedParry(player)
 		return
 	}
 
+	damage = cs.scaleDamageTaken(damage)
 	player.Health -= damage
 	if player.Health < 0 {
 		player.Health = 0
 	}
+	cs.logDamage(cs.attacker, CombatLogPlayer, damage, false, false, player.Health)
 
-	// Apply knockback
-	knockbackDir := 1.0
-	if player.X < enemyX {
-		knockbackDir = -1.0
+	// Apply knockback along the hit direction
+	if length := math.Hypot(dirX, dirY); length > 0 {
+		dirX, dirY = dirX/length, dirY/length
 	}
-
-	cs.knockbackVelX = knockbackDir * 8.0
-	cs.knockbackVelY = -5.0
+	cs.knockbackVelX = dirX * KnockbackSpeed
+	cs.knockbackVelY = dirY*KnockbackSpeed + KnockbackLift
 
 	// Apply stagger
 	cs.playerStaggered = true
 	cs.playerStaggerTime = StaggerDurationFrames
 
 	// Invulnerability frames
-	cs.invulnerableFrames = 60 // 1 second of invulnerability
+	cs.invulnerableFrames = cs.invulnerableDuration
 
 	// Spawn damage number
 	cs.AddDamageNumber(damage, player.X, player.Y-10, false)
@@ -398,14 +594,23 @@ func (cs *CombatSystem) ClearLastParrySuccess() {
 // Damage falls off linearly: full damage at range 0, zero damage at ProjectileMaxRange.
 // Returns false if the ranged attack is on cooldown or the player is staggered.
 func (cs *CombatSystem) PlayerRangedAttack(playerX, playerY, facingDir float64, baseDamage int) bool {
+	return cs.PlayerRangedAttackToward(playerX, playerY, facingDir, 0, baseDamage)
+}
+
+// PlayerRangedAttackToward spawns a projectile travelling along (dirX, dirY),
+// which need not be normalized; otherwise it behaves like PlayerRangedAttack.
+func (cs *CombatSystem) PlayerRangedAttackToward(playerX, playerY, dirX, dirY float64, baseDamage int) bool {
 	if cs.rangedCooldown > 0 || cs.playerStaggered {
 		return false
 	}
+	if length := math.Hypot(dirX, dirY); length > 0 {
+		dirX, dirY = dirX/length, dirY/length
+	}
 	cs.projectiles = append(cs.projectiles, Projectile{
 		X:            playerX + 16, // centre of player sprite
 		Y:            playerY + 12,
-		VelX:         ProjectileSpeed * facingDir,
-		VelY:         0,
+		VelX:         ProjectileSpeed * dirX,
+		VelY:         ProjectileSpeed * dirY,
 		Damage:       baseDamage,
 		DistTraveled: 0,
 		Active:       true,
@@ -454,3 +659,104 @@ func (cs *CombatSystem) CheckProjectileEnemyHit(enemy *entity.EnemyInstance) int
 	}
 	return 0
 }
+
+// SpawnEnemyProjectile fires a hostile projectile from (x, y).
+// Enemy projectiles deal full damage regardless of distance traveled.
+func (cs *CombatSystem) SpawnEnemyProjectile(x, y, velX, velY float64, damage int) {
+	cs.enemyProjectiles = append(cs.enemyProjectiles, Projectile{
+		X:      x,
+		Y:      y,
+		VelX:   velX,
+		VelY:   velY,
+		Damage: damage,
+		Active: true,
+	})
+}
+
+// GetEnemyProjectiles returns the slice of hostile projectiles for rendering.
+func (cs *CombatSystem) GetEnemyProjectiles() []Projectile {
+	return cs.enemyProjectiles
+}
+
+// ClearEnemyProjectiles removes all hostile projectiles, e.g. on room change.
+func (cs *CombatSystem) ClearEnemyProjectiles() {
+	cs.enemyProjectiles = cs.enemyProjectiles[:0]
+}
+
+// CheckEnemyProjectilePlayerHit tests every hostile projectile against the
+// player bounds. On first hit the projectile is deactivated and damage is
+// applied to the player, unless the player is parrying, which deflects it.
+// Returns the damage carried by the projectile, or 0.
+func (cs *CombatSystem) CheckEnemyProjectilePlayerHit(player *Player, playerW, playerH float64) int {
+	if cs.ignoresHits() {
+		return 0
+	}
+	for i := range cs.enemyProjectiles {
+		p := &cs.enemyProjectiles[i]
+		if !p.Active || p.Deflected {
+			continue
+		}
+		if p.X >= player.X && p.X <= player.X+playerW && p.Y >= player.Y && p.Y <= player.Y+playerH {
+			cs.attributeDamage(CombatLogEnemy)
+			if p.Source != nil {
+				cs.attributeDamage(p.Source.Enemy.Name)
+			}
+			if cs.IsInParryWindow() {
+				cs.logDamage(cs.attacker, CombatLogPlayer, p.Damage, false, true, player.Health)
+				cs.succeedParry(player)
+				cs.deflectProjectile(p)
+				return 0
+			}
+			p.Active = false
+			cs.ApplyDamageToPlayerFrom(player, p.Damage, p.VelX, p.VelY)
+			return p.Damage
+		}
+	}
+	return 0
+}
+
+// SpawnAreaHazard creates a circular damage zone centered on (x, y) that
+// lasts lifeTime frames.
+func (cs *CombatSystem) SpawnAreaHazard(x, y, radius float64, damage, lifeTime int) {
+	cs.areaHazards = append(cs.areaHazards, AreaHazard{
+		X:        x,
+		Y:        y,
+		Radius:   radius,
+		Damage:   damage,
+		LifeTime: lifeTime,
+	})
+}
+
+// SpawnSlam turns a landed enemy slam into an area hazard.
+func (cs *CombatSystem) SpawnSlam(slam entity.SlamEvent) {
+	cs.SpawnAreaHazard(slam.X, slam.Y, slam.Radius, slam.Damage, entity.SlamHazardFrames)
+}
+
+// GetAreaHazards returns the active area hazards for rendering.
+func (cs *CombatSystem) GetAreaHazards() []AreaHazard {
+	return cs.areaHazards
+}
+
+// ClearAreaHazards removes all area hazards, e.g. on room change.
+func (cs *CombatSystem) ClearAreaHazards() {
+	cs.areaHazards = cs.areaHazards[:0]
+}
+
+// CheckAreaHazardPlayerHit damages the player if they stand within an area
+// hazard that has not hit them yet. Returns the damage dealt, or 0.
+func (cs *CombatSystem) CheckAreaHazardPlayerHit(player *Player, playerW, playerH float64) int {
+	if cs.ignoresHits() {
+		return 0
+	}
+	for i := range cs.areaHazards {
+		h := &cs.areaHazards[i]
+		if h.HitDone || !entity.CircleOverlapsRect(h.X, h.Y, h.Radius, player.X, player.Y, playerW, playerH) {
+			continue
+		}
+		h.HitDone = true
+		cs.attributeDamage(CombatLogSlam)
+		cs.ApplyDamageToPlayer(player, h.Damage, h.X)
+		return h.Damage
+	}
+	return 0
+}
@@ -0,0 +1,118 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Combat log sources and targets that are not a named enemy
+const (
+	CombatLogPlayer = "player"
+	CombatLogEnemy  = "enemy" // An attacker that was not named
+	CombatLogSlam   = "slam"  // Area hazards left by enemy attacks
+	CombatLogArena  = "arena" // Boss arena hazards
+	CombatLogFall   = "fall"  // Falling out of the room
+)
+
+// CombatLogEntry is one damage event. Parried hits are logged with the
+// damage they would have dealt and leave Health unchanged.
+type CombatLogEntry struct {
+	Frame   int64  `json:"frame"`
+	Source  string `json:"source"`
+	Target  string `json:"target"`
+	Amount  int    `json:"amount"`
+	Crit    bool   `json:"crit"`
+	Parried bool   `json:"parried"`
+	Health  int    `json:"health"` // Target's health after the hit
+}
+
+// CombatLog records damage events in memory for balancing and bug
+// analysis. It is off unless attached to a runner (see
+// GameRunner.SetCombatLog), so normal play pays nothing for it.
+type CombatLog struct {
+	frame   int64
+	entries []CombatLogEntry
+}
+
+// NewCombatLog creates an empty combat log
+func NewCombatLog() *CombatLog {
+	return &CombatLog{}
+}
+
+// Tick advances the log's frame clock
+func (cl *CombatLog) Tick() {
+	cl.frame++
+}
+
+// Frame returns the current frame timestamp
+func (cl *CombatLog) Frame() int64 {
+	return cl.frame
+}
+
+// Record stamps the entry with the current frame and appends it
+func (cl *CombatLog) Record(entry CombatLogEntry) {
+	entry.Frame = cl.frame
+	cl.entries = append(cl.entries, entry)
+}
+
+// Entries returns the logged events, oldest first
+func (cl *CombatLog) Entries() []CombatLogEntry {
+	return cl.entries
+}
+
+// Clear drops every logged event
+func (cl *CombatLog) Clear() {
+	cl.entries = cl.entries[:0]
+}
+
+// MarshalJSON encodes the log as an array of its entries
+func (cl *CombatLog) MarshalJSON() ([]byte, error) {
+	if cl.entries == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(cl.entries)
+}
+
+// WriteFile dumps the log to path as indented JSON
+func (cl *CombatLog) WriteFile(path string) error {
+	data, err := json.MarshalIndent(cl, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode combat log: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write combat log: %w", err)
+	}
+	return nil
+}
+
+// SetCombatLog attaches a log that records every damage event; nil turns
+// logging off. One log can be shared by successive runners.
+func (gr *GameRunner) SetCombatLog(log *CombatLog) {
+	gr.combatSystem.log = log
+}
+
+// CombatLog returns the attached combat log, or nil
+func (gr *GameRunner) CombatLog() *CombatLog {
+	return gr.combatSystem.log
+}
+
+// logDamage records a hit by source on target if a combat log is attached
+func (cs *CombatSystem) logDamage(source, target string, amount int, crit, parried bool, health int) {
+	if cs.log == nil {
+		return
+	}
+	if source == "" {
+		source = CombatLogEnemy
+	}
+	cs.log.Record(CombatLogEntry{
+		Source: source, Target: target, Amount: amount,
+		Crit: crit, Parried: parried, Health: health,
+	})
+}
+
+// attributeDamage names who deals the damage the player takes next, for
+// the combat log
+func (cs *CombatSystem) attributeDamage(attacker string) {
+	cs.attacker = attacker
+}
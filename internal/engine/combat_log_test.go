@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+func TestCombatLogRecordsDamageInOrder(t *testing.T) {
+	cs := NewCombatSystem()
+	log := NewCombatLog()
+	cs.log = log
+
+	enemy := entity.NewEnemyInstance(&entity.Enemy{Name: "Ghoul", Health: 50, Damage: 6,
+		Behavior: entity.ChaseBehavior, Size: entity.MediumEnemy}, 200, 300)
+	player := &Player{X: 100, Y: 300, Health: 40, MaxHealth: 40}
+
+	cs.ApplyDamageToEnemy(enemy, 12, player.X)
+	cs.Update()
+	cs.playerAttackCharge = 1
+	cs.ApplyDamageToEnemy(enemy, 20, player.X)
+	cs.playerAttackCharge = 0
+	cs.Update()
+	cs.attributeDamage(enemy.Enemy.Name)
+	cs.ApplyDamageToPlayer(player, 6, enemy.X)
+	cs.invulnerableFrames = 0
+	cs.parryCooldown = 0
+	cs.playerStaggered = false
+	cs.PlayerParry()
+	cs.ApplyDamageToPlayer(player, 6, enemy.X)
+
+	want := []CombatLogEntry{
+		{Frame: 0, Source: CombatLogPlayer, Target: "Ghoul", Amount: 12, Health: 38},
+		{Frame: 1, Source: CombatLogPlayer, Target: "Ghoul", Amount: 20, Crit: true, Health: 18},
+		{Frame: 2, Source: "Ghoul", Target: CombatLogPlayer, Amount: 6, Health: 34},
+		{Frame: 2, Source: "Ghoul", Target: CombatLogPlayer, Amount: 6, Parried: true, Health: 34},
+	}
+	got := log.Entries()
+	if len(got) != len(want) {
+		t.Fatalf("logged %d entries, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	data, err := json.Marshal(log)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded []CombatLogEntry
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(decoded) != len(want) || decoded[1] != want[1] {
+		t.Errorf("JSON round trip = %+v, want %+v", decoded, want)
+	}
+}
+
+func TestCombatLogOffByDefault(t *testing.T) {
+	cs := NewCombatSystem()
+	enemy := entity.NewEnemyInstance(&entity.Enemy{Name: "Ghoul", Health: 50,
+		Behavior: entity.ChaseBehavior, Size: entity.MediumEnemy}, 200, 300)
+	cs.ApplyDamageToEnemy(enemy, 12, 100)
+	cs.Update()
+	if cs.log != nil {
+		t.Error("combat log should stay off unless attached")
+	}
+
+	data, err := json.Marshal(NewCombatLog())
+	if err != nil || string(data) != "[]" {
+		t.Errorf("empty log JSON = %s, %v, want []", data, err)
+	}
+}
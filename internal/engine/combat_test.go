@@ -1,9 +1,11 @@
 package engine
 
 import (
+	"math"
 	"testing"
 
 	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
 )
 
 func TestNewCombatSystem(t *testing.T) {
@@ -164,6 +166,73 @@ func TestApplyDamageToEnemy(t *testing.T) {
 	}
 }
 
+func TestHeavyAttackLaunchesSmallEnemyNotBoss(t *testing.T) {
+	light := NewCombatSystem()
+	light.PlayerAttack()
+	lightHit := entity.NewEnemyInstance(&entity.Enemy{Health: 500, Size: entity.BossEnemy}, 150, 100)
+	light.ApplyDamageToEnemy(lightHit, 10, 100)
+
+	heavy := NewCombatSystem()
+	if !heavy.PlayerHeavyAttack(1.0) {
+		t.Fatal("PlayerHeavyAttack() = false")
+	}
+
+	small := entity.NewEnemyInstance(&entity.Enemy{Health: 50, Size: entity.SmallEnemy}, 150, 100)
+	heavy.ApplyDamageToEnemy(small, 10, 100)
+	if small.VelY > -LaunchSpeed {
+		t.Errorf("small enemy VelY = %v after full heavy hit, want <= %v", small.VelY, -LaunchSpeed)
+	}
+	if small.HitStunFrames != LaunchStunFrames {
+		t.Errorf("small enemy HitStunFrames = %d, want %d", small.HitStunFrames, LaunchStunFrames)
+	}
+
+	boss := entity.NewEnemyInstance(&entity.Enemy{Health: 500, Size: entity.BossEnemy}, 150, 100)
+	heavy.ApplyDamageToEnemy(boss, 10, 100)
+	if boss.VelY < lightHit.VelY {
+		t.Errorf("boss VelY = %v after heavy hit, want no more lift than a light hit (%v)", boss.VelY, lightHit.VelY)
+	}
+	if boss.HitStunFrames != 0 {
+		t.Errorf("boss HitStunFrames = %d, want 0", boss.HitStunFrames)
+	}
+}
+
+func TestLaunchImpulseScalesWithChargeAndSize(t *testing.T) {
+	if LaunchImpulse(entity.SmallEnemy, 0.5) >= LaunchImpulse(entity.SmallEnemy, 1.0) {
+		t.Error("expected a fuller charge to launch higher")
+	}
+	if LaunchImpulse(entity.LargeEnemy, 1.0) >= LaunchImpulse(entity.MediumEnemy, 1.0) {
+		t.Error("expected larger enemies to launch lower")
+	}
+	if got := LaunchImpulse(entity.BossEnemy, 1.0); got != 0 {
+		t.Errorf("LaunchImpulse(boss) = %v, want 0", got)
+	}
+}
+
+func TestHeavyCharge(t *testing.T) {
+	if got := HeavyCharge(HeavyChargeMinFrames - 1); got != 0 {
+		t.Errorf("HeavyCharge(short hold) = %v, want 0", got)
+	}
+	if got := HeavyCharge(HeavyChargeFullFrames * 2); got != 1 {
+		t.Errorf("HeavyCharge(long hold) = %v, want 1", got)
+	}
+}
+
+func TestJuggleRefreshesHitStun(t *testing.T) {
+	cs := NewCombatSystem()
+	cs.PlayerHeavyAttack(1.0)
+	instance := entity.NewEnemyInstance(&entity.Enemy{Health: 100, Size: entity.SmallEnemy}, 150, 100)
+	cs.ApplyDamageToEnemy(instance, 5, 100)
+	instance.HitStunFrames = 1
+
+	// A light follow-up while airborne keeps the enemy stunned
+	light := NewCombatSystem()
+	light.PlayerAttack()
+	light.ApplyDamageToEnemy(instance, 5, 100)
+	if instance.HitStunFrames != JuggleStunFrames {
+		t.Errorf("HitStunFrames = %d after juggle hit, want %d", instance.HitStunFrames, JuggleStunFrames)
+	}
+}
+
 func TestCheckPlayerEnemyCollision(t *testing.T) {
 	cs := NewCombatSystem()
 
@@ -698,3 +767,213 @@ func TestParryDamageNumber(t *testing.T) {
 		t.Errorf("Expected parry damage number value 0, got %d", numbers[0].Value)
 	}
 }
+
+func TestEnemyProjectileHitsPlayer(t *testing.T) {
+	cs := NewCombatSystem()
+	player := &Player{Health: 100, MaxHealth: 100, X: 100, Y: 100}
+
+	// Fired from the right, travelling left towards the player
+	cs.SpawnEnemyProjectile(150, 116, -5, 0, 12)
+
+	hit := 0
+	for i := 0; i < 20 && hit == 0; i++ {
+		cs.Update()
+		hit = cs.CheckEnemyProjectilePlayerHit(player, 32, 32)
+	}
+
+	if hit != 12 {
+		t.Fatalf("CheckEnemyProjectilePlayerHit() = %d, want 12", hit)
+	}
+	if player.Health != 88 {
+		t.Errorf("player.Health = %d, want 88", player.Health)
+	}
+
+	cs.Update()
+	if n := len(cs.GetEnemyProjectiles()); n != 0 {
+		t.Errorf("len(GetEnemyProjectiles()) = %d after hit, want 0", n)
+	}
+}
+
+func TestBossControllerRunsPattern(t *testing.T) {
+	boss := &entity.Boss{
+		Enemy:  entity.Enemy{Health: 100, Size: entity.BossEnemy},
+		Phases: []entity.BossPhase{{HealthThreshold: 0.5}},
+		AttackPatterns: []entity.AttackPattern{{
+			Name: "test",
+			Moves: []entity.AttackMove{{
+				TelegraphFrames: 2, ActiveFrames: 1, RecoveryFrames: 1,
+				Projectiles: []entity.ProjectileSpawn{{Frame: 2, VelX: 4, Damage: 5}},
+			}},
+		}},
+	}
+	instance := entity.NewEnemyInstance(&boss.Enemy, 200, 100)
+	bc := NewBossController(boss, instance)
+	cs := NewCombatSystem()
+	player := &Player{Health: 100, MaxHealth: 100, X: 500, Y: 100}
+
+	for i := 0; i < BossPatternCooldownFrames+3; i++ {
+		bc.Update(player, physics.PlayerHeight, cs)
+	}
+
+	projectiles := cs.GetEnemyProjectiles()
+	if len(projectiles) != 1 {
+		t.Fatalf("len(GetEnemyProjectiles()) = %d, want 1", len(projectiles))
+	}
+	if projectiles[0].VelX <= 0 {
+		t.Errorf("projectile VelX = %v, want positive (towards player)", projectiles[0].VelX)
+	}
+}
+
+func TestPoiseBreakInterruptsBossPattern(t *testing.T) {
+	boss := &entity.Boss{
+		Enemy:  entity.Enemy{Health: 100, Size: entity.BossEnemy},
+		Phases: []entity.BossPhase{{HealthThreshold: 0.5}},
+		AttackPatterns: []entity.AttackPattern{{
+			Name: "test",
+			Moves: []entity.AttackMove{{
+				TelegraphFrames: 10, ActiveFrames: 1, RecoveryFrames: 1,
+				Projectiles: []entity.ProjectileSpawn{{Frame: 10, VelX: 4, Damage: 5}},
+			}},
+		}},
+	}
+	instance := entity.NewEnemyInstance(&boss.Enemy, 200, 100)
+	bc := NewBossController(boss, instance)
+	cs := NewCombatSystem()
+	player := &Player{Health: 100, MaxHealth: 100, X: 500, Y: 100}
+
+	// Break the boss's poise mid-telegraph
+	for i := 0; i < BossPatternCooldownFrames+5; i++ {
+		bc.Update(player, physics.PlayerHeight, cs)
+	}
+	if !instance.ApplyPoiseDamage(entity.MaxPoise(entity.BossEnemy)) {
+		t.Fatal("full poise damage did not break the boss's poise")
+	}
+	if instance.HitStunFrames != entity.StaggerFrames {
+		t.Fatalf("boss HitStunFrames = %d, want a %d-frame stagger", instance.HitStunFrames, entity.StaggerFrames)
+	}
+
+	for i := 0; i < 10; i++ {
+		bc.Update(player, physics.PlayerHeight, cs)
+	}
+	if n := len(cs.GetEnemyProjectiles()); n != 0 {
+		t.Errorf("staggered boss fired %d projectiles, want its pattern interrupted", n)
+	}
+	if _, _, _, _, _, ok := bc.AttackArea(); ok {
+		t.Error("staggered boss still shows an attack telegraph")
+	}
+}
+
+func TestSlamSpawnsAreaHazardThatDamagesPlayer(t *testing.T) {
+	cs := NewCombatSystem()
+	cs.SpawnSlam(entity.SlamEvent{X: 200, Y: 300, Radius: entity.SlamRadius, Damage: 12})
+
+	// Outside the radius: unharmed
+	far := &Player{X: 200 + entity.SlamRadius + 40, Y: 270, Health: 100, MaxHealth: 100}
+	if dmg := cs.CheckAreaHazardPlayerHit(far, 32, 32); dmg != 0 {
+		t.Errorf("Player outside the slam radius took %d damage", dmg)
+	}
+
+	// Inside the radius: damaged once
+	near := &Player{X: 210, Y: 270, Health: 100, MaxHealth: 100}
+	if dmg := cs.CheckAreaHazardPlayerHit(near, 32, 32); dmg != 12 {
+		t.Fatalf("Expected 12 damage inside the slam, got %d", dmg)
+	}
+	if near.Health != 88 {
+		t.Errorf("Expected player health 88, got %d", near.Health)
+	}
+
+	cs.invulnerableFrames = 0
+	if dmg := cs.CheckAreaHazardPlayerHit(near, 32, 32); dmg != 0 {
+		t.Error("A slam should damage the player only once")
+	}
+
+	for i := 0; i < entity.SlamHazardFrames; i++ {
+		cs.Update()
+	}
+	if len(cs.GetAreaHazards()) != 0 {
+		t.Error("Slam hazard should expire after SlamHazardFrames")
+	}
+}
+
+func TestKnockbackPushesAwayFromSource(t *testing.T) {
+	cs := NewCombatSystem()
+	player := &Player{X: 200, Y: 100, Health: 100, MaxHealth: 100}
+
+	// Hit from the left pushes right
+	cs.ApplyDamageToPlayer(player, 10, 150)
+	vx, vy := cs.GetKnockback()
+	if vx <= 0 {
+		t.Errorf("Hit from the left should push right, got vx = %f", vx)
+	}
+	if vy >= 0 {
+		t.Errorf("Knockback should lift the player, got vy = %f", vy)
+	}
+
+	// Hit from the right pushes left
+	cs = NewCombatSystem()
+	cs.ApplyDamageToPlayer(player, 10, 250)
+	if vx, _ := cs.GetKnockback(); vx >= 0 {
+		t.Errorf("Hit from the right should push left, got vx = %f", vx)
+	}
+}
+
+func TestProjectileKnocksBackAlongVelocity(t *testing.T) {
+	cs := NewCombatSystem()
+	player := &Player{X: 100, Y: 100, Health: 100, MaxHealth: 100}
+
+	// A projectile flying left, fired from the player's left side, still
+	// pushes the player left because that is the way it travels
+	cs.SpawnEnemyProjectile(110, 110, -6, 0, 5)
+	if dmg := cs.CheckEnemyProjectilePlayerHit(player, 32, 32); dmg != 5 {
+		t.Fatalf("Expected projectile hit for 5, got %d", dmg)
+	}
+	vx, vy := cs.GetKnockback()
+	if vx != -KnockbackSpeed || vy != KnockbackLift {
+		t.Errorf("Knockback = (%f, %f), want (%f, %f)", vx, vy, -KnockbackSpeed, KnockbackLift)
+	}
+
+	// A diagonal shot pushes along both axes of its velocity
+	cs = NewCombatSystem()
+	cs.SpawnEnemyProjectile(110, 110, 3, 4, 5)
+	cs.CheckEnemyProjectilePlayerHit(player, 32, 32)
+	vx, vy = cs.GetKnockback()
+	wantX := 0.6 * KnockbackSpeed
+	wantY := 0.8*KnockbackSpeed + KnockbackLift
+	if math.Abs(vx-wantX) > 1e-9 || math.Abs(vy-wantY) > 1e-9 {
+		t.Errorf("Knockback = (%f, %f), want (%f, %f)", vx, vy, wantX, wantY)
+	}
+}
+
+func TestEnemyAttackDamagesOnlyOnStrikeFrame(t *testing.T) {
+	gr := newTestRunner(t)
+
+	var enemy *entity.EnemyInstance
+	for _, template := range gr.game.Entities {
+		if inst := entity.NewEnemyInstance(template, 0, 0); inst.AnimController != nil {
+			enemy = inst
+			break
+		}
+	}
+	if enemy == nil {
+		t.Skip("no generated enemy has an animation")
+	}
+	enemy.State = entity.AttackState
+	enemy.AnimController.Play("attack", true)
+
+	player := gr.game.Player
+	for tick := 0; tick < 15; tick++ {
+		enemy.X, enemy.Y = player.X, player.Y
+		before := player.Health
+		strike := enemy.AnimController.GetCurrentFrameIndex() == entity.EnemyAttackStrikeFrame
+		gr.checkEnemyHitPlayer(enemy)
+		if hit := player.Health < before; hit != strike {
+			t.Fatalf("tick %d (frame %d): hit = %v, want %v",
+				tick, enemy.AnimController.GetCurrentFrameIndex(), hit, strike)
+		}
+		if strike {
+			return
+		}
+		enemy.AnimController.Update()
+	}
+	t.Fatal("attack animation never reached its strike frame")
+}
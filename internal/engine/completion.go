@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Completion weights: the share of the percentage each part of the world
+// is worth. Exploration counts most, then the bosses in the way.
+const (
+	CompletionRoomWeight    = 0.40
+	CompletionItemWeight    = 0.20
+	CompletionBossWeight    = 0.25
+	CompletionAbilityWeight = 0.15
+)
+
+// Completion counts how much of the world the player has found against
+// how much it holds
+type Completion struct {
+	RoomsVisited, Rooms          int
+	ItemsCollected, Items        int
+	BossesDefeated, Bosses       int
+	AbilitiesUnlocked, Abilities int
+}
+
+// Percent combines the counts into an overall percentage, 0 to 100. Each
+// part scores its found share times its weight; parts the world has none
+// of are left out and the remaining weights scaled up to cover them.
+func (c Completion) Percent() float64 {
+	parts := []struct {
+		found, total int
+		weight       float64
+	}{
+		{c.RoomsVisited, c.Rooms, CompletionRoomWeight},
+		{c.ItemsCollected, c.Items, CompletionItemWeight},
+		{c.BossesDefeated, c.Bosses, CompletionBossWeight},
+		{c.AbilitiesUnlocked, c.Abilities, CompletionAbilityWeight},
+	}
+	score, weight := 0.0, 0.0
+	for _, part := range parts {
+		if part.total <= 0 {
+			continue
+		}
+		share := math.Min(float64(part.found)/float64(part.total), 1)
+		score += share * part.weight
+		weight += part.weight
+	}
+	if weight == 0 {
+		return 0
+	}
+	return 100 * score / weight
+}
+
+// Completion tallies the rooms visited, treasures collected, bosses
+// defeated and abilities unlocked so far. Health pickups and mini-boss
+// loot are extras and not counted.
+func (gr *GameRunner) Completion() Completion {
+	c := Completion{
+		RoomsVisited: len(gr.visitedRooms),
+		Bosses:       len(gr.game.Bosses),
+		Abilities:    len(gr.game.Abilities),
+	}
+	if gr.game.World != nil {
+		c.Rooms = len(gr.game.World.Rooms)
+		for _, room := range gr.game.World.Rooms {
+			if room.Type == world.TreasureRoom {
+				c.Items += min(len(room.Items), len(gr.game.Items))
+			}
+		}
+	}
+	for id, collected := range gr.collectedItems {
+		if collected && id%1000 < healthPickupIDOffset {
+			c.ItemsCollected++
+		}
+	}
+	for _, boss := range gr.game.Bosses {
+		if gr.defeatedBosses[boss.Name] {
+			c.BossesDefeated++
+		}
+	}
+	for _, ability := range gr.game.Abilities {
+		if gr.game.Player.Abilities[gr.normalizeAbilityKey(ability.Name)] {
+			c.AbilitiesUnlocked++
+		}
+	}
+	return c
+}
+
+// CompletionPercent returns the overall completion, 0 to 100
+func (gr *GameRunner) CompletionPercent() float64 {
+	return gr.Completion().Percent()
+}
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestCompletionPercentWeighsEachPart(t *testing.T) {
+	c := Completion{
+		RoomsVisited: 5, Rooms: 10,
+		ItemsCollected: 1, Items: 4,
+		BossesDefeated: 1, Bosses: 2,
+		AbilitiesUnlocked: 3, Abilities: 3,
+	}
+	want := 100 * (0.5*CompletionRoomWeight + 0.25*CompletionItemWeight +
+		0.5*CompletionBossWeight + 1*CompletionAbilityWeight)
+	if got := c.Percent(); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Percent() = %v, want %v", got, want)
+	}
+}
+
+func TestCompletionPercentSkipsEmptyParts(t *testing.T) {
+	c := Completion{RoomsVisited: 2, Rooms: 4, BossesDefeated: 1, Bosses: 1}
+	want := 100 * (0.5*CompletionRoomWeight + CompletionBossWeight) / (CompletionRoomWeight + CompletionBossWeight)
+	if got := c.Percent(); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Percent() = %v, want %v", got, want)
+	}
+	if got := (Completion{}).Percent(); got != 0 {
+		t.Errorf("empty completion Percent() = %v, want 0", got)
+	}
+}
+
+func TestRunnerCompletionTracksProgressAndSaves(t *testing.T) {
+	gr := newTestRunner(t)
+	if len(gr.game.Bosses) == 0 || len(gr.game.Abilities) == 0 {
+		t.Skip("world has no bosses or abilities")
+	}
+
+	// A known state: three rooms, one treasure and one health pickup, the
+	// first boss and every ability
+	gr.visitedRooms = map[int]bool{gr.game.World.Rooms[0].ID: true, gr.game.World.Rooms[1].ID: true, gr.game.World.Rooms[2].ID: true}
+	var treasureRoom *world.Room
+	for _, room := range gr.game.World.Rooms {
+		if room.Type == world.TreasureRoom && len(room.Items) > 0 {
+			treasureRoom = room
+			break
+		}
+	}
+	gr.collectedItems = map[int]bool{}
+	if treasureRoom != nil {
+		gr.collectedItems[treasureRoom.ID*1000] = true
+		gr.collectedItems[treasureRoom.ID*1000+healthPickupIDOffset] = true
+	}
+	gr.defeatedBosses = map[string]bool{gr.game.Bosses[0].Name: true}
+	for _, ability := range gr.game.Abilities {
+		gr.game.Player.Abilities[gr.normalizeAbilityKey(ability.Name)] = true
+	}
+
+	c := gr.Completion()
+	if c.RoomsVisited != 3 || c.Rooms != len(gr.game.World.Rooms) {
+		t.Errorf("rooms = %d/%d, want 3/%d", c.RoomsVisited, c.Rooms, len(gr.game.World.Rooms))
+	}
+	if treasureRoom != nil && c.ItemsCollected != 1 {
+		t.Errorf("items collected = %d, want 1 treasure with the health pickup left out", c.ItemsCollected)
+	}
+	if c.BossesDefeated != 1 || c.Bosses != len(gr.game.Bosses) {
+		t.Errorf("bosses = %d/%d, want 1/%d", c.BossesDefeated, c.Bosses, len(gr.game.Bosses))
+	}
+	if c.AbilitiesUnlocked != len(gr.game.Abilities) {
+		t.Errorf("abilities unlocked = %d, want all %d", c.AbilitiesUnlocked, len(gr.game.Abilities))
+	}
+
+	saveData := gr.CreateSaveData()
+	if saveData.Completion != c.Percent() {
+		t.Errorf("saved completion = %v, want %v", saveData.Completion, c.Percent())
+	}
+
+	loaded := NewGameRunner(gr.game)
+	if err := loaded.RestoreFromSaveData(saveData); err != nil {
+		t.Fatalf("RestoreFromSaveData() error = %v", err)
+	}
+	if got := loaded.CompletionPercent(); got != saveData.Completion {
+		t.Errorf("completion after loading = %v, want the saved %v", got, saveData.Completion)
+	}
+}
@@ -0,0 +1,31 @@
+package engine
+
+import (
+	"github.com/opd-ai/vania/internal/graphics"
+	"github.com/opd-ai/vania/internal/pcg"
+)
+
+// SetPlayerPalette recolors the player with one of the seeded palette
+// variants, zero restoring the generated colors. Variants wrap around
+// graphics.PaletteSwapVariants. Only the look changes, never gameplay.
+func (g *Game) SetPlayerPalette(variant int) {
+	n := graphics.PaletteSwapVariants
+	g.PlayerPalette = ((variant % n) + n) % n
+	if g.Player == nil || g.Graphics == nil || g.Graphics.Sprites["player"] == nil {
+		return
+	}
+	gg := NewGameGeneratorWithGenre(g.Seed, g.Genre)
+	gg.dressPlayer(g.Player, g.Graphics.Sprites["player"], g.PlayerPalette)
+}
+
+// dressPlayer gives the player base recolored with the palette variant,
+// and animations built from it
+func (gg *GameGenerator) dressPlayer(player *Player, base *graphics.Sprite, variant int) {
+	sprite := base
+	if variant > 0 {
+		swap := graphics.GeneratePaletteSwap(pcg.HashSeed(gg.MasterSeed, "player-palette")+int64(variant), len(base.Palette))
+		sprite = base.Recolor(swap)
+	}
+	player.Sprite = sprite
+	player.AnimController = gg.playerAnimations(sprite)
+}
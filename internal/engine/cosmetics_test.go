@@ -0,0 +1,39 @@
+package engine
+
+import "testing"
+
+func TestSetPlayerPaletteRecolorsWithoutGameplayChanges(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	base := game.Player.Sprite
+	health, damage, speed := game.Player.Health, game.Player.Damage, game.Player.Speed
+
+	game.SetPlayerPalette(2)
+	recolored := game.Player.Sprite
+	if recolored == base {
+		t.Fatal("palette variant should replace the player sprite")
+	}
+	if recolored.Width != base.Width || recolored.Height != base.Height {
+		t.Errorf("recolored sprite is %dx%d, want %dx%d", recolored.Width, recolored.Height, base.Width, base.Height)
+	}
+	if game.Player.Health != health || game.Player.Damage != damage || game.Player.Speed != speed {
+		t.Error("palette swap should not change player stats")
+	}
+	if frame := game.Player.AnimController.GetCurrentFrame(); frame == nil {
+		t.Error("animations should be rebuilt from the recolored sprite")
+	}
+
+	game.SetPlayerPalette(2)
+	for i := range recolored.Image.Pix {
+		if game.Player.Sprite.Image.Pix[i] != recolored.Image.Pix[i] {
+			t.Fatal("the same variant should recolor identically")
+		}
+	}
+
+	game.SetPlayerPalette(0)
+	if game.Player.Sprite != base {
+		t.Error("variant 0 should restore the generated sprite")
+	}
+}
@@ -0,0 +1,30 @@
+package engine
+
+import "github.com/opd-ai/vania/internal/input"
+
+// updateCrouch lowers the player while crouch is held and stands them back
+// up on release once there is headroom, so letting go inside a crawl space
+// keeps the player crouched until they crawl out
+func (gr *GameRunner) updateCrouch(inputState input.InputState) {
+	switch {
+	case inputState.Crouch && !gr.playerBody.Grappling:
+		gr.playerBody.Crouch()
+	case gr.playerBody.Crouched:
+		gr.standUp()
+	}
+}
+
+// standUp raises a crouched player to full height if nothing is overhead
+// and reports whether they are standing
+func (gr *GameRunner) standUp() bool {
+	if gr.game.CurrentRoom == nil {
+		return !gr.playerBody.Crouched
+	}
+	return gr.playerBody.StandUp(gr.game.CurrentRoom.Platforms)
+}
+
+// playerHeight returns the height of the player's hurtbox, which shrinks
+// while crouched
+func (gr *GameRunner) playerHeight() float64 {
+	return gr.playerBody.Position.Height
+}
@@ -0,0 +1,148 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/input"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/render"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// newCrawlSpaceRunner settles the player on a flat floor with a crawl space
+// starting 64px to their right, and returns the runner and the slab's right
+// edge
+func newCrawlSpaceRunner(t *testing.T) (*GameRunner, float64) {
+	t.Helper()
+	gr, _ := newNoiseTestRunner(t)
+	gr.enemyInstances = nil
+
+	room := gr.game.CurrentRoom
+	feet := int(gr.playerBody.Position.Y + gr.playerBody.Position.Height)
+	slabX := int(gr.game.Player.X) + physics.PlayerWidth + 64
+	room.Hazards = nil
+	room.Platforms = []world.Platform{
+		{X: 0, Y: feet, Width: world.RoomPixelWidth, Height: 40},
+		{X: slabX, Y: feet - world.CrawlSpaceHeight - 32, Width: 128, Height: 32},
+	}
+	return gr, float64(slabX + 128)
+}
+
+func TestCrouchShrinksHurtbox(t *testing.T) {
+	gr, _ := newCrawlSpaceRunner(t)
+
+	if err := gr.Step(input.InputState{Crouch: true}); err != nil {
+		t.Fatalf("Step() error = %v", err)
+	}
+	if got := gr.playerHeight(); got != physics.CrouchHeight {
+		t.Errorf("playerHeight() while crouched = %v, want %v", got, physics.CrouchHeight)
+	}
+	for _, box := range gr.hitboxes() {
+		if box.Kind == render.PlayerHitbox && box.H != physics.CrouchHeight {
+			t.Errorf("player hitbox height = %v, want %v", box.H, physics.CrouchHeight)
+		}
+	}
+
+	if err := gr.Step(input.InputState{}); err != nil {
+		t.Fatalf("Step() error = %v", err)
+	}
+	if got := gr.playerHeight(); got != physics.PlayerHeight {
+		t.Errorf("playerHeight() after releasing crouch = %v, want %v", got, physics.PlayerHeight)
+	}
+}
+
+func TestCrouchTraversesCrawlSpace(t *testing.T) {
+	gr, slabRight := newCrawlSpaceRunner(t)
+	startX := gr.game.Player.X
+
+	for i := 0; i < 120; i++ {
+		if err := gr.Step(input.InputState{MoveRight: true}); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+	}
+	if gr.game.Player.X >= slabRight {
+		t.Fatalf("standing player walked through the crawl space to x=%v", gr.game.Player.X)
+	}
+
+	gr.playerBody.Position.X = startX
+	for i := 0; i < 60; i++ {
+		if err := gr.Step(input.InputState{MoveRight: true, Crouch: true}); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+	}
+	if gr.game.Player.X+physics.PlayerWidth <= slabRight-128 {
+		t.Fatalf("crouched player stopped at x=%v, want inside the crawl space", gr.game.Player.X)
+	}
+
+	// Letting go of crouch under the slab keeps the player low
+	if err := gr.Step(input.InputState{MoveRight: true}); err != nil {
+		t.Fatalf("Step() error = %v", err)
+	}
+	if !gr.playerBody.Crouched {
+		t.Error("player stood up under the crawl space slab")
+	}
+
+	for i := 0; i < 120 && gr.game.Player.X < slabRight; i++ {
+		if err := gr.Step(input.InputState{MoveRight: true}); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+	}
+	if gr.game.Player.X < slabRight {
+		t.Errorf("crouched player stopped at x=%v, want through the crawl space", gr.game.Player.X)
+	}
+	if err := gr.Step(input.InputState{}); err != nil {
+		t.Fatalf("Step() error = %v", err)
+	}
+	if gr.playerBody.Crouched {
+		t.Error("player still crouched after leaving the crawl space")
+	}
+}
+
+// headHeightBoss returns a boss controller, standing to the player's left,
+// whose only move swings a hitbox through the top 12px of a standing player
+// with feet at feetY
+func headHeightBoss(playerX, feetY float64) *BossController {
+	hitbox := &entity.PatternHitbox{Width: 200, Height: 12, Damage: 10}
+	boss := &entity.Boss{
+		Enemy:  entity.Enemy{Name: "Warden", Health: 100, Size: entity.BossEnemy},
+		Phases: []entity.BossPhase{{HealthThreshold: 1}},
+		AttackPatterns: []entity.AttackPattern{{
+			Name:  "sweep",
+			Moves: []entity.AttackMove{{TelegraphFrames: 2, ActiveFrames: 1, RecoveryFrames: 1, Hitbox: hitbox}},
+		}},
+	}
+	bc := NewBossController(boss, entity.NewEnemyInstance(&boss.Enemy, playerX-150, feetY-200))
+	cx, cy := bc.center()
+	hitbox.OffsetX = playerX - 100 - cx
+	hitbox.OffsetY = feetY - physics.PlayerHeight + hitbox.Height/2 - cy
+	return bc
+}
+
+// swingBoss runs bc until its first hitbox has been active against the
+// runner's player and returns the damage taken
+func swingBoss(gr *GameRunner, bc *BossController) int {
+	before := gr.game.Player.Health
+	for i := 0; i < BossPatternCooldownFrames+4; i++ {
+		bc.Update(gr.game.Player, gr.playerHeight(), gr.combatSystem)
+	}
+	return before - gr.game.Player.Health
+}
+
+func TestCrouchDucksHeadHeightBossAttack(t *testing.T) {
+	gr, _ := newCrawlSpaceRunner(t)
+	feet := gr.playerBody.Position.Y + gr.playerBody.Position.Height
+
+	if dmg := swingBoss(gr, headHeightBoss(gr.game.Player.X, feet)); dmg <= 0 {
+		t.Fatal("head-height swing missed a standing player")
+	}
+
+	gr.combatSystem = NewCombatSystem()
+	gr.game.Player.Health = gr.game.Player.MaxHealth
+	if err := gr.Step(input.InputState{Crouch: true}); err != nil {
+		t.Fatalf("Step() error = %v", err)
+	}
+	if dmg := swingBoss(gr, headHeightBoss(gr.game.Player.X, feet)); dmg != 0 {
+		t.Errorf("head-height swing dealt %d damage to a crouched player", dmg)
+	}
+}
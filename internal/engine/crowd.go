@@ -0,0 +1,38 @@
+package engine
+
+import "github.com/opd-ai/vania/internal/entity"
+
+// EnemyCollisionConfig controls whether enemies push each other apart
+type EnemyCollisionConfig struct {
+	Enabled       bool
+	FlyingOverlap bool // Let flying enemies pass through others
+}
+
+// DefaultEnemyCollisionConfig keeps ground enemies apart and lets flyers
+// swarm through them
+func DefaultEnemyCollisionConfig() EnemyCollisionConfig {
+	return EnemyCollisionConfig{Enabled: true, FlyingOverlap: true}
+}
+
+// SetEnemyCollisionConfig changes how enemies collide with each other
+func (gr *GameRunner) SetEnemyCollisionConfig(config EnemyCollisionConfig) {
+	gr.enemyCollision = config
+}
+
+// separateEnemies pushes overlapping enemies apart after they have moved.
+// Enemies frozen by the active-enemy cap stay where they are.
+func (gr *GameRunner) separateEnemies() {
+	if !gr.enemyCollision.Enabled {
+		return
+	}
+	enemies := gr.enemyInstances
+	if len(gr.frozenEnemies) > 0 {
+		enemies = make([]*entity.EnemyInstance, 0, len(gr.enemyInstances))
+		for _, enemy := range gr.enemyInstances {
+			if !gr.frozenEnemies[enemy] {
+				enemies = append(enemies, enemy)
+			}
+		}
+	}
+	entity.SeparateEnemies(enemies, gr.enemyCollision.FlyingOverlap)
+}
@@ -0,0 +1,26 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+func TestOverlappingGroundEnemiesSeparateOnUpdate(t *testing.T) {
+	gr := newTestRunner(t)
+
+	crawler := &entity.Enemy{Name: "Crawler", Health: 50, Size: entity.MediumEnemy, Behavior: entity.PatrolBehavior}
+	groundY := findGroundY(gr.game.CurrentRoom)
+	a := entity.NewEnemyInstance(crawler, 400, groundY-32)
+	b := entity.NewEnemyInstance(crawler, 410, groundY-32)
+	gr.enemyInstances = []*entity.EnemyInstance{a, b}
+
+	gr.updateEnemies()
+
+	ax, ay, aw, ah := a.GetBounds()
+	bx, by, bw, bh := b.GetBounds()
+	if ax < bx+bw && ax+aw > bx && ay < by+bh && ay+ah > by {
+		t.Errorf("enemies at x=%v and x=%v still overlap after an update", ax, bx)
+	}
+
+}
@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"image/color"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/particle"
+)
+
+// itemGlowColors tint the glow around an item by its rarity
+var itemGlowColors = map[entity.ItemRarity]color.RGBA{
+	entity.CommonRarity:    {255, 215, 0, 60},   // Gold
+	entity.UncommonRarity:  {80, 220, 100, 80},  // Green
+	entity.RareRarity:      {80, 150, 255, 100}, // Blue
+	entity.LegendaryRarity: {200, 90, 255, 120}, // Violet
+}
+
+// ItemGlowColor returns the glow drawn around an item of the given rarity
+func ItemGlowColor(rarity entity.ItemRarity) color.RGBA {
+	if glow, ok := itemGlowColors[rarity]; ok {
+		return glow
+	}
+	return itemGlowColors[entity.CommonRarity]
+}
+
+// deathEffect picks how an enemy bursts apart from its element and the
+// biome it dies in, falling back to the biome it was generated for
+func (gr *GameRunner) deathEffect(enemy *entity.EnemyInstance) particle.DeathEffect {
+	biome := enemy.Enemy.BiomeType
+	if room := gr.game.CurrentRoom; room != nil && room.Biome != nil {
+		biome = room.Biome.Name
+	}
+	return particle.DeathEffectFor(biome, enemy.Enemy.Element)
+}
+
+// spawnDeathEffect bursts a dead enemy apart in its death effect
+func (gr *GameRunner) spawnDeathEffect(enemy *entity.EnemyInstance) {
+	ex, ey, ew, eh := enemy.GetBounds()
+	emitter := gr.particlePresets.CreateDeathEffect(ex+ew/2, ey+eh/2, gr.deathEffect(enemy))
+	emitter.Burst(20)
+	gr.particleSystem.AddEmitter(emitter)
+}
@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/particle"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestEnemyDeathSpawnsBiomeEffect(t *testing.T) {
+	gr := newTestRunner(t)
+	room := *gr.game.CurrentRoom
+	gr.game.CurrentRoom = &room
+
+	tests := []struct {
+		biome, element string
+		want           particle.ParticleType
+	}{
+		{"crystal", "", particle.Shards},
+		{"abyss", "", particle.Ash},
+		{"forest", "", particle.Leaves},
+		{"forest", "fire", particle.Embers},
+	}
+	for _, tt := range tests {
+		room.Biome = &world.Biome{Name: tt.biome}
+		gr.particleSystem.Clear()
+		enemy := entity.NewEnemyInstance(&entity.Enemy{Name: "Wisp", Health: 10,
+			Element: tt.element, Behavior: entity.PatrolBehavior, Size: entity.SmallEnemy}, 300, 300)
+		enemy.TakeDamage(enemy.CurrentHealth)
+		gr.recordEnemyDeath(enemy)
+
+		particles := gr.particleSystem.GetAllParticles()
+		if len(particles) == 0 {
+			t.Errorf("%s/%q death spawned no particles", tt.biome, tt.element)
+			continue
+		}
+		for _, p := range particles {
+			if p.Type != tt.want {
+				t.Errorf("%s/%q death spawned particle type %v, want %v", tt.biome, tt.element, p.Type, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestItemGlowColorByRarity(t *testing.T) {
+	seen := make(map[[4]uint8]entity.ItemRarity)
+	for _, rarity := range []entity.ItemRarity{entity.CommonRarity, entity.UncommonRarity, entity.RareRarity, entity.LegendaryRarity} {
+		c := ItemGlowColor(rarity)
+		key := [4]uint8{c.R, c.G, c.B, c.A}
+		if other, dup := seen[key]; dup {
+			t.Errorf("rarities %v and %v share glow %v", other, rarity, c)
+		}
+		seen[key] = rarity
+	}
+}
@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+const (
+	// DeflectSpeedMultiplier scales a parried projectile's speed on its way
+	// back
+	DeflectSpeedMultiplier = 1.5
+
+	// DeflectDamageMultiplier scales the damage a parried projectile deals
+	// to enemies
+	DeflectDamageMultiplier = 2
+)
+
+// SpawnEnemyProjectileFrom fires a hostile projectile from (x, y) on behalf
+// of source. A parried projectile flies back at its source.
+func (cs *CombatSystem) SpawnEnemyProjectileFrom(source *entity.EnemyInstance, x, y, velX, velY float64, damage int) {
+	cs.SpawnEnemyProjectile(x, y, velX, velY, damage)
+	cs.enemyProjectiles[len(cs.enemyProjectiles)-1].Source = source
+}
+
+// deflectProjectile turns a parried hostile projectile around: it speeds
+// back toward the enemy that fired it, or straight back when the source is
+// unknown or gone, and from then on only hurts enemies.
+func (cs *CombatSystem) deflectProjectile(p *Projectile) {
+	speed := math.Hypot(p.VelX, p.VelY) * DeflectSpeedMultiplier
+	dirX, dirY := -p.VelX, -p.VelY
+	if p.Source != nil && !p.Source.IsDead() {
+		sx, sy, sw, sh := p.Source.GetBounds()
+		dirX, dirY = sx+sw/2-p.X, sy+sh/2-p.Y
+	}
+	if length := math.Hypot(dirX, dirY); length > 0 {
+		p.VelX, p.VelY = dirX/length*speed, dirY/length*speed
+	}
+	p.Damage *= DeflectDamageMultiplier
+	p.DistTraveled = 0
+	p.Deflected = true
+}
+
+// CheckDeflectedProjectileEnemyHit tests parried projectiles against the
+// given enemy. On first hit the projectile is deactivated and its damage is
+// applied to the enemy. Returns the damage dealt, or 0.
+func (cs *CombatSystem) CheckDeflectedProjectileEnemyHit(enemy *entity.EnemyInstance) int {
+	if enemy.IsDead() {
+		return 0
+	}
+	ex, ey, ew, eh := enemy.GetBounds()
+	for i := range cs.enemyProjectiles {
+		p := &cs.enemyProjectiles[i]
+		if !p.Active || !p.Deflected {
+			continue
+		}
+		if p.X >= ex && p.X <= ex+ew && p.Y >= ey && p.Y <= ey+eh {
+			p.Active = false
+			cs.ApplyDamageToEnemy(enemy, p.Damage, p.X-p.VelX)
+			return p.Damage
+		}
+	}
+	return 0
+}
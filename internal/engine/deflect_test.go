@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+// fireAtPlayer has an enemy to the right of player shoot a projectile that is
+// already overlapping the player, flying left
+func fireAtPlayer(cs *CombatSystem, player *Player) *entity.EnemyInstance {
+	shooter := entity.NewEnemyInstance(&entity.Enemy{Health: 100, Damage: 10}, player.X+200, player.Y)
+	cs.SpawnEnemyProjectileFrom(shooter, player.X+16, player.Y+16, -4, 0, 10)
+	return shooter
+}
+
+func TestParriedProjectileDamagesShooter(t *testing.T) {
+	cs := NewCombatSystem()
+	player := &Player{X: 100, Y: 100, Health: 100, MaxHealth: 100}
+	shooter := fireAtPlayer(cs, player)
+
+	if !cs.PlayerParry() {
+		t.Fatal("PlayerParry() = false")
+	}
+	if dmg := cs.CheckEnemyProjectilePlayerHit(player, 32, 32); dmg != 0 {
+		t.Errorf("parried projectile reported %d damage to the player", dmg)
+	}
+	if player.Health != 100 {
+		t.Errorf("player health = %d after a parry, want 100", player.Health)
+	}
+
+	p := cs.GetEnemyProjectiles()[0]
+	if !p.Active || !p.Deflected {
+		t.Fatalf("projectile not deflected: active=%v deflected=%v", p.Active, p.Deflected)
+	}
+	if p.VelX <= 0 {
+		t.Errorf("deflected projectile VelX = %v, want it flying back right", p.VelX)
+	}
+
+	startHealth := shooter.CurrentHealth
+	dealt := 0
+	for frame := 0; frame < 120 && dealt == 0; frame++ {
+		cs.Update()
+		if cs.CheckEnemyProjectilePlayerHit(player, 32, 32) != 0 {
+			t.Fatal("deflected projectile hit the player")
+		}
+		dealt = cs.CheckDeflectedProjectileEnemyHit(shooter)
+	}
+	if dealt != 10*DeflectDamageMultiplier {
+		t.Fatalf("deflected projectile dealt %d damage, want %d", dealt, 10*DeflectDamageMultiplier)
+	}
+	if shooter.CurrentHealth >= startHealth {
+		t.Errorf("shooter health = %d, want below %d", shooter.CurrentHealth, startHealth)
+	}
+}
+
+func TestUnparriedProjectileDamagesPlayer(t *testing.T) {
+	cs := NewCombatSystem()
+	player := &Player{X: 100, Y: 100, Health: 100, MaxHealth: 100}
+	shooter := fireAtPlayer(cs, player)
+
+	if dmg := cs.CheckEnemyProjectilePlayerHit(player, 32, 32); dmg != 10 {
+		t.Errorf("projectile dealt %d damage, want 10", dmg)
+	}
+	if player.Health != 90 {
+		t.Errorf("player health = %d, want 90", player.Health)
+	}
+	if cs.CheckDeflectedProjectileEnemyHit(shooter) != 0 {
+		t.Error("a projectile that hit the player damaged its shooter")
+	}
+}
@@ -0,0 +1,54 @@
+package engine
+
+import "github.com/opd-ai/vania/internal/world"
+
+// abilityRoom returns the room where the ability with the given key is
+// found: its pedestal, or else the room of the boss that grants it
+func (gr *GameRunner) abilityRoom(abilityKey string) *world.Room {
+	if gr.game.World == nil {
+		return nil
+	}
+	for _, p := range gr.game.AbilityPedestals {
+		if gr.normalizeAbilityKey(p.Ability.Name) == abilityKey {
+			for _, room := range gr.game.World.Rooms {
+				if room.ID == p.RoomID {
+					return room
+				}
+			}
+		}
+	}
+	for _, room := range gr.game.World.Rooms {
+		boss := gr.transitionHandler.BossForRoom(room)
+		if boss != nil && boss.GrantsAbility != "" && gr.normalizeAbilityKey(boss.GrantsAbility) == abilityKey {
+			return room
+		}
+	}
+	return nil
+}
+
+// abilityRegion returns the map region holding the ability with the given
+// key, or nil if it is nowhere in the world
+func (gr *GameRunner) abilityRegion(abilityKey string) *world.BiomeRegion {
+	room := gr.abilityRoom(abilityKey)
+	if room == nil {
+		return nil
+	}
+	for _, region := range gr.biomeRegions() {
+		for _, r := range region.Rooms {
+			if r == room {
+				return region
+			}
+		}
+	}
+	return nil
+}
+
+// lockedDoorHint returns the message for a door held shut by requirement,
+// pointing toward the region where the ability can be found when it is
+// known
+func (gr *GameRunner) lockedDoorHint(requirement string) string {
+	if region := gr.abilityRegion(requirement); region != nil {
+		return gr.loc.Text("toast.door_hint", requirement, region.Label)
+	}
+	return gr.loc.Text("toast.door_requires", requirement)
+}
@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestLockedDoorHintNamesRegionHoldingAbility(t *testing.T) {
+	gr := newTestRunner(t)
+	if len(gr.game.AbilityPedestals) == 0 {
+		t.Fatal("generated world has no ability pedestals")
+	}
+	pedestal := gr.game.AbilityPedestals[len(gr.game.AbilityPedestals)-1]
+	key := gr.normalizeAbilityKey(pedestal.Ability.Name)
+	delete(gr.game.Player.Abilities, key)
+
+	// Lock the first door of the current room behind the pedestal's ability
+	room := gr.game.CurrentRoom
+	if len(room.Doors) == 0 || room.Doors[0].LeadsTo == nil {
+		t.Fatal("start room has no connecting door")
+	}
+	door := &room.Doors[0]
+	door.Locked, door.PuzzleGated, door.BossGated = true, false, false
+	gr.game.World.Graph.Edges = append([]world.GraphEdge{{From: room.ID, To: door.LeadsTo.ID, Requirement: key}},
+		gr.game.World.Graph.Edges...)
+
+	gr.game.Player.X, gr.game.Player.Y = float64(door.X), float64(door.Y)
+	gr.checkLockedDoorInteraction()
+
+	region := gr.abilityRegion(key)
+	if region == nil {
+		t.Fatalf("no region found for ability %q", key)
+	}
+	holds := false
+	for _, r := range region.Rooms {
+		holds = holds || r.ID == pedestal.RoomID
+	}
+	if !holds {
+		t.Errorf("hinted region %q does not contain the %q pedestal's room %d", region.Label, key, pedestal.RoomID)
+	}
+	if !strings.Contains(gr.lockedDoorMessage, region.Label) || !strings.Contains(gr.lockedDoorMessage, key) {
+		t.Errorf("locked door message = %q, want it to name %q and %q", gr.lockedDoorMessage, key, region.Label)
+	}
+}
+
+func TestLockedDoorHintWithoutKnownSource(t *testing.T) {
+	gr := newTestRunner(t)
+	if got, want := gr.lockedDoorHint("teleport"), "Requires: teleport"; got != want {
+		t.Errorf("hint for an ability found nowhere = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"math"
+	"sort"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+// DefaultMaxActiveEnemies is how many living enemies update and draw at
+// once unless the performance settings say otherwise
+const DefaultMaxActiveEnemies = 12
+
+// SetMaxActiveEnemies caps how many living enemies update and draw at once.
+// Beyond the cap the enemies farthest from the player are frozen and hidden
+// until others die; zero or less removes the cap.
+func (gr *GameRunner) SetMaxActiveEnemies(limit int) {
+	gr.maxActiveEnemies = limit
+}
+
+// EnemyActive reports whether an enemy is updating and drawn this frame
+func (gr *GameRunner) EnemyActive(enemy *entity.EnemyInstance) bool {
+	return !gr.frozenEnemies[enemy]
+}
+
+// updateActiveEnemies picks which living enemies run this frame: bosses
+// always, then the enemies nearest the player, up to the cap
+func (gr *GameRunner) updateActiveEnemies() {
+	clear(gr.frozenEnemies)
+	if gr.maxActiveEnemies <= 0 || gr.liveEnemyCount() <= gr.maxActiveEnemies {
+		return
+	}
+
+	px, py := gr.game.Player.X, gr.game.Player.Y
+	distance := func(enemy *entity.EnemyInstance) float64 {
+		x, y, w, h := enemy.GetBounds()
+		return math.Hypot(x+w/2-px, y+h/2-py)
+	}
+	living := make([]*entity.EnemyInstance, 0, len(gr.enemyInstances))
+	for _, enemy := range gr.enemyInstances {
+		if !enemy.IsDead() {
+			living = append(living, enemy)
+		}
+	}
+	sort.SliceStable(living, func(i, j int) bool {
+		bi, bj := living[i].Enemy.Size == entity.BossEnemy, living[j].Enemy.Size == entity.BossEnemy
+		if bi != bj {
+			return bi
+		}
+		return distance(living[i]) < distance(living[j])
+	})
+	for _, enemy := range living[gr.maxActiveEnemies:] {
+		gr.frozenEnemies[enemy] = true
+	}
+}
@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+func TestActiveEnemyCapFreezesFarthestAndReactivates(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetMaxActiveEnemies(3)
+	gr.game.Player.X, gr.game.Player.Y = 100, 300
+
+	template := &entity.Enemy{Name: "Husk", Health: 20, Damage: 1, Speed: 1,
+		Behavior: entity.FlyingBehavior, Size: entity.MediumEnemy}
+	var enemies []*entity.EnemyInstance
+	for i := 0; i < 5; i++ {
+		enemies = append(enemies, entity.NewEnemyInstance(template, 200+float64(i)*150, 100))
+	}
+	gr.enemyInstances = enemies
+
+	frozenX, frozenY := enemies[4].X, enemies[4].Y
+	for frame := 0; frame < 30; frame++ {
+		gr.updateEnemies()
+	}
+	for i, enemy := range enemies {
+		if want := i < 3; gr.EnemyActive(enemy) != want {
+			t.Errorf("enemy %d active = %v, want %v", i, gr.EnemyActive(enemy), want)
+		}
+	}
+	if enemies[4].X != frozenX || enemies[4].Y != frozenY {
+		t.Errorf("frozen enemy moved from (%.0f, %.0f) to (%.0f, %.0f)", frozenX, frozenY, enemies[4].X, enemies[4].Y)
+	}
+
+	// Two deaths make room for both frozen enemies
+	enemies[0].TakeDamage(enemies[0].CurrentHealth)
+	enemies[1].TakeDamage(enemies[1].CurrentHealth)
+	gr.updateEnemies()
+	for _, enemy := range enemies[2:] {
+		if !gr.EnemyActive(enemy) {
+			t.Errorf("enemy at x %.0f still frozen with space under the cap", enemy.X)
+		}
+	}
+}
+
+func TestActiveEnemyCapKeepsBossActive(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetMaxActiveEnemies(1)
+	gr.game.Player.X, gr.game.Player.Y = 100, 300
+
+	minion := entity.NewEnemyInstance(&entity.Enemy{Name: "Imp", Health: 5,
+		Behavior: entity.FlyingBehavior, Size: entity.SmallEnemy}, 140, 300)
+	boss := entity.NewEnemyInstance(&entity.Enemy{Name: "Tyrant", Health: 500,
+		Behavior: entity.StationaryBehavior, Size: entity.BossEnemy}, 800, 200)
+	gr.enemyInstances = []*entity.EnemyInstance{minion, boss}
+
+	gr.updateActiveEnemies()
+	if !gr.EnemyActive(boss) || gr.EnemyActive(minion) {
+		t.Errorf("boss active = %v, minion active = %v; the boss should take the only slot",
+			gr.EnemyActive(boss), gr.EnemyActive(minion))
+	}
+
+	gr.SetMaxActiveEnemies(0)
+	gr.updateActiveEnemies()
+	if !gr.EnemyActive(minion) {
+		t.Error("without a cap every enemy should be active")
+	}
+}
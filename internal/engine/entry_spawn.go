@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Entry spawn tuning, in pixels
+const (
+	EntryDoorGap   = 8.0   // Space between the entrance and the player, so they don't walk straight back out
+	EntryFallbackX = 100.0 // Where the player enters a room with no matching door
+)
+
+// oppositeDoor maps a door's direction to the wall the player enters from
+var oppositeDoor = map[string]string{
+	"east":  "west",
+	"west":  "east",
+	"north": "south",
+	"south": "north",
+}
+
+// entryDoor returns the door of room the player arrives through after
+// leaving from through a door facing exitDirection: the door leading back,
+// else one on the opposite wall, else nil
+func entryDoor(room, from *world.Room, exitDirection string) *world.Door {
+	for i := range room.Doors {
+		if from != nil && room.Doors[i].LeadsTo == from {
+			return &room.Doors[i]
+		}
+	}
+	for i := range room.Doors {
+		if room.Doors[i].Direction == oppositeDoor[exitDirection] {
+			return &room.Doors[i]
+		}
+	}
+	return nil
+}
+
+// entrySpawnPoint returns where the player appears in room: just inside
+// the entrance door, standing on the first platform below it and clear of
+// hazards
+func entrySpawnPoint(room, from *world.Room, exitDirection string) (float64, float64) {
+	door := entryDoor(room, from, exitDirection)
+	if door == nil {
+		return standingSpot(room, EntryFallbackX, 0, 1)
+	}
+
+	dx, dy := float64(door.X), float64(door.Y)
+	dw, dh := float64(door.Width), float64(door.Height)
+	switch door.Direction {
+	case "east":
+		// Step in to the left of a right-hand door
+		return standingSpot(room, dx-physics.PlayerWidth-EntryDoorGap, dy, -1)
+	case "south":
+		// Arriving from below: stand beside the floor hatch
+		return standingSpot(room, dx+dw+EntryDoorGap, dy, 1)
+	case "north":
+		return standingSpot(room, dx+dw+EntryDoorGap, dy+dh, 1)
+	default:
+		return standingSpot(room, dx+dw+EntryDoorGap, dy, 1)
+	}
+}
+
+// standingSpot drops a player at x from fromY onto the first platform
+// below, or the room floor, and steps inward along dir until the spot is
+// clear of hazards. The result is clamped inside the room.
+func standingSpot(room *world.Room, x, fromY, dir float64) (float64, float64) {
+	maxX := float64(world.RoomPixelWidth) - physics.PlayerWidth
+	for step := 0; step < world.RoomPixelWidth/physics.PlayerWidth; step++ {
+		x = max(0, min(maxX, x))
+		y := landingY(room, x, fromY) - physics.PlayerHeight
+		if !overlapsRoomHazard(room, x, y) {
+			return x, y
+		}
+		x += dir * physics.PlayerWidth
+	}
+	return x, float64(room.GroundY()) - physics.PlayerHeight
+}
+
+// landingY returns the top of the first platform at or below fromY under a
+// player at x, or the room floor if none is
+func landingY(room *world.Room, x, fromY float64) float64 {
+	best := float64(room.GroundY())
+	for _, p := range room.Platforms {
+		top := float64(p.Y)
+		if top < fromY || top >= best {
+			continue
+		}
+		if x+physics.PlayerWidth > float64(p.X) && x < float64(p.X+p.Width) {
+			best = top
+		}
+	}
+	return best
+}
+
+// overlapsRoomHazard reports whether a player at (x, y) touches one of the
+// room's hazards
+func overlapsRoomHazard(room *world.Room, x, y float64) bool {
+	for _, h := range room.Hazards {
+		if physics.AABBOverlap(x, y, physics.PlayerWidth, physics.PlayerHeight,
+			float64(h.X), float64(h.Y), float64(h.Width), float64(h.Height)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// newEntryRooms links two rooms through the first's east door and the
+// second's west door. The second room has a ledge beneath its west door
+// and a floor below that.
+func newEntryRooms() (*world.Room, *world.Room) {
+	from := &world.Room{ID: 1}
+	to := &world.Room{
+		ID: 2,
+		Platforms: []world.Platform{
+			{X: 0, Y: 600, Width: world.RoomPixelWidth, Height: 40},
+			{X: 0, Y: 400, Width: 200, Height: 16},
+		},
+	}
+	from.Doors = []world.Door{{X: 886, Y: 272, Width: 64, Height: 96, Direction: "east", LeadsTo: to}}
+	to.Doors = []world.Door{
+		{X: 10, Y: 272, Width: 64, Height: 96, Direction: "west", LeadsTo: from},
+		{X: 886, Y: 272, Width: 64, Height: 96, Direction: "east"},
+	}
+	return from, to
+}
+
+func TestEnteringThroughRightDoorSpawnsAtLeftEntrance(t *testing.T) {
+	from, to := newEntryRooms()
+	game := &Game{CurrentRoom: from, Player: &Player{X: 900, Y: 300}}
+	rth := NewRoomTransitionHandler(game)
+
+	rth.StartTransition(&from.Doors[0])
+	rth.CompleteTransition()
+
+	entrance := to.Doors[0]
+	p := game.Player
+	if game.CurrentRoom != to {
+		t.Fatal("transition should move into the connected room")
+	}
+	if p.X < float64(entrance.X+entrance.Width) || p.X > float64(entrance.X+entrance.Width)+64 {
+		t.Errorf("player x = %.0f, want just right of the left entrance at %d", p.X, entrance.X+entrance.Width)
+	}
+	if feet := p.Y + physics.PlayerHeight; feet != 400 {
+		t.Errorf("player feet at y = %.0f, want on the ledge at 400", feet)
+	}
+	if rth.CheckDoorCollision(p.X, p.Y, physics.PlayerWidth, physics.PlayerHeight, nil) != nil {
+		t.Error("player should not spawn touching a door")
+	}
+}
+
+func TestEntrySpawnAvoidsHazards(t *testing.T) {
+	from, to := newEntryRooms()
+	to.Platforms = to.Platforms[:1]
+	to.Hazards = []world.Hazard{{X: 70, Y: 580, Type: "spike", Damage: 10, Width: 60, Height: 20}}
+
+	x, y := entrySpawnPoint(to, from, "east")
+	if overlapsRoomHazard(to, x, y) {
+		t.Errorf("spawn (%.0f, %.0f) overlaps the spikes", x, y)
+	}
+	if y+physics.PlayerHeight != 600 {
+		t.Errorf("player feet at y = %.0f, want on the floor at 600", y+physics.PlayerHeight)
+	}
+	if x > 200 {
+		t.Errorf("spawn x = %.0f, want it kept near the entrance", x)
+	}
+}
+
+func TestEntrySpawnFallsBackToOppositeWall(t *testing.T) {
+	_, to := newEntryRooms()
+	stranger := &world.Room{ID: 3}
+
+	// Leaving westward, the player enters through the east door
+	x, _ := entrySpawnPoint(to, stranger, "west")
+	if x >= 886 || x < 886-64 {
+		t.Errorf("spawn x = %.0f, want just left of the east door", x)
+	}
+}
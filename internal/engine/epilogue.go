@@ -0,0 +1,27 @@
+package engine
+
+import (
+	"github.com/opd-ai/vania/internal/narrative"
+	"github.com/opd-ai/vania/internal/pcg"
+)
+
+// Epilogue writes the run's ending from the world's narrative and the
+// player's accomplishments so far, one paragraph per string. It returns nil
+// when the game has no narrative.
+func (gr *GameRunner) Epilogue() []string {
+	if gr.game.Narrative == nil {
+		return nil
+	}
+
+	stats := narrative.EpilogueStats{
+		BossesDefeated: len(gr.defeatedBosses),
+		TotalBosses:    len(gr.mandatoryBosses()),
+	}
+	if gr.game.Achievements != nil {
+		s := gr.game.Achievements.GetStatistics()
+		stats.SecretsFound = s.SecretsFound
+		stats.ItemsCollected = s.ItemsCollected
+		stats.Deaths = s.DeathCount
+	}
+	return narrative.GenerateEpilogue(gr.game.Narrative, pcg.HashSeed(gr.game.Seed, "epilogue"), stats)
+}
@@ -0,0 +1,30 @@
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunnerEpilogue(t *testing.T) {
+	gr := newTestRunner(t)
+	for _, boss := range gr.mandatoryBosses() {
+		gr.defeatedBosses[boss.Name] = true
+	}
+	epilogue := gr.Epilogue()
+	text := strings.Join(epilogue, " ")
+	if !strings.Contains(text, gr.game.Narrative.Catastrophe) {
+		t.Errorf("epilogue does not mention the catastrophe: %s", text)
+	}
+	if !strings.Contains(text, "all ") {
+		t.Errorf("epilogue after beating every boss does not say so: %s", text)
+	}
+
+	again := newTestRunner(t)
+	for _, boss := range again.mandatoryBosses() {
+		again.defeatedBosses[boss.Name] = true
+	}
+	if !reflect.DeepEqual(epilogue, again.Epilogue()) {
+		t.Error("epilogue is not deterministic for the same seed and run")
+	}
+}
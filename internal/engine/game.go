@@ -21,6 +21,7 @@ type Game struct {
 	World        *world.World
 	Entities     []*entity.Enemy
 	Bosses       []*entity.Boss
+	MiniBosses   []*entity.MiniBoss
 	Items        []*entity.Item
 	Abilities    []entity.Ability
 	Graphics     *GraphicsSystem
@@ -33,6 +34,18 @@ type Game struct {
 	Genre        string
 	PCGContext   *pcg.PCGContext
 	Achievements *achievement.AchievementTracker
+	NGPlusLevel  int    // 0 for a first run, incremented per New Game Plus cycle
+	Loadout      string // Starting loadout name chosen at new game
+
+	// StartingAbilities are the NG+ abilities carried into this run
+	StartingAbilities []string
+
+	// AbilityPedestals hold the abilities found in the world, in unlock order
+	AbilityPedestals []*AbilityPedestal
+
+	// PlayerPalette is the cosmetic player palette variant; 0 keeps the
+	// generated colors (see SetPlayerPalette)
+	PlayerPalette int
 }
 
 // Player represents the player character
@@ -65,6 +78,10 @@ type AudioSystem struct {
 	Sounds         map[string]*audio.AudioSample
 	Music          map[string]*audio.AudioSample
 	AdaptiveTracks map[string]*audio.AdaptiveMusicTrack
+
+	// Ambient holds the ambient bed looped beneath the music, by biome
+	// music mood
+	Ambient map[string]*audio.MusicLayer
 }
 
 // GameGenerator orchestrates all generation
@@ -77,6 +94,17 @@ type GameGenerator struct {
 	WorldGen     *world.WorldGenerator
 	EntityGen    *EntityGenerator
 	PCGContext   *pcg.PCGContext
+
+	// New Game Plus: abilities the player starts with and difficulty level
+	StartingAbilities []string
+	NGPlusLevel       int
+
+	// Loadout sets the player's starting stats and ability
+	Loadout Loadout
+
+	// EnemyDefinitions are hand-authored enemies added to the procedural
+	// ones, appearing in rooms of their biome
+	EnemyDefinitions []entity.EnemyDefinition
 }
 
 // GraphicsGenerator manages graphics generation
@@ -112,7 +140,27 @@ func NewGameGeneratorWithGenre(masterSeed int64, genre string) *GameGenerator {
 		WorldGen:     world.NewWorldGenerator(15, 10, 100, 5),
 		EntityGen:    &EntityGenerator{Seed: seeds["entity"]},
 		PCGContext:   pcg.NewPCGContext(masterSeed),
+		Loadout:      loadouts[DefaultLoadoutName],
+	}
+}
+
+// SetDensity sets how many enemies, items and hazards the world holds
+func (gg *GameGenerator) SetDensity(density world.Density) error {
+	if err := density.Validate(); err != nil {
+		return err
 	}
+	gg.WorldGen.Density = density
+	return nil
+}
+
+// SetLayoutWeights sets the platform layouts each biome's combat rooms
+// choose between
+func (gg *GameGenerator) SetLayoutWeights(weights world.LayoutWeights) error {
+	if err := weights.Validate(); err != nil {
+		return err
+	}
+	gg.WorldGen.Layouts = weights
+	return nil
 }
 
 // GenerateCompleteGame creates a full game from seed
@@ -128,11 +176,13 @@ func (gg *GameGenerator) GenerateCompleteGame() (*Game, error) {
 	// Generate world using narrative constraints
 	worldData := gg.WorldGen.Generate(
 		pcg.HashSeed(gg.MasterSeed, "world"),
-		narrative.WorldConstraints,
+		gg.worldConstraints(narrative),
 	)
 
 	// Generate entities that fit world biomes
 	entities, bosses, items, abilities := gg.generateEntities(worldData, narrative, graphicsSystem)
+	scaleEnemyDifficulty(entities, bosses, gg.NGPlusLevel)
+	miniBosses := gg.generateMiniBosses(worldData, entities, bosses)
 
 	// Generate audio matching narrative tone
 	audioSystem := gg.generateAudio(narrative, worldData)
@@ -157,6 +207,7 @@ func (gg *GameGenerator) GenerateCompleteGame() (*Game, error) {
 		World:        worldData,
 		Entities:     entities,
 		Bosses:       bosses,
+		MiniBosses:   miniBosses,
 		Items:        items,
 		Abilities:    abilities,
 		Graphics:     graphicsSystem,
@@ -169,6 +220,11 @@ func (gg *GameGenerator) GenerateCompleteGame() (*Game, error) {
 		Genre:        gg.Genre,
 		PCGContext:   gg.PCGContext,
 		Achievements: achievementTracker,
+		NGPlusLevel:  gg.NGPlusLevel,
+		Loadout:      gg.Loadout.Name,
+
+		StartingAbilities: gg.StartingAbilities,
+		AbilityPedestals:  PlaceAbilityPedestals(worldData, abilities),
 	}
 
 	generationTime := time.Since(startTime)
@@ -177,6 +233,19 @@ func (gg *GameGenerator) GenerateCompleteGame() (*Game, error) {
 	return game, nil
 }
 
+// worldConstraints merges the narrative's world constraints with the
+// player's starting abilities so the world never gates on owned abilities.
+func (gg *GameGenerator) worldConstraints(narrative *narrative.WorldContext) map[string]interface{} {
+	constraints := make(map[string]interface{}, len(narrative.WorldConstraints)+1)
+	for k, v := range narrative.WorldConstraints {
+		constraints[k] = v
+	}
+	if abilities := gg.startingAbilities(); len(abilities) > 0 {
+		constraints["starting_abilities"] = abilities
+	}
+	return constraints
+}
+
 // generateGraphics creates all graphics
 func (gg *GameGenerator) generateGraphics(narrative *narrative.WorldContext) *GraphicsSystem {
 	system := &GraphicsSystem{
@@ -208,6 +277,7 @@ func (gg *GameGenerator) generateAudio(narrative *narrative.WorldContext, worldD
 		Sounds:         make(map[string]*audio.AudioSample),
 		Music:          make(map[string]*audio.AudioSample),
 		AdaptiveTracks: make(map[string]*audio.AdaptiveMusicTrack),
+		Ambient:        make(map[string]*audio.MusicLayer),
 	}
 
 	// Generate sound effects
@@ -219,16 +289,19 @@ func (gg *GameGenerator) generateAudio(narrative *narrative.WorldContext, worldD
 		audio.PickupSFX,
 		audio.DoorSFX,
 		audio.DamageSFX,
+		audio.WindupSFX,
 	}
 
 	for i, sfxType := range sfxTypes {
-		key := []string{"jump", "land", "attack", "hit", "pickup", "door", "damage"}[i]
+		key := []string{"jump", "land", "attack", "hit", "pickup", "door", "damage", "windup"}[i]
 		system.Sounds[key] = system.SFXGen.Generate(sfxType, gg.AudioGen.Seed+int64(i))
 	}
 
-	// Generate adaptive music tracks for each biome
+	// Generate adaptive music tracks for each biome, all carrying one motif
+	motif := audio.GenerateMotif(pcg.HashSeed(gg.AudioGen.Seed, "motif"))
 	for i, biome := range worldData.Biomes {
 		musicGen := gg.selectMusicGenerator(biome)
+		musicGen.SetMotif(motif, motifWave(biome))
 
 		// Generate adaptive track with multiple layers
 		adaptiveTrack := musicGen.GenerateAdaptiveMusicTrack(
@@ -244,6 +317,16 @@ func (gg *GameGenerator) generateAudio(narrative *narrative.WorldContext, worldD
 		)
 	}
 
+	// Generate one ambient bed per biome mood, seeded apart from the music
+	ambientGen := audio.NewAmbientGenerator(44100)
+	for i, biome := range worldData.Biomes {
+		mood := biome.GetMusicMood()
+		if _, exists := system.Ambient[mood]; !exists {
+			system.Ambient[mood] = ambientGen.Generate(mood,
+				pcg.HashSeed(gg.AudioGen.Seed, "ambient")+int64(i), audio.DefaultAmbientDuration)
+		}
+	}
+
 	return system
 }
 
@@ -278,12 +361,75 @@ func (gg *GameGenerator) selectMusicGenerator(biome *world.Biome) *audio.MusicGe
 	return audio.NewMusicGenerator(44100, bpm, 60, scale)
 }
 
+// motifWave chooses the instrument a biome's track plays the motif on
+func motifWave(biome *world.Biome) audio.WaveType {
+	switch biome.GetMusicMood() {
+	case "dark_ambient":
+		return audio.SawtoothWave
+	case "mysterious", "ethereal":
+		return audio.SineWave
+	case "horror":
+		return audio.SquareWave
+	default:
+		return audio.TriangleWave
+	}
+}
+
+// definedEnemies builds the enemies from gg.EnemyDefinitions whose biome
+// exists in the world. A definition without a kind the biome spawns takes
+// the biome's first enemy type, so rooms of that biome can select it.
+func (gg *GameGenerator) definedEnemies(worldData *world.World) []*entity.Enemy {
+	biomes := make(map[string]*world.Biome, len(worldData.Biomes))
+	for _, biome := range worldData.Biomes {
+		if biome != nil {
+			biomes[biome.Name] = biome
+		}
+	}
+
+	var enemies []*entity.Enemy
+	for _, def := range gg.EnemyDefinitions {
+		biome, ok := biomes[def.Biome]
+		if !ok {
+			continue
+		}
+		enemy := def.Enemy()
+		if kinds := biome.EnemyTypes; len(kinds) > 0 && !containsString(kinds, enemy.Kind) {
+			enemy.Kind = kinds[0]
+		}
+		if enemy.DangerLevel == 0 {
+			enemy.DangerLevel = biome.DangerLevel
+		}
+
+		enemy.SpriteData = generateEnemySprite(enemy, pcg.HashSeed(gg.EntityGen.Seed, "enemy-def:"+def.Name))
+
+		enemies = append(enemies, enemy)
+	}
+	return enemies
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// newNameGenerator creates the generator that names enemies and bosses in
+// the vocabulary of the story's theme
+func newNameGenerator(seed int64, story *narrative.WorldContext) *narrative.NameGenerator {
+	return narrative.NewNameGenerator(seed, story.Theme)
+}
+
 // generateEntities creates all enemies, bosses, items, and abilities
 func (gg *GameGenerator) generateEntities(worldData *world.World, narrative *narrative.WorldContext, gfx *GraphicsSystem) ([]*entity.Enemy, []*entity.Boss, []*entity.Item, []entity.Ability) {
 	enemyGen := entity.NewEnemyGenerator(gg.EntityGen.Seed)
 	bossGen := entity.NewBossGenerator(gg.EntityGen.Seed + 1000)
 	itemGen := entity.NewItemGenerator(gg.EntityGen.Seed + 2000)
 	abilityGen := entity.NewAbilityGenerator(gg.EntityGen.Seed + 3000)
+	names := newNameGenerator(gg.EntityGen.Seed+4000, narrative)
 
 	var enemies []*entity.Enemy
 	var bosses []*entity.Boss
@@ -298,18 +444,13 @@ func (gg *GameGenerator) generateEntities(worldData *world.World, narrative *nar
 					room.Biome.DangerLevel,
 					gg.EntityGen.Seed+int64(i*1000+j),
 				)
+				if kinds := room.Biome.EnemyTypes; len(kinds) > 0 {
+					enemy.Kind = kinds[j%len(kinds)]
+				}
+				enemy.Name = names.EnemyName(room.Biome.Name, int64(i*1000+j))
 
 				// Generate sprite for this enemy
-				enemySize := 32
-				if enemy.Size == entity.SmallEnemy {
-					enemySize = 16
-				} else if enemy.Size == entity.LargeEnemy {
-					enemySize = 48
-				} else if enemy.Size == entity.BossEnemy {
-					enemySize = 64
-				}
-				enemySpriteGen := graphics.NewSpriteGenerator(enemySize, enemySize, graphics.VerticalSymmetry)
-				enemy.SpriteData = enemySpriteGen.Generate(gg.EntityGen.Seed + int64(i*1000+j+5000))
+				enemy.SpriteData = generateEnemySprite(enemy, gg.EntityGen.Seed+int64(i*1000+j+5000))
 
 				enemies = append(enemies, enemy)
 			}
@@ -318,10 +459,10 @@ func (gg *GameGenerator) generateEntities(worldData *world.World, narrative *nar
 				room.Biome.Name,
 				gg.EntityGen.Seed+int64(i*1000),
 			)
+			boss.Name = names.BossName(room.Biome.Name, int64(i*1000))
 
 			// Generate boss sprite (larger)
-			bossSpriteGen := graphics.NewSpriteGenerator(64, 64, graphics.VerticalSymmetry)
-			boss.SpriteData = bossSpriteGen.Generate(gg.EntityGen.Seed + int64(i*1000+10000))
+			boss.SpriteData = generateEnemySprite(&boss.Enemy, gg.EntityGen.Seed+int64(i*1000+10000))
 
 			bosses = append(bosses, boss)
 		}
@@ -339,6 +480,9 @@ func (gg *GameGenerator) generateEntities(worldData *world.World, narrative *nar
 		}
 	}
 
+	// Add hand-authored enemies
+	enemies = append(enemies, gg.definedEnemies(worldData)...)
+
 	// Generate ability progression
 	abilities := abilityGen.GenerateProgression(gg.EntityGen.Seed)
 
@@ -347,17 +491,39 @@ func (gg *GameGenerator) generateEntities(worldData *world.World, narrative *nar
 
 // createPlayer creates the player character
 func (gg *GameGenerator) createPlayer(gfx *GraphicsSystem) *Player {
-	// Create animation generator
-	animGen := animation.NewAnimationGenerator(gg.MasterSeed + 9999)
-
 	// Get base player sprite
 	baseSprite := gfx.Sprites["player"]
 
+	abilities := make(map[string]bool)
+	for _, ability := range gg.startingAbilities() {
+		abilities[ability] = true
+	}
+
+	return &Player{
+		X:              100,
+		Y:              100,
+		Health:         gg.Loadout.MaxHealth,
+		MaxHealth:      gg.Loadout.MaxHealth,
+		Damage:         gg.Loadout.Damage,
+		Speed:          5.0,
+		Abilities:      abilities,
+		Inventory:      make([]*entity.Item, 0),
+		Sprite:         baseSprite,
+		AnimController: gg.playerAnimations(baseSprite),
+	}
+}
+
+// playerAnimations builds the player's animations from its base sprite
+func (gg *GameGenerator) playerAnimations(baseSprite *graphics.Sprite) *animation.AnimationController {
+	// Create animation generator
+	animGen := animation.NewAnimationGenerator(gg.MasterSeed + 9999)
+
 	// Generate animation frames
 	idleFrames := animGen.GenerateIdleFrames(baseSprite, 4)
 	walkFrames := animGen.GenerateWalkFrames(baseSprite, 4)
 	jumpFrames := animGen.GenerateJumpFrames(baseSprite, 3)
 	attackFrames := animGen.GenerateAttackFrames(baseSprite, 3)
+	climbFrames := animGen.GenerateJumpFrames(baseSprite, 2)
 
 	// Create animation controller
 	animController := animation.NewAnimationController("idle")
@@ -365,19 +531,9 @@ func (gg *GameGenerator) createPlayer(gfx *GraphicsSystem) *Player {
 	animController.AddAnimation(animation.NewAnimation("walk", walkFrames, 8, true))
 	animController.AddAnimation(animation.NewAnimation("jump", jumpFrames, 8, false))
 	animController.AddAnimation(animation.NewAnimation("attack", attackFrames, 5, false))
+	animController.AddAnimation(animation.NewAnimation("climb", climbFrames, 12, true))
 
-	return &Player{
-		X:              100,
-		Y:              100,
-		Health:         100,
-		MaxHealth:      100,
-		Damage:         10,
-		Speed:          5.0,
-		Abilities:      make(map[string]bool),
-		Inventory:      make([]*entity.Item, 0),
-		Sprite:         baseSprite,
-		AnimController: animController,
-	}
+	return animController
 }
 
 // validate checks if generation is valid
@@ -0,0 +1,170 @@
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/audio"
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// newTestRunner returns a runner for the game generated from seed 42
+func newTestRunner(t *testing.T) *GameRunner {
+	t.Helper()
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	return NewGameRunner(game)
+}
+
+func TestEnemyDefinitionsMergeIntoGeneratedGame(t *testing.T) {
+	base, err := NewGameGenerator(77).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	biome := base.World.Biomes[0]
+
+	gg := NewGameGenerator(77)
+	gg.EnemyDefinitions = []entity.EnemyDefinition{
+		{Name: "Custom Horror", Biome: biome.Name, Kind: "not-a-kind", Health: 99, Damage: 7, Speed: 1.5, Size: "medium", Behavior: "chase"},
+		{Name: "Lost Wanderer", Biome: "no-such-biome", Health: 10, Size: "small", Behavior: "patrol"},
+	}
+	game, err := gg.GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+
+	if len(game.Entities) != len(base.Entities)+1 {
+		t.Fatalf("Expected one custom enemy added to %d procedural ones, got %d", len(base.Entities), len(game.Entities))
+	}
+	custom := game.Entities[len(game.Entities)-1]
+	if custom.Name != "Custom Horror" || custom.Health != 99 || custom.BiomeType != biome.Name {
+		t.Errorf("Unexpected custom enemy %+v", custom)
+	}
+	if len(biome.EnemyTypes) > 0 && custom.Kind != biome.EnemyTypes[0] {
+		t.Errorf("Custom enemy kind %q should fall back to the biome's %q", custom.Kind, biome.EnemyTypes[0])
+	}
+	if custom.SpriteData == nil {
+		t.Error("Custom enemy should get a generated sprite")
+	}
+}
+
+func TestGeneratedEnemiesAndBossesAreNamed(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	again, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+
+	for i, enemy := range game.Entities {
+		if enemy.Name == "" {
+			t.Errorf("enemy %d has no name", i)
+		}
+		if enemy.Name != again.Entities[i].Name {
+			t.Errorf("enemy %d named %q, then %q, for the same seed", i, enemy.Name, again.Entities[i].Name)
+		}
+	}
+	for i, boss := range game.Bosses {
+		if !strings.Contains(boss.Name, " of the ") {
+			t.Errorf("boss %d name %q was not built by the name generator", i, boss.Name)
+		}
+		if boss.Name != again.Bosses[i].Name {
+			t.Errorf("boss %d named %q, then %q, for the same seed", i, boss.Name, again.Bosses[i].Name)
+		}
+	}
+}
+
+// spawnedCombatEnemies totals the enemies spawned across a game's combat rooms
+func spawnedCombatEnemies(game *Game) int {
+	rth := NewRoomTransitionHandler(game)
+	total := 0
+	for _, room := range game.World.Rooms {
+		if room.Type == world.CombatRoom {
+			total += len(rth.SpawnEnemiesForRoom(room))
+		}
+	}
+	return total
+}
+
+func TestEnemyDensityScalesSpawns(t *testing.T) {
+	normal, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+
+	gg := NewGameGenerator(42)
+	if err := gg.SetDensity(world.Density{Enemies: 2, Items: 1, Hazards: 1}); err != nil {
+		t.Fatalf("SetDensity() error = %v", err)
+	}
+	packed, err := gg.GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	if !gg.validate(packed.World, packed.Entities, packed.Narrative) {
+		t.Error("packed world failed generation validation")
+	}
+
+	base, more := spawnedCombatEnemies(normal), spawnedCombatEnemies(packed)
+	ratio := float64(more) / float64(base)
+	if ratio < 1.7 || ratio > 2.3 {
+		t.Errorf("density 2 spawned %d enemies vs %d normally (x%.2f), want about double", more, base, ratio)
+	}
+}
+
+func TestSetDensityRejectsInvalid(t *testing.T) {
+	gg := NewGameGenerator(42)
+	if err := gg.SetDensity(world.Density{Enemies: -1, Items: 1, Hazards: 1}); err == nil {
+		t.Error("SetDensity() accepted a negative density")
+	}
+	if gg.WorldGen.Density != world.DefaultDensity() {
+		t.Error("rejected density should leave the generator unchanged")
+	}
+}
+
+func TestSetLayoutWeightsRejectsInvalid(t *testing.T) {
+	gg := NewGameGenerator(42)
+	if err := gg.SetLayoutWeights(world.LayoutWeights{"cave": {{Layout: world.MazeLayout, Weight: 0}}}); err == nil {
+		t.Error("SetLayoutWeights() accepted a biome with no weighted layouts")
+	}
+	if len(gg.WorldGen.Layouts["cave"]) != len(world.DefaultLayoutWeights()["cave"]) {
+		t.Error("rejected layouts should leave the generator unchanged")
+	}
+
+	bridges := world.LayoutWeights{"cave": {{Layout: world.BridgeLayout, Weight: 1}}}
+	if err := gg.SetLayoutWeights(bridges); err != nil {
+		t.Fatalf("SetLayoutWeights() error = %v", err)
+	}
+	if len(gg.WorldGen.Layouts) != 1 {
+		t.Error("valid layouts were not installed")
+	}
+}
+
+func TestBiomeTracksShareSoundtrackMotif(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	if len(game.Audio.AdaptiveTracks) < 2 {
+		t.Skip("world has fewer than two biome tracks")
+	}
+
+	var first *audio.MotifVoicing
+	for name, track := range game.Audio.AdaptiveTracks {
+		if track.Motif == nil {
+			t.Fatalf("biome %q track has no motif", name)
+		}
+		if first == nil {
+			first = track.Motif
+			continue
+		}
+		if !reflect.DeepEqual(track.Motif.Motif, first.Motif) {
+			t.Errorf("biome %q motif = %+v, want the shared %+v", name, track.Motif.Motif, first.Motif)
+		}
+	}
+}
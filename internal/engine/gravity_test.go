@@ -0,0 +1,41 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/input"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestPlayerFallsUpOntoCeilingInInvertedRoom(t *testing.T) {
+	gr := newTestRunner(t)
+
+	room := *gr.game.CurrentRoom
+	room.Gravity = world.GravityUp
+	room.Platforms = []world.Platform{{X: 0, Y: 64, Width: world.RoomPixelWidth, Height: 32}}
+	room.Doors, room.Hazards = nil, nil
+	gr.game.CurrentRoom = &room
+	gr.populateCurrentRoom()
+	gr.enemyInstances = nil
+
+	gr.playerBody.Position.X, gr.playerBody.Position.Y = 100, 300
+	gr.playerBody.Velocity.X, gr.playerBody.Velocity.Y = 0, 0
+	gr.playerBody.OnGround = false
+
+	startY := gr.playerBody.Position.Y
+	for frame := 0; frame < 120; frame++ {
+		if err := gr.Step(input.InputState{}); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+		if frame == 5 && gr.game.Player.Y >= startY {
+			t.Fatalf("player Y = %v after falling, want above the start %v", gr.game.Player.Y, startY)
+		}
+	}
+
+	if !gr.playerBody.OnGround {
+		t.Fatal("player never landed on the ceiling platform")
+	}
+	if gr.game.Player.Y != 96 {
+		t.Errorf("player Y = %v, want 96 (under the ceiling platform)", gr.game.Player.Y)
+	}
+}
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/render"
+)
+
+// EnemyHazardCooldown is how many frames a room hazard waits before
+// hurting the same enemy again, so one that lands in lava isn't melted in
+// a single touch
+const EnemyHazardCooldown = 30
+
+// checkEnemyEnvironment hurts an enemy standing in one of the room's
+// hazards and kills one that has fallen out of the room. Knockback and
+// launches carry enemies into both; the kill goes to the player. Returns
+// whether the environment killed the enemy.
+func (gr *GameRunner) checkEnemyEnvironment(enemy *entity.EnemyInstance) bool {
+	room := gr.game.CurrentRoom
+	if room == nil || enemy.IsDead() {
+		return false
+	}
+	if enemy.HazardFrames > 0 {
+		enemy.HazardFrames--
+	}
+
+	// Fell through a gap in the floor
+	if enemy.Y > float64(render.ScreenHeight) {
+		gr.hurtEnemyByEnvironment(enemy, CombatLogFall, enemy.CurrentHealth)
+		return enemy.IsDead()
+	}
+
+	if enemy.HazardFrames > 0 {
+		return false
+	}
+	ex, ey, ew, eh := enemy.GetBounds()
+	for _, hazard := range room.Hazards {
+		if !physics.AABBOverlap(ex, ey, ew, eh,
+			float64(hazard.X), float64(hazard.Y), float64(hazard.Width), float64(hazard.Height)) {
+			continue
+		}
+		enemy.HazardFrames = EnemyHazardCooldown
+		gr.hurtEnemyByEnvironment(enemy, hazard.Type, hazard.Damage)
+		return enemy.IsDead()
+	}
+	return false
+}
+
+// hurtEnemyByEnvironment deals environmental damage from source, credited
+// to the player
+func (gr *GameRunner) hurtEnemyByEnvironment(enemy *entity.EnemyInstance, source string, damage int) {
+	if damage <= 0 {
+		return
+	}
+	enemy.TakeDamage(damage)
+	gr.combatSystem.logDamage(source, enemy.Enemy.Name, damage, false, false, enemy.CurrentHealth)
+	if gr.game.Achievements != nil {
+		gr.game.Achievements.RecordDamage(damage, 0)
+	}
+	if !enemy.IsDead() {
+		return
+	}
+	if gr.game.Achievements != nil {
+		gr.game.Achievements.RecordHazardKill()
+	}
+	gr.recordEnemyDeath(enemy)
+}
@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/render"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// newHazardTestRunner returns a runner whose current room is a flat floor
+// with a lava pool at x 300-360
+func newHazardTestRunner(t *testing.T) *GameRunner {
+	t.Helper()
+	gr := newTestRunner(t)
+	room := *gr.game.CurrentRoom
+	room.Platforms = []world.Platform{{X: 0, Y: 400, Width: render.ScreenWidth, Height: 32}}
+	room.Hazards = []world.Hazard{{X: 300, Y: 380, Type: "lava", Damage: 15, Width: 60, Height: 20}}
+	gr.game.CurrentRoom = &room
+	gr.game.Player.X, gr.game.Player.Y = 40, 300
+	return gr
+}
+
+func TestEnemyKnockedIntoLavaTakesHazardDamage(t *testing.T) {
+	gr := newHazardTestRunner(t)
+	template := &entity.Enemy{Name: "Brute", Health: 100, Damage: 5, Speed: 1,
+		Behavior: entity.PatrolBehavior, Size: entity.MediumEnemy}
+	enemy := entity.NewEnemyInstance(template, 250, 0)
+	_, _, _, h := enemy.GetBounds()
+	enemy.Y = 400 - h
+	enemy.OnGround = true
+	gr.enemyInstances = []*entity.EnemyInstance{enemy}
+
+	gr.combatSystem.ApplyDamageToEnemy(enemy, 10, gr.game.Player.X)
+	enemy.Stun(30)
+	afterHit := enemy.CurrentHealth
+
+	for frame := 0; frame < 30 && enemy.CurrentHealth == afterHit; frame++ {
+		gr.updateSingleEnemy(enemy)
+	}
+	if got := afterHit - enemy.CurrentHealth; got != 15 {
+		t.Fatalf("hazard damage = %d, want the lava's 15", got)
+	}
+
+	// The lava waits out its cooldown before burning again
+	health := enemy.CurrentHealth
+	enemy.HitStunFrames, enemy.VelX, enemy.VelY = EnemyHazardCooldown, 0, 0
+	for frame := 0; frame < EnemyHazardCooldown-1; frame++ {
+		gr.checkEnemyEnvironment(enemy)
+	}
+	if enemy.CurrentHealth != health {
+		t.Errorf("health = %d during the cooldown, want %d", enemy.CurrentHealth, health)
+	}
+	gr.checkEnemyEnvironment(enemy)
+	if enemy.CurrentHealth != health-15 {
+		t.Errorf("health = %d after the cooldown, want %d", enemy.CurrentHealth, health-15)
+	}
+}
+
+func TestEnvironmentalKillsCountTowardStats(t *testing.T) {
+	gr := newHazardTestRunner(t)
+	template := &entity.Enemy{Name: "Imp", Health: 10, Damage: 5, Speed: 1,
+		Behavior: entity.PatrolBehavior, Size: entity.SmallEnemy}
+	burned := entity.NewEnemyInstance(template, 310, 370)
+	fallen := entity.NewEnemyInstance(template, 600, float64(render.ScreenHeight)+10)
+	gr.enemyInstances = []*entity.EnemyInstance{burned, fallen}
+	before := gr.game.Achievements.GetStatistics()
+
+	if !gr.checkEnemyEnvironment(burned) {
+		t.Error("enemy in the lava should be killed by it")
+	}
+	if !gr.checkEnemyEnvironment(fallen) {
+		t.Error("enemy that fell out of the room should die")
+	}
+
+	stats := gr.game.Achievements.GetStatistics()
+	if got := stats.EnemiesDefeated - before.EnemiesDefeated; got != 2 {
+		t.Errorf("enemies defeated rose by %d, want 2", got)
+	}
+	if got := stats.HazardKills - before.HazardKills; got != 2 {
+		t.Errorf("hazard kills rose by %d, want 2", got)
+	}
+	// Damage is credited in full, like the player's own hits: the lava's 15
+	// plus the fallen enemy's remaining 10
+	if got := stats.TotalDamageDealt - before.TotalDamageDealt; got != 25 {
+		t.Errorf("damage dealt rose by %d, want 25", got)
+	}
+}
@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/render"
+)
+
+// HitboxToggleKey shows or hides collision outlines. Unlike the F3 debug
+// inspector it works in normal builds, for streamers and bug reports.
+const HitboxToggleKey = ebiten.KeyF8
+
+// SetShowHitboxes turns the collision outline overlay on or off
+func (gr *GameRunner) SetShowHitboxes(enabled bool) {
+	gr.showHitboxes = enabled
+}
+
+// ShowHitboxes reports whether collision outlines are drawn
+func (gr *GameRunner) ShowHitboxes() bool {
+	return gr.showHitboxes
+}
+
+// hitboxes returns the collision rectangles of the player, living enemies,
+// and the current room's hazards and doors
+func (gr *GameRunner) hitboxes() []render.Hitbox {
+	var boxes []render.Hitbox
+	if gr.game.Player != nil {
+		boxes = append(boxes, render.Hitbox{Kind: render.PlayerHitbox,
+			X: gr.game.Player.X, Y: gr.game.Player.Y, W: physics.PlayerWidth, H: gr.playerHeight()})
+	}
+	for _, enemy := range gr.enemyInstances {
+		if enemy.IsDead() {
+			continue
+		}
+		x, y, w, h := enemy.GetBounds()
+		boxes = append(boxes, render.Hitbox{Kind: render.EnemyHitbox, X: x, Y: y, W: w, H: h})
+	}
+	if room := gr.game.CurrentRoom; room != nil {
+		for _, hazard := range room.Hazards {
+			boxes = append(boxes, render.Hitbox{Kind: render.HazardHitbox,
+				X: float64(hazard.X), Y: float64(hazard.Y), W: float64(hazard.Width), H: float64(hazard.Height)})
+		}
+		for _, door := range room.Doors {
+			boxes = append(boxes, render.Hitbox{Kind: render.DoorHitbox,
+				X: float64(door.X), Y: float64(door.Y), W: float64(door.Width), H: float64(door.Height)})
+		}
+	}
+	return boxes
+}
+
+// handleOverlayKeys toggles the full debug inspector and the hitbox
+// overlay; each key affects only its own overlay
+func (gr *GameRunner) handleOverlayKeys(inspectorPressed, hitboxesPressed bool) {
+	if inspectorPressed {
+		gr.showDebugInfo = !gr.showDebugInfo
+	}
+	if hitboxesPressed {
+		gr.showHitboxes = !gr.showHitboxes
+	}
+}
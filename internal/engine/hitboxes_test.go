@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/render"
+)
+
+func TestHitboxToggleLeavesDebugInspectorOff(t *testing.T) {
+	gr := newTestRunner(t)
+
+	gr.handleOverlayKeys(false, true)
+	if !gr.ShowHitboxes() {
+		t.Fatal("hitbox key should enable the hitbox overlay")
+	}
+	if gr.showDebugInfo {
+		t.Error("hitbox key should not open the debug inspector")
+	}
+
+	boxes := gr.hitboxes()
+	if len(boxes) == 0 || boxes[0].Kind != render.PlayerHitbox {
+		t.Fatalf("hitboxes() = %v, want the player's first", boxes)
+	}
+	want := 1 + len(gr.game.CurrentRoom.Hazards) + len(gr.game.CurrentRoom.Doors)
+	for _, enemy := range gr.enemyInstances {
+		if !enemy.IsDead() {
+			want++
+		}
+	}
+	if len(boxes) != want {
+		t.Errorf("got %d hitboxes, want %d", len(boxes), want)
+	}
+
+	gr.handleOverlayKeys(true, false)
+	if !gr.showDebugInfo || !gr.ShowHitboxes() {
+		t.Error("debug inspector key should toggle only the inspector")
+	}
+	gr.handleOverlayKeys(false, true)
+	if gr.ShowHitboxes() || !gr.showDebugInfo {
+		t.Error("hitbox key should toggle only the hitbox overlay")
+	}
+}
@@ -0,0 +1,71 @@
+package engine
+
+import "github.com/opd-ai/vania/internal/entity"
+
+// HitStopConfig tunes the freeze frames played when a hit lands. The struck
+// enemy freezes on every hit; the player freezes once per melee swing so a
+// swing that connects over several frames is not stretched out.
+type HitStopConfig struct {
+	BaseFrames      int     // Freeze applied to any hit
+	FramesPerDamage float64 // Extra freeze per point of damage
+	MaxFrames       int     // Cap on the freeze of a normal hit
+	CritMultiplier  float64 // Scale for critical and heavy hits, applied past the cap
+}
+
+// DefaultHitStopConfig returns a short freeze of two to six frames, doubled
+// for heavy hits
+func DefaultHitStopConfig() HitStopConfig {
+	return HitStopConfig{
+		BaseFrames:      2,
+		FramesPerDamage: 0.1,
+		MaxFrames:       6,
+		CritMultiplier:  2.0,
+	}
+}
+
+// Frames returns the freeze length for a hit of the given damage. The zero
+// config disables hit-stop.
+func (c HitStopConfig) Frames(damage int, crit bool) int {
+	frames := float64(c.BaseFrames) + float64(damage)*c.FramesPerDamage
+	if c.MaxFrames > 0 && frames > float64(c.MaxFrames) {
+		frames = float64(c.MaxFrames)
+	}
+	if crit && c.CritMultiplier > 1 {
+		frames *= c.CritMultiplier
+	}
+	return int(frames + 0.5)
+}
+
+// SetHitStopConfig changes the hit-stop tuning; the zero config turns it off
+func (cs *CombatSystem) SetHitStopConfig(config HitStopConfig) {
+	cs.hitStop = config
+}
+
+// HitStopConfig returns the hit-stop tuning in effect
+func (cs *CombatSystem) HitStopConfig() HitStopConfig {
+	return cs.hitStop
+}
+
+// IsHitStopped reports whether the player is frozen by hit-stop this frame
+func (cs *CombatSystem) IsHitStopped() bool {
+	return cs.hitStopFrames > 0
+}
+
+// GetHitStopFrames returns the player's remaining hit-stop frames
+func (cs *CombatSystem) GetHitStopFrames() int {
+	return cs.hitStopFrames
+}
+
+// applyHitStop freezes the struck enemy and, for the first hit of a melee
+// swing, the player
+func (cs *CombatSystem) applyHitStop(enemy *entity.EnemyInstance, damage int, crit bool) {
+	frames := cs.hitStop.Frames(damage, crit)
+	if frames <= 0 {
+		return
+	}
+	enemy.HitStopFrames = max(enemy.HitStopFrames, frames)
+	if cs.playerAttacking && !cs.swingHitStopDone {
+		cs.hitStopFrames = max(cs.hitStopFrames, frames)
+		cs.swingHitStopDone = true
+	}
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/input"
+)
+
+func TestHitAppliesConfiguredHitStop(t *testing.T) {
+	cs := NewCombatSystem()
+	cs.SetHitStopConfig(HitStopConfig{BaseFrames: 4})
+	cs.PlayerAttack()
+
+	enemy := entity.NewEnemyInstance(&entity.Enemy{Health: 100, Size: entity.MediumEnemy}, 150, 100)
+	cs.ApplyDamageToEnemy(enemy, 10, 100)
+
+	if enemy.HitStopFrames != 4 {
+		t.Errorf("enemy HitStopFrames = %d, want 4", enemy.HitStopFrames)
+	}
+	if got := cs.GetHitStopFrames(); got != 4 {
+		t.Errorf("player hit-stop = %d, want 4", got)
+	}
+
+	// The swing holds its frame while frozen
+	frame := cs.playerAttackFrame
+	cs.Update()
+	if cs.playerAttackFrame != frame {
+		t.Errorf("attack frame advanced during hit-stop: %d -> %d", frame, cs.playerAttackFrame)
+	}
+	if got := cs.GetHitStopFrames(); got != 3 {
+		t.Errorf("hit-stop after one update = %d, want 3", got)
+	}
+}
+
+func TestCritHitStopsLongerThanNormalHit(t *testing.T) {
+	config := DefaultHitStopConfig()
+	if normal, crit := config.Frames(10, false), config.Frames(10, true); crit <= normal {
+		t.Errorf("Frames(crit) = %d, want more than normal hit's %d", crit, normal)
+	}
+
+	light := NewCombatSystem()
+	light.PlayerAttack()
+	lightHit := entity.NewEnemyInstance(&entity.Enemy{Health: 500, Size: entity.BossEnemy}, 150, 100)
+	light.ApplyDamageToEnemy(lightHit, 10, 100)
+
+	heavy := NewCombatSystem()
+	heavy.PlayerHeavyAttack(1.0)
+	heavyHit := entity.NewEnemyInstance(&entity.Enemy{Health: 500, Size: entity.BossEnemy}, 150, 100)
+	heavy.ApplyDamageToEnemy(heavyHit, 10, 100)
+
+	if heavyHit.HitStopFrames <= lightHit.HitStopFrames {
+		t.Errorf("heavy hit-stop = %d, want more than light hit's %d", heavyHit.HitStopFrames, lightHit.HitStopFrames)
+	}
+	if heavy.GetHitStopFrames() <= light.GetHitStopFrames() {
+		t.Errorf("heavy player hit-stop = %d, want more than light's %d", heavy.GetHitStopFrames(), light.GetHitStopFrames())
+	}
+}
+
+func TestHitStopScalesWithDamage(t *testing.T) {
+	config := DefaultHitStopConfig()
+	if small, big := config.Frames(5, false), config.Frames(40, false); big <= small {
+		t.Errorf("Frames(40) = %d, want more than Frames(5) = %d", big, small)
+	}
+	if got := config.Frames(1000, false); got != config.MaxFrames {
+		t.Errorf("Frames(1000) = %d, want cap %d", got, config.MaxFrames)
+	}
+	if got := (HitStopConfig{}).Frames(50, true); got != 0 {
+		t.Errorf("zero config Frames = %d, want 0", got)
+	}
+}
+
+func TestPlayerHitStopOncePerSwing(t *testing.T) {
+	cs := NewCombatSystem()
+	cs.SetHitStopConfig(HitStopConfig{BaseFrames: 3})
+	cs.PlayerAttack()
+
+	enemy := entity.NewEnemyInstance(&entity.Enemy{Health: 100, Size: entity.MediumEnemy}, 150, 100)
+	cs.ApplyDamageToEnemy(enemy, 10, 100)
+	for cs.IsHitStopped() {
+		cs.Update()
+	}
+
+	cs.ApplyDamageToEnemy(enemy, 10, 100)
+	if cs.IsHitStopped() {
+		t.Error("second hit in the same swing froze the player again")
+	}
+	if enemy.HitStopFrames != 3 {
+		t.Errorf("enemy HitStopFrames = %d, want every hit to freeze the target", enemy.HitStopFrames)
+	}
+}
+
+func TestHitStopKeepsStepDeterministic(t *testing.T) {
+	run := func() (float64, float64) {
+		gr := newTestRunner(t)
+		for i := 0; i < 180; i++ {
+			state := input.InputState{MoveRight: i%40 < 20, MoveLeft: i%40 >= 20, AttackPress: i%15 == 0}
+			if err := gr.Step(state); err != nil {
+				t.Fatalf("Step() error = %v", err)
+			}
+			if i == 30 {
+				// Force a freeze mid-run
+				gr.combatSystem.hitStopFrames = 5
+			}
+		}
+		return gr.game.Player.X, gr.game.Player.Y
+	}
+
+	x1, y1 := run()
+	x2, y2 := run()
+	if x1 != x2 || y1 != y2 {
+		t.Errorf("runs diverged: (%v, %v) vs (%v, %v)", x1, y1, x2, y2)
+	}
+}
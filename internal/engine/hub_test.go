@@ -0,0 +1,30 @@
+package engine
+
+import "testing"
+
+func TestHubDoorOpensOnlyOntoVisitedRooms(t *testing.T) {
+	gr := newTestRunner(t)
+	hub := gr.game.World.HubRoom
+	if hub == nil || len(hub.Doors) == 0 {
+		t.Skip("world has no hub room")
+	}
+	gr.game.CurrentRoom = hub
+	door := &hub.Doors[0]
+	doorKey := gr.transitionHandler.GetDoorKey(door)
+	delete(gr.visitedRooms, door.LeadsTo.ID)
+
+	gr.game.Player.X, gr.game.Player.Y = float64(door.X), float64(door.Y)
+	gr.checkLockedDoorInteraction()
+	if gr.unlockedDoors[doorKey] {
+		t.Fatal("hub door opened onto an unvisited room")
+	}
+	if want := gr.loc.Text("toast.door_hub"); gr.lockedDoorMessage != want {
+		t.Errorf("locked door message = %q, want %q", gr.lockedDoorMessage, want)
+	}
+
+	gr.visitedRooms[door.LeadsTo.ID] = true
+	gr.checkLockedDoorInteraction()
+	if !gr.unlockedDoors[doorKey] {
+		t.Error("hub door stayed shut after its room was visited")
+	}
+}
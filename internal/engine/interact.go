@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Interaction tuning
+const (
+	// InteractRange is how far, in pixels, the player's centre can be from
+	// an interactable's centre for it to offer its prompt
+	InteractRange = 64.0
+
+	// Size of the rest spot in save rooms
+	RestSpotWidth  = 40.0
+	RestSpotHeight = 12.0
+)
+
+// Interactable is something the player acts on with the interact key
+// rather than by touching it: a rest spot, and later switches, characters
+// and shops. Prompt names the action, e.g. "Rest".
+type Interactable struct {
+	X, Y, Width, Height float64
+	Prompt              string
+	OnInteract          func()
+}
+
+// InteractionManager holds the current room's interactables, picks the one
+// nearest the player each frame, and runs it when interact is pressed
+type InteractionManager struct {
+	interactables []*Interactable
+	nearest       *Interactable
+}
+
+// NewInteractionManager creates a manager with nothing to interact with
+func NewInteractionManager() *InteractionManager {
+	return &InteractionManager{}
+}
+
+// Clear removes every interactable, e.g. on leaving a room
+func (im *InteractionManager) Clear() {
+	im.interactables = nil
+	im.nearest = nil
+}
+
+// Add registers an interactable
+func (im *InteractionManager) Add(it *Interactable) {
+	im.interactables = append(im.interactables, it)
+}
+
+// Update selects the interactable nearest the player's centre (px, py)
+// within InteractRange and, when pressed, runs its handler. It reports
+// whether a handler ran.
+func (im *InteractionManager) Update(px, py float64, pressed bool) bool {
+	im.nearest = nil
+	best := InteractRange
+	for _, it := range im.interactables {
+		dist := math.Hypot(it.X+it.Width/2-px, it.Y+it.Height/2-py)
+		if dist <= best {
+			im.nearest, best = it, dist
+		}
+	}
+	if !pressed || im.nearest == nil || im.nearest.OnInteract == nil {
+		return false
+	}
+	im.nearest.OnInteract()
+	return true
+}
+
+// Nearest returns the interactable whose prompt is showing, or nil
+func (im *InteractionManager) Nearest() *Interactable {
+	return im.nearest
+}
+
+// restSpotBounds returns where the rest spot lies in a save room: on the
+// ground at its centre
+func restSpotBounds(room *world.Room) (x, y, w, h float64) {
+	groundY := findGroundY(room)
+	return world.RoomPixelWidth/2 - RestSpotWidth/2, groundY - RestSpotHeight, RestSpotWidth, RestSpotHeight
+}
+
+// registerRoomInteractables fills the interaction manager for the current
+// room
+func (gr *GameRunner) registerRoomInteractables() {
+	gr.interactions.Clear()
+	room := gr.game.CurrentRoom
+	if room == nil {
+		return
+	}
+	if room.Type == world.SaveRoom {
+		x, y, w, h := restSpotBounds(room)
+		gr.interactions.Add(&Interactable{X: x, Y: y, Width: w, Height: h,
+			Prompt: gr.loc.Text("prompt.rest"), OnInteract: gr.rest})
+	}
+}
+
+// updateInteractions offers the nearest interactable and runs it on press
+func (gr *GameRunner) updateInteractions(pressed bool) {
+	p := gr.game.Player
+	gr.interactions.Update(p.X+physics.PlayerWidth/2, p.Y+gr.playerHeight()/2, pressed)
+}
+
+// interactPrompt returns the prompt for the nearest interactable, naming
+// the bound key, or "" when nothing is in range
+func (gr *GameRunner) interactPrompt() string {
+	it := gr.interactions.Nearest()
+	if it == nil {
+		return ""
+	}
+	key := "?"
+	if keys := gr.inputHandler.KeyMapping().Interact; len(keys) > 0 {
+		key = keys[0].String()
+	}
+	return gr.loc.Text("prompt.interact", key, it.Prompt)
+}
+
+// rest restores the player's health and saves, at a save room's rest spot
+func (gr *GameRunner) rest() {
+	gr.game.Player.Health = gr.game.Player.MaxHealth
+	gr.showSaveToast(gr.QuickSave(), "toast.rested", "toast.rested_unsaved")
+}
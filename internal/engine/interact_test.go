@@ -0,0 +1,77 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestInteractionManagerSelectsNearestInRange(t *testing.T) {
+	im := NewInteractionManager()
+	far := &Interactable{X: 40, Y: 0, Width: 10, Height: 10, Prompt: "far"}
+	near := &Interactable{X: 10, Y: 0, Width: 10, Height: 10, Prompt: "near"}
+	im.Add(far)
+	im.Add(near)
+
+	im.Update(0, 5, false)
+
+	if im.Nearest() != near {
+		t.Fatalf("Nearest() = %v, want the closer interactable", im.Nearest())
+	}
+}
+
+func TestInteractionManagerPressInvokesNearestHandler(t *testing.T) {
+	im := NewInteractionManager()
+	var ranNear, ranFar bool
+	im.Add(&Interactable{X: 40, Y: 0, Width: 10, Height: 10, OnInteract: func() { ranFar = true }})
+	im.Add(&Interactable{X: 10, Y: 0, Width: 10, Height: 10, OnInteract: func() { ranNear = true }})
+
+	if im.Update(0, 5, false) || ranNear || ranFar {
+		t.Fatal("handler ran without interact being pressed")
+	}
+	if !im.Update(0, 5, true) {
+		t.Fatal("Update() = false on press, want true")
+	}
+	if !ranNear || ranFar {
+		t.Errorf("ran near = %v, far = %v; want only the nearest handler", ranNear, ranFar)
+	}
+}
+
+func TestInteractionManagerIgnoresOutOfRange(t *testing.T) {
+	im := NewInteractionManager()
+	ran := false
+	im.Add(&Interactable{X: InteractRange * 2, Y: 0, Width: 10, Height: 10, OnInteract: func() { ran = true }})
+
+	if im.Update(0, 5, true) || ran || im.Nearest() != nil {
+		t.Error("interactable out of range was selected")
+	}
+}
+
+func TestRestSpotHealsInSaveRoom(t *testing.T) {
+	gr, _ := newQuickSaveRunner(t)
+	for _, room := range gr.game.World.Rooms {
+		if room.Type == world.SaveRoom {
+			gr.game.CurrentRoom = room
+			break
+		}
+	}
+	if gr.game.CurrentRoom.Type != world.SaveRoom {
+		t.Skip("world has no save room")
+	}
+	gr.populateCurrentRoom()
+
+	x, y, w, h := restSpotBounds(gr.game.CurrentRoom)
+	player := gr.game.Player
+	player.X, player.Y = x+w/2, y+h/2-gr.playerHeight()/2
+	player.Health = 1
+
+	gr.updateInteractions(false)
+	if gr.interactPrompt() == "" {
+		t.Fatal("no prompt shown at the rest spot")
+	}
+	gr.updateInteractions(true)
+
+	if player.Health != player.MaxHealth {
+		t.Errorf("health = %d after resting, want %d", player.Health, player.MaxHealth)
+	}
+}
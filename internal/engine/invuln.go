@@ -0,0 +1,52 @@
+package engine
+
+const (
+	// DefaultInvulnerabilityFrames is the post-hit invulnerability on Normal
+	// difficulty: one second
+	DefaultInvulnerabilityFrames = 60
+
+	// InvulnerabilityBlinkInterval is how many frames the player stays shown,
+	// then hidden, while invulnerable
+	InvulnerabilityBlinkInterval = 4
+)
+
+// InvulnerabilityFramesForDifficulty returns the post-hit invulnerability for
+// a difficulty level (0=Easy, 1=Normal, 2=Hard, 3=Expert). Harder settings
+// leave a shorter window before the next hit can land.
+func InvulnerabilityFramesForDifficulty(difficulty int) int {
+	switch difficulty {
+	case 0:
+		return 90
+	case 2:
+		return 45
+	case 3:
+		return 30
+	default:
+		return DefaultInvulnerabilityFrames
+	}
+}
+
+// SetInvulnerabilityDuration changes how many frames the player is
+// invulnerable after taking a hit. Non-positive values disable i-frames.
+func (cs *CombatSystem) SetInvulnerabilityDuration(frames int) {
+	if frames < 0 {
+		frames = 0
+	}
+	cs.invulnerableDuration = frames
+}
+
+// InvulnerabilityDuration returns the post-hit invulnerability in frames
+func (cs *CombatSystem) InvulnerabilityDuration() int {
+	return cs.invulnerableDuration
+}
+
+// PlayerVisible reports whether the player should be drawn this frame. The
+// player blinks while invulnerable, alternating every
+// InvulnerabilityBlinkInterval frames, starting visible.
+func (cs *CombatSystem) PlayerVisible() bool {
+	if cs.invulnerableFrames <= 0 {
+		return true
+	}
+	elapsed := cs.invulnerableDuration - cs.invulnerableFrames
+	return (elapsed/InvulnerabilityBlinkInterval)%2 == 0
+}
@@ -0,0 +1,63 @@
+package engine
+
+import "testing"
+
+func TestInvulnerabilityLastsConfiguredFrames(t *testing.T) {
+	for _, frames := range []int{30, 60, 90} {
+		cs := NewCombatSystem()
+		cs.SetInvulnerabilityDuration(frames)
+		player := &Player{X: 100, Y: 100, Health: 100, MaxHealth: 100}
+
+		cs.ApplyDamageToPlayer(player, 10, 150)
+		for i := 0; i < frames-1; i++ {
+			cs.Update()
+			if !cs.IsInvulnerable() {
+				t.Fatalf("duration %d: invulnerability ended after %d frames", frames, i+1)
+			}
+		}
+		cs.Update()
+		if cs.IsInvulnerable() {
+			t.Errorf("duration %d: still invulnerable after %d frames", frames, frames)
+		}
+
+		health := player.Health
+		cs.ApplyDamageToPlayer(player, 10, 150)
+		if player.Health != health-10 {
+			t.Errorf("duration %d: hit after i-frames dealt %d damage, want 10", frames, health-player.Health)
+		}
+	}
+}
+
+func TestInvulnerabilityFramesForDifficulty(t *testing.T) {
+	prev := InvulnerabilityFramesForDifficulty(0)
+	for d := 1; d <= 3; d++ {
+		got := InvulnerabilityFramesForDifficulty(d)
+		if got >= prev {
+			t.Errorf("difficulty %d gives %d i-frames, want fewer than %d", d, got, prev)
+		}
+		prev = got
+	}
+	if got := InvulnerabilityFramesForDifficulty(1); got != DefaultInvulnerabilityFrames {
+		t.Errorf("Normal difficulty gives %d i-frames, want %d", got, DefaultInvulnerabilityFrames)
+	}
+}
+
+func TestPlayerBlinksWhileInvulnerable(t *testing.T) {
+	cs := NewCombatSystem()
+	cs.SetInvulnerabilityDuration(24)
+	if !cs.PlayerVisible() {
+		t.Fatal("player hidden before being hit")
+	}
+
+	cs.ApplyDamageToPlayer(&Player{Health: 100, MaxHealth: 100}, 5, 0)
+	for frame := 0; frame < 24; frame++ {
+		want := (frame/InvulnerabilityBlinkInterval)%2 == 0
+		if got := cs.PlayerVisible(); got != want {
+			t.Errorf("frame %d: PlayerVisible() = %v, want %v", frame, got, want)
+		}
+		cs.Update()
+	}
+	if !cs.PlayerVisible() {
+		t.Error("player hidden after invulnerability ended")
+	}
+}
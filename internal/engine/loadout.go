@@ -0,0 +1,93 @@
+// Package engine provides starting loadouts that set the player's initial
+// stats and grant one starting ability chosen at new-game time.
+package engine
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Loadout defines the player's starting stats and ability
+type Loadout struct {
+	Name            string
+	MaxHealth       int
+	Damage          int
+	StartingAbility string // Ability key granted at start ("" for none)
+}
+
+// DefaultLoadoutName is used when no loadout is chosen
+const DefaultLoadoutName = "balanced"
+
+// loadouts lists every selectable starting loadout by name
+var loadouts = map[string]Loadout{
+	"balanced": {
+		Name:      "balanced",
+		MaxHealth: 100,
+		Damage:    10,
+	},
+	"glass_cannon": {
+		Name:            "glass_cannon",
+		MaxHealth:       60,
+		Damage:          18,
+		StartingAbility: "dash",
+	},
+	"tank": {
+		Name:            "tank",
+		MaxHealth:       160,
+		Damage:          7,
+		StartingAbility: "glide",
+	},
+	"agile": {
+		Name:            "agile",
+		MaxHealth:       85,
+		Damage:          9,
+		StartingAbility: "double_jump",
+	},
+}
+
+// GetLoadout returns the loadout with the given name
+func GetLoadout(name string) (Loadout, error) {
+	loadout, ok := loadouts[name]
+	if !ok {
+		return Loadout{}, fmt.Errorf("unknown loadout %q (valid: %v)", name, LoadoutNames())
+	}
+	return loadout, nil
+}
+
+// LoadoutNames returns the names of all loadouts in sorted order
+func LoadoutNames() []string {
+	names := make([]string, 0, len(loadouts))
+	for name := range loadouts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetLoadout selects the starting loadout for the next generated game
+func (gg *GameGenerator) SetLoadout(name string) error {
+	loadout, err := GetLoadout(name)
+	if err != nil {
+		return err
+	}
+	gg.Loadout = loadout
+	return nil
+}
+
+// startingAbilities returns the NG+ carried abilities plus the loadout's
+// ability, sorted and without duplicates
+func (gg *GameGenerator) startingAbilities() []string {
+	seen := make(map[string]bool)
+	abilities := make([]string, 0, len(gg.StartingAbilities)+1)
+	for _, ability := range gg.StartingAbilities {
+		if !seen[ability] {
+			seen[ability] = true
+			abilities = append(abilities, ability)
+		}
+	}
+	if ability := gg.Loadout.StartingAbility; ability != "" && !seen[ability] {
+		abilities = append(abilities, ability)
+	}
+	sort.Strings(abilities)
+	return abilities
+}
@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"testing"
+)
+
+func TestLoadoutStartingStats(t *testing.T) {
+	for _, name := range LoadoutNames() {
+		t.Run(name, func(t *testing.T) {
+			want, err := GetLoadout(name)
+			if err != nil {
+				t.Fatalf("GetLoadout(%q) error = %v", name, err)
+			}
+
+			gen := NewGameGenerator(4242)
+			if err := gen.SetLoadout(name); err != nil {
+				t.Fatalf("SetLoadout(%q) error = %v", name, err)
+			}
+			game, err := gen.GenerateCompleteGame()
+			if err != nil {
+				t.Fatalf("GenerateCompleteGame() error = %v", err)
+			}
+
+			p := game.Player
+			if p.MaxHealth != want.MaxHealth || p.Health != want.MaxHealth {
+				t.Errorf("health = %d/%d, want %d/%d", p.Health, p.MaxHealth, want.MaxHealth, want.MaxHealth)
+			}
+			if p.Damage != want.Damage {
+				t.Errorf("Damage = %d, want %d", p.Damage, want.Damage)
+			}
+			if want.StartingAbility != "" {
+				if !p.Abilities[want.StartingAbility] {
+					t.Errorf("ability %q missing from player abilities %v", want.StartingAbility, p.Abilities)
+				}
+				for _, edge := range game.World.Graph.Edges {
+					if edge.Requirement == want.StartingAbility {
+						t.Errorf("edge %d->%d gated on starting ability %q", edge.From, edge.To, want.StartingAbility)
+					}
+				}
+			}
+			if game.Loadout != name {
+				t.Errorf("game.Loadout = %q, want %q", game.Loadout, name)
+			}
+		})
+	}
+}
+
+func TestSetLoadoutUnknown(t *testing.T) {
+	gen := NewGameGenerator(1)
+	if err := gen.SetLoadout("berserker"); err == nil {
+		t.Error("SetLoadout(\"berserker\") error = nil, want error")
+	}
+	if gen.Loadout.Name != DefaultLoadoutName {
+		t.Errorf("Loadout = %q after failed set, want %q", gen.Loadout.Name, DefaultLoadoutName)
+	}
+}
@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/opd-ai/vania/internal/pcg"
+)
+
+// metricsSampleFrames is how often, in frames, play performance is sampled
+const metricsSampleFrames = 60
+
+// QualityReport is a post-hoc quality assessment of a generated game
+type QualityReport struct {
+	Metrics pcg.QualityMetrics
+	Score   float64
+	Passed  bool
+}
+
+// MetricsCollector fills pcg.QualityMetrics from real measurements: how
+// long generation took, how the game performed while played, and how much
+// distinct content the world contains.
+type MetricsCollector struct {
+	metrics   pcg.QualityMetrics
+	fpsTotal  float64
+	fpsCount  int
+	tpsTotal  float64
+	tpsCount  int
+	frameTick int
+}
+
+// NewMetricsCollector creates an empty metrics collector
+func NewMetricsCollector() *MetricsCollector {
+	return &MetricsCollector{}
+}
+
+// TimeGeneration runs GenerateCompleteGame on gg, records how long it took
+// as GenerationTime, and records the content of the resulting game
+func (mc *MetricsCollector) TimeGeneration(gg *GameGenerator) (*Game, error) {
+	start := time.Now()
+	game, err := gg.GenerateCompleteGame()
+	elapsed := time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+
+	mc.metrics.GenerationTime = elapsed.Milliseconds()
+	if mc.metrics.GenerationTime == 0 && elapsed > 0 {
+		mc.metrics.GenerationTime = 1 // Never report measured work as free
+	}
+	mc.RecordContent(game)
+	return game, nil
+}
+
+// RecordContent sets ContentDiversity to the number of distinct biomes plus
+// the number of distinct enemy types in game
+func (mc *MetricsCollector) RecordContent(game *Game) {
+	biomes := make(map[string]bool)
+	if game.World != nil {
+		for _, biome := range game.World.Biomes {
+			if biome != nil {
+				biomes[biome.Name] = true
+			}
+		}
+	}
+
+	enemies := make(map[string]bool)
+	for _, enemy := range game.Entities {
+		if enemy != nil {
+			enemies[enemy.Name] = true
+		}
+	}
+
+	mc.metrics.ContentDiversity = float64(len(biomes) + len(enemies))
+}
+
+// SamplePerformance records one measurement of frames and ticks per second
+func (mc *MetricsCollector) SamplePerformance(fps, tps float64) {
+	if fps > 0 {
+		mc.fpsTotal += fps
+		mc.fpsCount++
+		mc.metrics.PerformanceFPS = int(mc.fpsTotal/float64(mc.fpsCount) + 0.5)
+	}
+	if tps > 0 {
+		mc.tpsTotal += tps
+		mc.tpsCount++
+	}
+}
+
+// tick advances the sample clock one frame and reports whether a sample
+// is due
+func (mc *MetricsCollector) tick() bool {
+	mc.frameTick++
+	if mc.frameTick < metricsSampleFrames {
+		return false
+	}
+	mc.frameTick = 0
+	return true
+}
+
+// AverageTPS returns the mean sampled ticks per second, or 0 if none were
+// sampled
+func (mc *MetricsCollector) AverageTPS() float64 {
+	if mc.tpsCount == 0 {
+		return 0
+	}
+	return mc.tpsTotal / float64(mc.tpsCount)
+}
+
+// Samples returns how many performance samples have been taken
+func (mc *MetricsCollector) Samples() int {
+	return mc.fpsCount
+}
+
+// Metrics returns a copy of the collected metrics
+func (mc *MetricsCollector) Metrics() pcg.QualityMetrics {
+	return mc.metrics
+}
+
+// Report scores the collected metrics with validator
+func (mc *MetricsCollector) Report(validator *pcg.Validator) QualityReport {
+	metrics := mc.metrics
+	return QualityReport{
+		Metrics: metrics,
+		Score:   validator.CalculateQualityScore(&metrics),
+		Passed:  validator.MeetsThreshold(&metrics),
+	}
+}
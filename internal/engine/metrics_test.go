@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/pcg"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestMetricsCollector_RecordsGenerationTime(t *testing.T) {
+	mc := NewMetricsCollector()
+	game, err := mc.TimeGeneration(NewGameGenerator(42))
+	if err != nil {
+		t.Fatalf("generation failed: %v", err)
+	}
+	if game == nil {
+		t.Fatal("TimeGeneration returned no game")
+	}
+
+	metrics := mc.Metrics()
+	if metrics.GenerationTime <= 0 {
+		t.Errorf("GenerationTime should be recorded, got %d", metrics.GenerationTime)
+	}
+	if metrics.ContentDiversity <= 0 {
+		t.Errorf("ContentDiversity should be recorded, got %f", metrics.ContentDiversity)
+	}
+}
+
+func TestMetricsCollector_ContentDiversityCountsDistinctContent(t *testing.T) {
+	game := &Game{
+		World: &world.World{Biomes: []*world.Biome{
+			{Name: "cave"}, {Name: "forest"}, {Name: "cave"},
+		}},
+		Entities: []*entity.Enemy{
+			{Name: "Slime"}, {Name: "Bat"}, {Name: "Slime"}, {Name: "Golem"},
+		},
+	}
+
+	mc := NewMetricsCollector()
+	mc.RecordContent(game)
+
+	// 2 distinct biomes + 3 distinct enemies
+	if got := mc.Metrics().ContentDiversity; got != 5 {
+		t.Errorf("Expected ContentDiversity 5, got %f", got)
+	}
+
+	game.Entities = append(game.Entities, &entity.Enemy{Name: "Wraith"})
+	mc.RecordContent(game)
+	if got := mc.Metrics().ContentDiversity; got != 6 {
+		t.Errorf("A new enemy type should raise ContentDiversity to 6, got %f", got)
+	}
+}
+
+func TestMetricsCollector_PerformanceAndReport(t *testing.T) {
+	mc := NewMetricsCollector()
+	mc.SamplePerformance(58, 60)
+	mc.SamplePerformance(62, 60)
+
+	if got := mc.Metrics().PerformanceFPS; got != 60 {
+		t.Errorf("Expected average FPS 60, got %d", got)
+	}
+	if got := mc.AverageTPS(); got != 60 {
+		t.Errorf("Expected average TPS 60, got %f", got)
+	}
+
+	report := mc.Report(pcg.NewValidator(0))
+	if !report.Passed {
+		t.Error("Report should pass a zero threshold")
+	}
+	if report.Metrics.PerformanceFPS != 60 {
+		t.Error("Report should carry the collected metrics")
+	}
+}
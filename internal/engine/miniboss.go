@@ -0,0 +1,115 @@
+package engine
+
+import (
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// miniBossLootIDOffset places a mini-boss's loot within its room's item ID
+// block, clear of treasure and health pickups
+const miniBossLootIDOffset = 900
+
+// generateMiniBosses occasionally puts a mini-boss in a combat room, built
+// from the room biome's regular enemies and boss. It runs after difficulty
+// scaling so the blend uses the final stats.
+func (gg *GameGenerator) generateMiniBosses(worldData *world.World, enemies []*entity.Enemy, bosses []*entity.Boss) []*entity.MiniBoss {
+	gen := entity.NewMiniBossGenerator(gg.EntityGen.Seed + 6000)
+
+	var miniBosses []*entity.MiniBoss
+	for i, room := range worldData.Rooms {
+		if room.Type != world.CombatRoom || room.Biome == nil {
+			continue
+		}
+		seed := gg.EntityGen.Seed + int64(i*1000) + 20000
+		if !gen.Roll(seed) {
+			continue
+		}
+		regulars := biomeRegulars(room.Biome.Name, enemies)
+		if len(regulars) == 0 {
+			continue
+		}
+
+		mb := gen.Generate(regulars, biomeBoss(room.Biome.Name, bosses), seed)
+		mb.RoomID = room.ID
+		mb.SpriteData = generateEnemySprite(&mb.Enemy, seed+5000)
+		miniBosses = append(miniBosses, mb)
+	}
+	return miniBosses
+}
+
+// biomeRegulars returns the regular enemies native to a biome
+func biomeRegulars(biome string, enemies []*entity.Enemy) []*entity.Enemy {
+	var regulars []*entity.Enemy
+	for _, enemy := range enemies {
+		if enemy.BiomeType == biome {
+			regulars = append(regulars, enemy)
+		}
+	}
+	return regulars
+}
+
+// biomeBoss returns the first boss of a biome, or nil
+func biomeBoss(biome string, bosses []*entity.Boss) *entity.Boss {
+	for _, boss := range bosses {
+		if boss.BiomeType == biome {
+			return boss
+		}
+	}
+	return nil
+}
+
+// MiniBossForRoom returns the mini-boss guarding a room, or nil
+func (rth *RoomTransitionHandler) MiniBossForRoom(room *world.Room) *entity.MiniBoss {
+	if room == nil {
+		return nil
+	}
+	for _, mb := range rth.game.MiniBosses {
+		if mb.RoomID == room.ID {
+			return mb
+		}
+	}
+	return nil
+}
+
+// dropMiniBossLoot leaves a defeated mini-boss's loot on the ground below
+// it, unless the player already picked it up on an earlier kill
+func (gr *GameRunner) dropMiniBossLoot(enemy *entity.EnemyInstance) {
+	mb := gr.transitionHandler.MiniBossForRoom(gr.game.CurrentRoom)
+	if mb == nil || enemy.Enemy != &mb.Enemy || mb.Loot == nil {
+		return
+	}
+	itemID := gr.game.CurrentRoom.ID*1000 + miniBossLootIDOffset
+	if gr.collectedItems[itemID] {
+		return
+	}
+	ex, _, ew, _ := enemy.GetBounds()
+	itemX := ex + ew/2 - world.ItemSize/2
+	itemY := findGroundY(gr.game.CurrentRoom) - world.ItemSize
+	gr.itemInstances = append(gr.itemInstances, entity.NewItemInstance(mb.Loot, itemID, itemX, itemY))
+}
+
+// attachMiniBossController creates a controller for the current room's
+// mini-boss, if one was spawned, so its special attack runs
+func (gr *GameRunner) attachMiniBossController() {
+	gr.miniBossController = nil
+	mb := gr.transitionHandler.MiniBossForRoom(gr.game.CurrentRoom)
+	if mb == nil {
+		return
+	}
+	for _, enemy := range gr.enemyInstances {
+		if enemy.Enemy == &mb.Enemy {
+			gr.miniBossController = NewMiniBossController(mb, enemy)
+			return
+		}
+	}
+}
+
+// updateMiniBossSpecial runs the mini-boss's special attack once it has
+// noticed the player
+func (gr *GameRunner) updateMiniBossSpecial(enemy *entity.EnemyInstance) {
+	bc := gr.miniBossController
+	if bc == nil || bc.Instance() != enemy || enemy.Awareness() != entity.Alerted {
+		return
+	}
+	bc.Update(gr.game.Player, gr.playerHeight(), gr.combatSystem)
+}
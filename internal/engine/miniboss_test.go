@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// gameWithMiniBoss returns a generated game holding at least one mini-boss
+func gameWithMiniBoss(t *testing.T) *Game {
+	t.Helper()
+	for seed := int64(1); seed <= 20; seed++ {
+		game, err := NewGameGenerator(seed).GenerateCompleteGame()
+		if err != nil {
+			t.Fatalf("GenerateCompleteGame() error = %v", err)
+		}
+		if len(game.MiniBosses) > 0 {
+			return game
+		}
+	}
+	t.Fatal("no seed from 1 to 20 generated a mini-boss")
+	return nil
+}
+
+func TestMiniBossStatsFitItsBiome(t *testing.T) {
+	game := gameWithMiniBoss(t)
+	for _, mb := range game.MiniBosses {
+		for _, enemy := range biomeRegulars(mb.BiomeType, game.Entities) {
+			if mb.Health <= enemy.Health {
+				t.Errorf("%s health %d not above regular %s at %d", mb.Name, mb.Health, enemy.Name, enemy.Health)
+			}
+		}
+		if boss := biomeBoss(mb.BiomeType, game.Bosses); boss != nil && mb.Health >= boss.Health {
+			t.Errorf("%s health %d not below boss %s at %d", mb.Name, mb.Health, boss.Name, boss.Health)
+		}
+	}
+}
+
+func TestMiniBossDropsLootOnDeath(t *testing.T) {
+	game := gameWithMiniBoss(t)
+	gr := NewGameRunner(game)
+	mb := game.MiniBosses[0]
+
+	for _, room := range game.World.Rooms {
+		if room.ID == mb.RoomID {
+			game.CurrentRoom = room
+		}
+	}
+	if game.CurrentRoom.Type != world.CombatRoom {
+		t.Fatalf("mini-boss room type = %v, want combat", game.CurrentRoom.Type)
+	}
+	gr.enemyInstances = gr.transitionHandler.SpawnEnemiesForRoom(game.CurrentRoom)
+	gr.itemInstances = nil
+
+	last := gr.enemyInstances[len(gr.enemyInstances)-1]
+	if last.Enemy != &mb.Enemy {
+		t.Fatal("combat room did not spawn its mini-boss")
+	}
+	last.TakeDamage(last.CurrentHealth)
+	gr.recordEnemyDeath(last)
+
+	if len(gr.itemInstances) != 1 || gr.itemInstances[0].Item != mb.Loot {
+		t.Fatalf("items after the kill = %d, want the mini-boss loot", len(gr.itemInstances))
+	}
+
+	// Loot already picked up is not dropped again
+	gr.collectItem(gr.itemInstances[0])
+	gr.itemInstances = nil
+	gr.recordEnemyDeath(last)
+	if len(gr.itemInstances) != 0 {
+		t.Error("collected loot dropped a second time")
+	}
+}
+
+func TestMiniBossUsesSpecialAttack(t *testing.T) {
+	game := gameWithMiniBoss(t)
+	gr := NewGameRunner(game)
+	mb := game.MiniBosses[0]
+	for _, room := range game.World.Rooms {
+		if room.ID == mb.RoomID {
+			game.CurrentRoom = room
+		}
+	}
+	gr.enemyInstances = gr.transitionHandler.SpawnEnemiesForRoom(game.CurrentRoom)
+	gr.attachMiniBossController()
+	if gr.miniBossController == nil {
+		t.Fatal("no controller attached for the room's mini-boss")
+	}
+	instance := gr.miniBossController.Instance()
+	if instance.Enemy != &mb.Enemy {
+		t.Fatal("controller drives the wrong enemy")
+	}
+
+	// Unaware, the mini-boss holds its special
+	game.Player.X, game.Player.Y = instance.X-80, instance.Y
+	for i := 0; i < MiniBossSpecialCooldownFrames+10; i++ {
+		gr.updateMiniBossSpecial(instance)
+	}
+	if gr.miniBossController.frame.Move != nil {
+		t.Fatal("special attack started before the mini-boss noticed the player")
+	}
+
+	instance.Alarm()
+	struck := false
+	for i := 0; i < MiniBossSpecialCooldownFrames+mb.Special.Duration() && !struck; i++ {
+		game.Player.X, game.Player.Y = instance.X-80, instance.Y
+		gr.updateMiniBossSpecial(instance)
+		frame := gr.miniBossController.frame
+		struck = frame.Hitbox != nil || len(gr.combatSystem.GetEnemyProjectiles()) > 0
+	}
+	if !struck {
+		t.Errorf("mini-boss never landed its %q special", mb.SpecialAttack)
+	}
+}
@@ -0,0 +1,77 @@
+// Package engine provides New Game Plus support: starting a fresh world
+// with the abilities unlocked in a completed run and tougher enemies.
+package engine
+
+import (
+	"sort"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+// NGPlusDifficultyStep is the enemy health/damage increase per NG+ level.
+const NGPlusDifficultyStep = 0.25
+
+// DifficultyScale returns the enemy stat multiplier for an NG+ level.
+// Level 0 is a normal game.
+func DifficultyScale(ngPlusLevel int) float64 {
+	if ngPlusLevel <= 0 {
+		return 1.0
+	}
+	return 1.0 + NGPlusDifficultyStep*float64(ngPlusLevel)
+}
+
+// NewGamePlusGenerator creates a generator for the next NG+ cycle after a
+// completed game. The new world uses the given seed (pass prev.Seed to
+// replay the same layout) and starts the player with every ability
+// unlocked in prev.
+func NewGamePlusGenerator(prev *Game, seed int64) *GameGenerator {
+	gg := NewGameGeneratorWithGenre(seed, prev.Genre)
+	gg.NGPlusLevel = prev.NGPlusLevel + 1
+	if loadout, err := GetLoadout(prev.Loadout); err == nil {
+		gg.Loadout = loadout
+	}
+	if prev.World != nil && prev.World.Density != nil {
+		gg.WorldGen.Density = *prev.World.Density
+	}
+
+	if prev.Player != nil {
+		for ability, unlocked := range prev.Player.Abilities {
+			if unlocked {
+				gg.StartingAbilities = append(gg.StartingAbilities, ability)
+			}
+		}
+	}
+	// Map iteration order is random; sort so generation stays deterministic
+	sort.Strings(gg.StartingAbilities)
+
+	return gg
+}
+
+// scaleEnemyDifficulty multiplies enemy and boss health and damage by the
+// NG+ difficulty scale. Applied after generation so seeds stay stable.
+func scaleEnemyDifficulty(enemies []*entity.Enemy, bosses []*entity.Boss, ngPlusLevel int) {
+	scale := DifficultyScale(ngPlusLevel)
+	if scale == 1.0 {
+		return
+	}
+
+	for _, enemy := range enemies {
+		enemy.Health = int(float64(enemy.Health) * scale)
+		enemy.Damage = int(float64(enemy.Damage) * scale)
+	}
+	for _, boss := range bosses {
+		boss.Health = int(float64(boss.Health) * scale)
+		boss.Damage = int(float64(boss.Damage) * scale)
+		for i := range boss.AttackPatterns {
+			for j := range boss.AttackPatterns[i].Moves {
+				move := &boss.AttackPatterns[i].Moves[j]
+				if move.Hitbox != nil {
+					move.Hitbox.Damage = int(float64(move.Hitbox.Damage) * scale)
+				}
+				for k := range move.Projectiles {
+					move.Projectiles[k].Damage = int(float64(move.Projectiles[k].Damage) * scale)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+func TestNewGamePlusCarriesAbilities(t *testing.T) {
+	prev, err := NewGameGenerator(12345).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	prev.Player.Abilities["double_jump"] = true
+	prev.Player.Abilities["dash"] = true
+	prev.Player.Abilities["glide"] = false
+
+	gen := NewGamePlusGenerator(prev, 999)
+	if gen.NGPlusLevel != 1 {
+		t.Errorf("NGPlusLevel = %d, want 1", gen.NGPlusLevel)
+	}
+
+	next, err := gen.GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	if next.NGPlusLevel != 1 {
+		t.Errorf("game NGPlusLevel = %d, want 1", next.NGPlusLevel)
+	}
+	for _, ability := range []string{"double_jump", "dash"} {
+		if !next.Player.Abilities[ability] {
+			t.Errorf("ability %q not carried into NG+", ability)
+		}
+	}
+	if next.Player.Abilities["glide"] {
+		t.Error("locked ability glide carried into NG+")
+	}
+
+	// Owned abilities must never gate the new world
+	for _, edge := range next.World.Graph.Edges {
+		if edge.Requirement == "double_jump" || edge.Requirement == "dash" {
+			t.Errorf("edge %d->%d gated on owned ability %q", edge.From, edge.To, edge.Requirement)
+		}
+	}
+}
+
+func TestNewGamePlusRaisesDifficulty(t *testing.T) {
+	tests := []struct {
+		level int
+		want  float64
+	}{
+		{0, 1.0},
+		{1, 1.25},
+		{2, 1.5},
+	}
+	for _, tt := range tests {
+		if got := DifficultyScale(tt.level); got != tt.want {
+			t.Errorf("DifficultyScale(%d) = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+
+	enemies := []*entity.Enemy{{Health: 100, Damage: 10}}
+	bosses := []*entity.Boss{{
+		Enemy: entity.Enemy{Health: 200, Damage: 20},
+		AttackPatterns: []entity.AttackPattern{{Moves: []entity.AttackMove{{
+			Hitbox:      &entity.PatternHitbox{Damage: 20},
+			Projectiles: []entity.ProjectileSpawn{{Damage: 8}},
+		}}}},
+	}}
+	scaleEnemyDifficulty(enemies, bosses, 2)
+
+	if enemies[0].Health != 150 || enemies[0].Damage != 15 {
+		t.Errorf("enemy = %d hp / %d dmg, want 150 / 15", enemies[0].Health, enemies[0].Damage)
+	}
+	if bosses[0].Health != 300 || bosses[0].Damage != 30 {
+		t.Errorf("boss = %d hp / %d dmg, want 300 / 30", bosses[0].Health, bosses[0].Damage)
+	}
+	move := bosses[0].AttackPatterns[0].Moves[0]
+	if move.Hitbox.Damage != 30 || move.Projectiles[0].Damage != 12 {
+		t.Errorf("pattern damage = %d / %d, want 30 / 12", move.Hitbox.Damage, move.Projectiles[0].Damage)
+	}
+}
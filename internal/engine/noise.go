@@ -0,0 +1,78 @@
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/vania/internal/input"
+	"github.com/opd-ai/vania/internal/physics"
+)
+
+// Noise radii in pixels. Enemies within the radius of a noise hear it
+// whichever way they are facing.
+const (
+	NoiseRunRadius     = 120.0 // Moving at full speed on the ground
+	NoiseAttackRadius  = 200.0 // Swinging a melee attack
+	NoiseLandingRadius = 240.0 // Landing hard from a long fall
+
+	// HardLandingDrop is how far the player must fall, in pixels, for the
+	// landing to be loud. A full jump on flat ground falls less.
+	HardLandingDrop = 160.0
+)
+
+// crouchSpeedFactor scales movement while crouch-walking
+const crouchSpeedFactor = 0.4
+
+// makeNoise records a noise of the given radius made by the player this
+// frame; the loudest noise of the frame wins
+func (gr *GameRunner) makeNoise(radius float64) {
+	gr.noiseRadius = max(gr.noiseRadius, radius)
+}
+
+// movementNoise returns the noise of the player's movement. Running on the
+// ground is heard; walking, crouch-walking, and moving through the air are
+// silent.
+func (gr *GameRunner) movementNoise(inputState input.InputState) float64 {
+	moving := inputState.MoveLeft || inputState.MoveRight
+	if !moving || !gr.playerBody.OnGround || inputState.Walk || gr.playerBody.Crouched {
+		return 0
+	}
+	return NoiseRunRadius
+}
+
+// trackLanding follows the player's fall and makes landing noise when a
+// long drop ends on the ground
+func (gr *GameRunner) trackLanding(wasOnGround bool) {
+	y := gr.playerBody.Position.Y
+	switch {
+	case !gr.playerBody.OnGround:
+		if wasOnGround {
+			gr.airApexY = y
+		}
+		gr.airApexY = min(gr.airApexY, y)
+	case !wasOnGround:
+		if y-gr.airApexY >= HardLandingDrop {
+			gr.makeNoise(NoiseLandingRadius)
+		}
+	}
+}
+
+// emitNoise lets every active enemy within this frame's noise hear it,
+// then clears the noise
+func (gr *GameRunner) emitNoise() {
+	radius := gr.noiseRadius
+	gr.noiseRadius = 0
+	if radius <= 0 {
+		return
+	}
+	px := gr.game.Player.X + physics.PlayerWidth/2
+	py := gr.game.Player.Y + gr.playerHeight()/2
+	for _, enemy := range gr.enemyInstances {
+		if enemy.IsDead() || !gr.EnemyActive(enemy) {
+			continue
+		}
+		ex, ey, ew, eh := enemy.GetBounds()
+		if math.Hypot(ex+ew/2-px, ey+eh/2-py) <= radius {
+			enemy.HearNoise(px)
+		}
+	}
+}
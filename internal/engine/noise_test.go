@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/input"
+)
+
+// newNoiseTestRunner settles the player on the ground with one stationary
+// enemy 150px to their right, facing away
+func newNoiseTestRunner(t *testing.T) (*GameRunner, *entity.EnemyInstance) {
+	t.Helper()
+	gr := newTestRunner(t)
+	gr.enemyInstances = nil
+	for i := 0; i < 90 || !gr.playerBody.OnGround; i++ {
+		if i >= 120 {
+			t.Fatal("player did not land")
+		}
+		if err := gr.Step(input.InputState{}); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+	}
+
+	enemy := entity.NewEnemyInstance(&entity.Enemy{Name: "Sentry", Health: 50,
+		Behavior: entity.StationaryBehavior, Size: entity.SmallEnemy},
+		gr.game.Player.X+150, gr.game.Player.Y)
+	gr.enemyInstances = []*entity.EnemyInstance{enemy}
+	return gr, enemy
+}
+
+func TestAttackNoiseAlertsEnemy(t *testing.T) {
+	gr, enemy := newNoiseTestRunner(t)
+
+	if err := gr.Step(input.InputState{AttackPress: true, Attack: true}); err != nil {
+		t.Fatalf("Step() error = %v", err)
+	}
+	if got := enemy.Awareness(); got != entity.Alerted {
+		t.Errorf("Awareness() after an attack 150px away = %v, want Alerted", got)
+	}
+	if enemy.Facing() != -1 {
+		t.Errorf("Facing() = %v, want the enemy turned toward the noise", enemy.Facing())
+	}
+}
+
+func TestCrouchWalkingIsQuiet(t *testing.T) {
+	gr, enemy := newNoiseTestRunner(t)
+
+	for i := 0; i < 20; i++ {
+		if err := gr.Step(input.InputState{MoveRight: true, Crouch: true}); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+	}
+	if got := enemy.Awareness(); got != entity.Unaware {
+		t.Errorf("Awareness() after crouch-walking = %v, want Unaware", got)
+	}
+}
+
+func TestRunningIsHeardNearby(t *testing.T) {
+	gr, enemy := newNoiseTestRunner(t)
+	enemy.X = gr.game.Player.X + NoiseRunRadius/2
+
+	if err := gr.Step(input.InputState{MoveLeft: true}); err != nil {
+		t.Fatalf("Step() error = %v", err)
+	}
+	if got := enemy.Awareness(); got != entity.Alerted {
+		t.Errorf("Awareness() with the player running nearby = %v, want Alerted", got)
+	}
+}
+
+func TestHardLandingMakesNoise(t *testing.T) {
+	gr, _ := newNoiseTestRunner(t)
+
+	// A short hop is quiet
+	gr.airApexY = gr.playerBody.Position.Y - HardLandingDrop/2
+	gr.trackLanding(false)
+	if gr.noiseRadius != 0 {
+		t.Errorf("short drop made noise %v", gr.noiseRadius)
+	}
+
+	gr.airApexY = gr.playerBody.Position.Y - HardLandingDrop
+	gr.trackLanding(false)
+	if gr.noiseRadius != NoiseLandingRadius {
+		t.Errorf("long drop noise = %v, want %v", gr.noiseRadius, NoiseLandingRadius)
+	}
+}
@@ -0,0 +1,146 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Ability pedestal layout and showcase timing
+const (
+	PedestalWidth  = 32
+	PedestalHeight = 48
+
+	// AbilityShowcaseFrames is how long the unlock showcase runs. Game time
+	// runs at half speed meanwhile.
+	AbilityShowcaseFrames = 120
+)
+
+// AbilityPedestal holds an ability for the player to pick up in the world
+type AbilityPedestal struct {
+	Ability entity.Ability
+	RoomID  int
+}
+
+// PlaceAbilityPedestals puts one pedestal per ability into the world, in
+// unlock order from the shallowest rooms to the deepest. The start room and
+// boss rooms are left empty, and when there are more abilities than rooms
+// the extras share the deepest rooms.
+func PlaceAbilityPedestals(w *world.World, abilities []entity.Ability) []*AbilityPedestal {
+	if w == nil || len(abilities) == 0 {
+		return nil
+	}
+
+	candidates := make([]*world.Room, 0, len(w.Rooms))
+	for _, room := range w.Rooms {
+		if room.Type != world.StartRoom && room.Type != world.BossRoom {
+			candidates = append(candidates, room)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	depth := func(room *world.Room) int {
+		if w.Graph != nil {
+			if node, ok := w.Graph.Nodes[room.ID]; ok {
+				return node.Depth
+			}
+		}
+		return 0
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		di, dj := depth(candidates[i]), depth(candidates[j])
+		if di != dj {
+			return di < dj
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	ordered := make([]entity.Ability, len(abilities))
+	copy(ordered, abilities)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].UnlockOrder < ordered[j].UnlockOrder
+	})
+
+	// Spread the pedestals evenly across the depth-sorted rooms
+	pedestals := make([]*AbilityPedestal, len(ordered))
+	for i, ability := range ordered {
+		idx := (i + 1) * len(candidates) / (len(ordered) + 1)
+		if idx >= len(candidates) {
+			idx = len(candidates) - 1
+		}
+		pedestals[i] = &AbilityPedestal{Ability: ability, RoomID: candidates[idx].ID}
+	}
+	return pedestals
+}
+
+// pedestalBounds returns where a pedestal stands in room
+func pedestalBounds(room *world.Room) (x, y, w, h float64) {
+	groundY := findGroundY(room)
+	return 480 - PedestalWidth/2, groundY - PedestalHeight, PedestalWidth, PedestalHeight
+}
+
+// currentPedestals returns the pedestals in the current room whose ability
+// the player does not have yet
+func (gr *GameRunner) currentPedestals() []*AbilityPedestal {
+	if gr.game.CurrentRoom == nil {
+		return nil
+	}
+	var pedestals []*AbilityPedestal
+	for _, p := range gr.game.AbilityPedestals {
+		if p.RoomID == gr.game.CurrentRoom.ID && !gr.game.Player.Abilities[gr.normalizeAbilityKey(p.Ability.Name)] {
+			pedestals = append(pedestals, p)
+		}
+	}
+	return pedestals
+}
+
+// checkPedestalCollection grants the ability of any pedestal the player
+// touches
+func (gr *GameRunner) checkPedestalCollection() {
+	for _, p := range gr.currentPedestals() {
+		px, py, pw, ph := pedestalBounds(gr.game.CurrentRoom)
+		if physics.AABBOverlap(gr.game.Player.X, gr.game.Player.Y, physics.PlayerWidth, gr.playerHeight(), px, py, pw, ph) {
+			gr.collectPedestal(p)
+		}
+	}
+}
+
+// collectPedestal grants a pedestal's ability and starts the showcase: a
+// stretch of slow motion, a particle burst, and an unlock banner
+func (gr *GameRunner) collectPedestal(p *AbilityPedestal) {
+	abilityKey := gr.normalizeAbilityKey(p.Ability.Name)
+	if gr.game.Player.Abilities[abilityKey] {
+		return
+	}
+	gr.game.Player.Abilities[abilityKey] = true
+
+	if gr.game.Achievements != nil {
+		gr.game.Achievements.RecordAbilityUnlocked()
+	}
+	gr.unlockAbilityGatedDoors(abilityKey)
+
+	gr.showcaseAbility = p.Ability
+	gr.showcaseFrames = AbilityShowcaseFrames
+
+	px, py, pw, _ := pedestalBounds(gr.game.CurrentRoom)
+	burst := gr.particlePresets.CreateExplosion(px+pw/2, py, 1.5)
+	burst.Burst(40)
+	gr.particleSystem.AddEmitter(burst)
+	sparkles := gr.particlePresets.CreateSparkles(px+pw/2, py)
+	sparkles.Burst(30)
+	gr.particleSystem.AddEmitter(sparkles)
+}
+
+// showcaseSlowsFrame advances the unlock showcase and reports whether game
+// logic should sit this frame out, running the showcase at half speed
+func (gr *GameRunner) showcaseSlowsFrame() bool {
+	if gr.showcaseFrames <= 0 {
+		return false
+	}
+	gr.showcaseFrames--
+	return gr.showcaseFrames%2 == 1
+}
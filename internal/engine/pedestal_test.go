@@ -0,0 +1,101 @@
+package engine
+
+import "testing"
+
+func TestPlaceAbilityPedestalsFollowsUnlockOrder(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	if len(game.AbilityPedestals) != len(game.Abilities) {
+		t.Fatalf("got %d pedestals, want one per ability (%d)", len(game.AbilityPedestals), len(game.Abilities))
+	}
+
+	rooms := make(map[int]int) // room ID -> graph depth
+	for _, room := range game.World.Rooms {
+		if node, ok := game.World.Graph.Nodes[room.ID]; ok {
+			rooms[room.ID] = node.Depth
+		}
+	}
+
+	for i, p := range game.AbilityPedestals {
+		if p.RoomID == game.World.StartRoom.ID {
+			t.Errorf("pedestal %q placed in the start room", p.Ability.Name)
+		}
+		if i == 0 {
+			continue
+		}
+		prev := game.AbilityPedestals[i-1]
+		if p.Ability.UnlockOrder < prev.Ability.UnlockOrder {
+			t.Errorf("pedestal %d (%q) comes before %q in unlock order", i, p.Ability.Name, prev.Ability.Name)
+		}
+		if rooms[p.RoomID] < rooms[prev.RoomID] {
+			t.Errorf("pedestal %q at depth %d is shallower than earlier %q at depth %d",
+				p.Ability.Name, rooms[p.RoomID], prev.Ability.Name, rooms[prev.RoomID])
+		}
+	}
+}
+
+func TestCollectPedestalGrantsAbility(t *testing.T) {
+	gr := newTestRunner(t)
+
+	var pedestal *AbilityPedestal
+	for _, p := range gr.game.AbilityPedestals {
+		if !gr.game.Player.Abilities[gr.normalizeAbilityKey(p.Ability.Name)] {
+			pedestal = p
+			break
+		}
+	}
+	if pedestal == nil {
+		t.Skip("player already owns every ability")
+	}
+	for _, room := range gr.game.World.Rooms {
+		if room.ID == pedestal.RoomID {
+			gr.game.CurrentRoom = room
+		}
+	}
+
+	px, py, _, _ := pedestalBounds(gr.game.CurrentRoom)
+	gr.game.Player.X, gr.game.Player.Y = px, py
+	before := gr.game.Achievements.GetStatistics().AbilitiesUnlocked
+
+	gr.checkPedestalCollection()
+
+	key := gr.normalizeAbilityKey(pedestal.Ability.Name)
+	if !gr.game.Player.Abilities[key] {
+		t.Errorf("Player.Abilities[%q] not set after collecting the pedestal", key)
+	}
+	if got := gr.game.Achievements.GetStatistics().AbilitiesUnlocked; got != before+1 {
+		t.Errorf("AbilitiesUnlocked = %d, want %d", got, before+1)
+	}
+	if gr.showcaseFrames != AbilityShowcaseFrames || gr.showcaseAbility.Name != pedestal.Ability.Name {
+		t.Errorf("showcase not started: frames=%d ability=%q", gr.showcaseFrames, gr.showcaseAbility.Name)
+	}
+	for _, p := range gr.currentPedestals() {
+		if p == pedestal {
+			t.Error("collected pedestal still listed in the room")
+		}
+	}
+
+	// Collecting again must not record a second unlock
+	gr.checkPedestalCollection()
+	if got := gr.game.Achievements.GetStatistics().AbilitiesUnlocked; got != before+1 {
+		t.Errorf("AbilitiesUnlocked = %d after re-touching, want %d", got, before+1)
+	}
+}
+
+func TestShowcaseRunsAtHalfSpeed(t *testing.T) {
+	gr := &GameRunner{showcaseFrames: 4}
+	skipped := 0
+	for i := 0; i < 4; i++ {
+		if gr.showcaseSlowsFrame() {
+			skipped++
+		}
+	}
+	if skipped != 2 {
+		t.Errorf("skipped %d of 4 showcase frames, want 2", skipped)
+	}
+	if gr.showcaseSlowsFrame() {
+		t.Error("frames still skipped after the showcase ended")
+	}
+}
@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/opd-ai/vania/internal/input"
+)
+
+// perfSeed is the fixed seed every performance check generates, so results
+// are comparable between runs
+const perfSeed = 42
+
+// defaultGenerationBudget is the generation time limit when
+// VANIA_GEN_BUDGET is not set
+const defaultGenerationBudget = 10 * time.Second
+
+// generationBudget returns the generation time limit, taken from the
+// VANIA_GEN_BUDGET environment variable (e.g. "3s") when set
+func generationBudget(t *testing.T) time.Duration {
+	value := os.Getenv("VANIA_GEN_BUDGET")
+	if value == "" {
+		return defaultGenerationBudget
+	}
+	budget, err := time.ParseDuration(value)
+	if err != nil {
+		t.Fatalf("invalid VANIA_GEN_BUDGET %q: %v", value, err)
+	}
+	return budget
+}
+
+func TestGenerationWithinBudget(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping generation budget check in short mode")
+	}
+	budget := generationBudget(t)
+
+	mc := NewMetricsCollector()
+	if _, err := mc.TimeGeneration(NewGameGenerator(perfSeed)); err != nil {
+		t.Fatalf("generation failed: %v", err)
+	}
+
+	elapsed := time.Duration(mc.Metrics().GenerationTime) * time.Millisecond
+	t.Logf("seed %d generated in %v (budget %v)", perfSeed, elapsed, budget)
+	if elapsed > budget {
+		t.Errorf("generation took %v, over the %v budget", elapsed, budget)
+	}
+}
+
+func BenchmarkGenerateCompleteGame(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := NewGameGenerator(perfSeed).GenerateCompleteGame(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRunnerStep(b *testing.B) {
+	game, err := NewGameGenerator(perfSeed).GenerateCompleteGame()
+	if err != nil {
+		b.Fatal(err)
+	}
+	gr := NewGameRunner(game)
+	state := input.InputState{}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := gr.Step(state); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package engine
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/render"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Practice mode keys: spawn a dummy enemy, toggle the room's hazards, and
+// put the sandbox back the way it started
+const (
+	PracticeSpawnKey  = ebiten.KeyF1
+	PracticeHazardKey = ebiten.KeyF2
+	PracticeResetKey  = ebiten.KeyF4
+)
+
+// practiceAbilities are the ability keys the runner understands, all
+// granted in practice mode
+var practiceAbilities = []string{
+	"double_jump", "dash", "wall_climb", "glide", "swim",
+	"charge_attack", "ranged", "shield", "grapple",
+}
+
+// PracticeSession is the sandbox state behind practice mode: a single room
+// with every ability, dummy enemies on demand, hazards that can be switched
+// off, and a reset that puts it all back.
+type PracticeSession struct {
+	room      *world.Room
+	hazards   []world.Hazard // The room's generated hazards
+	roster    []*entity.Enemy
+	nextDummy int
+
+	// Initial player state restored on reset
+	startX, startY float64
+	abilities      map[string]bool
+}
+
+// NewPracticeGame generates a world from seed and cuts it down to one
+// sandbox room: the first combat room with platforms and hazards to play
+// on, emptied of enemies, items and exits. Every ability is granted.
+func NewPracticeGame(seed int64, genreID string) (*Game, error) {
+	game, err := NewGameGeneratorWithGenre(seed, genreID).GenerateCompleteGame()
+	if err != nil {
+		return nil, err
+	}
+
+	source := game.World.StartRoom
+	for _, room := range game.World.Rooms {
+		if room.Type == world.CombatRoom && len(room.Platforms) > 0 && len(room.Hazards) > 0 {
+			source = room
+			break
+		}
+	}
+
+	sandbox := *source
+	sandbox.Connections = nil
+	sandbox.Doors = nil
+	sandbox.Enemies = nil
+	sandbox.Items = nil
+	sandbox.Puzzle = nil
+	sandbox.HealthPickups = 0
+	sandbox.Hazards = append([]world.Hazard(nil), source.Hazards...)
+
+	game.World = &world.World{
+		Rooms:     []*world.Room{&sandbox},
+		StartRoom: &sandbox,
+		Biomes:    game.World.Biomes,
+		Width:     1,
+		Height:    1,
+		Graph: &world.WorldGraph{
+			Nodes: map[int]*world.GraphNode{sandbox.ID: {RoomID: sandbox.ID, Required: true}},
+		},
+		Density: game.World.Density,
+	}
+	game.CurrentRoom = &sandbox
+	game.AbilityPedestals = nil
+
+	for _, ability := range practiceAbilities {
+		game.Player.Abilities[ability] = true
+	}
+	return game, nil
+}
+
+// NewPracticeRunner creates a game runner for a practice game. The room
+// starts empty, and saving is disabled so practice never touches saves.
+func NewPracticeRunner(game *Game) *GameRunner {
+	gr := NewGameRunner(game)
+	gr.saveManager = nil
+	gr.checkpointManager = nil
+	gr.tutorial = nil
+
+	ps := &PracticeSession{
+		room:      game.CurrentRoom,
+		hazards:   append([]world.Hazard(nil), game.CurrentRoom.Hazards...),
+		roster:    game.Entities,
+		startX:    gr.playerBody.Position.X,
+		startY:    gr.playerBody.Position.Y,
+		abilities: make(map[string]bool, len(game.Player.Abilities)),
+	}
+	for ability, granted := range game.Player.Abilities {
+		ps.abilities[ability] = granted
+	}
+	gr.practice = ps
+	ps.Reset(gr)
+	return gr
+}
+
+// Practice returns the practice session, or nil outside practice mode
+func (gr *GameRunner) Practice() *PracticeSession {
+	return gr.practice
+}
+
+// SpawnDummy drops the next enemy from the world's roster onto the ground
+// at a spot across the room. Dummies deal no damage. It fails once the room
+// holds MaxRoomEnemies living enemies.
+func (ps *PracticeSession) SpawnDummy(gr *GameRunner) bool {
+	if len(ps.roster) == 0 || gr.liveEnemyCount() >= MaxRoomEnemies {
+		return false
+	}
+	template := *ps.roster[ps.nextDummy%len(ps.roster)]
+	template.Damage = 0
+
+	_, _, _, eh := entity.GetEnemySizeBounds(&template)
+	x := 300.0 + float64(ps.nextDummy%5)*120
+	y := findGroundY(ps.room) - eh
+	gr.enemyInstances = append(gr.enemyInstances, entity.NewEnemyInstance(&template, x, y))
+	ps.nextDummy++
+	return true
+}
+
+// ToggleHazards removes or restores the room's hazards and reports whether
+// they are now on
+func (ps *PracticeSession) ToggleHazards() bool {
+	if ps.HazardsEnabled() {
+		ps.room.Hazards = nil
+		return false
+	}
+	ps.room.Hazards = append([]world.Hazard(nil), ps.hazards...)
+	return true
+}
+
+// HazardsEnabled reports whether the room's hazards are on
+func (ps *PracticeSession) HazardsEnabled() bool {
+	return len(ps.room.Hazards) > 0 || len(ps.hazards) == 0
+}
+
+// Reset restores the sandbox to how it started: player at the start with
+// full health and every ability, no enemies, and hazards on
+func (ps *PracticeSession) Reset(gr *GameRunner) {
+	gr.enemyInstances = nil
+	gr.enemySlots = make(map[*entity.EnemyInstance]int)
+	gr.enemyHealthTrails = make(map[*entity.EnemyInstance]*render.HealthTrail)
+	gr.itemInstances = nil
+	gr.RespawnPlayer(ps.startX, ps.startY)
+
+	player := gr.game.Player
+	player.Abilities = make(map[string]bool, len(ps.abilities))
+	for ability, granted := range ps.abilities {
+		player.Abilities[ability] = granted
+	}
+
+	ps.room.Hazards = append([]world.Hazard(nil), ps.hazards...)
+	ps.nextDummy = 0
+}
+
+// updatePractice handles the practice keys for one frame. A defeated player
+// resets the sandbox instead of ending the game.
+func (gr *GameRunner) updatePractice(spawn, toggleHazards, reset bool) {
+	ps := gr.practice
+	if ps == nil {
+		return
+	}
+	if spawn {
+		ps.SpawnDummy(gr)
+	}
+	if toggleHazards {
+		ps.ToggleHazards()
+	}
+	if reset || gr.game.Player.Health <= 0 {
+		ps.Reset(gr)
+	}
+}
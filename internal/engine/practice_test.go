@@ -0,0 +1,99 @@
+package engine
+
+import "testing"
+
+func newPracticeRunner(t *testing.T) *GameRunner {
+	t.Helper()
+	game, err := NewPracticeGame(42, "fantasy")
+	if err != nil {
+		t.Fatalf("NewPracticeGame() error = %v", err)
+	}
+	return NewPracticeRunner(game)
+}
+
+func TestPracticeGameGrantsAllAbilities(t *testing.T) {
+	gr := newPracticeRunner(t)
+
+	for _, ability := range practiceAbilities {
+		if !gr.game.Player.Abilities[ability] {
+			t.Errorf("practice player lacks %q", ability)
+		}
+	}
+	for _, ability := range gr.game.Abilities {
+		if key := gr.normalizeAbilityKey(ability.Name); !gr.game.Player.Abilities[key] {
+			t.Errorf("practice player lacks generated ability %q", key)
+		}
+	}
+
+	w := gr.game.World
+	if len(w.Rooms) != 1 || w.StartRoom != gr.game.CurrentRoom {
+		t.Fatalf("practice world has %d rooms, want a single start room", len(w.Rooms))
+	}
+	if len(gr.game.CurrentRoom.Doors) != 0 || len(gr.enemyInstances) != 0 {
+		t.Error("sandbox room should start with no exits and no enemies")
+	}
+	if gr.saveManager != nil {
+		t.Error("practice mode should not save")
+	}
+}
+
+func TestPracticeResetRestoresSandbox(t *testing.T) {
+	gr := newPracticeRunner(t)
+	ps := gr.Practice()
+	if ps == nil {
+		t.Fatal("Practice() = nil for a practice runner")
+	}
+	player := gr.game.Player
+	startX, startY := gr.playerBody.Position.X, gr.playerBody.Position.Y
+	hazards := len(gr.game.CurrentRoom.Hazards)
+
+	for i := 0; i < 3; i++ {
+		if !ps.SpawnDummy(gr) {
+			t.Fatal("SpawnDummy() failed in an empty room")
+		}
+	}
+	if len(gr.enemyInstances) != 3 {
+		t.Fatalf("spawned %d dummies, want 3", len(gr.enemyInstances))
+	}
+	if gr.enemyInstances[0].Enemy.Damage != 0 {
+		t.Error("dummies should deal no damage")
+	}
+	if hazards > 0 && ps.ToggleHazards() {
+		t.Error("ToggleHazards() should turn the room's hazards off")
+	}
+	player.Health = 1
+	player.Abilities = map[string]bool{}
+	gr.playerBody.Position.X += 200
+
+	ps.Reset(gr)
+
+	if len(gr.enemyInstances) != 0 {
+		t.Errorf("%d enemies remain after reset", len(gr.enemyInstances))
+	}
+	if len(gr.game.CurrentRoom.Hazards) != hazards || !ps.HazardsEnabled() {
+		t.Errorf("hazards after reset = %d, want %d", len(gr.game.CurrentRoom.Hazards), hazards)
+	}
+	if player.Health != player.MaxHealth {
+		t.Errorf("health after reset = %d, want %d", player.Health, player.MaxHealth)
+	}
+	if gr.playerBody.Position.X != startX || gr.playerBody.Position.Y != startY {
+		t.Errorf("player at (%v, %v) after reset, want (%v, %v)",
+			gr.playerBody.Position.X, gr.playerBody.Position.Y, startX, startY)
+	}
+	for _, ability := range practiceAbilities {
+		if !player.Abilities[ability] {
+			t.Errorf("ability %q not restored by reset", ability)
+		}
+	}
+}
+
+func TestPracticeResetsOnDeath(t *testing.T) {
+	gr := newPracticeRunner(t)
+	gr.game.Player.Health = 0
+
+	gr.updatePractice(false, false, false)
+
+	if gr.game.Player.Health != gr.game.Player.MaxHealth {
+		t.Errorf("health = %d after dying in practice, want a reset to full", gr.game.Player.Health)
+	}
+}
@@ -0,0 +1,156 @@
+// Package engine provides generation presets: named files that pin a
+// generated world by recording its master seed and generation options.
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// presetExt is the file extension for saved presets
+const presetExt = ".json"
+
+// GenerationPreset records everything needed to regenerate a world exactly
+type GenerationPreset struct {
+	Name              string         `json:"name"`
+	Seed              int64          `json:"seed"`
+	Genre             string         `json:"genre"`
+	Loadout           string         `json:"loadout,omitempty"`
+	NGPlusLevel       int            `json:"ng_plus_level,omitempty"`
+	StartingAbilities []string       `json:"starting_abilities,omitempty"`
+	Density           *world.Density `json:"density,omitempty"`
+}
+
+// NewPresetFromGame captures the generation options of game under name
+func NewPresetFromGame(name string, game *Game) *GenerationPreset {
+	return &GenerationPreset{
+		Name:              name,
+		Seed:              game.Seed,
+		Genre:             game.Genre,
+		Loadout:           game.Loadout,
+		NGPlusLevel:       game.NGPlusLevel,
+		StartingAbilities: append([]string(nil), game.StartingAbilities...),
+		Density:           presetDensity(game),
+	}
+}
+
+// presetDensity returns the game's world density, or nil for the default
+// so ordinary presets stay minimal
+func presetDensity(game *Game) *world.Density {
+	if game.World == nil || game.World.Density == nil || *game.World.Density == world.DefaultDensity() {
+		return nil
+	}
+	density := *game.World.Density
+	return &density
+}
+
+// Generator returns a game generator configured from the preset
+func (p *GenerationPreset) Generator() (*GameGenerator, error) {
+	genre := p.Genre
+	if genre == "" {
+		genre = "fantasy"
+	}
+	gg := NewGameGeneratorWithGenre(p.Seed, genre)
+	if p.Loadout != "" {
+		if err := gg.SetLoadout(p.Loadout); err != nil {
+			return nil, fmt.Errorf("preset %q: %w", p.Name, err)
+		}
+	}
+	if p.Density != nil {
+		if err := gg.SetDensity(*p.Density); err != nil {
+			return nil, fmt.Errorf("preset %q: %w", p.Name, err)
+		}
+	}
+	gg.NGPlusLevel = p.NGPlusLevel
+	gg.StartingAbilities = append([]string(nil), p.StartingAbilities...)
+	return gg, nil
+}
+
+// DefaultPresetDir returns the directory presets are stored in,
+// ~/.vania/presets
+func DefaultPresetDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".vania", "presets"), nil
+}
+
+// SavePreset writes preset to dir as <name>.json, replacing any preset of
+// the same name
+func SavePreset(dir string, preset *GenerationPreset) error {
+	path, err := presetPath(dir, preset.Name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create preset directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(preset, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode preset: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write preset: %w", err)
+	}
+	return nil
+}
+
+// LoadPreset reads the preset called name from dir
+func LoadPreset(dir, name string) (*GenerationPreset, error) {
+	path, err := presetPath(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read preset: %w", err)
+	}
+
+	var preset GenerationPreset
+	if err := json.Unmarshal(data, &preset); err != nil {
+		return nil, fmt.Errorf("failed to parse preset %q: %w", name, err)
+	}
+	if preset.Name == "" {
+		preset.Name = name
+	}
+	return &preset, nil
+}
+
+// ListPresets returns the names of the presets in dir in sorted order. A
+// missing directory has no presets.
+func ListPresets(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list presets: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != presetExt {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(entry.Name(), presetExt))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// presetPath returns the file for a preset name, rejecting names that
+// would escape the preset directory
+func presetPath(dir, name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("invalid preset name %q", name)
+	}
+	return filepath.Join(dir, name+presetExt), nil
+}
@@ -0,0 +1,121 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+func TestPresetRoundTripRegeneratesWorld(t *testing.T) {
+	gg := NewGameGeneratorWithGenre(2024, "scifi")
+	if err := gg.SetLoadout("tank"); err != nil {
+		t.Fatalf("SetLoadout() error = %v", err)
+	}
+	original, err := gg.GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+
+	dir := t.TempDir()
+	if err := SavePreset(dir, NewPresetFromGame("favorite", original)); err != nil {
+		t.Fatalf("SavePreset() error = %v", err)
+	}
+
+	names, err := ListPresets(dir)
+	if err != nil {
+		t.Fatalf("ListPresets() error = %v", err)
+	}
+	if len(names) != 1 || names[0] != "favorite" {
+		t.Fatalf("ListPresets() = %v, want [favorite]", names)
+	}
+
+	preset, err := LoadPreset(dir, "favorite")
+	if err != nil {
+		t.Fatalf("LoadPreset() error = %v", err)
+	}
+	regen, err := preset.Generator()
+	if err != nil {
+		t.Fatalf("Generator() error = %v", err)
+	}
+	reloaded, err := regen.GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() from preset error = %v", err)
+	}
+
+	if got, want := len(reloaded.World.Rooms), len(original.World.Rooms); got != want {
+		t.Errorf("room count = %d, want %d", got, want)
+	}
+	if got, want := len(reloaded.Bosses), len(original.Bosses); got != want {
+		t.Errorf("boss count = %d, want %d", got, want)
+	}
+	if got, want := len(reloaded.World.Biomes), len(original.World.Biomes); got != want {
+		t.Errorf("biome count = %d, want %d", got, want)
+	}
+	if reloaded.Seed != original.Seed || reloaded.Genre != original.Genre || reloaded.Loadout != original.Loadout {
+		t.Errorf("reloaded options = (%d, %s, %s), want (%d, %s, %s)",
+			reloaded.Seed, reloaded.Genre, reloaded.Loadout,
+			original.Seed, original.Genre, original.Loadout)
+	}
+}
+
+func TestPresetCarriesNewGamePlusOptions(t *testing.T) {
+	prev, err := NewGameGenerator(7).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	prev.Player.Abilities["dash"] = true
+	game, err := NewGamePlusGenerator(prev, 7).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+
+	preset := NewPresetFromGame("ngplus", game)
+	gg, err := preset.Generator()
+	if err != nil {
+		t.Fatalf("Generator() error = %v", err)
+	}
+	if gg.NGPlusLevel != 1 {
+		t.Errorf("NGPlusLevel = %d, want 1", gg.NGPlusLevel)
+	}
+	found := false
+	for _, ability := range gg.StartingAbilities {
+		found = found || ability == "dash"
+	}
+	if !found {
+		t.Errorf("StartingAbilities = %v, want dash carried over", gg.StartingAbilities)
+	}
+}
+
+func TestPresetCarriesDensity(t *testing.T) {
+	packed := world.Density{Enemies: 2, Items: 0.5, Hazards: 1}
+	gg := NewGameGenerator(7)
+	if err := gg.SetDensity(packed); err != nil {
+		t.Fatalf("SetDensity() error = %v", err)
+	}
+	game, err := gg.GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+
+	regen, err := NewPresetFromGame("packed", game).Generator()
+	if err != nil {
+		t.Fatalf("Generator() error = %v", err)
+	}
+	if regen.WorldGen.Density != packed {
+		t.Errorf("preset density = %+v, want %+v", regen.WorldGen.Density, packed)
+	}
+
+	normal, err := NewGameGenerator(7).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	if preset := NewPresetFromGame("normal", normal); preset.Density != nil {
+		t.Errorf("default density recorded in preset: %+v", *preset.Density)
+	}
+}
+
+func TestLoadPreset_RejectsPathNames(t *testing.T) {
+	if _, err := LoadPreset(t.TempDir(), "../escape"); err == nil {
+		t.Error("LoadPreset() accepted a name containing a path separator")
+	}
+}
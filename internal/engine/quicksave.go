@@ -0,0 +1,63 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Quicksave hotkeys. Both use the save manager's reserved quicksave slot,
+// so they never touch the numbered slots or the autosave.
+const (
+	QuickSaveKey = ebiten.KeyF5
+	QuickLoadKey = ebiten.KeyF11
+)
+
+// QuickSave writes the current game state to the quicksave slot
+func (gr *GameRunner) QuickSave() error {
+	if gr.saveManager == nil {
+		return fmt.Errorf("save system not initialized")
+	}
+	return gr.saveManager.QuickSave(gr.CreateSaveData())
+}
+
+// QuickLoad restores the game state from the quicksave slot. The saved
+// room is repopulated and the player is set down at rest.
+func (gr *GameRunner) QuickLoad() error {
+	if gr.saveManager == nil {
+		return fmt.Errorf("save system not initialized")
+	}
+
+	saveData, err := gr.saveManager.QuickLoad()
+	if err != nil {
+		return err
+	}
+	return gr.enterLoadedGame(saveData)
+}
+
+// handleQuickSaveKeys runs a quicksave or quickload for the pressed
+// hotkeys and confirms the result with a toast
+func (gr *GameRunner) handleQuickSaveKeys(saveKey, loadKey bool) {
+	switch {
+	case saveKey:
+		gr.ConfirmQuickSave()
+	case loadKey:
+		gr.showSaveToast(gr.QuickLoad(), "toast.quickloaded", "toast.quickload_failed")
+	}
+}
+
+// ConfirmQuickSave quicksaves and reports the outcome with a toast instead
+// of an error, as the hotkey does
+func (gr *GameRunner) ConfirmQuickSave() {
+	gr.showSaveToast(gr.QuickSave(), "toast.quicksaved", "toast.quicksave_failed")
+}
+
+// showSaveToast shows the success toast, or the failure toast when err is set
+func (gr *GameRunner) showSaveToast(err error, okKey, failKey string) {
+	if err != nil {
+		gr.itemMessage = gr.loc.Text(failKey)
+	} else {
+		gr.itemMessage = gr.loc.Text(okKey)
+	}
+	gr.itemMessageTimer = itemMessageDuration
+}
@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/save"
+)
+
+func newQuickSaveRunner(t *testing.T) (*GameRunner, string) {
+	t.Helper()
+	gr := newTestRunner(t)
+	dir := t.TempDir()
+	var err error
+	if gr.saveManager, err = save.NewSaveManager(dir); err != nil {
+		t.Fatalf("NewSaveManager() error = %v", err)
+	}
+	return gr, dir
+}
+
+func TestQuickSaveWritesSlot(t *testing.T) {
+	gr, dir := newQuickSaveRunner(t)
+
+	gr.handleQuickSaveKeys(true, false)
+
+	if _, err := os.Stat(filepath.Join(dir, "quicksave.json")); err != nil {
+		t.Fatalf("quicksave slot not written: %v", err)
+	}
+	if gr.itemMessage != gr.loc.Text("toast.quicksaved") || gr.itemMessageTimer == 0 {
+		t.Errorf("toast = %q, want quicksave confirmation", gr.itemMessage)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "autosave.json")); err == nil {
+		t.Error("quicksave overwrote the autosave slot")
+	}
+}
+
+func TestQuickLoadRestoresState(t *testing.T) {
+	gr, _ := newQuickSaveRunner(t)
+	player := gr.game.Player
+	player.X, player.Y = 240, 180
+	player.Health = player.MaxHealth - 3
+	gr.playerBody.Position.X, gr.playerBody.Position.Y = player.X, player.Y
+	gr.collectedItems[7] = true
+	gr.unlockedDoors["1-2"] = true
+	startRoom := gr.game.CurrentRoom
+
+	if err := gr.QuickSave(); err != nil {
+		t.Fatalf("QuickSave() error = %v", err)
+	}
+	want, err := gr.saveManager.QuickLoad()
+	if err != nil {
+		t.Fatalf("QuickLoad() error = %v", err)
+	}
+
+	// Wander off: move, take damage, gain an ability, and change rooms
+	player.X, player.Y = 700, 50
+	player.VelX = 5
+	player.Health = 1
+	player.Abilities["double_jump"] = true
+	gr.collectedItems[8] = true
+	for _, room := range gr.game.World.Rooms {
+		if room != startRoom {
+			gr.game.CurrentRoom = room
+			break
+		}
+	}
+
+	gr.handleQuickSaveKeys(false, true)
+	if gr.itemMessage != gr.loc.Text("toast.quickloaded") {
+		t.Fatalf("toast = %q, want quickload confirmation", gr.itemMessage)
+	}
+
+	got := gr.CreateSaveData()
+	if got.PlayerX != want.PlayerX || got.PlayerY != want.PlayerY || got.PlayerHealth != want.PlayerHealth {
+		t.Errorf("player = (%v, %v) hp %d, want (%v, %v) hp %d",
+			got.PlayerX, got.PlayerY, got.PlayerHealth, want.PlayerX, want.PlayerY, want.PlayerHealth)
+	}
+	if gr.game.CurrentRoom != startRoom {
+		t.Errorf("room = %d, want %d", gr.game.CurrentRoom.ID, startRoom.ID)
+	}
+	if len(got.PlayerAbilities) != len(want.PlayerAbilities) || got.PlayerAbilities["double_jump"] != want.PlayerAbilities["double_jump"] {
+		t.Errorf("abilities = %v, want %v", got.PlayerAbilities, want.PlayerAbilities)
+	}
+	if len(got.CollectedItems) != len(want.CollectedItems) || !got.CollectedItems[7] || got.CollectedItems[8] {
+		t.Errorf("collected items = %v, want %v", got.CollectedItems, want.CollectedItems)
+	}
+	if !got.UnlockedDoors["1-2"] {
+		t.Error("unlocked doors not restored")
+	}
+	if player.VelX != 0 || gr.playerBody.Position.X != want.PlayerX {
+		t.Errorf("player not set down at the saved spot: vel %v, body x %v", player.VelX, gr.playerBody.Position.X)
+	}
+}
+
+func TestQuickLoadWithoutSave(t *testing.T) {
+	gr, _ := newQuickSaveRunner(t)
+	x := gr.game.Player.X
+
+	gr.handleQuickSaveKeys(false, true)
+
+	if gr.itemMessage != gr.loc.Text("toast.quickload_failed") {
+		t.Errorf("toast = %q, want quickload failure", gr.itemMessage)
+	}
+	if gr.game.Player.X != x {
+		t.Error("failed quickload changed the game state")
+	}
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/graphics"
+	"github.com/opd-ai/vania/internal/pcg"
+)
+
+// enemySpriteSizes is the sprite edge length, in pixels, for each enemy size
+var enemySpriteSizes = map[entity.EnemySize]int{
+	entity.SmallEnemy:  16,
+	entity.MediumEnemy: 32,
+	entity.LargeEnemy:  48,
+	entity.BossEnemy:   64,
+}
+
+// generateEnemySprite creates the sprite for an enemy, sized to match it
+func generateEnemySprite(enemy *entity.Enemy, seed int64) *graphics.Sprite {
+	size, ok := enemySpriteSizes[enemy.Size]
+	if !ok {
+		size = 32
+	}
+	return graphics.NewSpriteGenerator(size, size, graphics.VerticalSymmetry).Generate(seed)
+}
+
+// RegenerateGraphics rerolls every sprite and tileset from seed, keeping the
+// world, narrative and entities as they are. Meant for iterating on art
+// against a fixed world.
+func (g *Game) RegenerateGraphics(seed int64) {
+	gg := NewGameGeneratorWithGenre(seed, g.Genre)
+	g.Graphics = gg.generateGraphics(g.Narrative)
+
+	if g.Player != nil {
+		gg.dressPlayer(g.Player, g.Graphics.Sprites["player"], g.PlayerPalette)
+	}
+
+	spriteSeed := pcg.HashSeed(seed, "enemy-sprites")
+	for i, enemy := range g.Entities {
+		enemy.SpriteData = generateEnemySprite(enemy, spriteSeed+int64(i))
+	}
+	for i, boss := range g.Bosses {
+		boss.SpriteData = generateEnemySprite(&boss.Enemy, spriteSeed+int64(10000+i))
+	}
+	for i, mb := range g.MiniBosses {
+		mb.SpriteData = generateEnemySprite(&mb.Enemy, spriteSeed+int64(20000+i))
+	}
+}
+
+// RegenerateAudio rerolls every sound effect and music track from seed,
+// keeping the world, narrative and entities as they are
+func (g *Game) RegenerateAudio(seed int64) {
+	gg := NewGameGeneratorWithGenre(seed, g.Genre)
+	g.Audio = gg.generateAudio(g.Narrative, g.World)
+}
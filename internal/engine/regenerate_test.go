@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/graphics"
+)
+
+func TestRegenerateGraphicsKeepsWorld(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+
+	roomIDs := make([]int, len(game.World.Rooms))
+	for i, room := range game.World.Rooms {
+		roomIDs[i] = room.ID
+	}
+	enemyNames := make([]string, len(game.Entities))
+	for i, enemy := range game.Entities {
+		enemyNames[i] = enemy.Name
+	}
+	bossCount, itemCount := len(game.Bosses), len(game.Items)
+	theme := game.Narrative.Theme
+	oldPlayer := game.Graphics.Sprites["player"]
+	oldEnemy := game.Entities[0].SpriteData.(*graphics.Sprite)
+	oldSounds := game.Audio
+
+	game.RegenerateGraphics(99)
+
+	if bytes.Equal(oldPlayer.Image.Pix, game.Graphics.Sprites["player"].Image.Pix) {
+		t.Error("player sprite did not change")
+	}
+	if game.Player.Sprite != game.Graphics.Sprites["player"] {
+		t.Error("player should use the regenerated sprite")
+	}
+	newEnemy, ok := game.Entities[0].SpriteData.(*graphics.Sprite)
+	if !ok || bytes.Equal(oldEnemy.Image.Pix, newEnemy.Image.Pix) {
+		t.Error("enemy sprite did not change")
+	}
+	if newEnemy.Width != oldEnemy.Width {
+		t.Errorf("enemy sprite width = %d, want %d to match its size", newEnemy.Width, oldEnemy.Width)
+	}
+	if len(game.Graphics.Tilesets) == 0 {
+		t.Error("tilesets were not regenerated")
+	}
+
+	if len(game.World.Rooms) != len(roomIDs) {
+		t.Fatalf("room count = %d, want %d", len(game.World.Rooms), len(roomIDs))
+	}
+	for i, room := range game.World.Rooms {
+		if room.ID != roomIDs[i] {
+			t.Errorf("room %d ID = %d, want %d", i, room.ID, roomIDs[i])
+		}
+	}
+	if len(game.Entities) != len(enemyNames) || len(game.Bosses) != bossCount || len(game.Items) != itemCount {
+		t.Error("entity counts changed")
+	}
+	for i, enemy := range game.Entities {
+		if enemy.Name != enemyNames[i] {
+			t.Errorf("enemy %d name = %q, want %q", i, enemy.Name, enemyNames[i])
+		}
+	}
+	if game.Narrative.Theme != theme {
+		t.Error("narrative changed")
+	}
+	if game.Audio != oldSounds {
+		t.Error("audio should be left alone")
+	}
+}
+
+func TestRegenerateAudioKeepsGraphics(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	gfx := game.Graphics
+	roomCount := len(game.World.Rooms)
+	oldJump := game.Audio.Sounds["jump"]
+
+	game.RegenerateAudio(99)
+
+	if game.Graphics != gfx {
+		t.Error("graphics should be left alone")
+	}
+	if len(game.World.Rooms) != roomCount {
+		t.Error("room count changed")
+	}
+	newJump := game.Audio.Sounds["jump"]
+	if newJump == nil || len(game.Audio.Music) != len(game.World.Biomes) {
+		t.Fatal("audio was not fully regenerated")
+	}
+	if sameSamples(oldJump.Data, newJump.Data) {
+		t.Error("jump sound did not change")
+	}
+}
+
+// sameSamples reports whether two sample buffers are identical
+func sameSamples(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+// RespawnMode controls whether defeated enemies come back
+type RespawnMode int
+
+const (
+	// RespawnOnReentry repopulates a room every time it is entered
+	RespawnOnReentry RespawnMode = iota
+	// RespawnNever keeps defeated enemies gone for the rest of the run
+	RespawnNever
+	// RespawnTimed brings a defeated enemy back once an interval of play
+	// time has passed since it fell
+	RespawnTimed
+)
+
+// DefaultRespawnIntervalFrames is the timed-respawn interval when none is
+// configured: two minutes of play
+const DefaultRespawnIntervalFrames = 2 * 60 * playFramesPerSecond
+
+// respawnModeNames are the settings-file names of each mode
+var respawnModeNames = map[RespawnMode]string{
+	RespawnOnReentry: "reentry",
+	RespawnNever:     "never",
+	RespawnTimed:     "timed",
+}
+
+// String returns the settings-file name of the mode
+func (m RespawnMode) String() string {
+	if name, ok := respawnModeNames[m]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseRespawnMode converts a settings-file name back to a mode
+func ParseRespawnMode(name string) (RespawnMode, error) {
+	for mode, modeName := range respawnModeNames {
+		if modeName == name {
+			return mode, nil
+		}
+	}
+	return RespawnOnReentry, fmt.Errorf("unknown respawn mode %q", name)
+}
+
+// RespawnPolicy is the respawn mode plus, for timed respawns, the interval
+type RespawnPolicy struct {
+	Mode           RespawnMode
+	IntervalFrames int64 // Play frames before a timed respawn
+}
+
+// enemySlot identifies one spawn position in one room
+type enemySlot struct {
+	roomID int
+	index  int
+}
+
+// EnemyPersistence remembers which room spawns have been defeated and when,
+// and decides from the respawn policy whether each should spawn again.
+type EnemyPersistence struct {
+	policy   RespawnPolicy
+	defeated map[enemySlot]int64 // play frame of defeat
+}
+
+// NewEnemyPersistence creates an empty tracker using policy
+func NewEnemyPersistence(policy RespawnPolicy) *EnemyPersistence {
+	ep := &EnemyPersistence{defeated: make(map[enemySlot]int64)}
+	ep.SetPolicy(policy)
+	return ep
+}
+
+// SetPolicy changes the respawn policy, keeping the defeats seen so far
+func (ep *EnemyPersistence) SetPolicy(policy RespawnPolicy) {
+	if policy.Mode == RespawnTimed && policy.IntervalFrames <= 0 {
+		policy.IntervalFrames = DefaultRespawnIntervalFrames
+	}
+	ep.policy = policy
+}
+
+// Policy returns the respawn policy in effect
+func (ep *EnemyPersistence) Policy() RespawnPolicy {
+	return ep.policy
+}
+
+// RecordDefeat marks spawn index of roomID as defeated at play frame
+func (ep *EnemyPersistence) RecordDefeat(roomID, index int, frame int64) {
+	ep.defeated[enemySlot{roomID, index}] = frame
+}
+
+// ShouldSpawn reports whether spawn index of roomID should be populated
+// when the room is entered at play frame
+func (ep *EnemyPersistence) ShouldSpawn(roomID, index int, frame int64) bool {
+	slot := enemySlot{roomID, index}
+	defeatedAt, wasDefeated := ep.defeated[slot]
+	if !wasDefeated {
+		return true
+	}
+
+	switch ep.policy.Mode {
+	case RespawnNever:
+		return false
+	case RespawnTimed:
+		if frame-defeatedAt < ep.policy.IntervalFrames {
+			return false
+		}
+		delete(ep.defeated, slot)
+		return true
+	default:
+		delete(ep.defeated, slot)
+		return true
+	}
+}
+
+// Filter drops the enemies of a freshly spawned room that should stay
+// defeated. It returns the survivors and, for each, its spawn index.
+func (ep *EnemyPersistence) Filter(roomID int, spawned []*entity.EnemyInstance, frame int64) ([]*entity.EnemyInstance, map[*entity.EnemyInstance]int) {
+	kept := make([]*entity.EnemyInstance, 0, len(spawned))
+	slots := make(map[*entity.EnemyInstance]int, len(spawned))
+	for i, enemy := range spawned {
+		if !ep.ShouldSpawn(roomID, i, frame) {
+			continue
+		}
+		kept = append(kept, enemy)
+		slots[enemy] = i
+	}
+	return kept, slots
+}
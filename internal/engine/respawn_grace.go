@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"math"
+
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/render"
+)
+
+// RespawnGraceConfig controls the grace period after the player respawns,
+// during which nearby enemies drop aggro and hold off so they cannot camp
+// the respawn point
+type RespawnGraceConfig struct {
+	Frames int     // How long enemies stay calm; zero turns the grace off
+	Radius float64 // Enemies within this distance of the respawn point are calmed
+}
+
+// DefaultRespawnGraceConfig calms enemies within about half the screen for
+// three seconds
+func DefaultRespawnGraceConfig() RespawnGraceConfig {
+	return RespawnGraceConfig{Frames: 3 * playFramesPerSecond, Radius: 480}
+}
+
+// SetRespawnGraceConfig changes the grace period given after a respawn
+func (gr *GameRunner) SetRespawnGraceConfig(config RespawnGraceConfig) {
+	gr.respawnGrace = config
+}
+
+// RespawnPlayer brings the player back at x, y with full health and a
+// fresh body, clears incoming attacks, and calms the enemies near the
+// respawn point for the grace period
+func (gr *GameRunner) RespawnPlayer(x, y float64) {
+	player := gr.game.Player
+	player.Health = player.MaxHealth
+	player.X, player.Y = x, y
+	player.VelX, player.VelY = 0, 0
+
+	body := physics.NewBody(x, y, physics.PlayerWidth, physics.PlayerHeight)
+	body.Movement = gr.playerBody.Movement
+	body.Ledge.Enabled = gr.playerBody.Ledge.Enabled
+	gr.playerBody = body
+	gr.doubleJumpUsed = false
+	gr.dashCooldown = 0
+	gr.playerStatus = NewStatusManager()
+	gr.playerHealthTrail = render.NewHealthTrail(player.Health)
+
+	gr.combatSystem.ClearEnemyProjectiles()
+	gr.combatSystem.ClearAreaHazards()
+	gr.startRespawnGrace(x, y)
+}
+
+// startRespawnGrace calms every living enemy within the grace radius of
+// the respawn point
+func (gr *GameRunner) startRespawnGrace(x, y float64) {
+	grace := gr.respawnGrace
+	if grace.Frames <= 0 {
+		return
+	}
+	px, py := x+physics.PlayerWidth/2, y+physics.PlayerHeight/2
+	for _, enemy := range gr.enemyInstances {
+		ex, ey, ew, eh := enemy.GetBounds()
+		if math.Hypot(ex+ew/2-px, ey+eh/2-py) <= grace.Radius {
+			enemy.Calm(grace.Frames)
+		}
+	}
+}
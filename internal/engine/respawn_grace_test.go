@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+func TestRespawnGraceCalmsNearbyEnemiesUntilItEnds(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetRespawnGraceConfig(RespawnGraceConfig{Frames: 90, Radius: 200})
+
+	template := &entity.Enemy{Name: "Brute", Health: 50, Damage: 5, Speed: 1,
+		Behavior: entity.ChaseBehavior, Size: entity.MediumEnemy}
+	near := entity.NewEnemyInstance(template, 240, 300)
+	far := entity.NewEnemyInstance(template, 900, 300)
+	near.Alarm()
+	far.Alarm()
+	gr.enemyInstances = []*entity.EnemyInstance{near, far}
+
+	gr.game.Player.Health = 1
+	gr.RespawnPlayer(200, 300)
+	if gr.game.Player.Health != gr.game.Player.MaxHealth {
+		t.Fatalf("respawn health = %d, want %d", gr.game.Player.Health, gr.game.Player.MaxHealth)
+	}
+	if !near.Calmed() {
+		t.Fatal("enemy near the respawn point should be calmed")
+	}
+	if far.Calmed() {
+		t.Error("enemy outside the grace radius should keep its aggro")
+	}
+
+	px, py := gr.game.Player.X, gr.game.Player.Y
+	for frame := 0; frame < 90; frame++ {
+		// Touching the player during the grace deals no damage
+		near.X, near.Y = px, py
+		gr.checkEnemyHitPlayer(near)
+		if gr.game.Player.Health != gr.game.Player.MaxHealth {
+			t.Fatalf("frame %d: calmed enemy hurt the player", frame)
+		}
+		near.Update(px, py)
+		if near.State != entity.IdleState || near.Awareness() == entity.Alerted {
+			t.Fatalf("frame %d: calmed enemy state = %v, awareness = %v, want idle and unaware",
+				frame, near.State, near.Awareness())
+		}
+	}
+	if near.Calmed() {
+		t.Fatal("grace should be over after its duration")
+	}
+
+	near.X = px + 40
+	for frame := 0; frame <= entity.AlertRiseFrames+1; frame++ {
+		near.Update(px, py)
+	}
+	if near.Awareness() != entity.Alerted || near.State == entity.IdleState {
+		t.Errorf("after the grace, enemy awareness = %v, state = %v, want it to re-aggro",
+			near.Awareness(), near.State)
+	}
+}
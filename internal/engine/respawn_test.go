@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+// spawnRoom returns three fresh enemy instances, as a room spawner would
+func spawnRoom() []*entity.EnemyInstance {
+	enemy := &entity.Enemy{Name: "Shade", Health: 20, Speed: 1}
+	return []*entity.EnemyInstance{
+		entity.NewEnemyInstance(enemy, 300, 100),
+		entity.NewEnemyInstance(enemy, 450, 100),
+		entity.NewEnemyInstance(enemy, 600, 100),
+	}
+}
+
+// clearRoom enters roomID at frame and defeats everything that spawned
+func clearRoom(ep *EnemyPersistence, roomID int, frame int64) int {
+	kept, slots := ep.Filter(roomID, spawnRoom(), frame)
+	for _, enemy := range kept {
+		ep.RecordDefeat(roomID, slots[enemy], frame)
+	}
+	return len(kept)
+}
+
+func TestRespawnNeverKeepsRoomsEmpty(t *testing.T) {
+	ep := NewEnemyPersistence(RespawnPolicy{Mode: RespawnNever})
+	if n := clearRoom(ep, 7, 0); n != 3 {
+		t.Fatalf("First visit should spawn 3 enemies, got %d", n)
+	}
+
+	for _, frame := range []int64{1, 60 * 60, 60 * 60 * 60} {
+		if kept, _ := ep.Filter(7, spawnRoom(), frame); len(kept) != 0 {
+			t.Errorf("Cleared room repopulated with %d enemies at frame %d", len(kept), frame)
+		}
+	}
+
+	// Other rooms are unaffected
+	if kept, _ := ep.Filter(8, spawnRoom(), 10); len(kept) != 3 {
+		t.Errorf("Uncleared room should spawn 3 enemies, got %d", len(kept))
+	}
+}
+
+func TestRespawnNeverKeepsPartialClears(t *testing.T) {
+	ep := NewEnemyPersistence(RespawnPolicy{Mode: RespawnNever})
+	kept, slots := ep.Filter(3, spawnRoom(), 0)
+	ep.RecordDefeat(3, slots[kept[1]], 0)
+
+	kept, slots = ep.Filter(3, spawnRoom(), 100)
+	if len(kept) != 2 {
+		t.Fatalf("Expected the 2 survivors to return, got %d", len(kept))
+	}
+	for _, enemy := range kept {
+		if slots[enemy] == 1 {
+			t.Error("The defeated spawn should stay empty")
+		}
+	}
+}
+
+func TestRespawnTimedRespawnsAfterInterval(t *testing.T) {
+	const interval = 600
+	ep := NewEnemyPersistence(RespawnPolicy{Mode: RespawnTimed, IntervalFrames: interval})
+	clearRoom(ep, 2, 1000)
+
+	if kept, _ := ep.Filter(2, spawnRoom(), 1000+interval-1); len(kept) != 0 {
+		t.Errorf("Enemies respawned before the interval: %d", len(kept))
+	}
+	if kept, _ := ep.Filter(2, spawnRoom(), 1000+interval); len(kept) != 3 {
+		t.Errorf("Expected 3 enemies after the interval, got %d", len(kept))
+	}
+}
+
+func TestRespawnTimedUsesDefaultInterval(t *testing.T) {
+	ep := NewEnemyPersistence(RespawnPolicy{Mode: RespawnTimed})
+	if ep.Policy().IntervalFrames != DefaultRespawnIntervalFrames {
+		t.Errorf("IntervalFrames = %d, want default %d", ep.Policy().IntervalFrames, DefaultRespawnIntervalFrames)
+	}
+}
+
+func TestRespawnOnReentryAlwaysRepopulates(t *testing.T) {
+	ep := NewEnemyPersistence(RespawnPolicy{Mode: RespawnOnReentry})
+	for visit := int64(0); visit < 3; visit++ {
+		if n := clearRoom(ep, 5, visit); n != 3 {
+			t.Errorf("Visit %d spawned %d enemies, want 3", visit, n)
+		}
+	}
+}
+
+func TestParseRespawnMode(t *testing.T) {
+	for _, mode := range []RespawnMode{RespawnOnReentry, RespawnNever, RespawnTimed} {
+		parsed, err := ParseRespawnMode(mode.String())
+		if err != nil || parsed != mode {
+			t.Errorf("ParseRespawnMode(%q) = %v, %v", mode.String(), parsed, err)
+		}
+	}
+	if _, err := ParseRespawnMode("sometimes"); err == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+}
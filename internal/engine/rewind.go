@@ -0,0 +1,167 @@
+package engine
+
+import (
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+	"github.com/opd-ai/vania/internal/render"
+)
+
+// Rewind-on-death tuning
+const (
+	// RewindSeconds is how far back a fatal hit rewinds the player
+	RewindSeconds = 3
+
+	// rewindSnapshotInterval is how many frames pass between snapshots
+	rewindSnapshotInterval = 10
+
+	// rewindBufferSize is how many snapshots the ring buffer holds
+	rewindBufferSize = RewindSeconds * playFramesPerSecond / rewindSnapshotInterval
+)
+
+// enemySnapshot is one enemy's position and health at a snapshot
+type enemySnapshot struct {
+	enemy      *entity.EnemyInstance
+	x, y       float64
+	velX, velY float64
+	health     int
+}
+
+// rewindSnapshot is the player's and the room's enemies' state at a moment
+type rewindSnapshot struct {
+	playerX, playerY float64
+	health           int
+	enemies          []enemySnapshot
+}
+
+// RewindBuffer is a ring buffer of recent snapshots for rewind on death
+type RewindBuffer struct {
+	snapshots [rewindBufferSize]rewindSnapshot
+	next      int // Slot the next snapshot is written to
+	count     int
+	frame     int
+}
+
+// NewRewindBuffer creates an empty rewind buffer
+func NewRewindBuffer() *RewindBuffer {
+	return &RewindBuffer{}
+}
+
+// Clear drops every snapshot, e.g. when the room changes
+func (rb *RewindBuffer) Clear() {
+	*rb = RewindBuffer{}
+}
+
+// Record stores a snapshot, overwriting the oldest once the buffer is full
+func (rb *RewindBuffer) Record(s rewindSnapshot) {
+	rb.snapshots[rb.next] = s
+	rb.next = (rb.next + 1) % rewindBufferSize
+	rb.count = min(rb.count+1, rewindBufferSize)
+}
+
+// Oldest returns the earliest snapshot still held, about RewindSeconds ago
+// once the buffer has filled
+func (rb *RewindBuffer) Oldest() (rewindSnapshot, bool) {
+	if rb.count == 0 {
+		return rewindSnapshot{}, false
+	}
+	return rb.snapshots[(rb.next-rb.count+rewindBufferSize)%rewindBufferSize], true
+}
+
+// SetRewindOnDeath turns rewind on death on or off. While on, a fatal hit
+// rewinds the player a few seconds instead of killing them. Like assist
+// mode, a run that turns it on stays flagged.
+func (gr *GameRunner) SetRewindOnDeath(enabled bool) {
+	gr.rewindOnDeath = enabled
+	gr.rewind.Clear()
+	if enabled {
+		gr.rewindUsed = true
+	}
+}
+
+// RewindUsed reports whether rewind on death was on at any point this run
+func (gr *GameRunner) RewindUsed() bool {
+	return gr.rewindUsed
+}
+
+// canRewind reports whether a death right now would be rewound
+func (gr *GameRunner) canRewind() bool {
+	return gr.rewindOnDeath && gr.rewind.count > 0
+}
+
+// recordPlayerDeath counts a death unless it is about to be rewound
+func (gr *GameRunner) recordPlayerDeath() {
+	if gr.game.Player.Health > 0 || gr.canRewind() || gr.game.Achievements == nil {
+		return
+	}
+	gr.game.Achievements.RecordDeath()
+}
+
+// updateRewind rewinds a dead player to the oldest snapshot, or otherwise
+// records a snapshot every rewindSnapshotInterval frames
+func (gr *GameRunner) updateRewind() {
+	if !gr.rewindOnDeath {
+		return
+	}
+	if gr.game.Player.Health <= 0 {
+		gr.rewindDeath()
+		return
+	}
+	if gr.rewind.frame%rewindSnapshotInterval == 0 {
+		gr.rewind.Record(gr.takeRewindSnapshot())
+	}
+	gr.rewind.frame++
+}
+
+// takeRewindSnapshot captures the player and the living enemies
+func (gr *GameRunner) takeRewindSnapshot() rewindSnapshot {
+	player := gr.game.Player
+	s := rewindSnapshot{playerX: player.X, playerY: player.Y, health: player.Health}
+	for _, enemy := range gr.enemyInstances {
+		if enemy.IsDead() {
+			continue
+		}
+		s.enemies = append(s.enemies, enemySnapshot{
+			enemy: enemy, x: enemy.X, y: enemy.Y,
+			velX: enemy.VelX, velY: enemy.VelY, health: enemy.CurrentHealth,
+		})
+	}
+	return s
+}
+
+// rewindDeath restores the oldest snapshot in place of a death. Enemies
+// killed since then stay dead, and those near the restored spot get the
+// respawn grace. It reports whether a snapshot was restored.
+func (gr *GameRunner) rewindDeath() bool {
+	s, ok := gr.rewind.Oldest()
+	if !ok {
+		return false
+	}
+	gr.rewind.Clear()
+
+	player := gr.game.Player
+	player.X, player.Y = s.playerX, s.playerY
+	player.VelX, player.VelY = 0, 0
+	player.Health = s.health
+	body := physics.NewBody(s.playerX, s.playerY, physics.PlayerWidth, physics.PlayerHeight)
+	body.Movement = gr.playerBody.Movement
+	body.Ledge.Enabled = gr.playerBody.Ledge.Enabled
+	gr.playerBody = body
+	gr.playerStatus = NewStatusManager()
+	gr.playerHealthTrail = render.NewHealthTrail(player.Health)
+
+	for _, es := range s.enemies {
+		if es.enemy.IsDead() {
+			continue
+		}
+		es.enemy.X, es.enemy.Y = es.x, es.y
+		es.enemy.VelX, es.enemy.VelY = es.velX, es.velY
+		es.enemy.CurrentHealth = es.health
+	}
+
+	gr.combatSystem.ClearEnemyProjectiles()
+	gr.combatSystem.ClearAreaHazards()
+	gr.startRespawnGrace(s.playerX, s.playerY)
+	gr.itemMessage = gr.loc.Text("toast.rewound")
+	gr.itemMessageTimer = itemMessageDuration
+	return true
+}
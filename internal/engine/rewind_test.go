@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+func TestRewindOnDeathRestoresSnapshotWithoutCountingDeath(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetRewindOnDeath(true)
+
+	template := &entity.Enemy{Name: "Brute", Health: 50, Damage: 500, Speed: 1,
+		Behavior: entity.ChaseBehavior, Size: entity.MediumEnemy}
+	enemy := entity.NewEnemyInstance(template, 800, 300)
+	gr.enemyInstances = []*entity.EnemyInstance{enemy}
+
+	player := gr.game.Player
+	player.X, player.Y, player.Health = 200, 300, 40
+	for frame := 0; frame < rewindSnapshotInterval*3; frame++ {
+		gr.updateRewind()
+	}
+
+	// The enemy closes in and lands a fatal hit
+	player.X = 500
+	enemy.X, enemy.Y = player.X, player.Y
+	deathsBefore := gr.game.Achievements.GetStatistics().DeathCount
+	gr.checkEnemyHitPlayer(enemy)
+	if player.Health > 0 {
+		t.Fatalf("health = %d after the fatal hit, want <= 0", player.Health)
+	}
+	gr.updateRewind()
+
+	if player.X != 200 || player.Y != 300 || player.Health != 40 {
+		t.Errorf("player at (%v, %v) with %d health, want the snapshot (200, 300) with 40",
+			player.X, player.Y, player.Health)
+	}
+	if enemy.X != 800 {
+		t.Errorf("enemy x = %v, want its snapshot position 800", enemy.X)
+	}
+	if deaths := gr.game.Achievements.GetStatistics().DeathCount; deaths != deathsBefore {
+		t.Errorf("death count = %d, want %d: a rewound death should not count", deaths, deathsBefore)
+	}
+	if !gr.RewindUsed() || !gr.CreateSaveData().RewindUsed {
+		t.Error("run using rewind on death is not flagged")
+	}
+}
+
+func TestRewindOnDeathCalmsNearbyEnemies(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetRewindOnDeath(true)
+	gr.SetRespawnGraceConfig(RespawnGraceConfig{Frames: 90, Radius: 200})
+
+	template := &entity.Enemy{Name: "Brute", Health: 50, Damage: 500, Speed: 1,
+		Behavior: entity.ChaseBehavior, Size: entity.MediumEnemy}
+	near := entity.NewEnemyInstance(template, 300, 300)
+	far := entity.NewEnemyInstance(template, 900, 300)
+	gr.enemyInstances = []*entity.EnemyInstance{near, far}
+
+	player := gr.game.Player
+	player.X, player.Y, player.Health = 200, 300, 40
+	for frame := 0; frame < rewindSnapshotInterval*3; frame++ {
+		gr.updateRewind()
+	}
+
+	near.Alarm()
+	far.Alarm()
+	near.X = player.X
+	gr.checkEnemyHitPlayer(near)
+	gr.updateRewind()
+
+	if !near.Calmed() {
+		t.Error("enemy next to the rewound player was not calmed")
+	}
+	if far.Calmed() {
+		t.Error("enemy outside the grace radius was calmed")
+	}
+}
+
+func TestDeathCountsWithoutRewind(t *testing.T) {
+	gr := newTestRunner(t)
+
+	template := &entity.Enemy{Name: "Brute", Health: 50, Damage: 500, Speed: 1,
+		Behavior: entity.ChaseBehavior, Size: entity.MediumEnemy}
+	enemy := entity.NewEnemyInstance(template, 0, 0)
+	player := gr.game.Player
+	enemy.X, enemy.Y = player.X, player.Y
+	gr.updateRewind()
+	gr.checkEnemyHitPlayer(enemy)
+
+	if deaths := gr.game.Achievements.GetStatistics().DeathCount; deaths != 1 {
+		t.Errorf("death count = %d, want 1", deaths)
+	}
+	if gr.RewindUsed() {
+		t.Error("run flagged for rewind without turning it on")
+	}
+}
+
+func TestRewindBufferKeepsOnlyRecentSnapshots(t *testing.T) {
+	rb := NewRewindBuffer()
+	if _, ok := rb.Oldest(); ok {
+		t.Fatal("empty buffer returned a snapshot")
+	}
+	for i := 0; i < rewindBufferSize+5; i++ {
+		rb.Record(rewindSnapshot{health: i})
+	}
+	if s, _ := rb.Oldest(); s.health != 5 {
+		t.Errorf("oldest snapshot health = %d, want 5", s.health)
+	}
+}
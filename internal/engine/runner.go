@@ -6,6 +6,7 @@ package engine
 import (
 	"fmt"
 	"image/color"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,8 +18,10 @@ import (
 	"github.com/opd-ai/vania/internal/entity"
 	"github.com/opd-ai/vania/internal/graphics"
 	"github.com/opd-ai/vania/internal/input"
+	"github.com/opd-ai/vania/internal/locale"
 	"github.com/opd-ai/vania/internal/narrative"
 	"github.com/opd-ai/vania/internal/particle"
+	"github.com/opd-ai/vania/internal/pcg"
 	"github.com/opd-ai/vania/internal/physics"
 	"github.com/opd-ai/vania/internal/render"
 	"github.com/opd-ai/vania/internal/save"
@@ -31,6 +34,10 @@ const (
 	itemMessageDuration         = 120 // 2 seconds at 60 FPS
 	roomDescriptionDuration     = 180 // 3 seconds at 60 FPS
 	roomDescriptionFadeDuration = 30  // 0.5 seconds fade in/out
+
+	// healthPickupIDOffset separates balanced healing pickups from treasure
+	// item IDs within a room's ID block (room.ID*1000 + offset + i)
+	healthPickupIDOffset = 500
 )
 
 // GameRunner wraps the Game with Ebiten rendering
@@ -52,7 +59,7 @@ type GameRunner struct {
 	paused               bool
 	saveManager          *save.SaveManager
 	checkpointManager    *save.CheckpointManager
-	startTime            time.Time
+	playFrames           int64 // active gameplay frames; excludes pauses and transitions
 	visitedRooms         map[int]bool
 	defeatedEnemies      map[int]bool
 	collectedItems       map[int]bool
@@ -61,12 +68,101 @@ type GameRunner struct {
 	lockedDoorTimer      int
 	itemMessage          string
 	itemMessageTimer     int
+	showcaseAbility      entity.Ability // Ability shown by the unlock showcase
+	showcaseFrames       int
 	musicContext         *audio.MusicContext
 	showDebugInfo        bool
+	showMinimap          bool
 	playerStatus         *StatusManager // active status effects on the player
 	systemManager        *ecs.SystemManager
 	roomDescription      string
 	roomDescriptionTimer int
+	bossController       *BossController    // scripted attacks for the current room's boss
+	bossArena            *BossArena         // fight-only hazards in the current boss room
+	puzzleState          *world.PuzzleState // plates and switches in the current room
+	puzzleStruck         bool               // current swing already hit a switch
+	defeatedBosses       map[string]bool    // boss names defeated this run
+	rng                  *pcg.RuntimeRNG    // gameplay randomness, saved with the game
+	attackChargeFrames   int                // frames attack has been held
+	goreDisabled         bool               // hits raise neutral dust instead of blood
+	assist               AssistConfig       // accessibility multipliers (see assist.go)
+	assistUsed           bool               // assist mode was on at some point this run
+	devMode              bool               // developer controls enabled (see stepdebug.go)
+	stepDebug            bool               // simulation frozen for frame stepping
+
+	// Sound effects and their captions (see sound.go)
+	soundPlayer     SoundPlayer
+	soundCues       []SoundCue
+	captionsEnabled bool
+	caption         string
+	captionTimer    int
+
+	// Damage trails shown behind health bars
+	playerHealthTrail *render.HealthTrail
+	enemyHealthTrails map[*entity.EnemyInstance]*render.HealthTrail
+
+	metrics *MetricsCollector // samples play performance when set
+
+	// Which room spawns stay defeated, and each live enemy's spawn index
+	enemyPersistence *EnemyPersistence
+	enemySlots       map[*entity.EnemyInstance]int
+
+	// Pathfinding grid for the current room, rebuilt when the room or its
+	// hazards change
+	navGrid    *entity.NavGrid
+	navRoom    *world.Room
+	navHazards int
+
+	// Sandbox controls, set only in practice mode (see practice.go)
+	practice *PracticeSession
+
+	// Intro prompts in the start room; nil once finished or skipped
+	tutorial *Tutorial
+
+	// Resolves toasts and room descriptions in the player's language
+	loc *locale.Localizer
+
+	enemyCollision EnemyCollisionConfig
+
+	// Letterbox and camera pan for boss intros (see cinematic.go)
+	cinematic *cinematic
+
+	// Collision outline overlay, independent of the debug inspector
+	showHitboxes bool
+
+	// Enemies near a respawning player drop aggro for a while (see respawn_grace.go)
+	respawnGrace RespawnGraceConfig
+
+	// Crossfades the current biome's ambient bed beneath the music
+	ambient *audio.AmbientMixer
+
+	// Performance cap on living enemies updating at once (see enemy_cap.go)
+	maxActiveEnemies int
+	frozenEnemies    map[*entity.EnemyInstance]bool
+
+	// Noise the player made this frame, heard by nearby enemies (see noise.go)
+	noiseRadius float64
+	airApexY    float64
+
+	// Strength of the pull on ranged shots toward enemies (see aim_assist.go)
+	aimAssist AimAssistStrength
+
+	// Frames newly spawned enemies hold off (see spawn_grace.go)
+	spawnGraceFrames int
+
+	// Interactables in the current room (see interact.go)
+	interactions *InteractionManager
+
+	// Rewind on death (see rewind.go)
+	rewindOnDeath bool
+	rewindUsed    bool
+	rewind        *RewindBuffer
+
+	// Back-to-back boss fights, or nil outside boss-rush mode (see boss_rush.go)
+	bossRush *BossRush
+
+	// Special attack of the current room's mini-boss, or nil (see miniboss.go)
+	miniBossController *BossController
 }
 
 // NewGameRunner creates a new game runner
@@ -77,20 +173,24 @@ func NewGameRunner(game *Game) *GameRunner {
 
 	if game.World != nil && game.World.StartRoom != nil {
 		// Position player in the start room
-		playerX = 100.0
-		playerY = 500.0
+		playerX = world.StartSpawnX
+		playerY = world.StartSpawnY
+	}
+
+	// First runs open with the intro prompts; NG+ players know the controls
+	var tutorial *Tutorial
+	if game.NGPlusLevel == 0 {
+		tutorial = NewTutorial(GenerateTutorial(firstAbility(game), nil))
 	}
 
 	// Create enemy instances for current room
 	var enemyInstances []*entity.EnemyInstance
-	if game.CurrentRoom != nil && len(game.Entities) > 0 {
+	if game.CurrentRoom != nil && !game.CurrentRoom.IsSafeHaven() && len(game.Entities) > 0 {
 		// Find ground platform Y for spawning
 		groundY := findGroundY(game.CurrentRoom)
 
-		// Spawn enemies in room (3-5 enemies per combat room)
-		enemyCount := 3
-		for i := 0; i < enemyCount && i < len(game.Entities); i++ {
-			enemy := game.Entities[i]
+		// Spawn enemies native to the room's biome
+		for i, enemy := range selectBiomeEnemies(game.Seed, game.World, game.CurrentRoom, game.Entities, 3) {
 			// Position enemies across the room on the ground platform
 			enemyX := 300.0 + float64(i*150)
 			_, _, _, eh := entity.GetEnemySizeBounds(enemy)
@@ -99,6 +199,15 @@ func NewGameRunner(game *Game) *GameRunner {
 		}
 	}
 
+	playerBody := physics.NewBody(playerX, playerY, physics.PlayerWidth, physics.PlayerHeight)
+	playerBody.Movement = physics.AcceleratedMovementConfig()
+	playerBody.Ledge.Enabled = true
+
+	enemySlots := make(map[*entity.EnemyInstance]int, len(enemyInstances))
+	for i, enemy := range enemyInstances {
+		enemySlots[enemy] = i
+	}
+
 	transitionHandler := NewRoomTransitionHandler(game)
 
 	// Initialize save system
@@ -116,33 +225,40 @@ func NewGameRunner(game *Game) *GameRunner {
 	// Create item instances for current room
 	itemInstances := createItemInstancesForRoom(game.CurrentRoom, game.Items)
 
+	var startPuzzle *world.Puzzle
+	if game.CurrentRoom != nil {
+		startPuzzle = game.CurrentRoom.Puzzle
+	}
+
 	// Create the renderer here so we can pass it to ECS systems
 	renderer := render.NewRenderer()
 	ps := particle.NewParticleSystem(1000) // Max 1000 particles
 
+	// Runtime randomness gets its own stream so it never disturbs generation
+	rng := pcg.NewRuntimeRNG(pcg.HashSeed(game.Seed, "runtime"))
+
 	// Initialize ECS SystemManager and register subsystem wrappers
 	sm := ecs.NewSystemManager()
 	sm.Register(NewAudioECSSystem(game.Audio), 10)
 	sm.Register(NewParticleECSSystem(ps, renderer), 20)
 
-	return &GameRunner{
+	gr := &GameRunner{
 		game:              game,
 		renderer:          renderer,
 		inputHandler:      input.NewInputHandler(),
-		playerBody:        physics.NewBody(playerX, playerY, physics.PlayerWidth, physics.PlayerHeight),
+		playerBody:        playerBody,
 		combatSystem:      NewCombatSystem(),
 		transitionHandler: transitionHandler,
 		enemyInstances:    enemyInstances,
 		itemInstances:     itemInstances,
 		particleSystem:    ps,
-		particlePresets:   &particle.ParticlePresets{},
+		particlePresets:   &particle.ParticlePresets{Rand: rng.Rand},
 		doubleJumpUsed:    false,
 		dashCooldown:      0,
 		playerFacingDir:   1.0,
 		paused:            false,
 		saveManager:       saveManager,
 		checkpointManager: checkpointManager,
-		startTime:         time.Now(),
 		visitedRooms:      make(map[int]bool),
 		defeatedEnemies:   make(map[int]bool),
 		collectedItems:    make(map[int]bool),
@@ -155,7 +271,48 @@ func NewGameRunner(game *Game) *GameRunner {
 		showDebugInfo:     false, // Debug info starts hidden
 		playerStatus:      NewStatusManager(),
 		systemManager:     sm,
-	}
+		puzzleState:       world.NewPuzzleState(startPuzzle),
+		defeatedBosses:    make(map[string]bool),
+		rng:               rng,
+		playerHealthTrail: render.NewHealthTrail(game.Player.Health),
+		enemyHealthTrails: make(map[*entity.EnemyInstance]*render.HealthTrail),
+		enemyPersistence:  NewEnemyPersistence(RespawnPolicy{}),
+		enemySlots:        enemySlots,
+		tutorial:          tutorial,
+		loc:               locale.NewLocalizer(locale.DefaultLanguage),
+		enemyCollision:    DefaultEnemyCollisionConfig(),
+		cinematic:         newCinematic(DefaultCinematicConfig()),
+		respawnGrace:      DefaultRespawnGraceConfig(),
+		ambient:           audio.NewAmbientMixer(audio.DefaultAmbientFadeFrames),
+		maxActiveEnemies:  DefaultMaxActiveEnemies,
+		frozenEnemies:     make(map[*entity.EnemyInstance]bool),
+		spawnGraceFrames:  DefaultSpawnGraceFrames,
+		interactions:      NewInteractionManager(),
+		rewind:            NewRewindBuffer(),
+	}
+	gr.registerRoomInteractables()
+	return gr
+}
+
+// populateCurrentRoom spawns the current room's enemies and items and
+// resets its per-room combat and puzzle state. The new enemies hold off
+// for the spawn grace.
+func (gr *GameRunner) populateCurrentRoom() {
+	gr.enemyInstances, gr.enemySlots = gr.enemyPersistence.Filter(
+		gr.game.CurrentRoom.ID,
+		gr.transitionHandler.SpawnEnemiesForRoom(gr.game.CurrentRoom),
+		gr.playFrames,
+	)
+	gr.startSpawnGrace()
+	gr.enemyHealthTrails = make(map[*entity.EnemyInstance]*render.HealthTrail)
+	gr.itemInstances = gr.transitionHandler.SpawnItemsForRoom(gr.game.CurrentRoom)
+	gr.combatSystem.ClearEnemyProjectiles()
+	gr.combatSystem.ClearAreaHazards()
+	gr.attachBossController()
+	gr.attachMiniBossController()
+	gr.puzzleState = world.NewPuzzleState(gr.game.CurrentRoom.Puzzle)
+	gr.registerRoomInteractables()
+	gr.rewind.Clear()
 }
 
 // Update implements ebiten.Game interface
@@ -173,25 +330,74 @@ func (gr *GameRunner) Update() error {
 		gr.paused = !gr.paused
 	}
 
-	// Handle debug toggle (F3 key)
-	if inpututil.IsKeyJustPressed(ebiten.KeyF3) {
-		gr.showDebugInfo = !gr.showDebugInfo
+	// Handle the debug inspector (F3) and hitbox overlay toggles
+	gr.handleOverlayKeys(inpututil.IsKeyJustPressed(ebiten.KeyF3), inpututil.IsKeyJustPressed(HitboxToggleKey))
+
+	// Capture or restore the visual state for screenshots (debug only)
+	if gr.showDebugInfo {
+		gr.handleVisualStateKeys(inpututil.IsKeyJustPressed(ebiten.KeyF9), inpututil.IsKeyJustPressed(ebiten.KeyF10))
+	}
+
+	// Handle minimap toggle (M key)
+	if inpututil.IsKeyJustPressed(ebiten.KeyM) {
+		gr.showMinimap = !gr.showMinimap
 	}
 
+	// Quicksave and quickload work anywhere, even while paused
+	gr.handleQuickSaveKeys(inpututil.IsKeyJustPressed(QuickSaveKey), inpututil.IsKeyJustPressed(QuickLoadKey))
+
 	if gr.paused {
 		return nil
 	}
 
+	// Frame stepping (dev mode only) freezes the game between advances
+	if skip, err := gr.updateStepDebug(inpututil.IsKeyJustPressed(StepDebugToggleKey),
+		inpututil.IsKeyJustPressed(StepDebugAdvanceKey), inputState); skip {
+		return err
+	}
+
+	if gr.metrics != nil && gr.metrics.tick() {
+		gr.metrics.SamplePerformance(ebiten.ActualFPS(), ebiten.ActualTPS())
+	}
+
+	err := gr.updatePlaying(inputState)
+	gr.updatePractice(inpututil.IsKeyJustPressed(PracticeSpawnKey),
+		inpututil.IsKeyJustPressed(PracticeHazardKey), inpututil.IsKeyJustPressed(PracticeResetKey))
+	gr.updateBossRush()
+	return err
+}
+
+// SetMetricsCollector makes the runner sample play performance into mc
+func (gr *GameRunner) SetMetricsCollector(mc *MetricsCollector) {
+	gr.metrics = mc
+}
+
+// Step advances the game by one frame using the given input instead of the
+// keyboard. It skips quit, pause, and debug handling, so it can drive the
+// game headlessly from tests or the attract-mode demo AI.
+func (gr *GameRunner) Step(inputState input.InputState) error {
 	return gr.updatePlaying(inputState)
 }
 
 // updatePlaying runs the main game-logic update when not paused.
 func (gr *GameRunner) updatePlaying(inputState input.InputState) error {
+	// Sound cues are reported per frame
+	gr.soundCues = gr.soundCues[:0]
+	if gr.captionTimer > 0 {
+		gr.captionTimer--
+	}
+
 	// Update transition handler
 	if gr.transitionHandler.Update() {
 		// Transition completed - spawn new enemies and items
-		gr.enemyInstances = gr.transitionHandler.SpawnEnemiesForRoom(gr.game.CurrentRoom)
-		gr.itemInstances = gr.transitionHandler.SpawnItemsForRoom(gr.game.CurrentRoom)
+		gr.populateCurrentRoom()
+
+		// Move the physics body to the entry position so the player does not
+		// reappear at the old door and transition straight back
+		gr.playerBody.Position.X = gr.game.Player.X
+		gr.playerBody.Position.Y = gr.game.Player.Y
+		gr.playerBody.Velocity.X = 0
+		gr.playerBody.Velocity.Y = 0
 	}
 
 	// Don't update game logic during transition
@@ -199,12 +405,20 @@ func (gr *GameRunner) updatePlaying(inputState input.InputState) error {
 		return nil
 	}
 
+	// Only active frames count toward play time
+	gr.playFrames++
+
+	// The ability showcase runs game time at half speed
+	if gr.showcaseSlowsFrame() {
+		return nil
+	}
+
 	// Check for door collision and transition
 	door := gr.transitionHandler.CheckDoorCollision(
 		gr.game.Player.X,
 		gr.game.Player.Y,
 		physics.PlayerWidth,
-		physics.PlayerHeight,
+		gr.playerHeight(),
 		gr.unlockedDoors,
 	)
 	if door != nil {
@@ -217,11 +431,19 @@ func (gr *GameRunner) updatePlaying(inputState input.InputState) error {
 		gr.lockedDoorTimer--
 	}
 	gr.checkLockedDoorInteraction()
+	gr.updateInteractions(inputState.InteractPress)
 
-	// Apply physics gravity (glide ability modifies fall rate)
+	// Hit-stop freezes the player for a few frames after a hit lands
+	playerFrozen := gr.combatSystem.IsHitStopped()
+
+	// Apply physics gravity (glide ability modifies fall rate), pulling
+	// up in inverted gravity rooms
+	gr.playerBody.InvertedGravity = gr.game.CurrentRoom != nil && gr.game.CurrentRoom.Inverted()
 	hasGlide := gr.game.Player.Abilities["glide"]
-	isGliding := hasGlide && inputState.UseAbility && !gr.playerBody.OnGround && gr.playerBody.Velocity.Y > 0
-	gr.playerBody.ApplyGravity(isGliding)
+	isGliding := hasGlide && inputState.UseAbility && !gr.playerBody.OnGround && gr.playerBody.Falling()
+	if !playerFrozen {
+		gr.playerBody.ApplyGravity(isGliding)
+	}
 
 	gr.combatSystem.Update()
 	gr.updateStatusEffects()
@@ -233,10 +455,19 @@ func (gr *GameRunner) updatePlaying(inputState input.InputState) error {
 
 	wasOnGround := gr.playerBody.OnGround
 
-	gr.updatePlayerInput(inputState)
-	gr.updatePlayerPhysics(wasOnGround)
-	gr.updatePlayerAnimation(inputState)
+	if !playerFrozen {
+		gr.updatePlayerInput(inputState)
+		gr.updatePlayerPhysics(wasOnGround)
+		gr.updatePlayerAnimation(inputState)
+	}
+	gr.updatePuzzle()
+	gr.emitNoise()
 	gr.updateEnemies()
+	gr.checkEnemyProjectileHitPlayer()
+	gr.checkAreaHazardHitPlayer()
+	gr.updateBossArena()
+	gr.updateRewind()
+	gr.updateHealthTrails()
 
 	gr.updateMusicContext()
 
@@ -246,14 +477,42 @@ func (gr *GameRunner) updatePlaying(inputState input.InputState) error {
 	if gr.roomDescriptionTimer > 0 {
 		gr.roomDescriptionTimer--
 	}
+	gr.updateTutorial(inputState)
 	gr.checkItemCollection()
-	gr.renderer.UpdateCamera(gr.game.Player.X, gr.game.Player.Y)
+	gr.checkPedestalCollection()
+	gr.updateCinematic()
+	gr.updateCamera()
 	gr.CheckAutoSave()
 	gr.updateRoomTracking()
 
 	return nil
 }
 
+// updateHealthTrails drains the health bar damage trails toward current health
+func (gr *GameRunner) updateHealthTrails() {
+	gr.playerHealthTrail.Update(gr.game.Player.Health)
+	for _, enemy := range gr.enemyInstances {
+		if enemy.IsDead() {
+			delete(gr.enemyHealthTrails, enemy)
+			continue
+		}
+		trail, ok := gr.enemyHealthTrails[enemy]
+		if !ok {
+			trail = render.NewHealthTrail(enemy.CurrentHealth)
+			gr.enemyHealthTrails[enemy] = trail
+		}
+		trail.Update(enemy.CurrentHealth)
+	}
+}
+
+// enemyTrailHealth returns the damage trail value for an enemy's health bar
+func (gr *GameRunner) enemyTrailHealth(enemy *entity.EnemyInstance) float64 {
+	if trail, ok := gr.enemyHealthTrails[enemy]; ok {
+		return trail.Displayed()
+	}
+	return float64(enemy.CurrentHealth)
+}
+
 // updateStatusEffects ticks active player status effects and applies damage.
 func (gr *GameRunner) updateStatusEffects() {
 	if statusDmg := gr.playerStatus.Update(1.0 / 60.0); statusDmg > 0 {
@@ -265,9 +524,27 @@ func (gr *GameRunner) updateStatusEffects() {
 	}
 }
 
+// walkSpeedFactor scales movement while the walk modifier is held
+const walkSpeedFactor = 0.5
+
 // updatePlayerInput processes movement, attack, jump, dash, and grapple inputs.
 func (gr *GameRunner) updatePlayerInput(inputState input.InputState) {
+	if gr.playerBody.Ledge.Grabbing {
+		gr.updatePlayerLedge(inputState)
+		gr.updatePlayerAttacks(inputState)
+		gr.inputHandler.UpdateBuffers()
+		return
+	}
+
+	gr.updateCrouch(inputState)
 	speedMult := gr.playerStatus.SpeedMultiplier()
+	if inputState.Walk {
+		speedMult *= walkSpeedFactor
+	}
+	if gr.playerBody.Crouched {
+		speedMult *= crouchSpeedFactor
+	}
+	gr.makeNoise(gr.movementNoise(inputState))
 	if inputState.MoveLeft {
 		gr.playerBody.MoveHorizontalScaled(-1, speedMult)
 		gr.playerFacingDir = -1.0
@@ -286,27 +563,72 @@ func (gr *GameRunner) updatePlayerInput(inputState input.InputState) {
 	gr.inputHandler.UpdateBuffers()
 }
 
+// updatePlayerLedge handles input while hanging from a ledge: jump climbs
+// up, and down or moving away from the wall lets go.
+func (gr *GameRunner) updatePlayerLedge(inputState input.InputState) {
+	side := gr.playerBody.Ledge.Side
+	gr.playerFacingDir = float64(side)
+
+	switch {
+	case inputState.JumpPress:
+		gr.playerBody.ClimbLedge()
+		gr.doubleJumpUsed = false
+	case inputState.Block,
+		side > 0 && inputState.MoveLeft,
+		side < 0 && inputState.MoveRight:
+		gr.playerBody.DropLedge()
+	}
+}
+
 // updatePlayerAttacks handles melee and ranged attack input with buffering.
 func (gr *GameRunner) updatePlayerAttacks(inputState input.InputState) {
+	if inputState.SwapWeaponPress && gr.combatSystem.SwapWeapon() {
+		gr.itemMessage = gr.loc.Text("toast.equipped", gr.combatSystem.ActiveWeapon().Name)
+		gr.itemMessageTimer = itemMessageDuration
+	}
 	if inputState.AttackPress {
-		if !gr.combatSystem.PlayerAttack() {
+		if gr.combatSystem.PlayerAttack() {
+			gr.playerAttacked()
+		} else {
 			gr.inputHandler.BufferAttack()
 		}
 	}
-	if gr.inputHandler.GetBufferedAttack() && gr.combatSystem.CanAttack() {
-		gr.combatSystem.PlayerAttack()
+	// Holding attack charges a heavy swing, released when let go
+	if inputState.Attack {
+		gr.attackChargeFrames++
+	} else {
+		if charge := HeavyCharge(gr.attackChargeFrames); charge > 0 && gr.combatSystem.PlayerHeavyAttack(charge) {
+			gr.playerAttacked()
+		}
+		gr.attackChargeFrames = 0
+	}
+	if gr.inputHandler.GetBufferedAttack() && gr.combatSystem.CanAttack() && gr.combatSystem.PlayerAttack() {
+		gr.playerAttacked()
 	}
 	if inputState.RangedAttackPress && gr.game.Player.Abilities["ranged"] {
-		gr.combatSystem.PlayerRangedAttack(
+		dirX, dirY := gr.rangedAimDirection()
+		gr.combatSystem.PlayerRangedAttackToward(
 			gr.game.Player.X, gr.game.Player.Y,
-			gr.playerFacingDir, gr.game.Player.Damage,
+			dirX, dirY, gr.combatSystem.RangedDamage(gr.game.Player.Damage),
 		)
 	}
 }
 
+// playerAttacked makes the noise of a swing and lets every active enemy see
+// it, so enemies learn how often the player attacks
+func (gr *GameRunner) playerAttacked() {
+	gr.makeNoise(NoiseAttackRadius)
+	for _, enemy := range gr.enemyInstances {
+		if !enemy.IsDead() && gr.EnemyActive(enemy) {
+			enemy.SeePlayerAttack()
+		}
+	}
+}
+
 // updatePlayerJump handles jump input and buffering.
 func (gr *GameRunner) updatePlayerJump(inputState input.InputState) {
-	if inputState.JumpPress {
+	// A crouched player must stand before jumping
+	if inputState.JumpPress && gr.standUp() {
 		hasDoubleJump := gr.game.Player.Abilities["double_jump"]
 		if gr.playerBody.Jump(hasDoubleJump, &gr.doubleJumpUsed) {
 			emitter := gr.particlePresets.CreateJumpDust(gr.game.Player.X+16, gr.game.Player.Y+32)
@@ -360,6 +682,7 @@ func dashDirection(inputState input.InputState) float64 {
 // executeDash performs the dash and emits trail particles.
 func (gr *GameRunner) executeDash(direction float64) {
 	gr.playerBody.Dash(direction)
+	gr.combatSystem.StartDodge()
 	gr.dashCooldown = 30
 	emitter := gr.particlePresets.CreateDashTrail(gr.game.Player.X+16, gr.game.Player.Y+16)
 	emitter.Start()
@@ -392,6 +715,7 @@ func (gr *GameRunner) updatePlayerGrapple(inputState input.InputState) {
 func (gr *GameRunner) updatePlayerPhysics(wasOnGround bool) {
 	knockbackX, knockbackY := gr.combatSystem.GetKnockback()
 	if knockbackX != 0 || knockbackY != 0 {
+		gr.playerBody.DropLedge()
 		gr.playerBody.Velocity.X += knockbackX
 		gr.playerBody.Velocity.Y += knockbackY
 	}
@@ -402,8 +726,14 @@ func (gr *GameRunner) updatePlayerPhysics(wasOnGround bool) {
 		gr.playerBody.ResolveCollisionWithPlatforms(gr.game.CurrentRoom.Platforms)
 	}
 
+	gr.trackLanding(wasOnGround)
 	if !wasOnGround && gr.playerBody.OnGround {
-		emitter := gr.particlePresets.CreateLandDust(gr.game.Player.X+16, gr.game.Player.Y+32)
+		// Dust rises from the feet: the top of the player on a ceiling
+		feetY := gr.game.Player.Y + 32
+		if gr.playerBody.InvertedGravity {
+			feetY = gr.game.Player.Y
+		}
+		emitter := gr.particlePresets.CreateLandDust(gr.game.Player.X+16, feetY)
 		emitter.Burst(12)
 		gr.particleSystem.AddEmitter(emitter)
 	}
@@ -428,6 +758,10 @@ func (gr *GameRunner) updatePlayerAnimation(inputState input.InputState) {
 		if currentAnim != "attack" {
 			gr.game.Player.AnimController.Play("attack", true)
 		}
+	case gr.playerBody.Ledge.Grabbing:
+		if currentAnim != "climb" {
+			gr.game.Player.AnimController.Play("climb", true)
+		}
 	case !gr.playerBody.OnGround:
 		if currentAnim != "jump" {
 			gr.game.Player.AnimController.Play("jump", true)
@@ -445,26 +779,145 @@ func (gr *GameRunner) updatePlayerAnimation(inputState input.InputState) {
 
 // updateEnemies runs AI, physics, and combat interactions for all enemies.
 func (gr *GameRunner) updateEnemies() {
+	gr.updateActiveEnemies()
 	for _, enemy := range gr.enemyInstances {
 		if enemy.IsDead() {
+			// Corpses keep animating until they have faded out
+			if !enemy.IsCorpseGone() {
+				enemy.Update(gr.game.Player.X, gr.game.Player.Y)
+			}
+			continue
+		}
+		if gr.frozenEnemies[enemy] {
 			continue
 		}
 		gr.updateSingleEnemy(enemy)
 	}
+	gr.separateEnemies()
 }
 
 // updateSingleEnemy handles AI, physics, and combat for one enemy instance.
 func (gr *GameRunner) updateSingleEnemy(enemy *entity.EnemyInstance) {
+	// Hit-stopped enemies hold still and cannot be struck again until the
+	// freeze ends
+	if enemy.HitStopFrames > 0 {
+		enemy.HitStopFrames--
+		return
+	}
+	enemy.SetGroundProbe(gr.groundBelow)
+	enemy.SetNavGrid(gr.roomNavGrid())
 	enemy.Update(gr.game.Player.X, gr.game.Player.Y)
+	if enemy.TakeWindup() {
+		gr.playCue(windupCue)
+	}
+	if slam, ok := enemy.TakeSlam(); ok {
+		gr.combatSystem.SpawnSlam(slam)
+	}
+	gr.spawnSummon(enemy)
 	gr.applyEnemyGravity(enemy)
-	enemy.X += enemy.VelX
-	enemy.Y += enemy.VelY
+	speed := gr.assist.enemySpeedScale()
+	enemy.X += enemy.VelX * speed
+	enemy.Y += enemy.VelY * speed
 	gr.resolveEnemyPlatformCollisions(enemy)
+	if gr.checkEnemyEnvironment(enemy) {
+		return
+	}
+	if gr.bossController != nil && gr.bossController.Instance() == enemy && !enemy.Calmed() {
+		gr.bossController.Update(gr.game.Player, gr.playerHeight(), gr.combatSystem)
+	}
+	gr.updateMiniBossSpecial(enemy)
 	gr.checkMeleeHitEnemy(enemy)
 	gr.checkProjectileHitEnemy(enemy)
 	gr.checkEnemyHitPlayer(enemy)
 }
 
+// updatePuzzle presses plates under the player, lets melee swings strike
+// switches, and mirrors puzzle door state into the unlocked-door set.
+func (gr *GameRunner) updatePuzzle() {
+	if gr.puzzleState == nil || gr.game.CurrentRoom == nil {
+		return
+	}
+
+	gr.puzzleState.Update(gr.game.Player.X, gr.game.Player.Y, physics.PlayerWidth, gr.playerHeight())
+
+	if !gr.combatSystem.IsPlayerAttacking() {
+		gr.puzzleStruck = false
+	} else if !gr.puzzleStruck {
+		ax, ay, aw, ah := gr.combatSystem.GetAttackHitbox(gr.game.Player.X, gr.game.Player.Y, gr.playerFacingDir)
+		gr.puzzleStruck = gr.puzzleState.Strike(ax, ay, aw, ah)
+	}
+
+	for i := range gr.game.CurrentRoom.Doors {
+		door := &gr.game.CurrentRoom.Doors[i]
+		if !door.PuzzleGated {
+			continue
+		}
+		doorKey := gr.transitionHandler.GetDoorKey(door)
+		if gr.puzzleState.IsDoorOpen(i) {
+			gr.unlockedDoors[doorKey] = true
+		} else {
+			delete(gr.unlockedDoors, doorKey)
+		}
+	}
+}
+
+// attachBossController creates a controller for the current room's boss, if
+// one was spawned, so its scripted attack patterns run.
+func (gr *GameRunner) attachBossController() {
+	gr.bossController = nil
+	gr.bossArena = nil
+	boss := gr.transitionHandler.BossForRoom(gr.game.CurrentRoom)
+	if boss == nil {
+		return
+	}
+	for _, enemy := range gr.enemyInstances {
+		if enemy.Enemy == &boss.Enemy {
+			gr.bossController = NewBossController(boss, enemy)
+			gr.bossArena = NewBossArena(gr.game.CurrentRoom, boss)
+			gr.startBossIntro(enemy)
+			return
+		}
+	}
+}
+
+// checkEnemyProjectileHitPlayer applies damage from hostile projectiles.
+func (gr *GameRunner) checkEnemyProjectileHitPlayer() {
+	damage := gr.combatSystem.CheckEnemyProjectilePlayerHit(
+		gr.game.Player, physics.PlayerWidth, gr.playerHeight(),
+	)
+	gr.recordHazardDamage(damage)
+}
+
+// checkAreaHazardHitPlayer applies damage from slam areas and other
+// enemy-made hazards.
+func (gr *GameRunner) checkAreaHazardHitPlayer() {
+	damage := gr.combatSystem.CheckAreaHazardPlayerHit(
+		gr.game.Player, physics.PlayerWidth, gr.playerHeight(),
+	)
+	gr.recordHazardDamage(damage)
+}
+
+// updateBossArena runs the boss room's arena hazard and applies its damage
+func (gr *GameRunner) updateBossArena() {
+	if gr.bossArena == nil || gr.bossController == nil || gr.bossController.Instance().Calmed() {
+		return
+	}
+	gr.bossArena.Update(gr.bossController.Instance())
+	gr.recordHazardDamage(gr.bossArena.CheckPlayerHit(gr.game.Player, gr.playerHeight(), gr.combatSystem))
+}
+
+// recordHazardDamage reports damage already applied to the player to the
+// achievement tracker.
+func (gr *GameRunner) recordHazardDamage(damage int) {
+	if damage <= 0 {
+		return
+	}
+	if gr.game.Achievements != nil {
+		gr.game.Achievements.RecordDamage(0, damage)
+	}
+	gr.recordPlayerDeath()
+}
+
 // applyEnemyGravity applies gravity to ground-based (non-flying) enemies.
 func (gr *GameRunner) applyEnemyGravity(enemy *entity.EnemyInstance) {
 	if enemy.Enemy.Behavior == entity.FlyingBehavior || enemy.OnGround {
@@ -488,7 +941,7 @@ func (gr *GameRunner) resolveEnemyPlatformCollisions(enemy *entity.EnemyInstance
 		py := float64(platform.Y)
 		pw := float64(platform.Width)
 		ph := float64(platform.Height)
-		if ex < px+pw && ex+ew > px && ey < py+ph && ey+eh > py {
+		if physics.AABBOverlap(ex, ey, ew, eh, px, py, pw, ph) {
 			if enemy.VelY > 0 && ey+eh-ph < py {
 				enemy.Y = py - eh
 				enemy.VelY = 0
@@ -498,6 +951,43 @@ func (gr *GameRunner) resolveEnemyPlatformCollisions(enemy *entity.EnemyInstance
 	}
 }
 
+// roomNavGrid returns the current room's pathfinding grid: platforms are
+// solid and hazards are marked for enemies to route around
+func (gr *GameRunner) roomNavGrid() *entity.NavGrid {
+	room := gr.game.CurrentRoom
+	if room == nil {
+		return nil
+	}
+	if gr.navGrid != nil && gr.navRoom == room && gr.navHazards == len(room.Hazards) {
+		return gr.navGrid
+	}
+
+	grid := entity.NewNavGrid(render.ScreenWidth, render.ScreenHeight)
+	for _, p := range room.Platforms {
+		grid.MarkSolid(float64(p.X), float64(p.Y), float64(p.Width), float64(p.Height))
+	}
+	for _, h := range room.Hazards {
+		grid.MarkHazard(float64(h.X), float64(h.Y), float64(h.Width), float64(h.Height))
+	}
+	gr.navGrid, gr.navRoom, gr.navHazards = grid, room, len(room.Hazards)
+	return grid
+}
+
+// groundBelow reports whether a platform top lies at x, from just above y
+// to LedgeProbeDepth below it. Enemies use it to spot ledges.
+func (gr *GameRunner) groundBelow(x, y float64) bool {
+	if gr.game.CurrentRoom == nil {
+		return true
+	}
+	for _, platform := range gr.game.CurrentRoom.Platforms {
+		px, py := float64(platform.X), float64(platform.Y)
+		if x >= px && x <= px+float64(platform.Width) && py >= y-1 && py <= y+entity.LedgeProbeDepth {
+			return true
+		}
+	}
+	return false
+}
+
 // checkMeleeHitEnemy tests whether the current player melee attack hits the
 // given enemy and applies damage and particle effects if so.
 func (gr *GameRunner) checkMeleeHitEnemy(enemy *entity.EnemyInstance) {
@@ -513,18 +1003,20 @@ func (gr *GameRunner) checkMeleeHitEnemy(enemy *entity.EnemyInstance) {
 	}
 
 	ex, ey, _, _ := enemy.GetBounds()
-	hitEmitter := gr.particlePresets.CreateHitEffect(ex+16, ey+16, gr.playerFacingDir)
+	hitEmitter := gr.particlePresets.CreateAngledHitEffect(ex+16, ey+16, gr.impactAngle(enemy))
 	hitEmitter.Burst(10)
 	gr.particleSystem.AddEmitter(hitEmitter)
 
-	bloodEmitter := gr.particlePresets.CreateBloodSplatter(ex+16, ey+16, gr.playerFacingDir)
-	bloodEmitter.Burst(6)
-	gr.particleSystem.AddEmitter(bloodEmitter)
+	splatterEmitter := gr.hitSplatter(ex+16, ey+16, gr.playerFacingDir)
+	splatterEmitter.Burst(6)
+	gr.particleSystem.AddEmitter(splatterEmitter)
 
-	gr.combatSystem.ApplyDamageToEnemy(enemy, gr.game.Player.Damage, gr.game.Player.X)
+	// Heavy attacks hit up to twice as hard at full charge
+	damage := int(float64(gr.combatSystem.AttackDamage(gr.game.Player.Damage)) * (1 + gr.combatSystem.AttackCharge()))
+	gr.combatSystem.ApplyDamageToEnemy(enemy, damage, gr.game.Player.X)
 
 	if gr.game.Achievements != nil {
-		gr.game.Achievements.RecordDamage(gr.game.Player.Damage, 0)
+		gr.game.Achievements.RecordDamage(damage, 0)
 	}
 	if wasAlive && enemy.IsDead() {
 		gr.recordEnemyDeath(enemy)
@@ -534,12 +1026,12 @@ func (gr *GameRunner) checkMeleeHitEnemy(enemy *entity.EnemyInstance) {
 // checkProjectileHitEnemy tests whether any active projectile hits the given
 // enemy and applies damage and particle effects if so.
 func (gr *GameRunner) checkProjectileHitEnemy(enemy *entity.EnemyInstance) {
-	projDmg := gr.combatSystem.CheckProjectileEnemyHit(enemy)
+	projDmg := gr.combatSystem.CheckProjectileEnemyHit(enemy) + gr.combatSystem.CheckDeflectedProjectileEnemyHit(enemy)
 	if projDmg <= 0 {
 		return
 	}
 	ex, ey, _, _ := enemy.GetBounds()
-	hitEmitter := gr.particlePresets.CreateHitEffect(ex+16, ey+16, gr.playerFacingDir)
+	hitEmitter := gr.particlePresets.CreateAngledHitEffect(ex+16, ey+16, gr.impactAngle(enemy))
 	hitEmitter.Burst(6)
 	gr.particleSystem.AddEmitter(hitEmitter)
 	if gr.game.Achievements != nil {
@@ -550,11 +1042,13 @@ func (gr *GameRunner) checkProjectileHitEnemy(enemy *entity.EnemyInstance) {
 	}
 }
 
-// recordEnemyDeath updates tracking maps and fires the death explosion.
+// recordEnemyDeath updates tracking maps and fires the death effect.
 func (gr *GameRunner) recordEnemyDeath(enemy *entity.EnemyInstance) {
-	ex, ey, _, _ := enemy.GetBounds()
 	enemyKey := int(enemy.X*1000 + enemy.Y)
 	gr.defeatedEnemies[enemyKey] = true
+	if slot, ok := gr.enemySlots[enemy]; ok && gr.game.CurrentRoom != nil {
+		gr.enemyPersistence.RecordDefeat(gr.game.CurrentRoom.ID, slot, gr.playFrames)
+	}
 	if gr.game.Achievements != nil {
 		wasPerfect := gr.combatSystem.GetInvulnerableFrames() == 0
 		gr.game.Achievements.RecordEnemyKill(wasPerfect)
@@ -562,10 +1056,8 @@ func (gr *GameRunner) recordEnemyDeath(enemy *entity.EnemyInstance) {
 
 	// Check if this was a boss and handle ability unlock
 	gr.handleBossDefeat(enemy)
-
-	explosionEmitter := gr.particlePresets.CreateExplosion(ex+16, ey+16, 1.0)
-	explosionEmitter.Burst(20)
-	gr.particleSystem.AddEmitter(explosionEmitter)
+	gr.dropMiniBossLoot(enemy)
+	gr.spawnDeathEffect(enemy)
 }
 
 // handleBossDefeat checks if the defeated enemy was a boss and unlocks any granted ability
@@ -574,8 +1066,13 @@ func (gr *GameRunner) handleBossDefeat(enemy *entity.EnemyInstance) {
 		return
 	}
 
+	gr.unlockBossGatedDoors()
+
 	// Find the corresponding boss for this enemy
 	for _, boss := range gr.game.Bosses {
+		if boss.Name == enemy.Enemy.Name {
+			gr.defeatedBosses[boss.Name] = true
+		}
 		if boss.Name == enemy.Enemy.Name && boss.GrantsAbility != "" {
 			// Unlock the ability granted by this boss
 			abilityKey := gr.normalizeAbilityKey(boss.GrantsAbility)
@@ -583,7 +1080,7 @@ func (gr *GameRunner) handleBossDefeat(enemy *entity.EnemyInstance) {
 				gr.game.Player.Abilities[abilityKey] = true
 
 				// Show unlock message
-				gr.itemMessage = "Ability Unlocked: " + boss.GrantsAbility
+				gr.itemMessage = gr.loc.Text("toast.ability_unlocked", boss.GrantsAbility)
 				gr.itemMessageTimer = itemMessageDuration
 
 				// Record for achievements
@@ -599,6 +1096,59 @@ func (gr *GameRunner) handleBossDefeat(enemy *entity.EnemyInstance) {
 	}
 }
 
+// unlockBossGatedDoors opens the current room's doors held shut by its boss
+func (gr *GameRunner) unlockBossGatedDoors() {
+	if gr.game.CurrentRoom == nil {
+		return
+	}
+	for i := range gr.game.CurrentRoom.Doors {
+		door := &gr.game.CurrentRoom.Doors[i]
+		if door.BossGated {
+			gr.unlockedDoors[gr.transitionHandler.GetDoorKey(door)] = true
+		}
+	}
+}
+
+// IsRunComplete reports whether every mandatory boss in the world has been
+// defeated, which finishes the run and makes New Game Plus available.
+// Optional bosses are left to the player. A boss rush never completes a
+// run; it ends on its own results screen (see BossRush.Finished).
+func (gr *GameRunner) IsRunComplete() bool {
+	if gr.bossRush != nil {
+		return false
+	}
+	bosses := gr.mandatoryBosses()
+	if len(bosses) == 0 {
+		return false
+	}
+	for _, boss := range bosses {
+		if !gr.defeatedBosses[boss.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+// mandatoryBosses returns the bosses that must be beaten. Bosses are
+// generated in boss-room order, so the nth boss guards the nth boss room.
+func (gr *GameRunner) mandatoryBosses() []*entity.Boss {
+	if gr.game.World == nil {
+		return gr.game.Bosses
+	}
+	bosses := make([]*entity.Boss, 0, len(gr.game.Bosses))
+	index := 0
+	for _, room := range gr.game.World.Rooms {
+		if room.Type != world.BossRoom {
+			continue
+		}
+		if index < len(gr.game.Bosses) && room.BossRole != world.OptionalBoss {
+			bosses = append(bosses, gr.game.Bosses[index])
+		}
+		index++
+	}
+	return bosses
+}
+
 // normalizeAbilityKey converts ability display names to internal keys
 func (gr *GameRunner) normalizeAbilityKey(abilityName string) string {
 	switch abilityName {
@@ -642,28 +1192,37 @@ func (gr *GameRunner) unlockAbilityGatedDoors(abilityKey string) {
 }
 
 // checkEnemyHitPlayer tests whether the given enemy is colliding with the
-// player and applies damage if so.
+// player and applies damage if so. An attacking enemy only hurts on the
+// strike frame of its attack animation.
 func (gr *GameRunner) checkEnemyHitPlayer(enemy *entity.EnemyInstance) {
+	if enemy.Calmed() {
+		return
+	}
+	if enemy.State == entity.AttackState && !enemy.OnStrikeFrame() {
+		return
+	}
 	if !gr.combatSystem.CheckPlayerEnemyCollision(
-		gr.game.Player.X, gr.game.Player.Y, physics.PlayerWidth, physics.PlayerHeight, enemy,
+		gr.game.Player.X, gr.game.Player.Y, physics.PlayerWidth, gr.playerHeight(), enemy,
 	) {
 		return
 	}
-	damage := enemy.Enemy.Damage
+	damage := enemy.EffectiveDamage()
 	if enemy.State == entity.AttackState {
 		damage = enemy.GetAttackDamage()
 	}
 	if gr.game.Achievements != nil {
 		gr.game.Achievements.RecordDamage(0, damage)
 	}
-	gr.combatSystem.ApplyDamageToPlayer(gr.game.Player, damage, enemy.X)
-	if gr.game.Player.Health <= 0 && gr.game.Achievements != nil {
-		gr.game.Achievements.RecordDeath()
-	}
+	// Push away from the enemy's center, offset so it lines up with the
+	// player's left edge that ApplyDamageToPlayer compares against
+	ex, _, ew, _ := enemy.GetBounds()
+	gr.combatSystem.attributeDamage(enemy.Enemy.Name)
+	gr.combatSystem.ApplyDamageToPlayer(gr.game.Player, damage, ex+ew/2-physics.PlayerWidth/2)
+	gr.recordPlayerDeath()
 }
 
 // updateRoomTracking marks the current room as visited and fires the first-
-// visit achievement event.
+// visit achievement events, counting a secret room as a secret found.
 func (gr *GameRunner) updateRoomTracking() {
 	if gr.game.CurrentRoom == nil {
 		return
@@ -674,12 +1233,113 @@ func (gr *GameRunner) updateRoomTracking() {
 		if gr.game.Achievements != nil {
 			isPerfect := !gr.combatSystem.IsInvulnerable()
 			gr.game.Achievements.RecordRoomVisit(isPerfect)
+			if gr.game.CurrentRoom.Secret {
+				gr.game.Achievements.RecordSecretFound()
+			}
 		}
 		// Show room description on first visit
 		gr.showRoomDescription()
 	}
 }
 
+// playFramesPerSecond converts counted gameplay frames to play time
+const playFramesPerSecond = 60
+
+// SetMovementConfig changes how the player's horizontal movement feels,
+// e.g. physics.DefaultMovementConfig for instant full-speed movement
+func (gr *GameRunner) SetMovementConfig(config physics.MovementConfig) {
+	gr.playerBody.Movement = config
+}
+
+// SetAutoRun enables or disables the auto-run toggle key
+func (gr *GameRunner) SetAutoRun(enabled bool) {
+	gr.inputHandler.SetAutoRun(enabled)
+}
+
+// SetInvulnerabilityDuration changes how many frames the player is safe
+// after taking a hit
+func (gr *GameRunner) SetInvulnerabilityDuration(frames int) {
+	gr.combatSystem.SetInvulnerabilityDuration(frames)
+}
+
+// SetGoreEnabled chooses between blood splatter and neutral impact dust
+// when enemies are hit
+func (gr *GameRunner) SetGoreEnabled(enabled bool) {
+	gr.goreDisabled = !enabled
+}
+
+// SetLocalizer sets the language of toasts and room descriptions; nil
+// keeps English
+func (gr *GameRunner) SetLocalizer(loc *locale.Localizer) {
+	if loc == nil {
+		loc = locale.NewLocalizer(locale.DefaultLanguage)
+	}
+	gr.loc = loc
+}
+
+// SetParticleLifetimeScale multiplies the lifetime of every particle
+// effect spawned from now on; 1 keeps the presets' timing
+func (gr *GameRunner) SetParticleLifetimeScale(scale float64) {
+	gr.particlePresets.LifeScale = scale
+}
+
+// impactAngle returns the direction of a player hit on enemy, from the
+// player's center to the enemy's
+func (gr *GameRunner) impactAngle(enemy *entity.EnemyInstance) float64 {
+	ex, ey, ew, eh := enemy.GetBounds()
+	return particle.ImpactAngle(gr.game.Player.X+physics.PlayerWidth/2, gr.game.Player.Y+gr.playerHeight()/2,
+		ex+ew/2, ey+eh/2)
+}
+
+// hitSplatter returns the splatter emitter for an enemy hit, honoring the
+// gore setting
+func (gr *GameRunner) hitSplatter(x, y, direction float64) *particle.ParticleEmitter {
+	if gr.goreDisabled {
+		return gr.particlePresets.CreateImpactDust(x, y, direction)
+	}
+	return gr.particlePresets.CreateBloodSplatter(x, y, direction)
+}
+
+// SetHUDLayout changes which HUD elements are drawn and where
+func (gr *GameRunner) SetHUDLayout(layout render.HUDLayout) {
+	gr.renderer.SetHUDLayout(layout)
+}
+
+// SetSmoothScaling switches scaled sprites and tiles between smooth and
+// pixel-perfect filtering
+func (gr *GameRunner) SetSmoothScaling(smooth bool) {
+	gr.renderer.SetSmoothScaling(smooth)
+}
+
+// SetCameraDeadZone sets how far, in pixels, the player can move around the
+// view center before the camera scrolls
+func (gr *GameRunner) SetCameraDeadZone(width, height float64) {
+	gr.renderer.SetCameraDeadZone(width, height)
+}
+
+// SetHitStopConfig changes the freeze played when hits land; the zero
+// config turns hit-stop off
+func (gr *GameRunner) SetHitStopConfig(config HitStopConfig) {
+	gr.combatSystem.SetHitStopConfig(config)
+}
+
+// SetRespawnPolicy changes whether defeated enemies come back. Defeats
+// already recorded are kept and judged by the new policy.
+func (gr *GameRunner) SetRespawnPolicy(policy RespawnPolicy) {
+	gr.enemyPersistence.SetPolicy(policy)
+}
+
+// PlayTime returns how long the game has actually been played. Time spent
+// paused, in menus, or in room transitions is not counted.
+func (gr *GameRunner) PlayTime() time.Duration {
+	return time.Duration(gr.playFrames) * time.Second / playFramesPerSecond
+}
+
+// VisitedRoomCount returns how many distinct rooms the player has entered
+func (gr *GameRunner) VisitedRoomCount() int {
+	return len(gr.visitedRooms)
+}
+
 // showRoomDescription generates and displays a room description for the current room.
 func (gr *GameRunner) showRoomDescription() {
 	if gr.game.CurrentRoom == nil || gr.game.Narrative == nil {
@@ -696,16 +1356,14 @@ func (gr *GameRunner) showRoomDescription() {
 	theme := gr.game.Narrative.Theme
 	desc := gr.generateRoomDescription(roomType, theme)
 
-	if desc != "" {
-		gr.roomDescription = desc
+	if text := gr.loc.Resolve(desc); text != "" {
+		gr.roomDescription = text
 		gr.roomDescriptionTimer = roomDescriptionDuration
 	}
 }
 
 // generateRoomDescription creates a room description based on room type and theme.
-func (gr *GameRunner) generateRoomDescription(roomType string, theme narrative.StoryTheme) string {
-	// Use the narrative generator if available in the game generator
-	// Otherwise generate a simple description based on type and theme
+func (gr *GameRunner) generateRoomDescription(roomType string, theme narrative.StoryTheme) locale.Message {
 	descriptions := getRoomDescriptions(theme)
 	if desc, ok := descriptions[roomType]; ok {
 		return desc
@@ -714,78 +1372,39 @@ func (gr *GameRunner) generateRoomDescription(roomType string, theme narrative.S
 	return getGenericRoomDescription(roomType, theme)
 }
 
-// getRoomDescriptions returns theme-specific room descriptions.
-func getRoomDescriptions(theme narrative.StoryTheme) map[string]string {
-	switch theme {
-	case narrative.FantasyTheme:
-		return map[string]string{
-			"cave":     "Ancient stones whisper forgotten secrets...",
-			"forest":   "Twisted roots pierce through crumbling walls...",
-			"dungeon":  "The air grows heavy with untold mysteries...",
-			"castle":   "Echoes of a fallen kingdom linger here...",
-			"corridor": "Shadows dance along weathered corridors...",
-			"chamber":  "A sanctum of old power awaits...",
-		}
-	case narrative.SciFiTheme:
-		return map[string]string{
-			"cave":     "Bio-luminescent fungi illuminate the passage...",
-			"forest":   "Synthetic vines entangle abandoned machinery...",
-			"dungeon":  "Malfunctioning systems flicker in the dark...",
-			"castle":   "The command deck lies in silent ruin...",
-			"corridor": "Emergency lighting guides through debris...",
-			"chamber":  "A reactor core hums with residual power...",
-		}
-	case narrative.HorrorTheme:
-		return map[string]string{
-			"cave":     "Something unspeakable stirs in the darkness...",
-			"forest":   "The trees seem to watch with malice...",
-			"dungeon":  "Chains rattle in unseen corners...",
-			"castle":   "The walls bleed memories of suffering...",
-			"corridor": "Each step echoes like a death knell...",
-			"chamber":  "An altar of nightmares awaits the unwary...",
-		}
-	case narrative.MysticalTheme:
-		return map[string]string{
-			"cave":     "Crystal formations pulse with ethereal light...",
-			"forest":   "Spirit wisps dance between ancient oaks...",
-			"dungeon":  "Arcane symbols shimmer on every surface...",
-			"castle":   "The veil between worlds grows thin here...",
-			"corridor": "Reality bends around each corner...",
-			"chamber":  "A nexus of magical convergence beckons...",
-		}
-	case narrative.PostApocTheme:
-		return map[string]string{
-			"cave":     "Radiation warnings mark forgotten shelters...",
-			"forest":   "Mutated vegetation reclaims the ruins...",
-			"dungeon":  "Pre-war bunkers hold decaying secrets...",
-			"castle":   "A fortress against the wasteland crumbles...",
-			"corridor": "Dust settles on the bones of civilization...",
-			"chamber":  "Survival caches lie hidden in the rubble...",
-		}
-	default:
-		return map[string]string{
-			"cave":     "A passage stretches into darkness...",
-			"corridor": "The way forward lies uncertain...",
-		}
+// describedThemes are the themes with their own room descriptions
+var describedThemes = map[narrative.StoryTheme]bool{
+	narrative.FantasyTheme:  true,
+	narrative.SciFiTheme:    true,
+	narrative.HorrorTheme:   true,
+	narrative.MysticalTheme: true,
+	narrative.PostApocTheme: true,
+}
+
+// getRoomDescriptions returns theme-specific room descriptions, keyed by
+// room type.
+func getRoomDescriptions(theme narrative.StoryTheme) map[string]locale.Message {
+	prefix := "room." + string(theme) + "."
+	roomTypes := []string{"cave", "forest", "dungeon", "castle", "corridor", "chamber"}
+	if !describedThemes[theme] {
+		prefix = "room.default."
+		roomTypes = []string{"cave", "corridor"}
+	}
+
+	descriptions := make(map[string]locale.Message, len(roomTypes))
+	for _, roomType := range roomTypes {
+		descriptions[roomType] = locale.NewMessage(prefix + roomType)
 	}
+	return descriptions
 }
 
 // getGenericRoomDescription provides a fallback description.
-func getGenericRoomDescription(roomType string, theme narrative.StoryTheme) string {
-	prefix := "You enter"
-	switch theme {
-	case narrative.FantasyTheme:
-		prefix = "Ancient magic pervades"
-	case narrative.SciFiTheme:
-		prefix = "Systems detect"
-	case narrative.HorrorTheme:
-		prefix = "Dread fills"
-	case narrative.MysticalTheme:
-		prefix = "Mystical energy surrounds"
-	case narrative.PostApocTheme:
-		prefix = "Ruins mark"
-	}
-	return fmt.Sprintf("%s this %s...", prefix, roomType)
+func getGenericRoomDescription(roomType string, theme narrative.StoryTheme) locale.Message {
+	key := "room.generic.default"
+	if describedThemes[theme] {
+		key = "room.generic." + string(theme)
+	}
+	return locale.NewMessage(key, roomType)
 }
 
 // Draw implements ebiten.Game interface
@@ -802,28 +1421,98 @@ func (gr *GameRunner) Draw(screen *ebiten.Image) {
 	for _, item := range gr.itemInstances {
 		if !item.Collected && !gr.collectedItems[item.ID] {
 			itemX, itemY, itemW, itemH := item.GetBounds()
-			gr.renderer.RenderItem(screen, itemX, itemY, itemW, itemH, item.Collected, nil)
+			gr.renderer.RenderItem(screen, itemX, itemY, itemW, itemH, item.Collected, ItemGlowColor(item.Item.Rarity), nil)
+		}
+	}
+
+	// Render ability pedestals
+	for range gr.currentPedestals() {
+		px, py, pw, ph := pedestalBounds(gr.game.CurrentRoom)
+		gr.renderer.RenderAbilityPedestal(screen, px, py, pw, ph, int(gr.playFrames))
+	}
+
+	// Render the save room's rest spot
+	if gr.game.CurrentRoom != nil && gr.game.CurrentRoom.Type == world.SaveRoom {
+		rx, ry, rw, rh := restSpotBounds(gr.game.CurrentRoom)
+		gr.renderer.RenderRestSpot(screen, rx, ry, rw, rh)
+	}
+
+	// Render puzzle elements
+	if gr.puzzleState != nil && gr.game.CurrentRoom != nil && gr.game.CurrentRoom.Puzzle != nil {
+		for i, el := range gr.game.CurrentRoom.Puzzle.Elements {
+			gr.renderer.RenderPuzzleElement(screen, float64(el.X), float64(el.Y),
+				float64(el.Width), float64(el.Height), gr.puzzleState.IsActive(i))
 		}
 	}
 
-	// Render enemies
+	// Render enemies, including corpses that have not yet faded out
 	for _, enemy := range gr.enemyInstances {
-		if !enemy.IsDead() {
-			ex, ey, ew, eh := enemy.GetBounds()
+		if enemy.IsCorpseGone() || gr.frozenEnemies[enemy] {
+			continue
+		}
+		ex, ey, ew, eh := enemy.GetBounds()
 
-			// Get current animation frame if available
-			var spriteToRender *graphics.Sprite
-			if enemy.AnimController != nil {
-				spriteToRender = enemy.AnimController.GetCurrentFrame()
+		// Get current animation frame if available
+		var spriteToRender *graphics.Sprite
+		if enemy.AnimController != nil {
+			spriteToRender = enemy.AnimController.GetCurrentFrame()
+		}
+		// Fallback to base sprite if no animation frame
+		if spriteToRender == nil {
+			if sprite, ok := enemy.Enemy.SpriteData.(*graphics.Sprite); ok {
+				spriteToRender = sprite
 			}
-			// Fallback to base sprite if no animation frame
-			if spriteToRender == nil {
-				if sprite, ok := enemy.Enemy.SpriteData.(*graphics.Sprite); ok {
-					spriteToRender = sprite
-				}
+		}
+
+		if enemy.IsDead() {
+			gr.renderer.RenderEnemyCorpse(screen, ex, ey, ew, eh, enemy.CorpseAlpha(), spriteToRender)
+		} else {
+			gr.renderer.RenderEnemy(screen, ex, ey, ew, eh, enemy.CurrentHealth, enemy.Enemy.Health, gr.enemyTrailHealth(enemy), false, spriteToRender)
+			if tint, ok := enemy.Tint(); ok {
+				gr.renderer.RenderEnemyTint(screen, ex, ey, spriteToRender, tint)
+			}
+			gr.renderer.RenderAlertIndicator(screen, ex, ey, ew, enemy.AlertIndicator())
+			if tx, ty, tw, th, ok := enemy.Telegraph(); ok {
+				gr.renderer.RenderEnemyAttackEffect(screen, tx, ty, tw, th, true)
 			}
+		}
+	}
+
+	// Render slam areas while they are dangerous
+	for _, h := range gr.combatSystem.GetAreaHazards() {
+		gr.renderer.RenderEnemyAttackEffect(screen, h.X-h.Radius, h.Y-h.Radius, h.Radius*2, h.Radius, false)
+	}
+
+	// Render arena hazards, warnings as telegraphs
+	if gr.bossArena != nil {
+		for _, zone := range gr.bossArena.Zones() {
+			gr.renderer.RenderEnemyAttackEffect(screen, zone.X, zone.Y, zone.W, zone.H, zone.Warning)
+		}
+	}
+
+	// Render boss and mini-boss telegraphs and active hitboxes
+	for _, bc := range []*BossController{gr.bossController, gr.miniBossController} {
+		if bc == nil {
+			continue
+		}
+		if bx, by, bw, bh, telegraphing, ok := bc.AttackArea(); ok {
+			if bc.Unblockable() {
+				gr.renderer.RenderUnblockableAttackEffect(screen, bx, by, bw, bh, telegraphing)
+			} else {
+				gr.renderer.RenderEnemyAttackEffect(screen, bx, by, bw, bh, telegraphing)
+			}
+		}
+	}
 
-			gr.renderer.RenderEnemy(screen, ex, ey, ew, eh, enemy.CurrentHealth, enemy.Enemy.Health, false, spriteToRender)
+	// Render projectiles
+	for _, p := range gr.combatSystem.GetProjectiles() {
+		if p.Active {
+			gr.renderer.RenderProjectile(screen, p.X, p.Y, 8, false)
+		}
+	}
+	for _, p := range gr.combatSystem.GetEnemyProjectiles() {
+		if p.Active {
+			gr.renderer.RenderProjectile(screen, p.X, p.Y, 10, !p.Deflected)
 		}
 	}
 
@@ -840,8 +1529,8 @@ func (gr *GameRunner) Draw(screen *ebiten.Image) {
 	// Render particles and other ECS-managed visuals
 	gr.systemManager.Draw(screen)
 
-	// Render player
-	if gr.game.Player != nil {
+	// Render player, blinking while invulnerable
+	if gr.game.Player != nil && gr.combatSystem.PlayerVisible() {
 		// Use animated sprite if available, otherwise fall back to base sprite
 		spriteToRender := gr.game.Player.Sprite
 		if gr.game.Player.AnimController != nil {
@@ -850,12 +1539,35 @@ func (gr *GameRunner) Draw(screen *ebiten.Image) {
 				spriteToRender = animFrame
 			}
 		}
-		gr.renderer.RenderPlayer(screen, gr.game.Player.X, gr.game.Player.Y, spriteToRender)
+		gr.renderer.SetPlayerInverted(gr.playerBody.InvertedGravity)
+		if gr.playerBody.Crouched {
+			gr.renderer.RenderCrouchingPlayer(screen, gr.game.Player.X, gr.game.Player.Y, gr.playerHeight(), spriteToRender)
+		} else {
+			gr.renderer.RenderPlayer(screen, gr.game.Player.X, gr.game.Player.Y, spriteToRender)
+		}
+	}
+
+	// Render the foreground tile overlay in front of the entities
+	if gr.game.CurrentRoom != nil && gr.game.Graphics != nil {
+		gr.renderer.RenderForeground(screen, gr.game.CurrentRoom, gr.game.Graphics.Tilesets)
+	}
+
+	// Outline collision rectangles over the world when enabled
+	if gr.showHitboxes {
+		gr.renderer.RenderHitboxes(screen, gr.hitboxes())
 	}
 
+	// Letterbox bars cover the world; the HUD stays on top in place
+	gr.renderer.RenderLetterbox(screen, gr.LetterboxHeight())
+
 	// Render UI
 	if gr.game.Player != nil {
-		gr.renderer.RenderUI(screen, gr.game.Player.Health, gr.game.Player.MaxHealth, gr.game.Player.Abilities)
+		gr.renderer.RenderUI(screen, gr.game.Player.Health, gr.game.Player.MaxHealth, gr.playerHealthTrail.Displayed(), gr.game.Player.Abilities)
+	}
+
+	if gr.showMinimap && gr.game.CurrentRoom != nil {
+		gr.renderer.RenderMinimap(screen, gr.game.World, gr.biomeRegions(), gr.visitedRooms, gr.game.CurrentRoom.ID,
+			gr.loc.Text("menu.completion", int(gr.CompletionPercent())))
 	}
 
 	// Render transition effect if transitioning
@@ -882,11 +1594,50 @@ func (gr *GameRunner) Draw(screen *ebiten.Image) {
 			msgX, msgY, color.RGBA{255, 215, 0, 200})
 	}
 
+	// Show the tutorial prompt below the item message, with a bar for the
+	// steps left
+	if prompt := gr.TutorialPrompt(); prompt != "" {
+		msgX := (render.ScreenWidth - render.MessageWidth) / 2
+		msgY := render.AbilityIconY + render.AbilityIconSize + render.MessageHeight + render.UIMargin*3
+		gr.renderMessageWithProgress(screen, prompt, len(gr.tutorial.steps)-gr.tutorial.step, len(gr.tutorial.steps),
+			msgX, msgY, color.RGBA{0, 0, 0, 180})
+	}
+
+	// Caption the latest sound cue along the bottom of the screen
+	if gr.captionTimer > 0 {
+		msgX := (render.ScreenWidth - render.MessageWidth) / 2
+		msgY := render.ScreenHeight - render.MessageHeight - render.UIMargin*2
+		gr.renderMessageWithProgress(screen, gr.caption, gr.captionTimer, captionDuration,
+			msgX, msgY, color.RGBA{0, 0, 0, 180})
+	}
+
+	// Show the ability unlock banner during the showcase
+	if gr.showcaseFrames > 0 {
+		gr.renderer.RenderAbilityBanner(screen, gr.showcaseAbility.Name, gr.showcaseAbility.Description)
+	}
+
+	// Boss rush fight, clock and deaths along the top
+	if gr.bossRush != nil {
+		status := gr.bossRushStatus()
+		statusW, _ := gr.renderer.MeasureText(status)
+		gr.renderer.RenderText(screen, status, (render.ScreenWidth-statusW)/2, 8, color.RGBA{255, 215, 0, 255})
+	}
+
+	// Prompt for the nearest interactable
+	if it := gr.interactions.Nearest(); it != nil {
+		gr.renderer.RenderInteractPrompt(screen, gr.interactPrompt(), it.X+it.Width/2, it.Y)
+	}
+
 	// Show room description on entry (bottom of screen, non-intrusive)
 	if gr.roomDescriptionTimer > 0 && gr.roomDescription != "" {
 		gr.renderRoomDescription(screen)
 	}
 
+	// Show how far the run has come while paused
+	if gr.paused {
+		gr.renderPauseOverlay(screen)
+	}
+
 	// Show debug info if enabled (positioned below UI to avoid overlap)
 	if gr.showDebugInfo {
 		aliveEnemies := 0
@@ -896,7 +1647,7 @@ func (gr *GameRunner) Draw(screen *ebiten.Image) {
 			}
 		}
 
-		debugInfo := fmt.Sprintf("Seed: %d | Room: %s | FPS: %.2f | Enemies: %d/%d | Items: %d/%d\nPosition: (%.0f, %.0f) | Velocity: (%.1f, %.1f)\nHealth: %d/%d | OnGround: %v | Invuln: %v\nControls: WASD/Arrows=Move, Space=Jump, J=Attack, K=Dash, P=Pause, F3=Debug, Ctrl+Q=Quit",
+		debugInfo := fmt.Sprintf("Seed: %d | Room: %s | FPS: %.2f | Enemies: %d/%d | Items: %d/%d\nPosition: (%.0f, %.0f) | Velocity: (%.1f, %.1f)\nHealth: %d/%d | OnGround: %v | Invuln: %v\nControls: WASD/Arrows=Move, Space=Jump, J=Attack, K=Dash, P=Pause, F3=Debug, F9/F10=Capture/Restore Frame, M=Map, E=Auto-Run, Ctrl+Q=Quit",
 			gr.game.Seed,
 			gr.getCurrentRoomName(),
 			ebiten.ActualTPS(),
@@ -918,20 +1669,30 @@ func (gr *GameRunner) Draw(screen *ebiten.Image) {
 			debugInfo = "PAUSED\nPress P to resume\n\n" + debugInfo
 		}
 
-		// Position debug info below health bar and abilities
-		debugX := render.UIMargin
-		debugY := render.AbilityIconY + render.AbilityIconSize + render.UIMargin + render.MessageHeight + render.UIMargin
 		// Use text rendering abstraction with fallback to debug text
 		if gr.renderer != nil {
-			gr.renderer.RenderText(screen, debugInfo, debugX, debugY, color.RGBA{255, 255, 255, 255})
+			gr.renderer.RenderDebugInfo(screen, debugInfo)
 		} else {
+			debugX := render.UIMargin
+			debugY := render.AbilityIconY + render.AbilityIconSize + render.UIMargin + render.MessageHeight + render.UIMargin
 			ebitenutil.DebugPrintAt(screen, debugInfo, debugX, debugY)
 		}
 	}
 
+	// Mark the frozen frame while stepping
+	if gr.stepDebug {
+		stepHint := "STEP MODE  F7=Advance  F6=Resume"
+		hintX := (render.ScreenWidth - len(stepHint)*8) / 2
+		if gr.renderer != nil {
+			gr.renderer.RenderText(screen, stepHint, hintX, render.UIMargin, color.RGBA{255, 220, 100, 255})
+		} else {
+			ebitenutil.DebugPrintAt(screen, stepHint, hintX, render.UIMargin)
+		}
+	}
+
 	// Always show minimal controls hint in top-right corner if debug is off
 	if !gr.showDebugInfo {
-		controlsHint := "F3=Debug Info"
+		controlsHint := "F3=Debug Info  M=Map"
 		hintWidth := len(controlsHint) * 8 // 8px per char (standardized font metrics)
 		hintX := render.ScreenWidth - hintWidth - render.UIMargin
 		hintY := render.UIMargin
@@ -943,6 +1704,18 @@ func (gr *GameRunner) Draw(screen *ebiten.Image) {
 	}
 }
 
+// biomeRegions returns the world's labeled biome zones, grouping them on
+// first use for worlds generated without them
+func (gr *GameRunner) biomeRegions() []*world.BiomeRegion {
+	if gr.game.World == nil {
+		return nil
+	}
+	if gr.game.World.Regions == nil {
+		gr.game.World.Regions = world.BuildBiomeRegions(gr.game.World.Rooms)
+	}
+	return gr.game.World.Regions
+}
+
 // Layout implements ebiten.Game interface
 func (gr *GameRunner) Layout(outsideWidth, outsideHeight int) (int, int) {
 	return render.ScreenWidth, render.ScreenHeight
@@ -992,7 +1765,7 @@ func (gr *GameRunner) CreateSaveData() *save.SaveData {
 	}
 
 	// Calculate play time
-	playTime := int64(time.Since(gr.startTime).Seconds())
+	playTime := int64(gr.PlayTime().Seconds())
 
 	// Current room ID
 	currentRoomID := 0
@@ -1019,6 +1792,7 @@ func (gr *GameRunner) CreateSaveData() *save.SaveData {
 			PerfectRooms:      stats.PerfectRooms,
 			ConsecutiveKills:  stats.ConsecutiveKills,
 			LongestCombo:      stats.LongestCombo,
+			HazardKills:       stats.HazardKills,
 		}
 	}
 
@@ -1037,10 +1811,30 @@ func (gr *GameRunner) CreateSaveData() *save.SaveData {
 		UnlockedDoors:    gr.unlockedDoors,
 		BossesDefeated:   gr.getBossesDefeated(),
 		CheckpointID:     currentRoomID,
+		NGPlusLevel:      gr.game.NGPlusLevel,
+		AssistMode:       gr.assistUsed,
+		RewindUsed:       gr.rewindUsed,
+		RNGState:         gr.rng.State(),
 		AchievementStats: achievementStats,
+
+		DefeatedBossNames: gr.defeatedBossNames(),
+		Completion:        gr.CompletionPercent(),
 	}
 }
 
+// defeatedBossNames returns the names of the bosses beaten, sorted so
+// saves are stable
+func (gr *GameRunner) defeatedBossNames() []string {
+	names := make([]string, 0, len(gr.defeatedBosses))
+	for name, defeated := range gr.defeatedBosses {
+		if defeated {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // getBossesDefeated returns a list of defeated boss IDs
 func (gr *GameRunner) getBossesDefeated() []int {
 	bossesDefeated := make([]int, 0)
@@ -1067,35 +1861,33 @@ func (gr *GameRunner) checkLockedDoorInteraction() {
 	for i := range gr.game.CurrentRoom.Doors {
 		door := &gr.game.CurrentRoom.Doors[i]
 
-		// Simple AABB collision check
-		doorX := float64(door.X)
-		doorY := float64(door.Y)
-		doorW := float64(door.Width)
-		doorH := float64(door.Height)
-
-		if playerX < doorX+doorW &&
-			playerX+physics.PlayerWidth > doorX &&
-			playerY < doorY+doorH &&
-			playerY+physics.PlayerHeight > doorY {
+		if physics.AABBOverlap(playerX, playerY, physics.PlayerWidth, gr.playerHeight(),
+			float64(door.X), float64(door.Y), float64(door.Width), float64(door.Height)) {
 
 			// Check if door is locked
 			doorKey := gr.transitionHandler.GetDoorKey(door)
 			if door.Locked && !gr.unlockedDoors[doorKey] {
 				// Check if player can unlock this door
-				if gr.transitionHandler.CanUnlockDoor(door, gr.game.Player.Abilities, gr.collectedItems) {
+				if gr.canOpenHubDoor(door) || gr.transitionHandler.CanUnlockDoor(door, gr.game.Player.Abilities, gr.collectedItems) {
 					// Automatically unlock the door
 					gr.UnlockDoor(door)
 				} else {
 					// Show locked message
-					if door.LeadsTo != nil {
+					if door.PuzzleGated {
+						gr.lockedDoorMessage = gr.loc.Text("toast.door_mechanism")
+					} else if door.BossGated {
+						gr.lockedDoorMessage = gr.loc.Text("toast.door_guardian")
+					} else if door.HubGated {
+						gr.lockedDoorMessage = gr.loc.Text("toast.door_hub")
+					} else if door.LeadsTo != nil {
 						requirement := gr.transitionHandler.findEdgeRequirement(gr.game.CurrentRoom.ID, door.LeadsTo.ID)
 						if requirement != "" {
-							gr.lockedDoorMessage = fmt.Sprintf("Requires: %s", requirement)
+							gr.lockedDoorMessage = gr.lockedDoorHint(requirement)
 						} else {
-							gr.lockedDoorMessage = "Door is locked"
+							gr.lockedDoorMessage = gr.loc.Text("toast.door_locked")
 						}
 					} else {
-						gr.lockedDoorMessage = "Door is locked"
+						gr.lockedDoorMessage = gr.loc.Text("toast.door_locked")
 					}
 					gr.lockedDoorTimer = lockedDoorMessageDuration
 				}
@@ -1104,6 +1896,12 @@ func (gr *GameRunner) checkLockedDoorInteraction() {
 	}
 }
 
+// canOpenHubDoor reports whether door is a hub exit onto a room the player
+// has already reached on foot
+func (gr *GameRunner) canOpenHubDoor(door *world.Door) bool {
+	return door.HubGated && door.LeadsTo != nil && gr.visitedRooms[door.LeadsTo.ID]
+}
+
 // UnlockDoor unlocks a door and adds particle effect
 func (gr *GameRunner) UnlockDoor(door *world.Door) {
 	if door == nil {
@@ -1114,7 +1912,7 @@ func (gr *GameRunner) UnlockDoor(door *world.Door) {
 	gr.unlockedDoors[doorKey] = true
 
 	// Show unlock message
-	gr.lockedDoorMessage = "Door unlocked!"
+	gr.lockedDoorMessage = gr.loc.Text("toast.door_unlocked")
 	gr.lockedDoorTimer = lockedDoorMessageDuration
 
 	// Create sparkle particle effect at door position
@@ -1130,7 +1928,7 @@ func (gr *GameRunner) checkItemCollection() {
 	playerX := gr.game.Player.X
 	playerY := gr.game.Player.Y
 	playerW := float64(physics.PlayerWidth)
-	playerH := float64(physics.PlayerHeight)
+	playerH := gr.playerHeight()
 
 	for _, item := range gr.itemInstances {
 		// Skip already collected items
@@ -1141,10 +1939,7 @@ func (gr *GameRunner) checkItemCollection() {
 		// Check collision with player
 		itemX, itemY, itemW, itemH := item.GetBounds()
 
-		if playerX < itemX+itemW &&
-			playerX+playerW > itemX &&
-			playerY < itemY+itemH &&
-			playerY+playerH > itemY {
+		if physics.AABBOverlap(playerX, playerY, playerW, playerH, itemX, itemY, itemW, itemH) {
 
 			// Collect the item!
 			gr.collectItem(item)
@@ -1168,7 +1963,7 @@ func (gr *GameRunner) collectItem(item *entity.ItemInstance) {
 	}
 
 	// Show message
-	gr.itemMessage = fmt.Sprintf("Collected: %s", item.Item.Name)
+	gr.itemMessage = gr.loc.Text("toast.collected", item.Item.Name)
 	gr.itemMessageTimer = itemMessageDuration
 
 	// Create sparkle particle effect at item position
@@ -1184,7 +1979,7 @@ func (gr *GameRunner) collectItem(item *entity.ItemInstance) {
 			gr.game.Player.Health = gr.game.Player.MaxHealth
 		}
 	case "increase_damage":
-		gr.game.Player.Damage += item.Item.Value / 10
+		gr.combatSystem.EquipWeapon(WeaponFromItem(item.Item))
 	}
 
 	// Check if item grants an ability (for key items)
@@ -1212,7 +2007,7 @@ func (gr *GameRunner) SaveGame(slotID int) error {
 	return gr.saveManager.SaveGame(saveData, slotID)
 }
 
-// LoadGame loads game state from a slot
+// LoadGame loads game state from a slot and repopulates the saved room
 func (gr *GameRunner) LoadGame(slotID int) error {
 	if gr.saveManager == nil {
 		return fmt.Errorf("save system not initialized")
@@ -1223,7 +2018,23 @@ func (gr *GameRunner) LoadGame(slotID int) error {
 		return err
 	}
 
-	return gr.RestoreFromSaveData(saveData)
+	return gr.enterLoadedGame(saveData)
+}
+
+// enterLoadedGame restores saveData, repopulates the saved room in place of
+// whatever room was live, and sets the player down at rest. Loading into a
+// save room leaves no enemies or hostile shots around the player.
+func (gr *GameRunner) enterLoadedGame(saveData *save.SaveData) error {
+	if err := gr.RestoreFromSaveData(saveData); err != nil {
+		return err
+	}
+
+	gr.populateCurrentRoom()
+	gr.game.Player.VelX = 0
+	gr.game.Player.VelY = 0
+	gr.playerBody.Velocity.X = 0
+	gr.playerBody.Velocity.Y = 0
+	return nil
 }
 
 // RestoreFromSaveData restores game state from save data
@@ -1232,6 +2043,12 @@ func (gr *GameRunner) RestoreFromSaveData(saveData *save.SaveData) error {
 	if saveData.Seed != gr.game.Seed {
 		return fmt.Errorf("save file seed mismatch: expected %d, got %d", gr.game.Seed, saveData.Seed)
 	}
+	if saveData.NGPlusLevel != gr.game.NGPlusLevel {
+		return fmt.Errorf("save file NG+ level mismatch: expected %d, got %d", gr.game.NGPlusLevel, saveData.NGPlusLevel)
+	}
+
+	// A loaded game is past the intro
+	gr.tutorial = nil
 
 	// Restore player state
 	gr.game.Player.X = saveData.PlayerX
@@ -1239,6 +2056,9 @@ func (gr *GameRunner) RestoreFromSaveData(saveData *save.SaveData) error {
 	gr.game.Player.Health = saveData.PlayerHealth
 	gr.game.Player.MaxHealth = saveData.PlayerMaxHealth
 	gr.game.Player.Abilities = saveData.PlayerAbilities
+	gr.playerHealthTrail = render.NewHealthTrail(saveData.PlayerHealth)
+	gr.assistUsed = gr.assistUsed || saveData.AssistMode
+	gr.rewindUsed = gr.rewindUsed || saveData.RewindUsed
 
 	// Update player body position
 	gr.playerBody.Position.X = saveData.PlayerX
@@ -1249,6 +2069,10 @@ func (gr *GameRunner) RestoreFromSaveData(saveData *save.SaveData) error {
 	for _, roomID := range saveData.VisitedRooms {
 		gr.visitedRooms[roomID] = true
 	}
+	gr.defeatedBosses = make(map[string]bool, len(saveData.DefeatedBossNames))
+	for _, name := range saveData.DefeatedBossNames {
+		gr.defeatedBosses[name] = true
+	}
 	gr.defeatedEnemies = saveData.DefeatedEnemies
 	gr.collectedItems = saveData.CollectedItems
 	if gr.collectedItems == nil {
@@ -1259,6 +2083,11 @@ func (gr *GameRunner) RestoreFromSaveData(saveData *save.SaveData) error {
 		gr.unlockedDoors = make(map[string]bool)
 	}
 
+	// Saves from before the RNG was recorded keep the current stream
+	if saveData.RNGState != 0 {
+		gr.rng.SetState(saveData.RNGState)
+	}
+
 	// Restore achievement statistics if available
 	if saveData.AchievementStats != nil && gr.game.Achievements != nil {
 		stats := gr.game.Achievements.GetStatistics()
@@ -1276,6 +2105,7 @@ func (gr *GameRunner) RestoreFromSaveData(saveData *save.SaveData) error {
 		stats.PerfectRooms = saveData.AchievementStats.PerfectRooms
 		stats.ConsecutiveKills = saveData.AchievementStats.ConsecutiveKills
 		stats.LongestCombo = saveData.AchievementStats.LongestCombo
+		stats.HazardKills = saveData.AchievementStats.HazardKills
 		stats.PlayTime = saveData.PlayTime
 		gr.game.Achievements.UpdateStatistics(stats)
 	}
@@ -1289,8 +2119,8 @@ func (gr *GameRunner) RestoreFromSaveData(saveData *save.SaveData) error {
 		}
 	}
 
-	// Adjust start time to account for saved play time
-	gr.startTime = time.Now().Add(-time.Duration(saveData.PlayTime) * time.Second)
+	// Resume the play timer from the saved play time
+	gr.playFrames = saveData.PlayTime * playFramesPerSecond
 
 	return nil
 }
@@ -1309,53 +2139,77 @@ func (gr *GameRunner) CheckAutoSave() {
 	}
 }
 
-// createItemInstancesForRoom creates item instances for a room
+// createItemInstancesForRoom creates item instances for a room: the treasure
+// room's generated items plus any healing pickups placed by the world's
+// resource balancing pass.
 func createItemInstancesForRoom(room *world.Room, allItems []*entity.Item) []*entity.ItemInstance {
 	var instances []*entity.ItemInstance
 
-	if room == nil || room.Type != world.TreasureRoom {
+	if room == nil || len(allItems) == 0 {
 		return instances
 	}
 
 	// Find ground platform Y for spawning
 	groundY := findGroundY(room)
+	itemY := groundY - 16.0 // Items are 16px tall
 
-	// Place 2-4 items in treasure rooms
-	itemCount := 2 + (room.ID % 3) // 2-4 items based on room ID
-	if itemCount > len(allItems) {
-		itemCount = len(allItems)
-	}
+	if room.Type == world.TreasureRoom {
+		// One instance per item slot the world generator reserved
+		itemCount := len(room.Items)
+		if itemCount > len(allItems) {
+			itemCount = len(allItems)
+		}
 
-	for i := 0; i < itemCount && i < len(allItems); i++ {
-		// Generate unique item ID based on room and position
-		itemID := room.ID*1000 + i
+		for i := 0; i < itemCount; i++ {
+			// Generate unique item ID based on room and position
+			itemID := room.ID*1000 + i
 
-		// Position items across the room on the ground platform
-		itemX := 200.0 + float64(i*150)
-		itemY := groundY - 16.0 // Items are 16px tall
+			// Position items across the room on the ground platform
+			itemX, itemY := itemSpot(room, i, 200.0+float64(i*150), itemY)
 
-		instance := entity.NewItemInstance(allItems[i%len(allItems)], itemID, itemX, itemY)
-		instances = append(instances, instance)
+			instance := entity.NewItemInstance(allItems[i%len(allItems)], itemID, itemX, itemY)
+			instances = append(instances, instance)
+		}
+	}
+
+	if healItem := findHealingItem(allItems); healItem != nil {
+		for i := 0; i < room.HealthPickups; i++ {
+			// Offset IDs so pickups never collide with treasure slots
+			itemID := room.ID*1000 + healthPickupIDOffset + i
+			itemX, itemY := itemSpot(room, len(room.Items)+i, 720.0-float64(i*60), itemY)
+			instances = append(instances, entity.NewItemInstance(healItem, itemID, itemX, itemY))
+		}
 	}
 
 	return instances
 }
 
+// itemSpot returns the generated position of the room's i-th item spot,
+// or the fallback position for rooms laid out without spots
+func itemSpot(room *world.Room, i int, fallbackX, fallbackY float64) (float64, float64) {
+	if i < len(room.ItemSpots) {
+		return float64(room.ItemSpots[i].X), float64(room.ItemSpots[i].Y)
+	}
+	return fallbackX, fallbackY
+}
+
+// findHealingItem returns the first generated item with a heal effect
+func findHealingItem(allItems []*entity.Item) *entity.Item {
+	for _, item := range allItems {
+		if item.Effect == "heal" {
+			return item
+		}
+	}
+	return nil
+}
+
 // findGroundY returns the Y coordinate of the ground platform surface in a room.
 // Falls back to screen-bottom floor if no ground platform is found.
 func findGroundY(room *world.Room) float64 {
 	if room == nil {
 		return float64(render.ScreenHeight - 32)
 	}
-	// Look for the lowest full-width platform (ground platform)
-	groundY := float64(render.ScreenHeight) // Default: screen bottom
-	for _, p := range room.Platforms {
-		if p.Width >= render.ScreenWidth/2 && float64(p.Y) < groundY {
-			// Found a wide platform — use its top surface
-			groundY = float64(p.Y)
-		}
-	}
-	return groundY
+	return float64(room.GroundY())
 }
 
 // renderMessageWithProgress renders a message with a progress bar showing remaining time
@@ -1428,6 +2282,28 @@ func (gr *GameRunner) renderMessageWithProgress(screen *ebiten.Image, message st
 	}
 }
 
+// renderPauseOverlay shows the pause title and completion percentage in a
+// box at the centre of the screen
+func (gr *GameRunner) renderPauseOverlay(screen *ebiten.Image) {
+	lines := []string{
+		gr.loc.Text("menu.title.pause"),
+		gr.loc.Text("menu.completion", int(gr.CompletionPercent())),
+	}
+	boxX := (render.ScreenWidth - render.MessageWidth) / 2
+	boxY := (render.ScreenHeight-render.MessageHeight)/2 - render.MessageHeight
+	boxH := render.MessageHeight * len(lines)
+	bgImg := ebiten.NewImage(render.MessageWidth, boxH)
+	bgImg.Fill(color.RGBA{0, 0, 0, 200})
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(float64(boxX), float64(boxY))
+	screen.DrawImage(bgImg, opts)
+	for i, line := range lines {
+		textW, textH := gr.renderer.MeasureText(line)
+		y := boxY + i*render.MessageHeight + (render.MessageHeight-textH)/2
+		gr.renderer.RenderText(screen, line, (render.ScreenWidth-textW)/2, y, color.RGBA{255, 255, 255, 255})
+	}
+}
+
 // renderRoomDescription renders the room description at the bottom of the screen
 // with fade-in and fade-out effects.
 func (gr *GameRunner) renderRoomDescription(screen *ebiten.Image) {
@@ -1472,6 +2348,7 @@ func (gr *GameRunner) renderRoomDescription(screen *ebiten.Image) {
 func (gr *GameRunner) updateMusicContext() {
 	// Count nearby enemies (alive enemies within aggro range)
 	nearbyCount := 0
+	chasingCount := 0
 	inCombat := false
 
 	for _, enemy := range gr.enemyInstances {
@@ -1493,6 +2370,9 @@ func (gr *GameRunner) updateMusicContext() {
 		if enemy.State == entity.ChaseState || enemy.State == entity.AttackState {
 			inCombat = true
 		}
+		if enemy.State == entity.ChaseState {
+			chasingCount++
+		}
 	}
 
 	// Determine if this is a boss fight
@@ -1519,6 +2399,7 @@ func (gr *GameRunner) updateMusicContext() {
 	gr.musicContext.NearbyEnemyCount = nearbyCount
 	gr.musicContext.PlayerHealthPct = healthPct
 	gr.musicContext.RoomDangerLevel = dangerLevel
+	gr.musicContext.ChasingEnemyCount = chasingCount
 
 	// Calculate intensity and update adaptive music track
 	intensity := gr.musicContext.CalculateIntensity()
@@ -1527,7 +2408,21 @@ func (gr *GameRunner) updateMusicContext() {
 	if gr.game.CurrentRoom != nil && gr.game.CurrentRoom.Biome != nil {
 		if track, exists := gr.game.Audio.AdaptiveTracks[gr.game.CurrentRoom.Biome.Name]; exists {
 			track.SetIntensity(intensity)
+			track.SetChasing(gr.musicContext.IsChased())
 			track.Update()
 		}
+		gr.ambient.SetLayer(gr.game.Audio.Ambient[gr.game.CurrentRoom.Biome.GetMusicMood()])
 	}
+	gr.ambient.Update()
+}
+
+// SetMusicVolume sets the music volume, from 0 to 1, that the ambient beds
+// are mixed beneath
+func (gr *GameRunner) SetMusicVolume(volume float64) {
+	gr.ambient.SetVolume(volume)
+}
+
+// AmbientMix returns the volume of each audible ambient bed by layer name
+func (gr *GameRunner) AmbientMix() map[string]float64 {
+	return gr.ambient.Mix()
 }
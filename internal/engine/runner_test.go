@@ -1,10 +1,20 @@
 package engine
 
 import (
+	"encoding/json"
+	"math"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/opd-ai/vania/internal/audio"
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/input"
+	"github.com/opd-ai/vania/internal/locale"
 	"github.com/opd-ai/vania/internal/narrative"
+	"github.com/opd-ai/vania/internal/particle"
+	"github.com/opd-ai/vania/internal/save"
+	"github.com/opd-ai/vania/internal/world"
 )
 
 func TestGetRoomDescriptions(t *testing.T) {
@@ -42,7 +52,7 @@ func TestGetGenericRoomDescription(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.roomType+"_"+string(tc.theme), func(t *testing.T) {
-			desc := getGenericRoomDescription(tc.roomType, tc.theme)
+			desc := locale.English(getGenericRoomDescription(tc.roomType, tc.theme))
 			if desc == "" {
 				t.Error("Generic description should not be empty")
 			}
@@ -66,3 +76,187 @@ func TestRoomDescriptionTimerConstants(t *testing.T) {
 		t.Error("Fade duration should be less than half of total duration")
 	}
 }
+
+func TestCreateItemInstancesForRoom(t *testing.T) {
+	items := []*entity.Item{
+		{Name: "Sword", Type: entity.WeaponItem, Effect: "increase_damage"},
+		{Name: "Potion", Type: entity.ConsumableItem, Effect: "heal", Value: 20},
+	}
+
+	tests := []struct {
+		name      string
+		room      *world.Room
+		wantTotal int
+		wantHeals int
+	}{
+		{"treasure room uses item slots", &world.Room{ID: 3, Type: world.TreasureRoom, Items: make([]interface{}, 2)}, 2, 1},
+		{"combat room with pickups", &world.Room{ID: 4, Type: world.CombatRoom, HealthPickups: 2}, 2, 2},
+		{"corridor without pickups", &world.Room{ID: 5, Type: world.CorridorRoom}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instances := createItemInstancesForRoom(tt.room, items)
+			if len(instances) != tt.wantTotal {
+				t.Errorf("len(instances) = %d, want %d", len(instances), tt.wantTotal)
+			}
+			heals := 0
+			seen := make(map[int]bool)
+			for _, inst := range instances {
+				if inst.Item.Effect == "heal" {
+					heals++
+				}
+				if seen[inst.ID] {
+					t.Errorf("duplicate item ID %d", inst.ID)
+				}
+				seen[inst.ID] = true
+			}
+			if heals != tt.wantHeals {
+				t.Errorf("heal pickups = %d, want %d", heals, tt.wantHeals)
+			}
+		})
+	}
+}
+
+func TestRNGStateSurvivesSaveAndLoad(t *testing.T) {
+	gr := newTestRunner(t)
+
+	// Round-trip through JSON as a save file would
+	raw, err := json.Marshal(gr.CreateSaveData())
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	act := func() []float64 {
+		var outcomes []float64
+		for i := 0; i < 5; i++ {
+			emitter := gr.particlePresets.CreateHitEffect(100, 100, 1)
+			emitter.Burst(4)
+			for _, p := range emitter.Particles {
+				outcomes = append(outcomes, p.VelX, p.VelY, float64(p.Life))
+			}
+			outcomes = append(outcomes, float64(gr.rng.Intn(100)))
+		}
+		return outcomes
+	}
+
+	first := act()
+
+	var saved save.SaveData
+	if err := json.Unmarshal(raw, &saved); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if err := gr.RestoreFromSaveData(&saved); err != nil {
+		t.Fatalf("RestoreFromSaveData() error = %v", err)
+	}
+	second := act()
+
+	if len(first) != len(second) {
+		t.Fatalf("got %d outcomes after reload, want %d", len(second), len(first))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("outcome %d after reload = %v, want %v", i, second[i], first[i])
+		}
+	}
+}
+
+func TestPlayTimeExcludesPausedTime(t *testing.T) {
+	gr := newTestRunner(t)
+
+	for i := 0; i < 120; i++ {
+		if err := gr.Step(input.InputState{}); err != nil {
+			t.Fatalf("Step() error = %v", err)
+		}
+	}
+
+	// A long pause must not advance the timer
+	gr.paused = true
+	for i := 0; i < 600; i++ {
+		if err := gr.Update(); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+	}
+	gr.paused = false
+
+	if got := gr.PlayTime(); got != 2*time.Second {
+		t.Errorf("PlayTime() = %v, want 2s of active play", got)
+	}
+	if got := gr.CreateSaveData().PlayTime; got != 2 {
+		t.Errorf("saved PlayTime = %d, want 2", got)
+	}
+}
+
+// meleeHitParticleTypes lands one melee hit on an enemy and returns the
+// particle types the hit spawned
+func meleeHitParticleTypes(t *testing.T, gore bool) map[particle.ParticleType]bool {
+	t.Helper()
+	gr := newTestRunner(t)
+	gr.SetGoreEnabled(gore)
+	gr.particleSystem.Clear()
+
+	gr.combatSystem.PlayerAttack()
+	gr.combatSystem.playerAttackFrame = 5 // into the swing's active frames
+	ax, ay, _, _ := gr.combatSystem.GetAttackHitbox(gr.game.Player.X, gr.game.Player.Y, gr.playerFacingDir)
+	enemy := entity.NewEnemyInstance(&entity.Enemy{Health: 100, Damage: 5, Size: entity.MediumEnemy}, ax, ay)
+	gr.checkMeleeHitEnemy(enemy)
+
+	types := make(map[particle.ParticleType]bool)
+	for _, p := range gr.particleSystem.GetAllParticles() {
+		types[p.Type] = true
+	}
+	return types
+}
+
+func TestGoreToggleSwapsBloodForImpactDust(t *testing.T) {
+	gory := meleeHitParticleTypes(t, true)
+	if !gory[particle.BloodSplatter] {
+		t.Error("expected blood splatter on hit with gore enabled")
+	}
+
+	clean := meleeHitParticleTypes(t, false)
+	if clean[particle.BloodSplatter] {
+		t.Error("expected no blood splatter with gore disabled")
+	}
+	if !clean[particle.ImpactDust] {
+		t.Error("expected impact dust on hit with gore disabled")
+	}
+}
+
+func TestUpdateMusicContextTracksChase(t *testing.T) {
+	gr := newTestRunner(t)
+
+	crawler := &entity.Enemy{Name: "Crawler", Health: 50, Size: entity.MediumEnemy, Behavior: entity.PatrolBehavior}
+	enemy := entity.NewEnemyInstance(crawler, 600, 400)
+	gr.enemyInstances = []*entity.EnemyInstance{enemy}
+
+	enemy.State = entity.ChaseState
+	gr.updateMusicContext()
+	if !gr.musicContext.IsChased() {
+		t.Error("chase music is off while an enemy is chasing")
+	}
+
+	enemy.State = entity.PatrolState
+	gr.updateMusicContext()
+	if gr.musicContext.IsChased() {
+		t.Error("chase music is still on after the enemy disengaged")
+	}
+}
+
+func TestUpdateMusicContextPlaysBiomeAmbience(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetMusicVolume(0.8)
+
+	mood := gr.game.CurrentRoom.Biome.GetMusicMood()
+	bed := gr.game.Audio.Ambient[mood]
+	if bed == nil {
+		t.Fatalf("no ambient bed generated for mood %q", mood)
+	}
+	for i := 0; i < audio.DefaultAmbientFadeFrames; i++ {
+		gr.updateMusicContext()
+	}
+	want := bed.BaseVolume * 0.8 * audio.AmbientMusicRatio
+	if got := gr.AmbientMix()[bed.Name]; math.Abs(got-want) > 1e-9 {
+		t.Errorf("ambient %q volume = %v, want %v", bed.Name, got, want)
+	}
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// findSaveRoom returns a save room of the runner's world
+func findSaveRoom(t *testing.T, gr *GameRunner) *world.Room {
+	t.Helper()
+	for _, room := range gr.game.World.Rooms {
+		if room.Type == world.SaveRoom {
+			return room
+		}
+	}
+	t.Skip("world has no save room")
+	return nil
+}
+
+func TestSaveRoomsSpawnNoEnemies(t *testing.T) {
+	gr, _ := newQuickSaveRunner(t)
+
+	saveRooms := 0
+	for _, room := range gr.game.World.Rooms {
+		if room.Type != world.SaveRoom {
+			continue
+		}
+		saveRooms++
+		if enemies := gr.transitionHandler.SpawnEnemiesForRoom(room); len(enemies) != 0 {
+			t.Errorf("save room %d spawned %d enemies, want none", room.ID, len(enemies))
+		}
+	}
+	if saveRooms == 0 {
+		t.Skip("world has no save room")
+	}
+}
+
+func TestLoadIntoSaveRoomHasNoThreats(t *testing.T) {
+	gr, _ := newQuickSaveRunner(t)
+	saveRoom := findSaveRoom(t, gr)
+
+	// Save while standing in the save room
+	gr.game.CurrentRoom = saveRoom
+	gr.game.Player.X = 400
+	gr.game.Player.Y = float64(saveRoom.GroundY()) - 32
+	if err := gr.SaveGame(1); err != nil {
+		t.Fatalf("SaveGame() error = %v", err)
+	}
+
+	// Load from elsewhere, mid-fight
+	for _, room := range gr.game.World.Rooms {
+		if room.Type == world.CombatRoom {
+			gr.game.CurrentRoom = room
+			break
+		}
+	}
+	gr.populateCurrentRoom()
+	if len(gr.enemyInstances) == 0 {
+		t.Fatal("expected enemies in the room loaded from")
+	}
+	gr.combatSystem.SpawnEnemyProjectile(gr.game.Player.X+40, gr.game.Player.Y, -4, 0, 5)
+	if err := gr.LoadGame(1); err != nil {
+		t.Fatalf("LoadGame() error = %v", err)
+	}
+
+	if gr.game.CurrentRoom != saveRoom {
+		t.Fatalf("loaded into room %d, want save room %d", gr.game.CurrentRoom.ID, saveRoom.ID)
+	}
+	for _, enemy := range gr.enemyInstances {
+		if !enemy.IsDead() {
+			t.Errorf("live enemy at (%.0f, %.0f) after loading into the save room", enemy.X, enemy.Y)
+		}
+	}
+	if n := len(gr.combatSystem.GetEnemyProjectiles()); n != 0 {
+		t.Errorf("%d hostile projectiles after loading into the save room, want none", n)
+	}
+	if v := math.Hypot(gr.playerBody.Velocity.X, gr.playerBody.Velocity.Y); v != 0 {
+		t.Errorf("player velocity = %v after loading, want at rest", v)
+	}
+}
@@ -0,0 +1,53 @@
+package engine
+
+// SoundPlayer plays loaded sound effects by name. audio.AudioPlayer
+// satisfies it and applies the SFX and master volume to each sound.
+type SoundPlayer interface {
+	PlaySound(name string) error
+}
+
+// SoundCue is a sound the game plays in response to an event, along with
+// the caption shown for it when captions are on
+type SoundCue struct {
+	Name    string
+	Caption string
+}
+
+// windupCue warns that an enemy is winding up an attack, so players can
+// react by ear
+var windupCue = SoundCue{Name: "windup", Caption: "[Enemy winds up]"}
+
+// captionDuration is how many frames a sound caption stays on screen
+const captionDuration = 90
+
+// SetSoundPlayer sets where sound cues are played; nil keeps the game
+// silent
+func (gr *GameRunner) SetSoundPlayer(player SoundPlayer) {
+	gr.soundPlayer = player
+}
+
+// SetCaptions turns on-screen captions for sound cues on or off
+func (gr *GameRunner) SetCaptions(enabled bool) {
+	gr.captionsEnabled = enabled
+	if !enabled {
+		gr.captionTimer = 0
+	}
+}
+
+// SoundCues returns the sound cues played during the current frame
+func (gr *GameRunner) SoundCues() []SoundCue {
+	return gr.soundCues
+}
+
+// playCue plays a sound cue and captions it when captions are on. A sound
+// the player has not loaded simply goes unheard.
+func (gr *GameRunner) playCue(cue SoundCue) {
+	gr.soundCues = append(gr.soundCues, cue)
+	if gr.soundPlayer != nil {
+		_ = gr.soundPlayer.PlaySound(cue.Name)
+	}
+	if gr.captionsEnabled && cue.Caption != "" {
+		gr.caption = cue.Caption
+		gr.captionTimer = captionDuration
+	}
+}
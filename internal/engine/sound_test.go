@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+// recordingSoundPlayer counts the sounds it is asked to play
+type recordingSoundPlayer struct {
+	plays map[string]int
+}
+
+func (p *recordingSoundPlayer) PlaySound(name string) error {
+	p.plays[name]++
+	return nil
+}
+
+func TestTelegraphPlaysWindupSoundOncePerAttack(t *testing.T) {
+	gr := newTestRunner(t)
+	sounds := &recordingSoundPlayer{plays: make(map[string]int)}
+	gr.SetSoundPlayer(sounds)
+	gr.SetCaptions(true)
+
+	enemy := entity.NewEnemyInstance(&entity.Enemy{
+		Health: 80, Damage: 15, Speed: 1.0, Size: entity.LargeEnemy,
+		Behavior: entity.PatrolBehavior, Archetype: entity.SlamArchetype,
+	}, gr.game.Player.X+30, gr.game.Player.Y)
+	enemy.Alarm()
+	enemy.OnGround = true
+	gr.enemyInstances = []*entity.EnemyInstance{enemy}
+
+	windupFrames := 0
+	for i := 0; i < entity.SlamTelegraphFrames+5; i++ {
+		gr.soundCues = gr.soundCues[:0]
+		enemy.OnGround = true
+		gr.updateSingleEnemy(enemy)
+		for _, cue := range gr.SoundCues() {
+			if cue == windupCue {
+				windupFrames++
+			}
+		}
+	}
+
+	if got := sounds.plays[windupCue.Name]; got != 1 {
+		t.Errorf("wind-up sound played %d times for one attack, want 1", got)
+	}
+	if windupFrames != 1 {
+		t.Errorf("wind-up cue reported on %d frames, want 1", windupFrames)
+	}
+	if gr.caption != windupCue.Caption || gr.captionTimer == 0 {
+		t.Errorf("caption = %q (timer %d), want the wind-up caption", gr.caption, gr.captionTimer)
+	}
+}
+
+func TestSoundCuesWithoutPlayerStaySilent(t *testing.T) {
+	gr := newTestRunner(t)
+
+	gr.playCue(windupCue)
+	if len(gr.SoundCues()) != 1 {
+		t.Errorf("got %d cues, want the cue recorded", len(gr.SoundCues()))
+	}
+	if gr.captionTimer != 0 {
+		t.Error("expected no caption with captions off")
+	}
+}
@@ -0,0 +1,20 @@
+package engine
+
+// DefaultSpawnGraceFrames is how long enemies hold off after a room is
+// entered: half a second for the player to get their bearings
+const DefaultSpawnGraceFrames = playFramesPerSecond / 2
+
+// SetSpawnGraceFrames changes how many frames enemies stay idle and
+// harmless after spawning on room entry; zero turns the grace off
+func (gr *GameRunner) SetSpawnGraceFrames(frames int) {
+	gr.spawnGraceFrames = max(frames, 0)
+}
+
+// startSpawnGrace calms every enemy in the room for the spawn grace, so
+// none can strike the player the moment they arrive. Hitting an enemy
+// ends its grace early.
+func (gr *GameRunner) startSpawnGrace() {
+	for _, enemy := range gr.enemyInstances {
+		enemy.Calm(gr.spawnGraceFrames)
+	}
+}
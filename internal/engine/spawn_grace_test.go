@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// enterRoomWithEnemies moves the runner into the first room that spawns
+// enemies and populates it
+func enterRoomWithEnemies(t *testing.T, gr *GameRunner) {
+	t.Helper()
+	for _, room := range gr.game.World.Rooms {
+		if room.Type == world.BossRoom || len(gr.transitionHandler.SpawnEnemiesForRoom(room)) == 0 {
+			continue
+		}
+		gr.game.CurrentRoom = room
+		gr.populateCurrentRoom()
+		return
+	}
+	t.Skip("world has no room with enemies")
+}
+
+func TestSpawnedEnemyInRangeHoldsOffDuringGrace(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetSpawnGraceFrames(20)
+	enterRoomWithEnemies(t, gr)
+
+	enemy := gr.enemyInstances[0]
+	if !enemy.Calmed() {
+		t.Fatal("enemy spawned on room entry is not held off")
+	}
+
+	player := gr.game.Player
+	px, py := player.X, player.Y
+	for frame := 0; frame < 20; frame++ {
+		enemy.X, enemy.Y = px, py
+		gr.checkEnemyHitPlayer(enemy)
+		if player.Health != player.MaxHealth {
+			t.Fatalf("frame %d: enemy hurt the player during the spawn grace", frame)
+		}
+		enemy.Update(px, py)
+		if enemy.State != entity.IdleState {
+			t.Fatalf("frame %d: enemy state = %v during the spawn grace, want idle", frame, enemy.State)
+		}
+	}
+	if enemy.Calmed() {
+		t.Fatal("spawn grace should be over after its duration")
+	}
+
+	enemy.X, enemy.Y = px, py
+	gr.checkEnemyHitPlayer(enemy)
+	if player.Health == player.MaxHealth {
+		t.Error("enemy touching the player after the spawn grace dealt no damage")
+	}
+}
+
+func TestSpawnGraceOff(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetSpawnGraceFrames(0)
+	enterRoomWithEnemies(t, gr)
+
+	for _, enemy := range gr.enemyInstances {
+		if enemy.Calmed() {
+			t.Fatal("enemy held off with the spawn grace turned off")
+		}
+	}
+}
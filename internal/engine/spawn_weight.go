@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/pcg"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Spawn weighting tuning
+const (
+	// SpawnDepthWeight is how much of a room's spawn target comes from its
+	// depth in the room graph; the rest comes from its biome's danger
+	SpawnDepthWeight = 0.6
+
+	// SpawnWeightSharpness is how strongly picks favour enemies whose
+	// threat rank matches the room's target
+	SpawnWeightSharpness = 4.0
+
+	// spawnWeightFloor keeps every candidate possible, for variety
+	spawnWeightFloor = 0.05
+)
+
+// enemyThreat rates how hard an enemy is to face, for ranking enemies of
+// the same biome
+func enemyThreat(enemy *entity.Enemy) int {
+	return enemy.DangerLevel*100 + enemy.Health + enemy.Damage*2
+}
+
+// spawnTarget returns where in its biome's threat range a room's enemies
+// should sit, from 0 (the weakest) to 1 (the strongest). Deeper rooms and
+// more dangerous biomes aim higher, so difficulty ramps up with progress.
+func spawnTarget(w *world.World, room *world.Room) float64 {
+	if w == nil {
+		return 0.5
+	}
+
+	depth, maxDepth := 0, 0
+	if w.Graph != nil {
+		for _, node := range w.Graph.Nodes {
+			if node.Depth > maxDepth {
+				maxDepth = node.Depth
+			}
+		}
+		if node, ok := w.Graph.Nodes[room.ID]; ok {
+			depth = node.Depth
+		}
+	}
+	depthFrac := 0.0
+	if maxDepth > 0 {
+		depthFrac = float64(depth) / float64(maxDepth)
+	}
+
+	minDanger, maxDanger := math.MaxInt, 0
+	for _, biome := range w.Biomes {
+		if biome == nil {
+			continue
+		}
+		minDanger = min(minDanger, biome.DangerLevel)
+		maxDanger = max(maxDanger, biome.DangerLevel)
+	}
+	dangerFrac := depthFrac
+	if room.Biome != nil && maxDanger > minDanger {
+		dangerFrac = float64(room.Biome.DangerLevel-minDanger) / float64(maxDanger-minDanger)
+	}
+
+	return SpawnDepthWeight*depthFrac + (1-SpawnDepthWeight)*dangerFrac
+}
+
+// pickWeightedEnemies picks count enemies from candidates, favouring those
+// whose threat rank lies near target. Picks are made with replacement, so a
+// biome with few kinds still fills the room. The pick is seeded by the game
+// seed and room ID so a room always spawns the same enemies in its world.
+func pickWeightedEnemies(seed int64, room *world.Room, candidates []*entity.Enemy, count int, target float64) []*entity.Enemy {
+	if len(candidates) == 0 {
+		return nil
+	}
+	ranked := make([]*entity.Enemy, len(candidates))
+	copy(ranked, candidates)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return enemyThreat(ranked[i]) < enemyThreat(ranked[j])
+	})
+
+	weights := make([]float64, len(ranked))
+	total := 0.0
+	for i := range ranked {
+		rank := 0.5
+		if len(ranked) > 1 {
+			rank = float64(i) / float64(len(ranked)-1)
+		}
+		weights[i] = math.Pow(1-math.Abs(rank-target), SpawnWeightSharpness) + spawnWeightFloor
+		total += weights[i]
+	}
+
+	rng := rand.New(rand.NewSource(pcg.HashSeed(seed, fmt.Sprintf("spawns-%d", room.ID))))
+	selected := make([]*entity.Enemy, 0, count)
+	for len(selected) < count {
+		roll := rng.Float64() * total
+		i := 0
+		for ; i < len(weights)-1 && roll >= weights[i]; i++ {
+			roll -= weights[i]
+		}
+		selected = append(selected, ranked[i])
+	}
+	return selected
+}
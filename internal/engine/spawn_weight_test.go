@@ -0,0 +1,134 @@
+package engine
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// meanThreat averages enemyThreat over enemies
+func meanThreat(enemies []*entity.Enemy) float64 {
+	if len(enemies) == 0 {
+		return 0
+	}
+	total := 0
+	for _, enemy := range enemies {
+		total += enemyThreat(enemy)
+	}
+	return float64(total) / float64(len(enemies))
+}
+
+func TestDeeperRoomsSpawnStrongerEnemies(t *testing.T) {
+	cave := &world.Biome{Name: "cave", DangerLevel: 3}
+	var enemies []*entity.Enemy
+	for i := 0; i < 6; i++ {
+		enemies = append(enemies, &entity.Enemy{BiomeType: "cave", DangerLevel: 3, Health: 10 + i*10, Damage: 5 + i})
+	}
+
+	w := &world.World{Biomes: []*world.Biome{cave}, Graph: &world.WorldGraph{Nodes: map[int]*world.GraphNode{}}}
+	var shallow, deep []*entity.Enemy
+	for id := 0; id < 40; id++ {
+		depth := 1
+		if id%2 == 1 {
+			depth = 9
+		}
+		w.Graph.Nodes[id] = &world.GraphNode{RoomID: id, Depth: depth}
+		room := &world.Room{ID: id, Type: world.CombatRoom, Biome: cave}
+		picked := selectBiomeEnemies(42, w, room, enemies, 3)
+		if depth == 1 {
+			shallow = append(shallow, picked...)
+		} else {
+			deep = append(deep, picked...)
+		}
+	}
+
+	if s, d := meanThreat(shallow), meanThreat(deep); d <= s {
+		t.Errorf("mean threat deep = %.1f, shallow = %.1f; want deeper rooms stronger", d, s)
+	}
+}
+
+func TestGeneratedDeepRoomsSpawnMoreDangerousEnemies(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+	handler := NewRoomTransitionHandler(game)
+
+	// Split the combat rooms at their median depth
+	var depths []int
+	for _, room := range game.World.Rooms {
+		if node, ok := game.World.Graph.Nodes[room.ID]; ok && room.Type == world.CombatRoom {
+			depths = append(depths, node.Depth)
+		}
+	}
+	sort.Ints(depths)
+	if len(depths) < 2 {
+		t.Skip("world has too few combat rooms")
+	}
+	median := depths[len(depths)/2]
+
+	var shallow, deep []*entity.Enemy
+	for _, room := range game.World.Rooms {
+		node, ok := game.World.Graph.Nodes[room.ID]
+		if !ok || room.Type != world.CombatRoom {
+			continue
+		}
+		for _, inst := range handler.SpawnEnemiesForRoom(room) {
+			if node.Depth < median {
+				shallow = append(shallow, inst.Enemy)
+			} else {
+				deep = append(deep, inst.Enemy)
+			}
+		}
+	}
+	if len(shallow) == 0 || len(deep) == 0 {
+		t.Skip("world lacks combat rooms at both shallow and deep depths")
+	}
+
+	if s, d := meanThreat(shallow), meanThreat(deep); d <= s {
+		t.Errorf("mean threat deep = %.1f, shallow = %.1f; want deeper rooms stronger", d, s)
+	}
+}
+
+func TestSpawnPicksDependOnGameSeed(t *testing.T) {
+	cave := &world.Biome{Name: "cave", DangerLevel: 3}
+	var enemies []*entity.Enemy
+	for i := 0; i < 6; i++ {
+		enemies = append(enemies, &entity.Enemy{Name: string(rune('A' + i)), BiomeType: "cave", Health: 10 + i*10})
+	}
+	w := &world.World{Biomes: []*world.Biome{cave}, Graph: &world.WorldGraph{Nodes: map[int]*world.GraphNode{}}}
+	room := &world.Room{ID: 7, Type: world.CombatRoom, Biome: cave}
+
+	names := func(seed int64) string {
+		s := ""
+		for _, enemy := range selectBiomeEnemies(seed, w, room, enemies, 5) {
+			s += enemy.Name
+		}
+		return s
+	}
+	if names(42) != names(42) {
+		t.Error("same seed spawned different enemies in the same room")
+	}
+	for seed := int64(1); seed < 20; seed++ {
+		if names(seed) != names(0) {
+			return
+		}
+	}
+	t.Errorf("room %d spawned %s for every game seed", room.ID, names(0))
+}
+
+func TestSpawnPicksFillRoomFromFewKinds(t *testing.T) {
+	cave := &world.Biome{Name: "cave"}
+	enemies := []*entity.Enemy{{Name: "Bat", BiomeType: "cave", Health: 10}}
+	w := &world.World{Biomes: []*world.Biome{cave}, Graph: &world.WorldGraph{Nodes: map[int]*world.GraphNode{}}}
+	room := &world.Room{ID: 3, Type: world.CombatRoom, Biome: cave}
+
+	if picked := selectBiomeEnemies(42, w, room, enemies, 4); len(picked) != 4 {
+		t.Errorf("picked %d enemies from one kind, want 4", len(picked))
+	}
+	if picked := selectBiomeEnemies(42, w, room, nil, 4); len(picked) != 0 {
+		t.Errorf("picked %d enemies with no candidates", len(picked))
+	}
+}
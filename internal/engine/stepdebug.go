@@ -0,0 +1,46 @@
+package engine
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/input"
+)
+
+// Step-debug keys, active only in dev mode. While stepping, the simulation
+// is frozen and each advance press runs exactly one logic tick.
+const (
+	StepDebugToggleKey  = ebiten.KeyF6
+	StepDebugAdvanceKey = ebiten.KeyF7
+)
+
+// SetDevMode enables developer controls such as frame stepping. Turning dev
+// mode off also leaves step-debug mode.
+func (gr *GameRunner) SetDevMode(enabled bool) {
+	gr.devMode = enabled
+	if !enabled {
+		gr.stepDebug = false
+	}
+}
+
+// StepDebugging reports whether the simulation is frozen for frame stepping
+func (gr *GameRunner) StepDebugging() bool {
+	return gr.stepDebug
+}
+
+// updateStepDebug handles the step-debug keys for one frame and reports
+// whether the normal update should be skipped. An advance press while
+// stepping runs a single tick through Step.
+func (gr *GameRunner) updateStepDebug(toggle, advance bool, inputState input.InputState) (skip bool, err error) {
+	if !gr.devMode {
+		return false, nil
+	}
+	if toggle {
+		gr.stepDebug = !gr.stepDebug
+	}
+	if !gr.stepDebug {
+		return false, nil
+	}
+	if advance {
+		return true, gr.Step(inputState)
+	}
+	return true, nil
+}
@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/input"
+)
+
+func TestStepDebugAdvancesOnlyOnStep(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SetDevMode(true)
+	move := input.InputState{MoveRight: true}
+
+	if skip, err := gr.updateStepDebug(true, false, move); !skip || err != nil {
+		t.Fatalf("entering step mode: skip = %v, err = %v; want the frame skipped", skip, err)
+	}
+
+	frames, x, y := gr.playFrames, gr.game.Player.X, gr.game.Player.Y
+	for i := 0; i < 30; i++ {
+		if skip, _ := gr.updateStepDebug(false, false, move); !skip {
+			t.Fatal("expected frames to be skipped while stepping")
+		}
+	}
+	if gr.playFrames != frames || gr.game.Player.X != x || gr.game.Player.Y != y {
+		t.Fatalf("gr.game advanced without a step: frames %d -> %d, pos (%v, %v) -> (%v, %v)",
+			frames, gr.playFrames, x, y, gr.game.Player.X, gr.game.Player.Y)
+	}
+
+	for i := 1; i <= 3; i++ {
+		if _, err := gr.updateStepDebug(false, true, move); err != nil {
+			t.Fatalf("step error = %v", err)
+		}
+		if gr.playFrames != frames+int64(i) {
+			t.Fatalf("after %d steps played %d frames, want %d", i, gr.playFrames-frames, i)
+		}
+	}
+
+	// Leaving step mode hands the frame back to the normal update
+	if skip, _ := gr.updateStepDebug(true, false, move); skip {
+		t.Error("expected normal updates once step mode is left")
+	}
+}
+
+func TestStepDebugNeedsDevMode(t *testing.T) {
+	gr := newTestRunner(t)
+
+	if skip, _ := gr.updateStepDebug(true, false, input.InputState{}); skip || gr.StepDebugging() {
+		t.Error("step mode entered without dev mode")
+	}
+
+	gr.SetDevMode(true)
+	gr.updateStepDebug(true, false, input.InputState{})
+	gr.SetDevMode(false)
+	if gr.StepDebugging() {
+		t.Error("step mode survived turning dev mode off")
+	}
+}
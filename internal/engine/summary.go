@@ -0,0 +1,165 @@
+package engine
+
+import "github.com/opd-ai/vania/internal/pcg"
+
+// ContentSummary describes what was generated for a game: the data behind
+// the stats-only report, in a form that can also be emitted as JSON
+type ContentSummary struct {
+	Seed         int64                `json:"seed"`
+	Genre        string               `json:"genre"`
+	Narrative    NarrativeSummary     `json:"narrative"`
+	World        WorldSummary         `json:"world"`
+	Entities     EntitySummary        `json:"entities"`
+	Graphics     GraphicsSummary      `json:"graphics"`
+	Audio        AudioSummary         `json:"audio"`
+	Factions     []FactionSummary     `json:"factions"`
+	Achievements *AchievementsSummary `json:"achievements,omitempty"`
+}
+
+// NarrativeSummary holds the story setup
+type NarrativeSummary struct {
+	Theme            string `json:"theme"`
+	Mood             string `json:"mood"`
+	Civilization     string `json:"civilization"`
+	Catastrophe      string `json:"catastrophe"`
+	PlayerMotivation string `json:"player_motivation"`
+}
+
+// WorldSummary holds the world layout counts and biome list
+type WorldSummary struct {
+	Rooms     int              `json:"rooms"`
+	BossRooms int              `json:"boss_rooms"`
+	Width     int              `json:"width"`
+	Height    int              `json:"height"`
+	Biomes    []BiomeSummary   `json:"biomes"`
+	Pacing    pcg.PathAnalysis `json:"pacing"` // Critical path length and difficulty curve
+}
+
+// BiomeSummary describes one biome
+type BiomeSummary struct {
+	Name        string `json:"name"`
+	DangerLevel int    `json:"danger_level"`
+	Temperature int    `json:"temperature"`
+}
+
+// EntitySummary holds enemy, item and ability counts with boss and ability
+// details
+type EntitySummary struct {
+	Enemies   int              `json:"enemies"`
+	Bosses    []BossSummary    `json:"bosses"`
+	Items     int              `json:"items"`
+	Abilities []AbilitySummary `json:"abilities"`
+}
+
+// BossSummary describes one boss
+type BossSummary struct {
+	Name   string `json:"name"`
+	Health int    `json:"health"`
+	Phases int    `json:"phases"`
+}
+
+// AbilitySummary describes one ability in unlock order
+type AbilitySummary struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	UnlockOrder int    `json:"unlock_order"`
+}
+
+// GraphicsSummary holds generated graphics counts
+type GraphicsSummary struct {
+	Sprites  int `json:"sprites"`
+	Tilesets int `json:"tilesets"`
+}
+
+// AudioSummary holds generated audio counts
+type AudioSummary struct {
+	Sounds int `json:"sounds"`
+	Music  int `json:"music"`
+}
+
+// FactionSummary describes one faction
+type FactionSummary struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Relationship string `json:"relationship"`
+}
+
+// AchievementsSummary holds the achievement totals
+type AchievementsSummary struct {
+	Total     int `json:"total"`
+	MaxPoints int `json:"max_points"`
+}
+
+// NewContentSummary gathers the summary for a generated game
+func NewContentSummary(game *Game) ContentSummary {
+	s := ContentSummary{
+		Seed:  game.Seed,
+		Genre: game.Genre,
+		Narrative: NarrativeSummary{
+			Theme:            string(game.Narrative.Theme),
+			Mood:             string(game.Narrative.Mood),
+			Civilization:     game.Narrative.CivilizationType,
+			Catastrophe:      game.Narrative.Catastrophe,
+			PlayerMotivation: game.Narrative.PlayerMotivation,
+		},
+		World: WorldSummary{
+			Rooms:     len(game.World.Rooms),
+			BossRooms: len(game.World.BossRooms),
+			Width:     game.World.Width,
+			Height:    game.World.Height,
+			Biomes:    []BiomeSummary{},
+			Pacing:    pcg.AnalyzeCriticalPath(game.World),
+		},
+		Entities: EntitySummary{
+			Enemies:   len(game.Entities),
+			Bosses:    []BossSummary{},
+			Items:     len(game.Items),
+			Abilities: []AbilitySummary{},
+		},
+		Graphics: GraphicsSummary{
+			Sprites:  len(game.Graphics.Sprites),
+			Tilesets: len(game.Graphics.Tilesets),
+		},
+		Audio: AudioSummary{
+			Sounds: len(game.Audio.Sounds),
+			Music:  len(game.Audio.Music),
+		},
+		Factions: []FactionSummary{},
+	}
+
+	for _, biome := range game.World.Biomes {
+		s.World.Biomes = append(s.World.Biomes, BiomeSummary{
+			Name:        biome.Name,
+			DangerLevel: biome.DangerLevel,
+			Temperature: biome.Temperature,
+		})
+	}
+	for _, boss := range game.Bosses {
+		s.Entities.Bosses = append(s.Entities.Bosses, BossSummary{
+			Name:   boss.Name,
+			Health: boss.Health,
+			Phases: len(boss.Phases),
+		})
+	}
+	for _, ability := range game.Abilities {
+		s.Entities.Abilities = append(s.Entities.Abilities, AbilitySummary{
+			Name:        ability.Name,
+			Description: ability.Description,
+			UnlockOrder: ability.UnlockOrder,
+		})
+	}
+	for _, faction := range game.Narrative.Factions {
+		s.Factions = append(s.Factions, FactionSummary{
+			Name:         faction.Name,
+			Description:  faction.Description,
+			Relationship: faction.Relationship,
+		})
+	}
+	if game.Achievements != nil {
+		s.Achievements = &AchievementsSummary{
+			Total:     len(game.Achievements.GetAllAchievements()),
+			MaxPoints: game.Achievements.GetMaxPoints(),
+		}
+	}
+	return s
+}
@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestContentSummaryJSON(t *testing.T) {
+	game, err := NewGameGenerator(42).GenerateCompleteGame()
+	if err != nil {
+		t.Fatalf("GenerateCompleteGame() error = %v", err)
+	}
+
+	data, err := json.Marshal(NewContentSummary(game))
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	for _, key := range []string{"seed", "genre", "narrative", "world", "entities", "graphics", "audio", "factions", "achievements"} {
+		if _, ok := decoded[key]; !ok {
+			t.Errorf("summary JSON is missing key %q", key)
+		}
+	}
+
+	var summary ContentSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		t.Fatalf("json.Unmarshal() into ContentSummary error = %v", err)
+	}
+	if summary.Seed != 42 {
+		t.Errorf("seed = %d, want 42", summary.Seed)
+	}
+	if summary.World.Rooms != len(game.World.Rooms) {
+		t.Errorf("world.rooms = %d, want %d", summary.World.Rooms, len(game.World.Rooms))
+	}
+	if len(summary.World.Biomes) != len(game.World.Biomes) {
+		t.Errorf("world.biomes has %d entries, want %d", len(summary.World.Biomes), len(game.World.Biomes))
+	}
+	if summary.World.Pacing.Length == 0 || len(summary.World.Pacing.Curve) != summary.World.Pacing.Length {
+		t.Errorf("world.pacing = %+v, want the critical path curve", summary.World.Pacing)
+	}
+	if summary.Entities.Enemies != len(game.Entities) {
+		t.Errorf("entities.enemies = %d, want %d", summary.Entities.Enemies, len(game.Entities))
+	}
+	if len(summary.Entities.Bosses) != len(game.Bosses) {
+		t.Errorf("entities.bosses has %d entries, want %d", len(summary.Entities.Bosses), len(game.Bosses))
+	}
+	if len(summary.Entities.Abilities) != len(game.Abilities) {
+		t.Errorf("entities.abilities has %d entries, want %d", len(summary.Entities.Abilities), len(game.Abilities))
+	}
+	if summary.Graphics.Sprites != len(game.Graphics.Sprites) || summary.Audio.Sounds != len(game.Audio.Sounds) {
+		t.Errorf("graphics/audio counts = %+v/%+v, want %d sprites and %d sounds",
+			summary.Graphics, summary.Audio, len(game.Graphics.Sprites), len(game.Audio.Sounds))
+	}
+	if len(summary.Factions) != len(game.Narrative.Factions) {
+		t.Errorf("factions has %d entries, want %d", len(summary.Factions), len(game.Narrative.Factions))
+	}
+	if summary.Achievements == nil || summary.Achievements.Total == 0 {
+		t.Errorf("achievements = %+v, want a non-empty total", summary.Achievements)
+	}
+}
+
+func TestContentSummaryIsDeterministic(t *testing.T) {
+	encode := func() string {
+		game, err := NewGameGenerator(7).GenerateCompleteGame()
+		if err != nil {
+			t.Fatalf("GenerateCompleteGame() error = %v", err)
+		}
+		data, err := json.Marshal(NewContentSummary(game))
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		return string(data)
+	}
+	if a, b := encode(), encode(); a != b {
+		t.Error("summary JSON differs between runs with the same seed")
+	}
+}
@@ -0,0 +1,29 @@
+package engine
+
+import "github.com/opd-ai/vania/internal/entity"
+
+// MaxRoomEnemies caps how many living enemies a room holds. Summoned
+// minions wait for space rather than crowding past it.
+const MaxRoomEnemies = 8
+
+// liveEnemyCount returns how many enemies in the room are still alive
+func (gr *GameRunner) liveEnemyCount() int {
+	alive := 0
+	for _, enemy := range gr.enemyInstances {
+		if !enemy.IsDead() {
+			alive++
+		}
+	}
+	return alive
+}
+
+// spawnSummon adds a minion the enemy is ready to summon, if the room has
+// space for it
+func (gr *GameRunner) spawnSummon(enemy *entity.EnemyInstance) {
+	if gr.liveEnemyCount() >= MaxRoomEnemies {
+		return
+	}
+	if minion, ok := enemy.TakeSummon(); ok {
+		gr.enemyInstances = append(gr.enemyInstances, minion)
+	}
+}
@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+)
+
+// readySummoner returns an alerted summoner with a minion ready to call in
+func readySummoner() *entity.EnemyInstance {
+	summoner := entity.NewEnemyInstance(&entity.Enemy{
+		Health:     60,
+		Damage:     10,
+		Size:       entity.MediumEnemy,
+		Behavior:   entity.StationaryBehavior,
+		AttackType: entity.RangedAttack,
+		Summoner:   true,
+	}, 300, 300)
+	summoner.Alarm()
+	for i := 0; i < entity.SummonIntervalFrames; i++ {
+		summoner.Update(summoner.X+60, summoner.Y)
+	}
+	return summoner
+}
+
+func TestSpawnSummonAddsMinionToRoom(t *testing.T) {
+	gr := newTestRunner(t)
+
+	summoner := readySummoner()
+	gr.enemyInstances = []*entity.EnemyInstance{summoner}
+	gr.spawnSummon(summoner)
+
+	if len(gr.enemyInstances) != 2 {
+		t.Fatalf("room has %d enemies after a summon, want 2", len(gr.enemyInstances))
+	}
+	if gr.enemyInstances[1].Enemy.Size != entity.SmallEnemy {
+		t.Error("summoned enemy should be a small minion")
+	}
+}
+
+func TestSpawnSummonRespectsRoomCap(t *testing.T) {
+	gr := newTestRunner(t)
+
+	summoner := readySummoner()
+	gr.enemyInstances = []*entity.EnemyInstance{summoner}
+	for len(gr.enemyInstances) < MaxRoomEnemies {
+		gr.enemyInstances = append(gr.enemyInstances, entity.NewEnemyInstance(&entity.Enemy{Health: 10}, 0, 0))
+	}
+
+	gr.spawnSummon(summoner)
+	if len(gr.enemyInstances) != MaxRoomEnemies {
+		t.Fatalf("room has %d enemies, want the cap of %d", len(gr.enemyInstances), MaxRoomEnemies)
+	}
+
+	// Once something dies the waiting summon comes through
+	gr.enemyInstances[1].CurrentHealth = 0
+	gr.spawnSummon(summoner)
+	if len(gr.enemyInstances) != MaxRoomEnemies+1 {
+		t.Errorf("room has %d enemies after space opened, want %d", len(gr.enemyInstances), MaxRoomEnemies+1)
+	}
+}
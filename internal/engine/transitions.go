@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
 	"github.com/opd-ai/vania/internal/world"
 )
 
@@ -75,16 +76,8 @@ func (rth *RoomTransitionHandler) CheckDoorCollision(playerX, playerY, playerW,
 	for i := range rth.game.CurrentRoom.Doors {
 		door := &rth.game.CurrentRoom.Doors[i]
 
-		// Simple AABB collision
-		doorX := float64(door.X)
-		doorY := float64(door.Y)
-		doorW := float64(door.Width)
-		doorH := float64(door.Height)
-
-		if playerX < doorX+doorW &&
-			playerX+playerW > doorX &&
-			playerY < doorY+doorH &&
-			playerY+playerH > doorY {
+		if physics.AABBOverlap(playerX, playerY, playerW, playerH,
+			float64(door.X), float64(door.Y), float64(door.Width), float64(door.Height)) {
 
 			// Check if door is locked and not unlocked yet
 			doorKey := rth.GetDoorKey(door)
@@ -116,6 +109,12 @@ func (rth *RoomTransitionHandler) CanUnlockDoor(door *world.Door, playerAbilitie
 		return true
 	}
 
+	// Puzzle doors open only through the room's puzzle state, boss doors
+	// only when the boss falls, and hub exits only onto visited rooms
+	if door.PuzzleGated || door.BossGated || door.HubGated {
+		return false
+	}
+
 	// Check if player has required ability
 	// Door requirements are stored in world graph edges
 	if door.LeadsTo != nil {
@@ -197,10 +196,8 @@ func (rth *RoomTransitionHandler) CompleteTransition() {
 	// Switch to new room
 	rth.game.CurrentRoom = rth.targetRoom
 
-	// Position player based on entry direction
-	// For now, place player near the opposite side of where they entered
-	rth.game.Player.X = 100.0 // Default position
-	rth.game.Player.Y = 500.0
+	// Enter beside the door connecting back to the room just left
+	rth.game.Player.X, rth.game.Player.Y = entrySpawnPoint(rth.targetRoom, rth.sourceRoom, rth.slideDirection)
 
 	// Reset player velocity
 	rth.game.Player.VelX = 0
@@ -252,7 +249,19 @@ func (rth *RoomTransitionHandler) GetTargetRoom() *world.Room {
 func (rth *RoomTransitionHandler) SpawnEnemiesForRoom(room *world.Room) []*entity.EnemyInstance {
 	var enemyInstances []*entity.EnemyInstance
 
-	if room == nil || len(rth.game.Entities) == 0 {
+	// Save rooms are no-spawn zones
+	if room == nil || room.IsSafeHaven() {
+		return enemyInstances
+	}
+
+	// Boss rooms spawn their generated boss when one is available
+	if boss := rth.BossForRoom(room); boss != nil {
+		_, _, _, bh := entity.GetEnemySizeBounds(&boss.Enemy)
+		bossY := findGroundY(room) - bh
+		return append(enemyInstances, entity.NewEnemyInstance(&boss.Enemy, 600.0, bossY))
+	}
+
+	if len(rth.game.Entities) == 0 {
 		return enemyInstances
 	}
 
@@ -261,6 +270,9 @@ func (rth *RoomTransitionHandler) SpawnEnemiesForRoom(room *world.Room) []*entit
 	switch room.Type {
 	case world.CombatRoom:
 		enemyCount = 3 + (len(room.Enemies) % 3) // 3-5 enemies
+		if rth.game.World != nil {
+			enemyCount = world.ScaleCount(enemyCount, rth.game.World.EnemyDensity())
+		}
 	case world.BossRoom:
 		enemyCount = 1 // One boss
 	case world.TreasureRoom:
@@ -269,10 +281,8 @@ func (rth *RoomTransitionHandler) SpawnEnemiesForRoom(room *world.Room) []*entit
 		enemyCount = 0
 	}
 
-	// Spawn enemies
-	for i := 0; i < enemyCount && i < len(rth.game.Entities); i++ {
-		enemy := rth.game.Entities[i]
-
+	// Spawn enemies that belong to this room's biome
+	for i, enemy := range selectBiomeEnemies(rth.game.Seed, rth.game.World, room, rth.game.Entities, enemyCount) {
 		// Position enemies across the room on the ground platform
 		enemyX := 300.0 + float64(i*150)
 		_, _, _, eh := entity.GetEnemySizeBounds(enemy)
@@ -282,9 +292,72 @@ func (rth *RoomTransitionHandler) SpawnEnemiesForRoom(room *world.Room) []*entit
 		enemyInstances = append(enemyInstances, entity.NewEnemyInstance(enemy, enemyX, enemyY))
 	}
 
+	// A mini-boss holds the far side of its combat room
+	if mb := rth.MiniBossForRoom(room); mb != nil && room.Type == world.CombatRoom {
+		_, _, mw, mh := entity.GetEnemySizeBounds(&mb.Enemy)
+		mbX := float64(world.RoomPixelWidth) - 200.0 - mw/2
+		enemyInstances = append(enemyInstances, entity.NewEnemyInstance(&mb.Enemy, mbX, findGroundY(room)-mh))
+	}
+
 	return enemyInstances
 }
 
+// selectBiomeEnemies picks count generated enemies that inhabit the room's
+// biome: their BiomeType matches the biome and their Kind is one of the
+// biome's EnemyTypes. The same enemy may be picked more than once. Picks
+// are weighted towards stronger enemies in deeper rooms and more dangerous
+// biomes (see spawn_weight.go). Rooms without a biome fall back to the
+// first enemies in the list.
+func selectBiomeEnemies(seed int64, w *world.World, room *world.Room, enemies []*entity.Enemy, count int) []*entity.Enemy {
+	if room.Biome == nil {
+		if count > len(enemies) {
+			count = len(enemies)
+		}
+		return enemies[:count]
+	}
+
+	kinds := make(map[string]bool, len(room.Biome.EnemyTypes))
+	for _, kind := range room.Biome.EnemyTypes {
+		kinds[kind] = true
+	}
+
+	var candidates []*entity.Enemy
+	for _, enemy := range enemies {
+		if enemy.BiomeType != room.Biome.Name || enemy.Size == entity.BossEnemy {
+			continue
+		}
+		if len(kinds) > 0 && !kinds[enemy.Kind] {
+			continue
+		}
+		candidates = append(candidates, enemy)
+	}
+
+	return pickWeightedEnemies(seed, room, candidates, count, spawnTarget(w, room))
+}
+
+// BossForRoom returns the boss generated for a boss room, or nil. Bosses are
+// generated in world room order, so the boss index is the number of boss
+// rooms that precede this one.
+func (rth *RoomTransitionHandler) BossForRoom(room *world.Room) *entity.Boss {
+	if room == nil || room.Type != world.BossRoom || rth.game.World == nil {
+		return nil
+	}
+
+	index := 0
+	for _, r := range rth.game.World.Rooms {
+		if r == room {
+			if index < len(rth.game.Bosses) {
+				return rth.game.Bosses[index]
+			}
+			return nil
+		}
+		if r.Type == world.BossRoom {
+			index++
+		}
+	}
+	return nil
+}
+
 // SpawnItemsForRoom creates item instances for the current room
 func (rth *RoomTransitionHandler) SpawnItemsForRoom(room *world.Room) []*entity.ItemInstance {
 	return createItemInstancesForRoom(room, rth.game.Items)
@@ -3,6 +3,7 @@ package engine
 import (
 	"testing"
 
+	"github.com/opd-ai/vania/internal/entity"
 	"github.com/opd-ai/vania/internal/world"
 )
 
@@ -603,3 +604,76 @@ func TestRoomTransitionHandler_findEdgeRequirement(t *testing.T) {
 		})
 	}
 }
+
+func TestRoomTransitionHandler_SpawnBossForRoom(t *testing.T) {
+	combat := &world.Room{ID: 0, Type: world.CombatRoom}
+	boss1 := &world.Room{ID: 1, Type: world.BossRoom}
+	boss2 := &world.Room{ID: 2, Type: world.BossRoom}
+
+	game := &Game{
+		World: &world.World{Rooms: []*world.Room{combat, boss1, boss2}},
+		Bosses: []*entity.Boss{
+			{Enemy: entity.Enemy{Name: "First", Health: 200, Size: entity.BossEnemy}},
+			{Enemy: entity.Enemy{Name: "Second", Health: 200, Size: entity.BossEnemy}},
+		},
+	}
+	handler := NewRoomTransitionHandler(game)
+
+	if boss := handler.BossForRoom(combat); boss != nil {
+		t.Errorf("BossForRoom(combat) = %q, want nil", boss.Name)
+	}
+
+	enemies := handler.SpawnEnemiesForRoom(boss2)
+	if len(enemies) != 1 {
+		t.Fatalf("SpawnEnemiesForRoom(boss room) returned %d enemies, want 1", len(enemies))
+	}
+	if enemies[0].Enemy.Name != "Second" {
+		t.Errorf("spawned boss = %q, want %q", enemies[0].Enemy.Name, "Second")
+	}
+}
+
+func TestRoomTransitionHandler_CanUnlockDoor_PuzzleGated(t *testing.T) {
+	game := &Game{CurrentRoom: &world.Room{ID: 1, Type: world.PuzzleRoom}}
+	handler := NewRoomTransitionHandler(game)
+
+	door := &world.Door{Locked: true, PuzzleGated: true}
+	if handler.CanUnlockDoor(door, map[string]bool{"dash": true}, nil) {
+		t.Error("CanUnlockDoor() = true for puzzle-gated door, want false")
+	}
+}
+
+func TestRoomTransitionHandler_SpawnEnemiesForRoom_BiomeFiltered(t *testing.T) {
+	cave := world.NewBiomeGenerator().Generate("cave", 1)
+	game := &Game{
+		Entities: []*entity.Enemy{
+			{Name: "Sky Bird", BiomeType: "sky", Kind: "bird", Health: 10},
+			{Name: "Cave Bat", BiomeType: "cave", Kind: "bat", Health: 10},
+			{Name: "Forest Wolf", BiomeType: "forest", Kind: "wolf", Health: 10},
+			{Name: "Cave Slime", BiomeType: "cave", Kind: "slime", Health: 10},
+			{Name: "Stray Bird", BiomeType: "cave", Kind: "bird", Health: 10},
+			{Name: "Cave Spider", BiomeType: "cave", Kind: "spider", Health: 10},
+		},
+	}
+	handler := NewRoomTransitionHandler(game)
+
+	room := &world.Room{ID: 7, Type: world.CombatRoom, Biome: cave, Enemies: make([]interface{}, 2)}
+	enemies := handler.SpawnEnemiesForRoom(room)
+	if len(enemies) == 0 {
+		t.Fatal("SpawnEnemiesForRoom() spawned no enemies in a cave room")
+	}
+
+	for _, inst := range enemies {
+		if inst.Enemy.BiomeType != "cave" {
+			t.Errorf("spawned %q with BiomeType %q in cave room", inst.Enemy.Name, inst.Enemy.BiomeType)
+		}
+		matched := false
+		for _, kind := range cave.EnemyTypes {
+			if inst.Enemy.Kind == kind {
+				matched = true
+			}
+		}
+		if !matched {
+			t.Errorf("spawned %q of kind %q, not in cave EnemyTypes %v", inst.Enemy.Name, inst.Enemy.Kind, cave.EnemyTypes)
+		}
+	}
+}
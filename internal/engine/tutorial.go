@@ -0,0 +1,192 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/input"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Tutorial pacing
+const (
+	TutorialMoveFrames    = 45  // Frames of movement input that complete the move prompt
+	TutorialAbilityFrames = 240 // How long the ability prompt stays up
+	tutorialKeysShown     = 2   // Keys listed per action, to fit the message box
+)
+
+// TutorialAction is what the player does to finish a tutorial step
+type TutorialAction int
+
+const (
+	TutorialMove TutorialAction = iota
+	TutorialJump
+	TutorialAttack
+	TutorialAbility // Timed: the ability is introduced, not yet owned
+)
+
+// TutorialStep is one prompt of the intro sequence
+type TutorialStep struct {
+	Action TutorialAction
+	Prompt string
+}
+
+// tutorialAbilityHints maps an ability to the binding that uses it and how
+var tutorialAbilityHints = map[string]struct{ action, verb string }{
+	"Double Jump":   {"jump", "Jump again in mid-air"},
+	"Dash":          {"dash", "Dash"},
+	"Glide":         {"ability", "Hold while falling"},
+	"Projectile":    {"ranged", "Shoot"},
+	"Charge Attack": {"attack", "Hold to charge"},
+	"Shield":        {"block", "Block"},
+}
+
+// defaultTutorialBindings returns the default keys for each action the
+// tutorial mentions, keyed like the settings menu's bindings
+func defaultTutorialBindings() map[string][]ebiten.Key {
+	km := input.DefaultKeyMapping()
+	return map[string][]ebiten.Key{
+		"move_left":  km.MoveLeft,
+		"move_right": km.MoveRight,
+		"jump":       km.Jump,
+		"attack":     km.Attack,
+		"dash":       km.Dash,
+		"ability":    km.UseAbility,
+		"ranged":     km.RangedAttack,
+		"block":      km.Block,
+	}
+}
+
+// GenerateTutorial builds the intro prompts for movement, jumping,
+// attacking and, when first is non-nil, the first ability in the world.
+// bindings uses the settings menu's action names; actions it leaves out
+// fall back to the default keys.
+func GenerateTutorial(first *entity.Ability, bindings map[string][]ebiten.Key) []TutorialStep {
+	keys := defaultTutorialBindings()
+	for action, bound := range bindings {
+		if len(bound) > 0 {
+			keys[action] = bound
+		}
+	}
+	names := func(action string) string {
+		bound := keys[action]
+		if len(bound) > tutorialKeysShown {
+			bound = bound[:tutorialKeysShown]
+		}
+		parts := make([]string, len(bound))
+		for i, key := range bound {
+			parts[i] = key.String()
+		}
+		return strings.Join(parts, " / ")
+	}
+
+	steps := []TutorialStep{
+		{TutorialMove, fmt.Sprintf("Move: %s\nand %s", names("move_left"), names("move_right"))},
+		{TutorialJump, fmt.Sprintf("Jump: %s", names("jump"))},
+		{TutorialAttack, fmt.Sprintf("Attack: %s", names("attack"))},
+	}
+	if first != nil {
+		prompt := fmt.Sprintf("Ahead: %s\nFind it to go further", first.Name)
+		if hint, ok := tutorialAbilityHints[first.Name]; ok {
+			prompt = fmt.Sprintf("Ahead: %s\n%s: %s", first.Name, hint.verb, names(hint.action))
+		}
+		steps = append(steps, TutorialStep{TutorialAbility, prompt})
+	}
+	return steps
+}
+
+// Tutorial walks the player through a list of steps, one prompt at a time
+type Tutorial struct {
+	steps  []TutorialStep
+	step   int
+	frames int // Progress on the current step
+}
+
+// NewTutorial creates a tutorial starting at the first step
+func NewTutorial(steps []TutorialStep) *Tutorial {
+	return &Tutorial{steps: steps}
+}
+
+// Prompt returns the current step's prompt, or "" once done
+func (t *Tutorial) Prompt() string {
+	if t.Done() {
+		return ""
+	}
+	return t.steps[t.step].Prompt
+}
+
+// Done reports whether every step is complete
+func (t *Tutorial) Done() bool {
+	return t.step >= len(t.steps)
+}
+
+// Update advances the current step from this frame's input
+func (t *Tutorial) Update(in input.InputState) {
+	if t.Done() {
+		return
+	}
+	complete := false
+	switch t.steps[t.step].Action {
+	case TutorialMove:
+		if in.MoveLeft || in.MoveRight {
+			t.frames++
+		}
+		complete = t.frames >= TutorialMoveFrames
+	case TutorialJump:
+		complete = in.JumpPress
+	case TutorialAttack:
+		complete = in.AttackPress
+	case TutorialAbility:
+		t.frames++
+		complete = t.frames >= TutorialAbilityFrames
+	}
+	if complete {
+		t.step++
+		t.frames = 0
+	}
+}
+
+// firstAbility returns the first ability the player will find, if any
+func firstAbility(game *Game) *entity.Ability {
+	if len(game.AbilityPedestals) == 0 {
+		return nil
+	}
+	return &game.AbilityPedestals[0].Ability
+}
+
+// SetTutorialBindings rewrites the tutorial prompts for the player's key
+// bindings, keeping their progress
+func (gr *GameRunner) SetTutorialBindings(bindings map[string][]ebiten.Key) {
+	if gr.tutorial == nil {
+		return
+	}
+	gr.tutorial.steps = GenerateTutorial(firstAbility(gr.game), bindings)
+}
+
+// SkipTutorial ends the intro sequence
+func (gr *GameRunner) SkipTutorial() {
+	gr.tutorial = nil
+}
+
+// TutorialPrompt returns the prompt on screen, or "" when there is none
+func (gr *GameRunner) TutorialPrompt() string {
+	if gr.tutorial == nil {
+		return ""
+	}
+	return gr.tutorial.Prompt()
+}
+
+// updateTutorial advances the intro sequence. It only runs in the start
+// room and ends for good once the player leaves it or finishes.
+func (gr *GameRunner) updateTutorial(in input.InputState) {
+	if gr.tutorial == nil {
+		return
+	}
+	if gr.tutorial.Done() || gr.game.CurrentRoom == nil || gr.game.CurrentRoom.Type != world.StartRoom {
+		gr.tutorial = nil
+		return
+	}
+	gr.tutorial.Update(in)
+}
@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/input"
+)
+
+func TestTutorialPromptsUseBoundKeys(t *testing.T) {
+	bindings := map[string][]ebiten.Key{
+		"move_left":  {ebiten.KeyH},
+		"move_right": {ebiten.KeyL},
+		"jump":       {ebiten.KeyK},
+		"attack":     {ebiten.KeyU},
+		"dash":       {ebiten.KeyO},
+	}
+	steps := GenerateTutorial(&entity.Ability{Name: "Dash"}, bindings)
+	if len(steps) != 4 {
+		t.Fatalf("got %d steps, want move, jump, attack and ability", len(steps))
+	}
+
+	want := [][]string{{"H", "L"}, {"K"}, {"U"}, {"Dash", "O"}}
+	for i, step := range steps {
+		for _, name := range want[i] {
+			if !strings.Contains(step.Prompt, name) {
+				t.Errorf("step %d prompt %q does not mention %q", i, step.Prompt, name)
+			}
+		}
+	}
+	if strings.Contains(steps[1].Prompt, "Space") {
+		t.Errorf("jump prompt %q still shows the default key", steps[1].Prompt)
+	}
+}
+
+func TestTutorialRebindsMidSequence(t *testing.T) {
+	gr := newTestRunner(t)
+	if gr.TutorialPrompt() == "" {
+		t.Fatal("a new game should open with a tutorial prompt")
+	}
+	for i := 0; i < TutorialMoveFrames; i++ {
+		gr.updateTutorial(input.InputState{MoveRight: true})
+	}
+
+	gr.SetTutorialBindings(map[string][]ebiten.Key{"jump": {ebiten.KeyG}})
+	if prompt := gr.TutorialPrompt(); !strings.HasPrefix(prompt, "Jump") || !strings.Contains(prompt, "G") {
+		t.Errorf("prompt after moving and rebinding = %q, want the jump step on G", prompt)
+	}
+
+	gr.updateTutorial(input.InputState{JumpPress: true})
+	gr.updateTutorial(input.InputState{AttackPress: true})
+	if !strings.HasPrefix(gr.TutorialPrompt(), "Ahead") {
+		t.Errorf("prompt = %q, want the ability introduction", gr.TutorialPrompt())
+	}
+}
+
+func TestSkipTutorialSuppressesSequence(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.SkipTutorial()
+	gr.SetTutorialBindings(map[string][]ebiten.Key{"jump": {ebiten.KeyG}})
+	gr.updateTutorial(input.InputState{})
+
+	if prompt := gr.TutorialPrompt(); prompt != "" {
+		t.Errorf("skipped tutorial still shows %q", prompt)
+	}
+}
@@ -0,0 +1,38 @@
+package engine
+
+// DodgeInvulnerabilityFrames is how long a dodge makes the player immune to
+// hits, unblockable ones included
+const DodgeInvulnerabilityFrames = 12
+
+// StartDodge gives the player dodge i-frames
+func (cs *CombatSystem) StartDodge() {
+	cs.dodgeFrames = DodgeInvulnerabilityFrames
+}
+
+// IsDodging reports whether the player is inside dodge i-frames
+func (cs *CombatSystem) IsDodging() bool {
+	return cs.dodgeFrames > 0
+}
+
+// ignoresHits reports whether the player is immune to damage right now,
+// either from post-hit invulnerability or a dodge
+func (cs *CombatSystem) ignoresHits() bool {
+	return cs.invulnerableFrames > 0 || cs.dodgeFrames > 0
+}
+
+// ApplyUnblockableDamageToPlayer applies damage that a parry cannot catch,
+// pushing the player away from sourceX. Only invulnerability or a dodge
+// avoids it.
+func (cs *CombatSystem) ApplyUnblockableDamageToPlayer(player *Player, damage int, sourceX float64) {
+	dirX := 1.0
+	if player.X < sourceX {
+		dirX = -1.0
+	}
+	cs.applyDamageToPlayer(player, damage, dirX, 0, false)
+}
+
+// Unblockable reports whether the boss's current move must be dodged
+// rather than parried
+func (bc *BossController) Unblockable() bool {
+	return bc.frame.Move != nil && bc.frame.Move.Hitbox != nil && bc.frame.Move.Hitbox.Unblockable
+}
@@ -0,0 +1,85 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/physics"
+)
+
+// runBossHit drives a one-move boss until its hitbox lands on the player,
+// calling defend just before the active frame
+func runBossHit(unblockable bool, defend func(cs *CombatSystem)) *Player {
+	boss := &entity.Boss{
+		Enemy:  entity.Enemy{Name: "Warden", Health: 100, Size: entity.BossEnemy},
+		Phases: []entity.BossPhase{{HealthThreshold: 1}},
+		AttackPatterns: []entity.AttackPattern{{
+			Name: "test",
+			Moves: []entity.AttackMove{{
+				TelegraphFrames: 2, ActiveFrames: 1, RecoveryFrames: 1,
+				Hitbox: &entity.PatternHitbox{OffsetX: -200, Width: 400, Height: 200,
+					Damage: 10, Unblockable: unblockable},
+			}},
+		}},
+	}
+	bc := NewBossController(boss, entity.NewEnemyInstance(&boss.Enemy, 200, 100))
+	cs := NewCombatSystem()
+	player := &Player{Health: 100, MaxHealth: 100, X: 220, Y: 120}
+
+	for i := 0; i < BossPatternCooldownFrames+2; i++ {
+		bc.Update(player, physics.PlayerHeight, cs)
+	}
+	defend(cs)
+	bc.Update(player, physics.PlayerHeight, cs)
+	return player
+}
+
+func TestUnblockableAttackIgnoresParry(t *testing.T) {
+	parry := func(cs *CombatSystem) {
+		if !cs.PlayerParry() {
+			t.Fatal("PlayerParry() = false")
+		}
+	}
+
+	if player := runBossHit(false, parry); player.Health != 100 {
+		t.Errorf("parried blockable hit: health = %d, want 100", player.Health)
+	}
+	if player := runBossHit(true, parry); player.Health != 90 {
+		t.Errorf("parried unblockable hit: health = %d, want 90", player.Health)
+	}
+}
+
+func TestDodgeAvoidsUnblockableAttack(t *testing.T) {
+	player := runBossHit(true, (*CombatSystem).StartDodge)
+	if player.Health != 100 {
+		t.Errorf("dodged unblockable hit: health = %d, want 100", player.Health)
+	}
+}
+
+func TestDodgeFramesExpire(t *testing.T) {
+	cs := NewCombatSystem()
+	cs.StartDodge()
+	for i := 0; i < DodgeInvulnerabilityFrames; i++ {
+		if !cs.IsDodging() {
+			t.Fatalf("dodge ended after %d frames, want %d", i, DodgeInvulnerabilityFrames)
+		}
+		cs.Update()
+	}
+	if cs.IsDodging() {
+		t.Error("dodge i-frames did not expire")
+	}
+
+	player := &Player{Health: 100, MaxHealth: 100}
+	cs.ApplyUnblockableDamageToPlayer(player, 10, 50)
+	if player.Health != 90 {
+		t.Errorf("health after the dodge = %d, want 90", player.Health)
+	}
+}
+
+func TestDashStartsDodge(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.executeDash(1)
+	if !gr.combatSystem.IsDodging() {
+		t.Error("dashing should grant dodge i-frames")
+	}
+}
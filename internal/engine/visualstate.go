@@ -0,0 +1,176 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/opd-ai/vania/internal/particle"
+)
+
+// VisualState is a serializable record of everything that decides how a
+// frame looks: camera, player and enemy positions, live particles, and the
+// runtime RNG that drives further effects. Restoring it into a runner for
+// the same seed and room reproduces the captured frame, for screenshots and
+// bug reports.
+type VisualState struct {
+	Seed      int64             `json:"seed"`
+	Genre     string            `json:"genre"`
+	RoomID    int               `json:"room_id"`
+	Frame     int64             `json:"frame"`
+	RNGState  uint64            `json:"rng_state"`
+	CameraX   float64           `json:"camera_x"`
+	CameraY   float64           `json:"camera_y"`
+	Player    EntityVisual      `json:"player"`
+	Enemies   []EntityVisual    `json:"enemies"`
+	Particles particle.Snapshot `json:"particles"`
+}
+
+// EntityVisual is the captured position and motion of one entity
+type EntityVisual struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	VelX   float64 `json:"vel_x"`
+	VelY   float64 `json:"vel_y"`
+	Facing float64 `json:"facing,omitempty"` // Player only
+	Health int     `json:"health"`
+}
+
+// CaptureVisualState records the runner's current visual state
+func (gr *GameRunner) CaptureVisualState() *VisualState {
+	state := &VisualState{
+		Seed:      gr.game.Seed,
+		Genre:     gr.game.Genre,
+		Frame:     gr.playFrames,
+		RNGState:  gr.rng.State(),
+		Particles: gr.particleSystem.Snapshot(),
+	}
+	if gr.game.CurrentRoom != nil {
+		state.RoomID = gr.game.CurrentRoom.ID
+	}
+	state.CameraX, state.CameraY = gr.renderer.CameraPosition()
+
+	player := gr.game.Player
+	state.Player = EntityVisual{
+		X: player.X, Y: player.Y,
+		VelX: player.VelX, VelY: player.VelY,
+		Facing: gr.playerFacingDir,
+		Health: player.Health,
+	}
+
+	state.Enemies = make([]EntityVisual, len(gr.enemyInstances))
+	for i, enemy := range gr.enemyInstances {
+		state.Enemies[i] = EntityVisual{
+			X: enemy.X, Y: enemy.Y,
+			VelX: enemy.VelX, VelY: enemy.VelY,
+			Health: enemy.CurrentHealth,
+		}
+	}
+	return state
+}
+
+// RestoreVisualState puts the runner back into a captured visual state. The
+// state must come from the same seed and be restored while in the room it
+// was captured in, so that the enemies line up.
+func (gr *GameRunner) RestoreVisualState(state *VisualState) error {
+	if state.Seed != gr.game.Seed {
+		return fmt.Errorf("visual state is for seed %d, game uses seed %d", state.Seed, gr.game.Seed)
+	}
+	if gr.game.CurrentRoom == nil || gr.game.CurrentRoom.ID != state.RoomID {
+		return fmt.Errorf("visual state was captured in room %d", state.RoomID)
+	}
+	if len(state.Enemies) != len(gr.enemyInstances) {
+		return fmt.Errorf("visual state has %d enemies, room has %d", len(state.Enemies), len(gr.enemyInstances))
+	}
+
+	player := gr.game.Player
+	player.X, player.Y = state.Player.X, state.Player.Y
+	player.VelX, player.VelY = state.Player.VelX, state.Player.VelY
+	player.Health = state.Player.Health
+	gr.playerBody.Position.X, gr.playerBody.Position.Y = state.Player.X, state.Player.Y
+	gr.playerBody.Velocity.X, gr.playerBody.Velocity.Y = state.Player.VelX, state.Player.VelY
+	if state.Player.Facing != 0 {
+		gr.playerFacingDir = state.Player.Facing
+	}
+
+	for i, enemy := range gr.enemyInstances {
+		e := state.Enemies[i]
+		enemy.X, enemy.Y = e.X, e.Y
+		enemy.VelX, enemy.VelY = e.VelX, e.VelY
+		enemy.CurrentHealth = e.Health
+	}
+
+	gr.renderer.SetCameraPosition(state.CameraX, state.CameraY)
+	gr.rng.SetState(state.RNGState)
+	gr.particleSystem.Restore(state.Particles, gr.particlePresets.Rand)
+	gr.playFrames = state.Frame
+	return nil
+}
+
+// DefaultVisualStatePath returns where the debug capture key writes the
+// visual state, ~/.vania/visual_state.json
+func DefaultVisualStatePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".vania", "visual_state.json"), nil
+}
+
+// SaveVisualState writes state to path as JSON
+func SaveVisualState(path string, state *VisualState) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create visual state directory: %w", err)
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode visual state: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write visual state: %w", err)
+	}
+	return nil
+}
+
+// LoadVisualState reads a visual state written by SaveVisualState
+func LoadVisualState(path string) (*VisualState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read visual state: %w", err)
+	}
+	var state VisualState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode visual state: %w", err)
+	}
+	return &state, nil
+}
+
+// handleVisualStateKeys captures (F9) or restores (F10) the visual state
+// while debug info is shown, reporting the outcome in the item message
+func (gr *GameRunner) handleVisualStateKeys(capture, restore bool) {
+	if !capture && !restore {
+		return
+	}
+	path, err := DefaultVisualStatePath()
+	if err == nil {
+		if capture {
+			err = SaveVisualState(path, gr.CaptureVisualState())
+		} else {
+			var state *VisualState
+			if state, err = LoadVisualState(path); err == nil {
+				err = gr.RestoreVisualState(state)
+			}
+		}
+	}
+
+	switch {
+	case err != nil:
+		gr.itemMessage = err.Error()
+	case capture:
+		gr.itemMessage = "Visual state saved"
+	default:
+		gr.itemMessage = "Visual state restored"
+	}
+	gr.itemMessageTimer = itemMessageDuration
+}
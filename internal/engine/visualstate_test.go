@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/input"
+)
+
+func TestVisualStateRoundTrip(t *testing.T) {
+	gr := newTestRunner(t)
+	step := func(frames int) {
+		for i := 0; i < frames; i++ {
+			state := input.InputState{MoveRight: i%40 < 20, MoveLeft: i%40 >= 20, JumpPress: i%25 == 0}
+			if err := gr.Step(state); err != nil {
+				t.Fatalf("Step() error = %v", err)
+			}
+		}
+	}
+
+	step(60)
+	burst := gr.particlePresets.CreateExplosion(gr.game.Player.X, gr.game.Player.Y, 1.0)
+	burst.Burst(20)
+	gr.particleSystem.AddEmitter(burst)
+	gr.renderer.SetCameraPosition(-12, -34)
+
+	captured := gr.CaptureVisualState()
+	path := filepath.Join(t.TempDir(), "visual_state.json")
+	if err := SaveVisualState(path, captured); err != nil {
+		t.Fatalf("SaveVisualState() error = %v", err)
+	}
+
+	// Move everything on before restoring
+	step(45)
+	gr.renderer.SetCameraPosition(0, 0)
+
+	loaded, err := LoadVisualState(path)
+	if err != nil {
+		t.Fatalf("LoadVisualState() error = %v", err)
+	}
+	if err := gr.RestoreVisualState(loaded); err != nil {
+		t.Fatalf("RestoreVisualState() error = %v", err)
+	}
+
+	restored := gr.CaptureVisualState()
+	if !reflect.DeepEqual(restored, captured) {
+		t.Errorf("restored visual state differs from the capture:\n got %+v\nwant %+v", restored, captured)
+	}
+	if x, y := gr.renderer.CameraPosition(); x != -12 || y != -34 {
+		t.Errorf("camera at (%v, %v), want (-12, -34)", x, y)
+	}
+	if gr.playerBody.Position.X != captured.Player.X || gr.playerBody.Position.Y != captured.Player.Y {
+		t.Errorf("player body at (%v, %v), want (%v, %v)",
+			gr.playerBody.Position.X, gr.playerBody.Position.Y, captured.Player.X, captured.Player.Y)
+	}
+}
+
+func TestRestoreVisualStateRejectsOtherSeed(t *testing.T) {
+	gr := newTestRunner(t)
+	state := gr.CaptureVisualState()
+	state.Seed++
+	if err := gr.RestoreVisualState(state); err == nil {
+		t.Error("RestoreVisualState() accepted a state from another seed")
+	}
+}
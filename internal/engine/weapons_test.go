@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/entity"
+	"github.com/opd-ai/vania/internal/input"
+)
+
+// activeHitbox swings and advances into the active frames
+func activeHitbox(cs *CombatSystem) (x, y, w, h float64) {
+	cs.PlayerAttack()
+	for i := 0; i < 3; i++ {
+		cs.Update()
+	}
+	return cs.GetAttackHitbox(100, 100, -1)
+}
+
+func TestSwapWeaponChangesHitboxAndDamage(t *testing.T) {
+	cs := NewCombatSystem()
+	spear := WeaponFromItem(&entity.Item{Name: "Shadow Spear", Type: entity.WeaponItem, Value: 50})
+	cs.EquipWeapon(spear)
+
+	x, _, w, h := activeHitbox(cs)
+	if w != 40 || h != 32 || x != 60 {
+		t.Fatalf("default hitbox = x %v, %vx%v; want x 60, 40x32", x, w, h)
+	}
+	if got := cs.AttackDamage(10); got != 10 {
+		t.Errorf("default AttackDamage(10) = %d, want 10", got)
+	}
+
+	if cs.SwapWeapon() {
+		t.Error("SwapWeapon() succeeded mid-swing")
+	}
+	for cs.IsPlayerAttacking() || !cs.CanAttack() {
+		cs.Update()
+	}
+	if !cs.SwapWeapon() {
+		t.Fatal("SwapWeapon() failed with two weapons carried")
+	}
+	if cs.ActiveWeapon().Name != "Shadow Spear" {
+		t.Fatalf("active weapon = %q, want Shadow Spear", cs.ActiveWeapon().Name)
+	}
+
+	x, _, w, h = activeHitbox(cs)
+	if w != 64 || h != 16 || x != 36 {
+		t.Errorf("spear hitbox = x %v, %vx%v; want x 36, 64x16", x, w, h)
+	}
+	if got := cs.AttackDamage(10); got != 17 {
+		t.Errorf("spear AttackDamage(10) = %d, want 17", got)
+	}
+	if cs.playerAttackCooldown != spear.CooldownFrames-3 {
+		t.Errorf("cooldown = %d, want the spear's %d less 3 frames", cs.playerAttackCooldown, spear.CooldownFrames)
+	}
+}
+
+func TestRangedShotsShareWeaponBonus(t *testing.T) {
+	cs := NewCombatSystem()
+	if got := cs.RangedDamage(10); got != 10 {
+		t.Errorf("default RangedDamage(10) = %d, want 10", got)
+	}
+
+	// A damage pickup equips its weapon; the shot that follows hits harder
+	cs.EquipWeapon(WeaponFromItem(&entity.Item{Name: "Shadow Spear", Type: entity.WeaponItem, Value: 50}))
+	cs.SwapWeapon()
+	if got := cs.RangedDamage(10); got != 17 {
+		t.Errorf("spear RangedDamage(10) = %d, want 17", got)
+	}
+	cs.PlayerRangedAttack(100, 100, 1, cs.RangedDamage(10))
+	if shots := cs.GetProjectiles(); len(shots) != 1 || shots[0].Damage != 17 {
+		t.Errorf("projectiles = %+v, want one shot dealing 17", shots)
+	}
+}
+
+func TestEquipWeaponReplacesActiveWhenFull(t *testing.T) {
+	cs := NewCombatSystem()
+	cs.EquipWeapon(WeaponFromItem(&entity.Item{Name: "Holy Axe"}))
+	cs.EquipWeapon(WeaponFromItem(&entity.Item{Name: "Frozen Dagger"}))
+
+	weapons := cs.Weapons()
+	if len(weapons) != WeaponSlots {
+		t.Fatalf("carrying %d weapons, want %d", len(weapons), WeaponSlots)
+	}
+	if weapons[0].Name != "Frozen Dagger" || weapons[1].Name != "Holy Axe" {
+		t.Errorf("slots = %q, %q; want the dagger to replace the active default", weapons[0].Name, weapons[1].Name)
+	}
+}
+
+func TestDamagePickupRaisesRangedDamage(t *testing.T) {
+	gr := newTestRunner(t)
+	gr.game.Player.Abilities["ranged"] = true
+	base := gr.game.Player.Damage
+
+	pickup := &entity.Item{Name: "Holy Axe", Type: entity.WeaponItem, Effect: "increase_damage", Value: 20}
+	gr.collectItem(entity.NewItemInstance(pickup, 1, 0, 0))
+	gr.combatSystem.SwapWeapon()
+
+	gr.updatePlayerAttacks(input.InputState{RangedAttackPress: true})
+	shots := gr.combatSystem.GetProjectiles()
+	if len(shots) != 1 {
+		t.Fatalf("fired %d shots, want 1", len(shots))
+	}
+	if want := base + WeaponFromItem(pickup).DamageBonus; shots[0].Damage != want {
+		t.Errorf("shot damage = %d after the pickup, want %d", shots[0].Damage, want)
+	}
+}
@@ -0,0 +1,76 @@
+package entity
+
+// AggressionProfile is an enemy's temperament. It sets how early the enemy
+// retreats, how quickly it learns the player, and which tactics it leans
+// toward, so enemies with the same stats still fight differently.
+type AggressionProfile int
+
+const (
+	BalancedAggression AggressionProfile = iota
+	CautiousAggression
+	RecklessAggression
+)
+
+// aggressionTuning holds a profile's AIMemory starting values and its
+// tactical thresholds, as fractions of full health
+type aggressionTuning struct {
+	retreatThreshold float64 // Retreat below this health
+	learningRate     float64
+	aggressiveAbove  float64 // Push an advantage above this health
+	defensiveBelow   float64 // Turn defensive under pressure below this health
+}
+
+// aggressionTunings maps each profile to its tuning. Balanced keeps the
+// original AIMemory defaults.
+var aggressionTunings = map[AggressionProfile]aggressionTuning{
+	BalancedAggression: {retreatThreshold: 0.3, learningRate: 0.05, aggressiveAbove: 0.6, defensiveBelow: 0.5},
+	CautiousAggression: {retreatThreshold: 0.5, learningRate: 0.1, aggressiveAbove: 0.8, defensiveBelow: 0.7},
+	RecklessAggression: {retreatThreshold: 0.1, learningRate: 0.02, aggressiveAbove: 0.3, defensiveBelow: 0.2},
+}
+
+var aggressionNames = map[string]AggressionProfile{
+	"balanced": BalancedAggression,
+	"cautious": CautiousAggression,
+	"reckless": RecklessAggression,
+}
+
+// String returns the profile's name
+func (p AggressionProfile) String() string {
+	for name, profile := range aggressionNames {
+		if profile == p {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// tuning returns the profile's tuning, falling back to balanced
+func (p AggressionProfile) tuning() aggressionTuning {
+	if t, ok := aggressionTunings[p]; ok {
+		return t
+	}
+	return aggressionTunings[BalancedAggression]
+}
+
+// SetAggression gives the memory a profile's starting retreat threshold and
+// learning rate. Learning moves them on from there as the fight goes.
+func (mem *AIMemory) SetAggression(profile AggressionProfile) {
+	t := profile.tuning()
+	mem.Aggression = profile
+	mem.RetreatThreshold = t.retreatThreshold
+	mem.LearningRate = t.learningRate
+}
+
+// rollAggression picks a profile: 40% balanced, 30% each cautious and
+// reckless
+func (eg *EnemyGenerator) rollAggression() AggressionProfile {
+	roll := eg.rng.Intn(10)
+	switch {
+	case roll < 4:
+		return BalancedAggression
+	case roll < 7:
+		return CautiousAggression
+	default:
+		return RecklessAggression
+	}
+}
@@ -0,0 +1,75 @@
+package entity
+
+import "testing"
+
+// retreatHealth lowers health in 1% steps under identical combat inputs and
+// returns the first health at which the memory retreats
+func retreatHealth(profile AggressionProfile) float64 {
+	mem := NewAIMemory()
+	mem.SetAggression(profile)
+	mem.RecordCombatEvent(true, true, 20, 60)
+
+	for percent := 100; percent >= 0; percent-- {
+		health := float64(percent) / 100
+		if mem.GetTacticalState(health, false, 80) == TacticalRetreating {
+			return health
+		}
+	}
+	return -1
+}
+
+func TestRecklessRetreatsLaterThanCautious(t *testing.T) {
+	reckless := retreatHealth(RecklessAggression)
+	balanced := retreatHealth(BalancedAggression)
+	cautious := retreatHealth(CautiousAggression)
+
+	if !(reckless < balanced && balanced < cautious) {
+		t.Errorf("retreat health reckless %.2f, balanced %.2f, cautious %.2f; want increasing",
+			reckless, balanced, cautious)
+	}
+}
+
+func TestAggressionBiasesTactics(t *testing.T) {
+	reckless := NewAIMemory()
+	reckless.SetAggression(RecklessAggression)
+	cautious := NewAIMemory()
+	cautious.SetAggression(CautiousAggression)
+	for _, mem := range []*AIMemory{reckless, cautious} {
+		mem.RecordCombatEvent(true, false, 0, 60)
+	}
+
+	// Winning at half health: only the reckless enemy presses the attack
+	if got := reckless.GetTacticalState(0.5, false, 80); got != TacticalAggressive {
+		t.Errorf("reckless state = %v, want TacticalAggressive", got)
+	}
+	if got := cautious.GetTacticalState(0.5, false, 80); got == TacticalAggressive {
+		t.Error("cautious enemy should not press the attack at half health")
+	}
+}
+
+func TestBalancedAggressionKeepsDefaults(t *testing.T) {
+	mem := NewAIMemory()
+	defaults := *mem
+	mem.SetAggression(BalancedAggression)
+
+	if mem.RetreatThreshold != defaults.RetreatThreshold || mem.LearningRate != defaults.LearningRate {
+		t.Errorf("balanced profile changed defaults: retreat %v, learning %v", mem.RetreatThreshold, mem.LearningRate)
+	}
+}
+
+func TestGeneratedEnemiesVaryInAggression(t *testing.T) {
+	eg := NewEnemyGenerator(1)
+	seen := make(map[AggressionProfile]bool)
+	for seed := int64(0); seed < 50; seed++ {
+		enemy := eg.Generate("cave", 3, seed)
+		seen[enemy.Aggression] = true
+
+		instance := NewEnemyInstance(enemy, 0, 0)
+		if instance.Memory.Aggression != enemy.Aggression {
+			t.Fatalf("instance memory has %v, enemy has %v", instance.Memory.Aggression, enemy.Aggression)
+		}
+	}
+	if len(seen) != 3 {
+		t.Errorf("50 enemies produced profiles %v, want all three", seen)
+	}
+}
@@ -34,8 +34,78 @@ type EnemyInstance struct {
 	FormationY    float64       // Target Y position in formation
 	LastPlayerX   float64       // Track player position for learning
 	LastPlayerY   float64
+
+	DeathFrames   int  // Frames since death, for the corpse animation and fade
+	HitStunFrames int  // Frames left stunned by a launch; the AI is paused meanwhile
+	HitStopFrames int  // Frames left frozen in place by a hit's hit-stop
+	HazardFrames  int  // Frames before a room hazard can hurt it again
+	Enraged       bool // Fighting harder at low health (see enrage.go)
+
+	Traits SizeTraits // Modifiers from the enemy's size (see size_traits.go)
+
+	// Resistance to stagger (see poise.go)
+	Poise           float64
+	poiseRegenDelay int
+
+	// Awareness of the player (see awareness.go)
+	Alert         float64 // Alert meter from 0 to 1; aggro needs a full meter
+	alerted       bool
+	alertedFrames int
+	calmFrames    int // Frames left ignoring the player (see Calm)
+
+	// What the enemy can see of the player (see vision.go)
+	Vision VisionCone
+	facing float64
+
+	// Dash and slam attacks in progress (see attack_archetype.go)
+	actionPhase  archetypePhase
+	actionFrames int
+	actionDir    float64
+	pendingSlam  *SlamEvent
+	windupCue    bool // A wind-up started this frame (see TakeWindup)
+
+	// Attack combo in progress (see combo.go)
+	ComboStep     int // Attacks made so far in the current combo
+	comboTimer    int
+	comboCooldown int
+
+	// Summoning (see summon.go)
+	minions     []*EnemyInstance
+	summonTimer int
+	summonReady bool
+
+	groundProbe GroundProbe // Sees the ground ahead (see ledge.go)
+
+	// Route through the room (see pathfind.go)
+	nav       *NavGrid
+	path      []Position
+	pathTimer int
+
+	// Update schedule (see lod.go)
+	lodFrame   int
+	thinkVelX  float64 // Velocity the last think aimed for
+	thinkFrame int     // lodFrame of the last think
+
+	// Countering the player's habits (see mimic.go)
+	sawAttack    bool
+	counter      MimicCounter
+	mimicTargetX float64
 }
 
+// hitStunDrag slows a stunned enemy's drift each frame
+const hitStunDrag = 0.9
+
+// Corpse timing. A dead enemy plays its death animation, then fades out.
+const (
+	deathAnimFrameCount = 4  // Sprite frames in the death animation
+	deathAnimFrameTime  = 10 // Game frames per death sprite frame
+
+	// DeathAnimationFrames is how long the death animation plays
+	DeathAnimationFrames = deathAnimFrameCount * deathAnimFrameTime
+	// CorpseFadeFrames is how long the corpse takes to fade out afterwards
+	CorpseFadeFrames = 30
+)
+
 // EnemyState represents current enemy state
 type EnemyState int
 
@@ -63,13 +133,18 @@ func NewEnemyInstance(enemy *Enemy, x, y float64) *EnemyInstance {
 		aggroRange = 250.0
 	}
 
+	traits := SizeTraitsFor(enemy.Size)
+	if traits.Swarms {
+		aggroRange *= SwarmAggroMultiplier
+	}
+
 	// Create animation controller if sprite data is available
 	var animController *animation.AnimationController
 	if sprite, ok := enemy.SpriteData.(*graphics.Sprite); ok && sprite != nil {
 		animController = CreateEnemyAnimController(sprite, enemy)
 	}
 
-	return &EnemyInstance{
+	ei := &EnemyInstance{
 		Enemy:          enemy,
 		X:              x,
 		Y:              y,
@@ -92,81 +167,107 @@ func NewEnemyInstance(enemy *Enemy, x, y float64) *EnemyInstance {
 		FormationY:     y,
 		LastPlayerX:    0,
 		LastPlayerY:    0,
+		Poise:          MaxPoise(enemy.Size),
+		Traits:         traits,
+		Vision:         DefaultVisionCone(enemy, aggroRange),
+		facing:         1.0,
 	}
+	ei.Memory.SetAggression(enemy.Aggression)
+	return ei
 }
 
 // Update updates enemy AI behavior
 func (ei *EnemyInstance) Update(playerX, playerY float64) {
 	if ei.CurrentHealth <= 0 {
 		ei.State = DeadState
-		// Play death animation once
-		if ei.AnimController != nil {
+		// Play death animation once, holding its last frame. Stop updating
+		// just before it completes, or the controller falls back to idle.
+		if ei.AnimController != nil && ei.DeathFrames < DeathAnimationFrames-2 {
 			currentAnim := ei.AnimController.GetCurrentAnimation()
 			if currentAnim != "death" {
 				ei.AnimController.Play("death", true)
 			}
 			ei.AnimController.Update()
 		}
+		ei.DeathFrames++
 		return
 	}
 
-	// Update AI memory with player observations
-	// Detect if player did actions (simplified detection for now)
-	playerDidJump := math.Abs(playerY-ei.LastPlayerY) > 5.0 && playerY < ei.LastPlayerY
-	playerDidAttack := false // Would need actual attack detection from game state
-	playerDidDash := math.Abs(playerX-ei.LastPlayerX) > 10.0
+	ei.updatePoise()
 
-	ei.Memory.UpdateMemory(playerX, playerY, playerDidJump, playerDidAttack, playerDidDash)
-	ei.LastPlayerX = playerX
-	ei.LastPlayerY = playerY
+	// Stunned enemies drift with their knockback instead of acting
+	if ei.HitStunFrames > 0 {
+		ei.cancelArchetypeAttack()
+		ei.breakCombo()
+		ei.HitStunFrames--
+		ei.VelX *= hitStunDrag
+		if ei.Enemy.Behavior == FlyingBehavior {
+			ei.VelY *= hitStunDrag
+		}
+		if ei.AnimController != nil {
+			ei.AnimController.Update()
+		}
+		return
+	}
+
+	// Calmed enemies stand idle and ignore the player
+	if ei.calmFrames > 0 {
+		ei.calmFrames--
+		if ei.AnimController != nil {
+			ei.AnimController.Update()
+		}
+		return
+	}
+
+	prevVelX := ei.VelX
 
 	// Decrease attack cooldown
 	if ei.AttackCooldown > 0 {
 		ei.AttackCooldown--
 	}
+	ei.updateCombo()
 
 	// Calculate distance to player
 	dx := playerX - ei.X
 	dy := playerY - ei.Y
 	distToPlayer := math.Sqrt(dx*dx + dy*dy)
 
-	// Determine tactical state based on AI memory
-	healthPercent := float64(ei.CurrentHealth) / float64(ei.Enemy.Health)
-	hasAllies := ei.Group != nil && len(ei.Group.Members) > 1
-	ei.TacticalState = ei.Memory.GetTacticalState(healthPercent, hasAllies, distToPlayer)
-
-	// Apply tactical state modifications to behavior
-	ei.applyTacticalBehavior(distToPlayer, dx, dy, playerX, playerY)
-
-	// Update behavior based on pattern
-	switch ei.Enemy.Behavior {
-	case PatrolBehavior:
-		ei.updatePatrolBehavior(distToPlayer, dx, dy)
-	case ChaseBehavior:
-		ei.updateChaseBehavior(distToPlayer, dx, dy)
-	case FleeBehavior:
-		ei.updateFleeBehavior(distToPlayer, dx, dy)
-	case StationaryBehavior:
-		ei.updateStationaryBehavior(distToPlayer, dx, dy)
-	case FlyingBehavior:
-		ei.updateFlyingBehavior(distToPlayer, dx, dy)
-	case JumpingBehavior:
-		ei.updateJumpingBehavior(distToPlayer, dx, dy)
+	// Enemies have to notice the player before they turn hostile
+	ei.updateAwareness(ei.CanSeePlayer(dx, dy))
+
+	// Distant enemies think only on their scheduled frames, carrying on
+	// toward their last decision in between
+	acting := ei.actionPhase != archetypeIdle
+	if ei.thinksThisFrame(distToPlayer) {
+		acting = ei.think(playerX, playerY, dx, dy, distToPlayer)
+		ei.thinkVelX = ei.VelX
+	} else if acting {
+		ei.updateArchetypeAttack()
+	} else {
+		ei.VelX = ei.thinkVelX
 	}
 
-	// Apply formation movement if in a group
-	if ei.Group != nil && ei.Group.Formation != NoFormation {
-		ei.applyFormationMovement()
+	// Ramp toward the new velocity; lunges keep their burst
+	if !acting {
+		ei.smoothVelocityX(prevVelX)
 	}
 
-	// Apply velocity limits
-	maxSpeed := ei.Enemy.Speed
+	// Apply velocity limits; a lunge is meant to outpace them
+	maxSpeed := ei.EffectiveSpeed()
+	if ei.IsDashing() {
+		maxSpeed = DashSpeed
+	}
 	if ei.VelX > maxSpeed {
 		ei.VelX = maxSpeed
 	} else if ei.VelX < -maxSpeed {
 		ei.VelX = -maxSpeed
 	}
 
+	// Stop short of ledges and hazards rather than walking into them
+	ei.avoidLedges()
+	ei.avoidHazardsAhead()
+	ei.updateFacing()
+
 	// Apply gravity for ground-based enemies
 	if ei.Enemy.Behavior != FlyingBehavior && !ei.OnGround {
 		ei.VelY += 0.5 // Gravity
@@ -199,10 +300,84 @@ func (ei *EnemyInstance) Update(playerX, playerY float64) {
 	}
 }
 
+// think runs the enemy's full AI for a frame: it updates its memory,
+// awareness and tactics, then picks a movement from its behavior pattern.
+// It reports whether a dash or slam is driving the enemy instead.
+func (ei *EnemyInstance) think(playerX, playerY, dx, dy, distToPlayer float64) bool {
+	// Update AI memory with player observations. Distant enemies think
+	// only every few frames, so movement is judged per frame since the
+	// last think; otherwise a steady run would read as a dash.
+	frames := float64(max(1, ei.lodFrame-ei.thinkFrame))
+	ei.thinkFrame = ei.lodFrame
+	stepX := (playerX - ei.LastPlayerX) / frames
+	stepY := (playerY - ei.LastPlayerY) / frames
+	playerDidJump := stepY < -5.0
+	playerDidAttack := ei.sawAttack
+	ei.sawAttack = false
+	playerDidDash := math.Abs(stepX) > 10.0
+
+	ei.Memory.UpdateMemory(playerX, playerY, playerDidJump, playerDidAttack, playerDidDash)
+	ei.LastPlayerX = playerX
+	ei.LastPlayerY = playerY
+
+	// Determine tactical state based on AI memory
+	healthPercent := float64(ei.CurrentHealth) / float64(ei.Enemy.Health)
+	hasAllies := ei.Group != nil && len(ei.Group.Members) > 1
+	ei.TacticalState = ei.Memory.GetTacticalState(healthPercent, hasAllies, distToPlayer)
+
+	// Enraged and swarming enemies keep fighting instead of falling back
+	ei.updateEnrage(healthPercent)
+	if (ei.Enraged || ei.Traits.Swarms) && (ei.TacticalState == TacticalRetreating || ei.TacticalState == TacticalRegrouping) {
+		ei.TacticalState = TacticalNormal
+	}
+
+	// Apply tactical state modifications to behavior
+	if ei.alerted {
+		ei.applyTacticalBehavior(distToPlayer, dx, dy, playerX, playerY)
+	}
+
+	ei.updateSummon()
+
+	// A dash or slam overrides the behavior pattern until it finishes
+	acting := ei.actionPhase != archetypeIdle ||
+		(ei.alerted && ei.startArchetypeAttack(distToPlayer, dx))
+	if acting {
+		ei.updateArchetypeAttack()
+	} else {
+		// Update behavior based on pattern
+		switch ei.Enemy.Behavior {
+		case PatrolBehavior:
+			ei.updatePatrolBehavior(distToPlayer, dx, dy)
+		case ChaseBehavior:
+			ei.updateChaseBehavior(distToPlayer, dx, dy)
+		case FleeBehavior:
+			ei.updateFleeBehavior(distToPlayer, dx, dy)
+		case StationaryBehavior:
+			ei.updateStationaryBehavior(distToPlayer, dx, dy)
+		case FlyingBehavior:
+			ei.updateFlyingBehavior(distToPlayer, dx, dy)
+		case JumpingBehavior:
+			ei.updateJumpingBehavior(distToPlayer, dx, dy)
+		}
+	}
+
+	// Mimics answer the player's habits in place of their usual movement
+	if !acting {
+		ei.updateMimic(distToPlayer, dx)
+	}
+
+	// Apply formation movement if in a group
+	if !acting && ei.Group != nil && ei.Group.Formation != NoFormation {
+		ei.applyFormationMovement()
+	}
+
+	return acting
+}
+
 // updatePatrolBehavior implements patrol AI
 func (ei *EnemyInstance) updatePatrolBehavior(distToPlayer, dx, dy float64) {
 	// Check if player is in aggro range
-	if distToPlayer < ei.AggroRange {
+	if ei.alerted && distToPlayer < ei.AggroRange {
 		ei.State = ChaseState
 		ei.chasePlayer(dx, dy)
 		return
@@ -210,7 +385,7 @@ func (ei *EnemyInstance) updatePatrolBehavior(distToPlayer, dx, dy float64) {
 
 	// Patrol between min and max X
 	ei.State = PatrolState
-	ei.VelX = ei.Enemy.Speed * ei.PatrolDir
+	ei.VelX = ei.EffectiveSpeed() * ei.PatrolDir
 
 	// Reverse direction at boundaries
 	if ei.X >= ei.PatrolMaxX {
@@ -222,10 +397,16 @@ func (ei *EnemyInstance) updatePatrolBehavior(distToPlayer, dx, dy float64) {
 
 // updateChaseBehavior implements chase AI
 func (ei *EnemyInstance) updateChaseBehavior(distToPlayer, dx, dy float64) {
+	if !ei.alerted {
+		ei.State = IdleState
+		ei.VelX *= 0.8 // Friction
+		return
+	}
+
 	if distToPlayer < ei.AttackRange && ei.AttackCooldown <= 0 {
 		ei.State = AttackState
 		ei.VelX = 0
-		ei.AttackCooldown = 60 // 1 second cooldown at 60 FPS
+		ei.AttackCooldown = ei.attackCooldown(60) // 1 second cooldown at 60 FPS
 		return
 	}
 
@@ -239,9 +420,9 @@ func (ei *EnemyInstance) updateFleeBehavior(distToPlayer, dx, dy float64) {
 	if distToPlayer < ei.AggroRange {
 		ei.State = FleeState
 		if dx > 0 {
-			ei.VelX = -ei.Enemy.Speed
+			ei.VelX = -ei.EffectiveSpeed()
 		} else {
-			ei.VelX = ei.Enemy.Speed
+			ei.VelX = ei.EffectiveSpeed()
 		}
 	} else {
 		ei.State = IdleState
@@ -253,9 +434,9 @@ func (ei *EnemyInstance) updateFleeBehavior(distToPlayer, dx, dy float64) {
 func (ei *EnemyInstance) updateStationaryBehavior(distToPlayer, dx, dy float64) {
 	ei.VelX = 0
 
-	if distToPlayer < ei.AttackRange && ei.AttackCooldown <= 0 {
+	if ei.alerted && distToPlayer < ei.AttackRange && ei.AttackCooldown <= 0 {
 		ei.State = AttackState
-		ei.AttackCooldown = 90 // Longer cooldown for stationary
+		ei.AttackCooldown = ei.attackCooldown(90) // Longer cooldown for stationary
 	} else {
 		ei.State = IdleState
 	}
@@ -263,40 +444,44 @@ func (ei *EnemyInstance) updateStationaryBehavior(distToPlayer, dx, dy float64)
 
 // updateFlyingBehavior implements flying AI
 func (ei *EnemyInstance) updateFlyingBehavior(distToPlayer, dx, dy float64) {
-	if distToPlayer < ei.AttackRange && ei.AttackCooldown <= 0 {
+	if ei.alerted && distToPlayer < ei.AttackRange && ei.AttackCooldown <= 0 {
 		ei.State = AttackState
 		ei.VelX = 0
 		ei.VelY = 0
-		ei.AttackCooldown = 60
+		ei.AttackCooldown = ei.attackCooldown(60)
 		return
 	}
 
-	if distToPlayer < ei.AggroRange {
+	if ei.alerted && distToPlayer < ei.AggroRange {
 		ei.State = ChaseState
-		// Move toward player in both X and Y
-		ei.VelX = (dx / distToPlayer) * ei.Enemy.Speed
-		ei.VelY = (dy / distToPlayer) * ei.Enemy.Speed
+		// Move toward player in both X and Y, around hazards when pathing
+		px, py := ei.pathDirection(dx, dy)
+		if dist := math.Hypot(px, py); dist > 0 {
+			ei.VelX = (px / dist) * ei.EffectiveSpeed()
+			ei.VelY = (py / dist) * ei.EffectiveSpeed()
+		}
 	} else {
 		ei.State = PatrolState
 		// Hover slowly
-		ei.VelX = ei.Enemy.Speed * 0.3 * ei.PatrolDir
+		ei.VelX = ei.EffectiveSpeed() * 0.3 * ei.PatrolDir
 		ei.VelY = math.Sin(ei.X*0.1) * 0.5
 
 		if ei.X >= ei.PatrolMaxX || ei.X <= ei.PatrolMinX {
 			ei.PatrolDir *= -1
 		}
 	}
+	ei.steerAroundSolids()
 }
 
 // updateJumpingBehavior implements jumping AI
 func (ei *EnemyInstance) updateJumpingBehavior(distToPlayer, dx, dy float64) {
-	if distToPlayer < ei.AttackRange && ei.AttackCooldown <= 0 {
+	if ei.alerted && distToPlayer < ei.AttackRange && ei.AttackCooldown <= 0 {
 		ei.State = AttackState
-		ei.AttackCooldown = 60
+		ei.AttackCooldown = ei.attackCooldown(60)
 		return
 	}
 
-	if distToPlayer < ei.AggroRange {
+	if ei.alerted && distToPlayer < ei.AggroRange {
 		ei.State = ChaseState
 		ei.chasePlayer(dx, dy)
 
@@ -307,7 +492,7 @@ func (ei *EnemyInstance) updateJumpingBehavior(distToPlayer, dx, dy float64) {
 		}
 	} else {
 		ei.State = PatrolState
-		ei.VelX = ei.Enemy.Speed * ei.PatrolDir
+		ei.VelX = ei.EffectiveSpeed() * ei.PatrolDir
 
 		if ei.X >= ei.PatrolMaxX || ei.X <= ei.PatrolMinX {
 			ei.PatrolDir *= -1
@@ -318,26 +503,23 @@ func (ei *EnemyInstance) updateJumpingBehavior(distToPlayer, dx, dy float64) {
 // chasePlayer moves enemy toward player
 func (ei *EnemyInstance) chasePlayer(dx, dy float64) {
 	if dx > 0 {
-		ei.VelX = ei.Enemy.Speed
+		ei.VelX = ei.EffectiveSpeed()
 	} else {
-		ei.VelX = -ei.Enemy.Speed
+		ei.VelX = -ei.EffectiveSpeed()
 	}
 }
 
 // TakeDamage applies damage to enemy
 func (ei *EnemyInstance) TakeDamage(damage int) {
 	ei.CurrentHealth -= damage
+	ei.calmFrames = 0 // Striking a calmed enemy ends its grace
+	ei.Alarm()
 
 	// Record combat event in memory
 	if ei.Memory != nil {
 		ei.Memory.RecordCombatEvent(false, true, damage, 0)
 	}
 
-	// Play hit animation
-	if ei.AnimController != nil && ei.CurrentHealth > 0 {
-		ei.AnimController.Play("hit", true)
-	}
-
 	if ei.CurrentHealth < 0 {
 		ei.CurrentHealth = 0
 	}
@@ -373,9 +555,9 @@ func (ei *EnemyInstance) applyTacticalBehavior(distToPlayer, dx, dy, playerX, pl
 		_ = targetY - ei.Y // fdy unused for ground-based flanking
 		if math.Abs(fdx) > 10 {
 			if fdx > 0 {
-				ei.VelX = ei.Enemy.Speed
+				ei.VelX = ei.EffectiveSpeed()
 			} else {
-				ei.VelX = -ei.Enemy.Speed
+				ei.VelX = -ei.EffectiveSpeed()
 			}
 		}
 
@@ -383,13 +565,13 @@ func (ei *EnemyInstance) applyTacticalBehavior(distToPlayer, dx, dy, playerX, pl
 		// Hit and run: attack then retreat
 		if distToPlayer < ei.AttackRange && ei.AttackCooldown <= 0 {
 			ei.State = AttackState
-			ei.AttackCooldown = 45
+			ei.AttackCooldown = ei.attackCooldown(45)
 		} else if distToPlayer < ei.AttackRange*1.5 {
 			// Retreat after attacking
 			if dx > 0 {
-				ei.VelX = -ei.Enemy.Speed
+				ei.VelX = -ei.EffectiveSpeed()
 			} else {
-				ei.VelX = ei.Enemy.Speed
+				ei.VelX = ei.EffectiveSpeed()
 			}
 		}
 
@@ -397,9 +579,9 @@ func (ei *EnemyInstance) applyTacticalBehavior(distToPlayer, dx, dy, playerX, pl
 		// Move away from player
 		ei.State = FleeState
 		if dx > 0 {
-			ei.VelX = -ei.Enemy.Speed * 1.2
+			ei.VelX = -ei.EffectiveSpeed() * 1.2
 		} else {
-			ei.VelX = ei.Enemy.Speed * 1.2
+			ei.VelX = ei.EffectiveSpeed() * 1.2
 		}
 
 	case TacticalRegrouping:
@@ -423,9 +605,9 @@ func (ei *EnemyInstance) applyTacticalBehavior(distToPlayer, dx, dy, playerX, pl
 
 				if math.Abs(gdx) > 10 {
 					if gdx > 0 {
-						ei.VelX = ei.Enemy.Speed
+						ei.VelX = ei.EffectiveSpeed()
 					} else {
-						ei.VelX = -ei.Enemy.Speed
+						ei.VelX = -ei.EffectiveSpeed()
 					}
 				}
 			}
@@ -445,8 +627,8 @@ func (ei *EnemyInstance) applyFormationMovement() {
 		// Blend formation movement with current velocity
 		formationInfluence := 0.3
 
-		targetVelX := (dx / dist) * ei.Enemy.Speed
-		targetVelY := (dy / dist) * ei.Enemy.Speed
+		targetVelX := (dx / dist) * ei.EffectiveSpeed()
+		targetVelY := (dy / dist) * ei.EffectiveSpeed()
 
 		ei.VelX = ei.VelX*(1.0-formationInfluence) + targetVelX*formationInfluence
 
@@ -471,17 +653,43 @@ func (ei *EnemyInstance) RecordEvasion() {
 	}
 }
 
+// Launch knocks the enemy upward at speed and stuns it for stunFrames
+func (ei *EnemyInstance) Launch(speed float64, stunFrames int) {
+	ei.VelY = -speed
+	ei.OnGround = false
+	ei.Stun(stunFrames)
+}
+
 // IsDead checks if enemy is dead
 func (ei *EnemyInstance) IsDead() bool {
 	return ei.CurrentHealth <= 0
 }
 
+// IsCorpseGone reports whether a dead enemy has finished its death
+// animation and fade-out and can be removed
+func (ei *EnemyInstance) IsCorpseGone() bool {
+	return ei.IsDead() && ei.DeathFrames >= DeathAnimationFrames+CorpseFadeFrames
+}
+
+// CorpseAlpha returns the opacity to draw a dead enemy with: opaque through
+// the death animation, then fading linearly to zero
+func (ei *EnemyInstance) CorpseAlpha() float64 {
+	fade := ei.DeathFrames - DeathAnimationFrames
+	if fade <= 0 {
+		return 1.0
+	}
+	if fade >= CorpseFadeFrames {
+		return 0
+	}
+	return 1.0 - float64(fade)/CorpseFadeFrames
+}
+
 // GetAttackDamage returns damage dealt by enemy attack
 func (ei *EnemyInstance) GetAttackDamage() int {
 	if ei.State != AttackState {
 		return 0
 	}
-	return ei.Enemy.Damage
+	return ei.comboDamage(ei.EffectiveDamage())
 }
 
 // GetBounds returns enemy collision bounds
@@ -674,16 +882,16 @@ func (ei *EnemyInstance) MaintainPreferredRange(playerX, playerY float64) {
 	if dist < preferredDist-tolerance {
 		// Too close, back away
 		if dx > 0 {
-			ei.VelX = -ei.Enemy.Speed * 0.7
+			ei.VelX = -ei.EffectiveSpeed() * 0.7
 		} else {
-			ei.VelX = ei.Enemy.Speed * 0.7
+			ei.VelX = ei.EffectiveSpeed() * 0.7
 		}
 	} else if dist > preferredDist+tolerance {
 		// Too far, close in
 		if dx > 0 {
-			ei.VelX = ei.Enemy.Speed
+			ei.VelX = ei.EffectiveSpeed()
 		} else {
-			ei.VelX = -ei.Enemy.Speed
+			ei.VelX = -ei.EffectiveSpeed()
 		}
 	} else {
 		// At good distance, strafe or stay still
@@ -713,7 +921,7 @@ func (ei *EnemyInstance) UpdateFlyingAltitude(playerY float64) {
 
 	dy := targetY - ei.Y
 	if math.Abs(dy) > 10 {
-		ei.VelY = math.Copysign(ei.Enemy.Speed*0.5, dy)
+		ei.VelY = math.Copysign(ei.EffectiveSpeed()*0.5, dy)
 	} else {
 		// Gentle hover bobbing
 		ei.VelY = math.Sin(ei.X*0.1) * 0.5
@@ -756,7 +964,7 @@ func CreateEnemyAnimController(baseSprite *graphics.Sprite, enemy *Enemy) *anima
 	idleFrames := animGen.GenerateEnemyIdleFrames(baseSprite, 4)
 	patrolFrames := animGen.GenerateEnemyPatrolFrames(baseSprite, 4)
 	attackFrames := animGen.GenerateEnemyAttackFrames(baseSprite, 3)
-	deathFrames := animGen.GenerateEnemyDeathFrames(baseSprite, 4)
+	deathFrames := animGen.GenerateEnemyDeathFrames(baseSprite, deathAnimFrameCount)
 	hitFrames := animGen.GenerateHitFrames(baseSprite, 2)
 
 	// Create animation controller with idle as default
@@ -764,7 +972,7 @@ func CreateEnemyAnimController(baseSprite *graphics.Sprite, enemy *Enemy) *anima
 	animController.AddAnimation(animation.NewAnimation("idle", idleFrames, 15, true))
 	animController.AddAnimation(animation.NewAnimation("patrol", patrolFrames, 8, true))
 	animController.AddAnimation(animation.NewAnimation("attack", attackFrames, 5, false))
-	animController.AddAnimation(animation.NewAnimation("death", deathFrames, 10, false))
+	animController.AddAnimation(animation.NewAnimation("death", deathFrames, deathAnimFrameTime, false))
 	animController.AddAnimation(animation.NewAnimation("hit", hitFrames, 3, false))
 
 	return animController
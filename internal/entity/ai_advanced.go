@@ -39,7 +39,8 @@ type AIMemory struct {
 
 	// Learning parameters
 	LastUpdateTime time.Time
-	LearningRate   float64 // How quickly to adapt (0.0-1.0)
+	LearningRate   float64           // How quickly to adapt (0.0-1.0)
+	Aggression     AggressionProfile // Temperament biasing tactics (see aggression.go)
 }
 
 // Position represents a 2D coordinate
@@ -245,8 +246,10 @@ func (mem *AIMemory) GetTacticalState(healthPercent float64, hasAllies bool, dis
 		return TacticalRetreating
 	}
 
+	tuning := mem.Aggression.tuning()
+
 	// Be aggressive if we're winning
-	if mem.SuccessfulHits > mem.DamageReceived/10 && healthPercent > 0.6 {
+	if mem.SuccessfulHits > mem.DamageReceived/10 && healthPercent > tuning.aggressiveAbove {
 		return TacticalAggressive
 	}
 
@@ -261,7 +264,7 @@ func (mem *AIMemory) GetTacticalState(healthPercent float64, hasAllies bool, dis
 	}
 
 	// Defensive if taking too much damage
-	if mem.DamageReceived > 30 && healthPercent < 0.5 {
+	if mem.DamageReceived > 30 && healthPercent < tuning.defensiveBelow {
 		return TacticalDefensive
 	}
 
@@ -86,6 +86,7 @@ func TestChaseBehavior(t *testing.T) {
 		Behavior: ChaseBehavior,
 	}
 	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm() // Already aware of the player
 
 	// Player nearby
 	instance.Update(150, 100)
@@ -142,6 +143,22 @@ func TestStationaryBehavior(t *testing.T) {
 	}
 }
 
+func TestStationaryEnemyWaitsUntilAlerted(t *testing.T) {
+	instance := NewEnemyInstance(&Enemy{Health: 50, Behavior: StationaryBehavior}, 100, 100)
+
+	// The player stands in reach behind the enemy, outside its vision cone
+	instance.Update(90, 100)
+	if instance.State == AttackState {
+		t.Fatal("unaware stationary enemy attacked")
+	}
+
+	instance.Alarm()
+	instance.Update(90, 100)
+	if instance.State != AttackState {
+		t.Errorf("alerted stationary enemy in reach has state %v, want AttackState", instance.State)
+	}
+}
+
 func TestFlyingBehavior(t *testing.T) {
 	enemy := &Enemy{
 		Health:   40,
@@ -149,6 +166,7 @@ func TestFlyingBehavior(t *testing.T) {
 		Behavior: FlyingBehavior,
 	}
 	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm() // Already aware of the player
 
 	// Player in range
 	instance.Update(200, 150)
@@ -250,7 +268,8 @@ func TestEnemyStateTransitions(t *testing.T) {
 		t.Errorf("Expected initial IdleState, got %v", instance.State)
 	}
 
-	// Player in aggro range
+	// Player in aggro range once noticed
+	instance.Alarm()
 	instance.Update(150, 100)
 	if instance.State != ChaseState {
 		t.Errorf("Expected ChaseState, got %v", instance.State)
@@ -279,6 +298,7 @@ func TestJumpingBehavior(t *testing.T) {
 	}
 	instance := NewEnemyInstance(enemy, 100, 100)
 	instance.OnGround = true
+	instance.Alarm() // Already aware of the player
 
 	// Player in range
 	instance.Update(150, 100)
@@ -425,6 +445,59 @@ func TestEnemyAnimationStateTransitions(t *testing.T) {
 	}
 }
 
+func TestEnemyCorpsePlaysDeathAnimationBeforeCulling(t *testing.T) {
+	enemy := &Enemy{
+		Name:        "TestEnemy",
+		Health:      100,
+		Damage:      10,
+		Speed:       2.0,
+		Size:        MediumEnemy,
+		Behavior:    PatrolBehavior,
+		AttackType:  MeleeAttack,
+		DangerLevel: 3,
+		BiomeType:   "cave",
+		SpriteData:  &graphics.Sprite{Width: 32, Height: 32},
+	}
+	instance := NewEnemyInstance(enemy, 0, 0)
+	instance.Update(1000, 1000)
+
+	instance.CurrentHealth = 0
+	frames := make(map[*graphics.Sprite]bool)
+	for i := 0; i < DeathAnimationFrames; i++ {
+		instance.Update(0, 0)
+		if instance.IsCorpseGone() {
+			t.Fatalf("corpse culled after %d frames, during its death animation", i+1)
+		}
+		if anim := instance.AnimController.GetCurrentAnimation(); anim != "death" {
+			t.Fatalf("frame %d: animation = %q, want death", i+1, anim)
+		}
+		if alpha := instance.CorpseAlpha(); alpha != 1.0 {
+			t.Fatalf("frame %d: CorpseAlpha() = %v during death animation, want 1", i+1, alpha)
+		}
+		frames[instance.AnimController.GetCurrentFrame()] = true
+	}
+	if len(frames) != deathAnimFrameCount {
+		t.Errorf("death animation showed %d sprite frames, want %d", len(frames), deathAnimFrameCount)
+	}
+
+	// Then it fades out and is culled
+	lastAlpha := 1.0
+	for i := 0; i < CorpseFadeFrames; i++ {
+		instance.Update(0, 0)
+		if alpha := instance.CorpseAlpha(); alpha > lastAlpha {
+			t.Fatalf("fade frame %d: CorpseAlpha() rose to %v", i+1, alpha)
+		} else {
+			lastAlpha = alpha
+		}
+	}
+	if !instance.IsCorpseGone() {
+		t.Error("IsCorpseGone() = false after the fade-out finished")
+	}
+	if lastAlpha != 0 {
+		t.Errorf("CorpseAlpha() = %v once gone, want 0", lastAlpha)
+	}
+}
+
 // Test hit animation on damage
 func TestEnemyHitAnimation(t *testing.T) {
 	sprite := &graphics.Sprite{
@@ -457,9 +530,13 @@ func TestEnemyHitAnimation(t *testing.T) {
 		t.Errorf("Expected health %d, got %d", initialHealth-20, instance.CurrentHealth)
 	}
 
-	// Hit animation should be playing
+	// Damage alone no longer flinches; the hit animation marks a stagger
+	if instance.AnimController.GetCurrentAnimation() == "hit" {
+		t.Error("Expected no hit animation before poise breaks")
+	}
+	instance.ApplyPoiseDamage(MaxPoise(enemy.Size))
 	if instance.AnimController.GetCurrentAnimation() != "hit" {
-		t.Error("Expected hit animation after taking damage")
+		t.Error("Expected hit animation after poise breaks")
 	}
 }
 
@@ -691,3 +768,22 @@ func TestPlatformerAI_NormalizeDirection(t *testing.T) {
 		}
 	}
 }
+
+func TestHitStunPausesAI(t *testing.T) {
+	enemy := &Enemy{Health: 50, Speed: 2.0, Size: SmallEnemy, Behavior: ChaseBehavior}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Launch(8, 3)
+
+	for i := 0; i < 3; i++ {
+		instance.Update(110, 100) // Player in range
+		if instance.State == ChaseState || instance.State == AttackState {
+			t.Fatalf("frame %d: stunned enemy entered state %v", i+1, instance.State)
+		}
+	}
+	if instance.HitStunFrames != 0 {
+		t.Errorf("HitStunFrames = %d after stun elapsed, want 0", instance.HitStunFrames)
+	}
+	if instance.VelY != -8 {
+		t.Errorf("VelY = %v, want launch speed kept for gravity to handle", instance.VelY)
+	}
+}
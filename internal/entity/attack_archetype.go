@@ -0,0 +1,203 @@
+package entity
+
+import "math"
+
+// AttackArchetype defines how an enemy delivers its attack
+type AttackArchetype int
+
+const (
+	// StrikeArchetype attacks on contact or in melee range
+	StrikeArchetype AttackArchetype = iota
+	// DashArchetype winds up, then lunges at the player at high speed
+	DashArchetype
+	// SlamArchetype winds up, then slams the ground, leaving a short-lived
+	// damaging area around itself
+	SlamArchetype
+)
+
+// Dash and slam tuning, in frames at 60 FPS and pixels
+const (
+	DashTelegraphFrames = 20  // Wind-up before the lunge
+	DashFrames          = 12  // Length of the lunge
+	DashSpeed           = 7.0 // Lunge speed, well above any walk speed
+	DashTriggerRange    = 120.0
+	DashCooldownFrames  = 90
+
+	SlamTelegraphFrames = 30 // Wind-up before the slam lands
+	SlamRadius          = 64.0
+	SlamHazardFrames    = 20 // How long the slam's area stays dangerous
+	SlamCooldownFrames  = 120
+)
+
+// archetypePhase is the step an enemy is at in a dash or slam
+type archetypePhase int
+
+const (
+	archetypeIdle archetypePhase = iota
+	archetypeWindup
+	archetypeActive
+)
+
+// SelectArchetype picks an attack archetype from an enemy's size and
+// behavior: large grounded enemies slam, medium melee enemies that move
+// along the ground dash, and everything else strikes.
+func SelectArchetype(size EnemySize, behavior BehaviorPattern, attack AttackType) AttackArchetype {
+	if behavior == FlyingBehavior || behavior == FleeBehavior {
+		return StrikeArchetype
+	}
+	switch {
+	case size == LargeEnemy:
+		return SlamArchetype
+	case size == MediumEnemy && attack == MeleeAttack && behavior != StationaryBehavior:
+		return DashArchetype
+	default:
+		return StrikeArchetype
+	}
+}
+
+// SlamEvent describes a slam that has just landed
+type SlamEvent struct {
+	X, Y   float64 // Center of the area
+	Radius float64
+	Damage int
+}
+
+// startArchetypeAttack begins a dash or slam wind-up when the player is in
+// reach. It reports whether an attack was started.
+func (ei *EnemyInstance) startArchetypeAttack(distToPlayer, dx float64) bool {
+	if ei.AttackCooldown > 0 || !ei.OnGround {
+		return false
+	}
+
+	switch ei.Enemy.Archetype {
+	case DashArchetype:
+		if distToPlayer > DashTriggerRange {
+			return false
+		}
+		ei.actionFrames = DashTelegraphFrames
+	case SlamArchetype:
+		if distToPlayer > SlamRadius {
+			return false
+		}
+		ei.actionFrames = SlamTelegraphFrames
+	default:
+		return false
+	}
+
+	ei.actionPhase = archetypeWindup
+	ei.windupCue = true
+	ei.actionDir = 1.0
+	if dx < 0 {
+		ei.actionDir = -1.0
+	}
+	return true
+}
+
+// updateArchetypeAttack advances a dash or slam in progress
+func (ei *EnemyInstance) updateArchetypeAttack() {
+	ei.actionFrames--
+
+	switch ei.actionPhase {
+	case archetypeWindup:
+		// Plant in place while telegraphing
+		ei.State = IdleState
+		ei.VelX = 0
+		if ei.actionFrames > 0 {
+			return
+		}
+		if ei.Enemy.Archetype == SlamArchetype {
+			ei.landSlam()
+			return
+		}
+		ei.actionPhase = archetypeActive
+		ei.actionFrames = DashFrames
+		ei.State = AttackState
+		ei.VelX = DashSpeed * ei.actionDir
+	case archetypeActive:
+		ei.State = AttackState
+		ei.VelX = DashSpeed * ei.actionDir
+		if ei.actionFrames <= 0 {
+			ei.actionPhase = archetypeIdle
+			ei.VelX = 0
+			ei.AttackCooldown = DashCooldownFrames
+		}
+	}
+}
+
+// landSlam ends a slam wind-up and queues the slam for the game to spawn
+func (ei *EnemyInstance) landSlam() {
+	ei.AttackCooldown = ei.attackCooldown(SlamCooldownFrames)
+	x, y, w, h := ei.GetBounds()
+	ei.pendingSlam = &SlamEvent{
+		X:      x + w/2,
+		Y:      y + h,
+		Radius: SlamRadius,
+		Damage: ei.comboDamage(ei.EffectiveDamage()),
+	}
+	ei.State = AttackState
+	ei.actionPhase = archetypeIdle
+}
+
+// cancelArchetypeAttack abandons a wind-up or lunge, e.g. when stunned
+func (ei *EnemyInstance) cancelArchetypeAttack() {
+	if ei.actionPhase == archetypeActive {
+		ei.VelX = 0
+	}
+	ei.actionPhase = archetypeIdle
+}
+
+// IsDashing reports whether the enemy is mid-lunge
+func (ei *EnemyInstance) IsDashing() bool {
+	return ei.actionPhase == archetypeActive
+}
+
+// TakeSlam returns a slam that landed this frame, if any, and clears it
+func (ei *EnemyInstance) TakeSlam() (SlamEvent, bool) {
+	if ei.pendingSlam == nil {
+		return SlamEvent{}, false
+	}
+	slam := *ei.pendingSlam
+	ei.pendingSlam = nil
+	return slam, true
+}
+
+// TakeWindup reports whether a dash or slam wind-up started this frame and
+// clears it, so the game can cue the wind-up sound once per attack
+func (ei *EnemyInstance) TakeWindup() bool {
+	started := ei.windupCue
+	ei.windupCue = false
+	return started
+}
+
+// Telegraph returns the area a winding-up dash, slam or combo follow-up
+// will hit, so it can be drawn as a warning. ok is false when no attack is
+// winding up.
+func (ei *EnemyInstance) Telegraph() (x, y, w, h float64, ok bool) {
+	if ei.actionPhase != archetypeWindup {
+		return ei.comboTelegraph()
+	}
+
+	ex, ey, ew, eh := ei.GetBounds()
+	switch ei.Enemy.Archetype {
+	case DashArchetype:
+		reach := DashSpeed * DashFrames
+		if ei.actionDir < 0 {
+			return ex - reach, ey, reach + ew, eh, true
+		}
+		return ex, ey, reach + ew, eh, true
+	case SlamArchetype:
+		cx := ex + ew/2
+		return cx - SlamRadius, ey + eh - SlamRadius, SlamRadius * 2, SlamRadius, true
+	}
+	return 0, 0, 0, 0, false
+}
+
+// CircleOverlapsRect reports whether a circle overlaps an axis-aligned
+// rectangle
+func CircleOverlapsRect(cx, cy, radius, x, y, w, h float64) bool {
+	nearX := math.Max(x, math.Min(cx, x+w))
+	nearY := math.Max(y, math.Min(cy, y+h))
+	dx := cx - nearX
+	dy := cy - nearY
+	return dx*dx+dy*dy <= radius*radius
+}
@@ -0,0 +1,136 @@
+package entity
+
+import "testing"
+
+func TestSelectArchetype(t *testing.T) {
+	tests := []struct {
+		size     EnemySize
+		behavior BehaviorPattern
+		attack   AttackType
+		want     AttackArchetype
+	}{
+		{LargeEnemy, PatrolBehavior, AreaAttack, SlamArchetype},
+		{MediumEnemy, ChaseBehavior, MeleeAttack, DashArchetype},
+		{MediumEnemy, ChaseBehavior, RangedAttack, StrikeArchetype},
+		{MediumEnemy, StationaryBehavior, MeleeAttack, StrikeArchetype},
+		{LargeEnemy, FlyingBehavior, AreaAttack, StrikeArchetype},
+		{SmallEnemy, ChaseBehavior, ContactDamage, StrikeArchetype},
+	}
+	for _, tt := range tests {
+		if got := SelectArchetype(tt.size, tt.behavior, tt.attack); got != tt.want {
+			t.Errorf("SelectArchetype(%v, %v, %v) = %v, want %v", tt.size, tt.behavior, tt.attack, got, tt.want)
+		}
+	}
+}
+
+func TestDashAttackBurstsForward(t *testing.T) {
+	enemy := &Enemy{
+		Health:    50,
+		Damage:    10,
+		Speed:     1.5,
+		Size:      MediumEnemy,
+		Behavior:  ChaseBehavior,
+		Archetype: DashArchetype,
+	}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+
+	// Player ahead to the right, inside dash range
+	playerX := instance.X + DashTriggerRange/2
+	instance.Update(playerX, 100)
+	if _, _, _, _, ok := instance.Telegraph(); !ok {
+		t.Fatal("Dash should telegraph before lunging")
+	}
+	if instance.VelX != 0 {
+		t.Errorf("Enemy should hold still while telegraphing, VelX = %f", instance.VelX)
+	}
+
+	for i := 1; i < DashTelegraphFrames; i++ {
+		instance.Update(playerX, 100)
+	}
+
+	if !instance.IsDashing() {
+		t.Fatal("Enemy should be dashing once the telegraph ends")
+	}
+	if instance.VelX != DashSpeed {
+		t.Errorf("Dash should burst forward at %f, got %f", DashSpeed, instance.VelX)
+	}
+	if instance.VelX <= enemy.Speed {
+		t.Error("Dash should outpace the enemy's walk speed")
+	}
+	if instance.GetAttackDamage() != enemy.Damage {
+		t.Error("A dashing enemy should deal its attack damage")
+	}
+
+	for i := 0; i < DashFrames; i++ {
+		instance.Update(playerX, 100)
+	}
+	if instance.IsDashing() {
+		t.Error("Dash should end after DashFrames")
+	}
+	if instance.AttackCooldown <= 0 {
+		t.Error("Dash should go on cooldown")
+	}
+}
+
+func TestSlamAttackLandsAfterTelegraph(t *testing.T) {
+	enemy := &Enemy{
+		Health:    80,
+		Damage:    15,
+		Speed:     1.0,
+		Size:      LargeEnemy,
+		Behavior:  PatrolBehavior,
+		Archetype: SlamArchetype,
+	}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+
+	for i := 0; i < SlamTelegraphFrames-1; i++ {
+		instance.Update(130, 100)
+		if _, ok := instance.TakeSlam(); ok {
+			t.Fatalf("Slam landed during its telegraph at frame %d", i)
+		}
+	}
+
+	instance.Update(130, 100)
+	slam, ok := instance.TakeSlam()
+	if !ok {
+		t.Fatal("Slam should land when the telegraph ends")
+	}
+	if slam.Radius != SlamRadius || slam.Damage != instance.EffectiveDamage() {
+		t.Errorf("Unexpected slam %+v", slam)
+	}
+	if _, ok := instance.TakeSlam(); ok {
+		t.Error("A slam should only be taken once")
+	}
+}
+
+func TestHitStunCancelsDash(t *testing.T) {
+	enemy := &Enemy{Health: 50, Speed: 1.5, Size: MediumEnemy, Behavior: ChaseBehavior, Archetype: DashArchetype}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+	instance.Update(150, 100)
+
+	instance.Launch(5, 10)
+	instance.Update(150, 100)
+	if _, _, _, _, ok := instance.Telegraph(); ok {
+		t.Error("Hit-stun should cancel a dash wind-up")
+	}
+}
+
+func TestWindupIsReportedOncePerAttack(t *testing.T) {
+	enemy := &Enemy{Health: 80, Damage: 15, Speed: 1.0, Size: LargeEnemy, Behavior: PatrolBehavior, Archetype: SlamArchetype}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+
+	windups := 0
+	for i := 0; i < SlamTelegraphFrames+5; i++ {
+		instance.Update(130, 100)
+		if instance.TakeWindup() {
+			windups++
+		}
+	}
+	if windups != 1 {
+		t.Errorf("wind-up reported %d times for one slam, want 1", windups)
+	}
+}
@@ -0,0 +1,105 @@
+package entity
+
+// AwarenessState describes how aware an enemy is of the player
+type AwarenessState int
+
+const (
+	// Unaware enemies go about their patrol
+	Unaware AwarenessState = iota
+	// Suspicious enemies have noticed something but are not yet hostile
+	Suspicious
+	// Alerted enemies have detected the player and will give chase
+	Alerted
+)
+
+// Awareness tuning. The alert meter runs from 0 to 1.
+const (
+	AlertRiseFrames  = 45  // Frames in range to go from unaware to alerted
+	AlertDecayFrames = 180 // Frames out of range to calm down completely
+	AlertFlashFrames = 60  // Frames the "!" indicator shows after alerting
+)
+
+// updateAwareness fills the alert meter while the player is in range and
+// drains it otherwise. Reaching a full meter alerts the enemy, which stays
+// alerted until the meter has drained back to empty.
+func (ei *EnemyInstance) updateAwareness(playerInRange bool) {
+	if ei.alerted {
+		ei.alertedFrames++
+	}
+
+	if playerInRange {
+		ei.Alert += 1.0 / AlertRiseFrames
+		if ei.Alert >= 1.0 {
+			ei.Alert = 1.0
+			if !ei.alerted {
+				ei.alerted = true
+				ei.alertedFrames = 0
+			}
+		}
+		return
+	}
+
+	ei.Alert -= 1.0 / AlertDecayFrames
+	if ei.Alert <= 1e-9 { // Allow for rounding in the repeated subtraction
+		ei.Alert = 0
+		ei.alerted = false
+	}
+}
+
+// Calm resets the enemy to idle and unaware, and keeps it from noticing
+// or attacking the player for frames, e.g. while the player respawns
+func (ei *EnemyInstance) Calm(frames int) {
+	if ei.IsDead() || frames <= 0 {
+		return
+	}
+	ei.cancelArchetypeAttack()
+	ei.breakCombo()
+	ei.State = IdleState
+	ei.VelX = 0
+	ei.Alert = 0
+	ei.alerted = false
+	ei.calmFrames = max(ei.calmFrames, frames)
+	if ei.AnimController != nil {
+		ei.AnimController.Play("idle", false)
+	}
+}
+
+// Calmed reports whether the enemy is still ignoring the player after Calm
+func (ei *EnemyInstance) Calmed() bool {
+	return ei.calmFrames > 0
+}
+
+// Alarm fully alerts the enemy at once, e.g. when it is hit
+func (ei *EnemyInstance) Alarm() {
+	ei.Alert = 1.0
+	if !ei.alerted {
+		ei.alerted = true
+		ei.alertedFrames = 0
+	}
+}
+
+// Awareness returns the enemy's current awareness state
+func (ei *EnemyInstance) Awareness() AwarenessState {
+	switch {
+	case ei.alerted:
+		return Alerted
+	case ei.Alert > 0:
+		return Suspicious
+	default:
+		return Unaware
+	}
+}
+
+// AlertIndicator returns the symbol to draw over the enemy: "?" while
+// suspicious, "!" just after being alerted, and "" otherwise
+func (ei *EnemyInstance) AlertIndicator() string {
+	switch ei.Awareness() {
+	case Suspicious:
+		return "?"
+	case Alerted:
+		if ei.alertedFrames < AlertFlashFrames {
+			return "!"
+		}
+	}
+	return ""
+}
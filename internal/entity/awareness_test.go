@@ -0,0 +1,78 @@
+package entity
+
+import "testing"
+
+func newAwarenessTestEnemy() *EnemyInstance {
+	enemy := &Enemy{Health: 50, Speed: 2.0, Behavior: PatrolBehavior}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.PatrolMinX = 50
+	instance.PatrolMaxX = 150
+	return instance
+}
+
+func TestAwareness_BriefPresenceRaisesAlert(t *testing.T) {
+	instance := newAwarenessTestEnemy()
+
+	for i := 0; i < AlertRiseFrames/3; i++ {
+		instance.Update(150, 100) // In aggro range
+	}
+
+	if instance.Alert <= 0 {
+		t.Error("Alert did not rise while the player was in range")
+	}
+	if instance.Alert >= 1 {
+		t.Errorf("Alert = %v after a brief presence, want below full", instance.Alert)
+	}
+	if got := instance.Awareness(); got != Suspicious {
+		t.Errorf("Awareness() = %v, want Suspicious", got)
+	}
+	if instance.State == ChaseState {
+		t.Error("enemy aggroed before reaching full alert")
+	}
+	if got := instance.AlertIndicator(); got != "?" {
+		t.Errorf("AlertIndicator() = %q, want \"?\"", got)
+	}
+}
+
+func TestAwareness_SustainedPresenceTriggersAggro(t *testing.T) {
+	instance := newAwarenessTestEnemy()
+
+	for i := 0; i < AlertRiseFrames+1; i++ {
+		instance.Update(150, 100)
+	}
+
+	if got := instance.Awareness(); got != Alerted {
+		t.Fatalf("Awareness() = %v after sustained presence, want Alerted", got)
+	}
+	if instance.State != ChaseState {
+		t.Errorf("State = %v, want ChaseState once alerted", instance.State)
+	}
+	if got := instance.AlertIndicator(); got != "!" {
+		t.Errorf("AlertIndicator() = %q, want \"!\"", got)
+	}
+}
+
+func TestAwareness_DecaysOutOfRange(t *testing.T) {
+	instance := newAwarenessTestEnemy()
+	instance.Alarm()
+
+	for i := 0; i < AlertDecayFrames; i++ {
+		instance.Update(2000, 100) // Far away
+	}
+
+	if got := instance.Awareness(); got != Unaware {
+		t.Errorf("Awareness() = %v after the player left, want Unaware", got)
+	}
+	if got := instance.AlertIndicator(); got != "" {
+		t.Errorf("AlertIndicator() = %q, want none", got)
+	}
+}
+
+func TestAwareness_DamageAlarms(t *testing.T) {
+	instance := newAwarenessTestEnemy()
+	instance.TakeDamage(1)
+
+	if got := instance.Awareness(); got != Alerted {
+		t.Errorf("Awareness() = %v after being hit, want Alerted", got)
+	}
+}
@@ -0,0 +1,311 @@
+// Package entity provides a data-driven attack-pattern format for bosses.
+// A pattern is a sequence of moves, each with a telegraph window, an
+// optional hitbox, and projectile spawns keyed to specific frames.
+package entity
+
+import (
+	"math/rand"
+)
+
+// PatternHitbox describes a damaging area relative to the attacker's centre.
+// OffsetX is measured in the facing direction, so a positive value is always
+// in front of the boss regardless of which way it is facing.
+type PatternHitbox struct {
+	OffsetX, OffsetY float64
+	Width, Height    float64
+	Damage           int
+
+	// Unblockable hits cannot be parried; the player has to dodge them
+	Unblockable bool
+}
+
+// UnblockableTelegraphFrames is the minimum wind-up of an unblockable move.
+// It is not shortened in later phases, so there is always time to dodge.
+const UnblockableTelegraphFrames = 60
+
+// WorldRect converts the hitbox to world coordinates for an attacker centred
+// at (originX, originY) facing facingDir (-1 left, 1 right).
+func (h PatternHitbox) WorldRect(originX, originY, facingDir float64) (x, y, width, height float64) {
+	if facingDir < 0 {
+		x = originX - h.OffsetX - h.Width
+	} else {
+		x = originX + h.OffsetX
+	}
+	y = originY + h.OffsetY - h.Height/2
+	return x, y, h.Width, h.Height
+}
+
+// ProjectileSpawn describes a projectile fired on a specific frame of a move.
+// Frame is relative to the start of the move (0 = first frame of the move).
+// OffsetX and VelX are measured in the facing direction.
+type ProjectileSpawn struct {
+	Frame            int
+	OffsetX, OffsetY float64
+	VelX, VelY       float64
+	Damage           int
+}
+
+// AttackMove is a single step of a boss attack pattern.
+type AttackMove struct {
+	Name string
+
+	// TelegraphFrames is the wind-up before the hitbox becomes active.
+	TelegraphFrames int
+	// ActiveFrames is how long the hitbox stays live after the telegraph.
+	ActiveFrames int
+	// RecoveryFrames is the vulnerable pause after the hitbox expires.
+	RecoveryFrames int
+
+	// Hitbox is the damaging area during active frames (nil for no melee).
+	Hitbox *PatternHitbox
+	// Projectiles are fired on their configured frames.
+	Projectiles []ProjectileSpawn
+}
+
+// Duration returns the total length of the move in frames.
+func (m AttackMove) Duration() int {
+	return m.TelegraphFrames + m.ActiveFrames + m.RecoveryFrames
+}
+
+// AttackPattern is an ordered sequence of moves executed as one attack.
+type AttackPattern struct {
+	Name  string
+	Moves []AttackMove
+}
+
+// Duration returns the total length of the pattern in frames.
+func (p AttackPattern) Duration() int {
+	total := 0
+	for _, m := range p.Moves {
+		total += m.Duration()
+	}
+	return total
+}
+
+// PatternFrame reports what a pattern is doing on a single frame.
+type PatternFrame struct {
+	MoveIndex    int
+	MoveFrame    int
+	Move         *AttackMove
+	Telegraphing bool
+	Hitbox       *PatternHitbox    // non-nil only while the hitbox is active
+	Projectiles  []ProjectileSpawn // projectiles spawned on this frame
+}
+
+// PatternExecutor steps through an AttackPattern one frame at a time.
+type PatternExecutor struct {
+	pattern   *AttackPattern
+	moveIndex int
+	moveFrame int
+	done      bool
+}
+
+// NewPatternExecutor creates an executor positioned at the first frame of pattern.
+func NewPatternExecutor(pattern *AttackPattern) *PatternExecutor {
+	pe := &PatternExecutor{pattern: pattern}
+	pe.Reset()
+	return pe
+}
+
+// Reset rewinds the executor to the first frame of its pattern.
+func (pe *PatternExecutor) Reset() {
+	pe.moveIndex = 0
+	pe.moveFrame = 0
+	pe.done = pe.pattern == nil || len(pe.pattern.Moves) == 0
+}
+
+// Pattern returns the pattern being executed.
+func (pe *PatternExecutor) Pattern() *AttackPattern {
+	return pe.pattern
+}
+
+// Done reports whether every move of the pattern has finished.
+func (pe *PatternExecutor) Done() bool {
+	return pe.done
+}
+
+// Step returns the state for the current frame and advances by one frame.
+// Once the pattern is done, Step returns a zero PatternFrame with a nil Move.
+func (pe *PatternExecutor) Step() PatternFrame {
+	if pe.done {
+		return PatternFrame{MoveIndex: -1}
+	}
+
+	move := &pe.pattern.Moves[pe.moveIndex]
+	frame := PatternFrame{
+		MoveIndex:    pe.moveIndex,
+		MoveFrame:    pe.moveFrame,
+		Move:         move,
+		Telegraphing: pe.moveFrame < move.TelegraphFrames,
+	}
+
+	activeStart := move.TelegraphFrames
+	activeEnd := activeStart + move.ActiveFrames
+	if move.Hitbox != nil && pe.moveFrame >= activeStart && pe.moveFrame < activeEnd {
+		frame.Hitbox = move.Hitbox
+	}
+
+	for _, spawn := range move.Projectiles {
+		if spawn.Frame == pe.moveFrame {
+			frame.Projectiles = append(frame.Projectiles, spawn)
+		}
+	}
+
+	pe.moveFrame++
+	if pe.moveFrame >= move.Duration() {
+		pe.moveFrame = 0
+		pe.moveIndex++
+		if pe.moveIndex >= len(pe.pattern.Moves) {
+			pe.done = true
+		}
+	}
+
+	return frame
+}
+
+// PhaseIndex returns the index of the boss phase active at the given health
+// percentage (0.0-1.0). Phases are ordered by descending HealthThreshold;
+// the deepest phase whose threshold has been crossed wins.
+func (b *Boss) PhaseIndex(healthPercent float64) int {
+	index := 0
+	for i, phase := range b.Phases {
+		if healthPercent <= phase.HealthThreshold {
+			index = i
+		}
+	}
+	return index
+}
+
+// PatternForPhase returns the scripted attack pattern for a phase, or nil if
+// the boss has no scripted patterns.
+func (b *Boss) PatternForPhase(phase int) *AttackPattern {
+	if len(b.AttackPatterns) == 0 {
+		return nil
+	}
+	if phase < 0 {
+		phase = 0
+	}
+	if phase >= len(b.AttackPatterns) {
+		phase = len(b.AttackPatterns) - 1
+	}
+	return &b.AttackPatterns[phase]
+}
+
+// buildAttackPattern scripts the moves for a named attack pattern. Timings
+// are jittered by rng so two bosses sharing a pattern name still differ.
+// Later phases (higher phase index) telegraph for less time.
+func buildAttackPattern(name string, damage, phase int, rng *rand.Rand) AttackPattern {
+	// Each phase shaves a few frames off the wind-up, floored for fairness.
+	telegraph := func(base int) int {
+		t := base + rng.Intn(10) - phase*4
+		if t < 12 {
+			t = 12
+		}
+		return t
+	}
+
+	pattern := AttackPattern{Name: name}
+
+	switch name {
+	case "triple_strike":
+		for i := 0; i < 3; i++ {
+			pattern.Moves = append(pattern.Moves, AttackMove{
+				Name:            "strike",
+				TelegraphFrames: telegraph(16),
+				ActiveFrames:    8,
+				RecoveryFrames:  6,
+				Hitbox:          &PatternHitbox{OffsetX: 48, Width: 64, Height: 48, Damage: damage},
+			})
+		}
+
+	case "charge_attack":
+		pattern.Moves = append(pattern.Moves, AttackMove{
+			Name:            "charge",
+			TelegraphFrames: telegraph(36),
+			ActiveFrames:    20,
+			RecoveryFrames:  30,
+			Hitbox:          &PatternHitbox{OffsetX: 32, Width: 96, Height: 96, Damage: damage * 3 / 2},
+		})
+
+	case "area_blast":
+		// Too big to parry: the blast must be dodged through
+		pattern.Moves = append(pattern.Moves, AttackMove{
+			Name:            "blast",
+			TelegraphFrames: max(telegraph(45), UnblockableTelegraphFrames),
+			ActiveFrames:    12,
+			RecoveryFrames:  40,
+			Hitbox: &PatternHitbox{OffsetX: -128, Width: 256, Height: 160, Damage: damage * 2,
+				Unblockable: true},
+		})
+
+	case "summon_minions":
+		// No minion spawning yet: a slow fan of orbs stands in.
+		spawns := make([]ProjectileSpawn, 0, 3)
+		for i := 0; i < 3; i++ {
+			spawns = append(spawns, ProjectileSpawn{
+				Frame:   i * 10,
+				OffsetX: 32,
+				OffsetY: -32,
+				VelX:    2.5,
+				VelY:    float64(i-1) * 1.0,
+				Damage:  damage / 2,
+			})
+		}
+		t := telegraph(30)
+		for i := range spawns {
+			spawns[i].Frame += t
+		}
+		pattern.Moves = append(pattern.Moves, AttackMove{
+			Name:            "summon",
+			TelegraphFrames: t,
+			ActiveFrames:    30,
+			RecoveryFrames:  30,
+			Projectiles:     spawns,
+		})
+
+	case "projectile_barrage":
+		volleys := 3 + rng.Intn(3)
+		t := telegraph(20)
+		spawns := make([]ProjectileSpawn, 0, volleys)
+		for i := 0; i < volleys; i++ {
+			spawns = append(spawns, ProjectileSpawn{
+				Frame:   t + i*8,
+				OffsetX: 48,
+				VelX:    6.0,
+				Damage:  damage / 2,
+			})
+		}
+		pattern.Moves = append(pattern.Moves, AttackMove{
+			Name:            "barrage",
+			TelegraphFrames: t,
+			ActiveFrames:    volleys * 8,
+			RecoveryFrames:  24,
+			Projectiles:     spawns,
+		})
+
+	case "ground_pound":
+		t := telegraph(30)
+		pattern.Moves = append(pattern.Moves, AttackMove{
+			Name:            "pound",
+			TelegraphFrames: t,
+			ActiveFrames:    10,
+			RecoveryFrames:  36,
+			Hitbox:          &PatternHitbox{OffsetX: -80, OffsetY: 48, Width: 160, Height: 32, Damage: damage},
+			Projectiles: []ProjectileSpawn{
+				{Frame: t, OffsetX: 64, OffsetY: 56, VelX: 5.0, Damage: damage / 2},
+				{Frame: t, OffsetX: -64, OffsetY: 56, VelX: -5.0, Damage: damage / 2},
+			},
+		})
+
+	default:
+		pattern.Moves = append(pattern.Moves, AttackMove{
+			Name:            "strike",
+			TelegraphFrames: telegraph(24),
+			ActiveFrames:    10,
+			RecoveryFrames:  20,
+			Hitbox:          &PatternHitbox{OffsetX: 48, Width: 64, Height: 48, Damage: damage},
+		})
+	}
+
+	return pattern
+}
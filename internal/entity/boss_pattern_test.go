@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestPatternExecutorRunsMovesInOrder(t *testing.T) {
+	pattern := &AttackPattern{
+		Name: "test",
+		Moves: []AttackMove{
+			{Name: "first", TelegraphFrames: 3, ActiveFrames: 2, RecoveryFrames: 1,
+				Hitbox: &PatternHitbox{Width: 10, Height: 10, Damage: 5}},
+			{Name: "second", TelegraphFrames: 2, ActiveFrames: 4, RecoveryFrames: 2,
+				Projectiles: []ProjectileSpawn{{Frame: 2, VelX: 3}, {Frame: 4, VelX: 3}}},
+		},
+	}
+
+	exec := NewPatternExecutor(pattern)
+	var moveNames []string
+	var hitboxFrames, telegraphFrames, projectileFrames []int
+
+	for frame := 0; !exec.Done(); frame++ {
+		if frame > pattern.Duration() {
+			t.Fatalf("executor did not finish within %d frames", pattern.Duration())
+		}
+		pf := exec.Step()
+		if len(moveNames) == 0 || moveNames[len(moveNames)-1] != pf.Move.Name {
+			moveNames = append(moveNames, pf.Move.Name)
+		}
+		if pf.Telegraphing {
+			telegraphFrames = append(telegraphFrames, frame)
+		}
+		if pf.Hitbox != nil {
+			hitboxFrames = append(hitboxFrames, frame)
+		}
+		for range pf.Projectiles {
+			projectileFrames = append(projectileFrames, frame)
+		}
+	}
+
+	if want := []string{"first", "second"}; !reflect.DeepEqual(moveNames, want) {
+		t.Errorf("move order = %v, want %v", moveNames, want)
+	}
+	if want := []int{0, 1, 2, 6, 7}; !reflect.DeepEqual(telegraphFrames, want) {
+		t.Errorf("telegraph frames = %v, want %v", telegraphFrames, want)
+	}
+	if want := []int{3, 4}; !reflect.DeepEqual(hitboxFrames, want) {
+		t.Errorf("hitbox frames = %v, want %v", hitboxFrames, want)
+	}
+	// Second move starts on frame 6, so its spawns land on frames 8 and 10
+	if want := []int{8, 10}; !reflect.DeepEqual(projectileFrames, want) {
+		t.Errorf("projectile frames = %v, want %v", projectileFrames, want)
+	}
+
+	if pf := exec.Step(); pf.Move != nil {
+		t.Errorf("Step() after Done returned move %q, want nil", pf.Move.Name)
+	}
+
+	exec.Reset()
+	if exec.Done() {
+		t.Error("Done() after Reset = true, want false")
+	}
+}
+
+func TestPatternHitboxWorldRectMirrorsFacing(t *testing.T) {
+	h := PatternHitbox{OffsetX: 20, OffsetY: 0, Width: 30, Height: 10}
+
+	x, _, _, _ := h.WorldRect(100, 50, 1)
+	if x != 120 {
+		t.Errorf("facing right x = %v, want 120", x)
+	}
+	x, _, _, _ = h.WorldRect(100, 50, -1)
+	if x != 50 {
+		t.Errorf("facing left x = %v, want 50", x)
+	}
+}
+
+func TestBossGeneratorAttackPatterns(t *testing.T) {
+	gen := NewBossGenerator(1)
+	boss := gen.Generate("cave", 4242)
+
+	if len(boss.AttackPatterns) != len(boss.Phases) {
+		t.Fatalf("len(AttackPatterns) = %d, want %d", len(boss.AttackPatterns), len(boss.Phases))
+	}
+	for i, p := range boss.AttackPatterns {
+		if p.Name != boss.Phases[i].AttackPattern {
+			t.Errorf("pattern %d name = %q, want %q", i, p.Name, boss.Phases[i].AttackPattern)
+		}
+		if len(p.Moves) == 0 {
+			t.Errorf("pattern %d has no moves", i)
+		}
+		for _, m := range p.Moves {
+			if m.TelegraphFrames <= 0 {
+				t.Errorf("pattern %d move %q has no telegraph", i, m.Name)
+			}
+			for _, s := range m.Projectiles {
+				if s.Frame < 0 || s.Frame >= m.Duration() {
+					t.Errorf("pattern %d projectile frame %d outside move duration %d", i, s.Frame, m.Duration())
+				}
+			}
+		}
+	}
+
+	again := NewBossGenerator(1).Generate("cave", 4242)
+	if !reflect.DeepEqual(boss.AttackPatterns, again.AttackPatterns) {
+		t.Error("attack patterns are not deterministic for the same seed")
+	}
+}
+
+func TestBossPhaseIndex(t *testing.T) {
+	boss := &Boss{Phases: []BossPhase{
+		{HealthThreshold: 0.66},
+		{HealthThreshold: 0.33},
+	}}
+
+	tests := []struct {
+		health float64
+		want   int
+	}{
+		{1.0, 0},
+		{0.5, 0},
+		{0.3, 1},
+		{0.0, 1},
+	}
+	for _, tt := range tests {
+		if got := boss.PhaseIndex(tt.health); got != tt.want {
+			t.Errorf("PhaseIndex(%v) = %d, want %d", tt.health, got, tt.want)
+		}
+	}
+}
+
+func TestAreaBlastIsUnblockable(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for phase := 0; phase < 4; phase++ {
+		pattern := buildAttackPattern("area_blast", 10, phase, rng)
+		move := pattern.Moves[0]
+		if move.Hitbox == nil || !move.Hitbox.Unblockable {
+			t.Fatalf("phase %d area blast is not unblockable", phase)
+		}
+		if move.TelegraphFrames < UnblockableTelegraphFrames {
+			t.Errorf("phase %d telegraph = %d, want at least %d", phase, move.TelegraphFrames, UnblockableTelegraphFrames)
+		}
+	}
+
+	strike := buildAttackPattern("triple_strike", 10, 0, rng)
+	if strike.Moves[0].Hitbox.Unblockable {
+		t.Error("triple strike should stay blockable")
+	}
+}
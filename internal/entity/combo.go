@@ -0,0 +1,90 @@
+package entity
+
+// Combo tuning. Aggressive enemies and bosses chain quick follow-up strikes
+// before their full cooldown, each one hitting harder than the last.
+const (
+	ComboFollowUpFrames = 18   // Wind-up before each follow-up strike
+	ComboWindowFrames   = 30   // Extra time the player can stay out of reach before the combo drops
+	ComboDamageStep     = 0.25 // Damage added per follow-up, as a fraction of base damage
+	ComboReachStep      = 0.25 // Telegraph reach added per follow-up, as a fraction of attack range
+)
+
+// SelectComboLength picks how many attacks an enemy chains: three for
+// bosses, two for reckless enemies, and a single attack otherwise
+func SelectComboLength(size EnemySize, aggression AggressionProfile) int {
+	switch {
+	case size == BossEnemy:
+		return 3
+	case aggression == RecklessAggression:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// comboLength returns the enemy's combo length, treating unset as one
+func (ei *EnemyInstance) comboLength() int {
+	return max(ei.Enemy.ComboLength, 1)
+}
+
+// attackCooldown records an attack starting and returns the cooldown to
+// wait before the next one: a short follow-up wind-up mid-combo, or full
+// once the combo's last attack is out
+func (ei *EnemyInstance) attackCooldown(full int) int {
+	if ei.ComboStep >= ei.comboLength() {
+		ei.ComboStep = 0
+	}
+	ei.ComboStep++
+	ei.comboTimer = 0
+	if ei.ComboStep < ei.comboLength() {
+		ei.comboCooldown = full
+		ei.windupCue = true
+		return ComboFollowUpFrames
+	}
+	return full
+}
+
+// updateCombo drops a combo whose follow-up never came, e.g. because the
+// player moved out of reach, and starts the full cooldown
+func (ei *EnemyInstance) updateCombo() {
+	if !ei.ComboPending() {
+		return
+	}
+	ei.comboTimer++
+	if ei.comboTimer > ComboFollowUpFrames+ComboWindowFrames {
+		ei.breakCombo()
+	}
+}
+
+// breakCombo abandons the combo in progress
+func (ei *EnemyInstance) breakCombo() {
+	if ei.ComboPending() {
+		ei.AttackCooldown = max(ei.AttackCooldown, ei.comboCooldown)
+	}
+	ei.ComboStep = 0
+}
+
+// ComboPending reports whether the enemy is partway through a combo, with
+// a follow-up still to come
+func (ei *EnemyInstance) ComboPending() bool {
+	return ei.ComboStep > 0 && ei.ComboStep < ei.comboLength()
+}
+
+// comboDamage scales damage up for each follow-up in the current combo
+func (ei *EnemyInstance) comboDamage(damage int) int {
+	if ei.ComboStep <= 1 {
+		return damage
+	}
+	return int(float64(damage)*(1+ComboDamageStep*float64(ei.ComboStep-1)) + 0.5)
+}
+
+// comboTelegraph returns the area the next follow-up will strike, growing
+// with each step of the combo. ok is false between combos.
+func (ei *EnemyInstance) comboTelegraph() (x, y, w, h float64, ok bool) {
+	if !ei.ComboPending() || ei.AttackCooldown <= 0 {
+		return 0, 0, 0, 0, false
+	}
+	ex, ey, ew, eh := ei.GetBounds()
+	reach := ei.AttackRange * (1 + ComboReachStep*float64(ei.ComboStep))
+	return ex - reach, ey, ew + reach*2, eh, true
+}
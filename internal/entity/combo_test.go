@@ -0,0 +1,103 @@
+package entity
+
+import "testing"
+
+// attackFrames runs the enemy next to the player and returns the frames on
+// which it started an attack
+func attackFrames(instance *EnemyInstance, frames int) []int {
+	var starts []int
+	for f := 0; f < frames; f++ {
+		instance.Update(instance.X+10, instance.Y)
+		if instance.State == AttackState {
+			starts = append(starts, f)
+		}
+	}
+	return starts
+}
+
+func TestComboEnemyChainsAttacksBeforeCooldown(t *testing.T) {
+	enemy := &Enemy{Health: 100, Damage: 10, Speed: 1.0, Size: MediumEnemy, Behavior: StationaryBehavior, ComboLength: 3}
+	instance := NewEnemyInstance(enemy, 100, 100)
+
+	starts := attackFrames(instance, 300)
+	if len(starts) < 4 {
+		t.Fatalf("got %d attacks, want at least 4", len(starts))
+	}
+	for i := 1; i < 3; i++ {
+		if gap := starts[i] - starts[i-1]; gap > ComboFollowUpFrames+1 {
+			t.Errorf("follow-up %d came after %d frames, want at most %d", i, gap, ComboFollowUpFrames+1)
+		}
+	}
+	if gap := starts[3] - starts[2]; gap < 90 {
+		t.Errorf("attack after the combo came after %d frames, want the full cooldown of 90", gap)
+	}
+}
+
+func TestSingleAttackEnemyCoolsDownAfterEachAttack(t *testing.T) {
+	enemy := &Enemy{Health: 100, Damage: 10, Speed: 1.0, Size: MediumEnemy, Behavior: StationaryBehavior}
+	instance := NewEnemyInstance(enemy, 100, 100)
+
+	starts := attackFrames(instance, 200)
+	if len(starts) < 2 {
+		t.Fatalf("got %d attacks, want at least 2", len(starts))
+	}
+	if gap := starts[1] - starts[0]; gap < 90 {
+		t.Errorf("second attack came after %d frames, want the full cooldown of 90", gap)
+	}
+}
+
+func TestComboDamageEscalates(t *testing.T) {
+	enemy := &Enemy{Health: 100, Damage: 10, Speed: 1.0, Size: MediumEnemy, Behavior: StationaryBehavior, ComboLength: 3}
+	instance := NewEnemyInstance(enemy, 100, 100)
+
+	var damages []int
+	for f := 0; f < 100 && len(damages) < 3; f++ {
+		instance.Update(instance.X+10, instance.Y)
+		if instance.State == AttackState {
+			damages = append(damages, instance.GetAttackDamage())
+			if len(damages) < 3 {
+				if _, _, _, _, ok := instance.Telegraph(); !ok {
+					t.Errorf("no telegraph before follow-up %d", len(damages))
+				}
+			}
+		}
+	}
+	if len(damages) != 3 {
+		t.Fatalf("got %d attacks, want 3", len(damages))
+	}
+	if !(damages[0] < damages[1] && damages[1] < damages[2]) {
+		t.Errorf("combo damage = %v, want increasing", damages)
+	}
+}
+
+func TestComboDropsWhenPlayerLeaves(t *testing.T) {
+	enemy := &Enemy{Health: 100, Damage: 10, Speed: 1.0, Size: MediumEnemy, Behavior: StationaryBehavior, ComboLength: 3}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm() // Stationary enemies only attack once alerted
+
+	instance.Update(instance.X+10, instance.Y)
+	if instance.ComboStep != 1 {
+		t.Fatalf("ComboStep = %d after the opener, want 1", instance.ComboStep)
+	}
+	for f := 0; f <= ComboFollowUpFrames+ComboWindowFrames; f++ {
+		instance.Update(instance.X+500, instance.Y)
+	}
+	if instance.ComboPending() {
+		t.Error("combo still pending after the player left")
+	}
+	if instance.AttackCooldown <= 0 {
+		t.Error("dropped combo did not start the full cooldown")
+	}
+}
+
+func TestSelectComboLength(t *testing.T) {
+	if got := SelectComboLength(BossEnemy, CautiousAggression); got != 3 {
+		t.Errorf("boss combo length = %d, want 3", got)
+	}
+	if got := SelectComboLength(MediumEnemy, RecklessAggression); got != 2 {
+		t.Errorf("reckless combo length = %d, want 2", got)
+	}
+	if got := SelectComboLength(MediumEnemy, CautiousAggression); got != 1 {
+		t.Errorf("cautious combo length = %d, want 1", got)
+	}
+}
@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"math"
+	"sort"
+
+	"github.com/opd-ai/vania/internal/physics"
+)
+
+// SeparationCellSize is the side of a spatial grid cell used to find
+// neighboring enemies, in pixels. Enemies only test overlap against others
+// sharing a cell.
+const SeparationCellSize = 64.0
+
+// SpatialGrid buckets rectangles by the cells they cover so nearby pairs
+// can be found without testing every pair
+type SpatialGrid struct {
+	cellSize float64
+	cells    map[[2]int][]int
+}
+
+// NewSpatialGrid creates an empty grid with the given cell size
+func NewSpatialGrid(cellSize float64) *SpatialGrid {
+	return &SpatialGrid{cellSize: cellSize, cells: make(map[[2]int][]int)}
+}
+
+// Insert adds the rectangle with the given index to every cell it covers
+func (g *SpatialGrid) Insert(index int, x, y, w, h float64) {
+	c0, r0 := int(math.Floor(x/g.cellSize)), int(math.Floor(y/g.cellSize))
+	c1, r1 := int(math.Floor((x+w)/g.cellSize)), int(math.Floor((y+h)/g.cellSize))
+	for r := r0; r <= r1; r++ {
+		for c := c0; c <= c1; c++ {
+			key := [2]int{c, r}
+			g.cells[key] = append(g.cells[key], index)
+		}
+	}
+}
+
+// Pairs returns each pair of indices that share a cell, once, lower index
+// first, in a stable order
+func (g *SpatialGrid) Pairs() [][2]int {
+	seen := make(map[[2]int]bool)
+	var pairs [][2]int
+	for _, members := range g.cells {
+		for i := 0; i < len(members); i++ {
+			for j := i + 1; j < len(members); j++ {
+				pair := [2]int{min(members[i], members[j]), max(members[i], members[j])}
+				if !seen[pair] {
+					seen[pair] = true
+					pairs = append(pairs, pair)
+				}
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	return pairs
+}
+
+// SeparateEnemies pushes overlapping living enemies apart, each taking half
+// of the correction. Ground enemies are only pushed sideways so they stay
+// on their platforms. With flyingOverlap, pairs involving a flying enemy
+// are left alone.
+func SeparateEnemies(enemies []*EnemyInstance, flyingOverlap bool) {
+	grid := NewSpatialGrid(SeparationCellSize)
+	for i, enemy := range enemies {
+		if enemy.IsDead() {
+			continue
+		}
+		x, y, w, h := enemy.GetBounds()
+		grid.Insert(i, x, y, w, h)
+	}
+
+	for _, pair := range grid.Pairs() {
+		a, b := enemies[pair[0]], enemies[pair[1]]
+		flying := a.Enemy.Behavior == FlyingBehavior || b.Enemy.Behavior == FlyingBehavior
+		if flying && flyingOverlap {
+			continue
+		}
+		separatePair(a, b, flying)
+	}
+}
+
+// separatePair moves two enemies out of each other along the axis of least
+// overlap, or sideways only when neither flies
+func separatePair(a, b *EnemyInstance, flying bool) {
+	ax, ay, aw, ah := a.GetBounds()
+	bx, by, bw, bh := b.GetBounds()
+	dx, dy := physics.AABBPenetration(ax, ay, aw, ah, bx, by, bw, bh)
+	if dx == 0 || dy == 0 {
+		return
+	}
+
+	if flying && math.Abs(dy) < math.Abs(dx) {
+		a.Y += dy / 2
+		b.Y -= dy / 2
+		return
+	}
+
+	// Ties go to a on the left so stacked enemies still split apart
+	a.X += dx / 2
+	b.X -= dx / 2
+}
@@ -0,0 +1,59 @@
+package entity
+
+import "testing"
+
+func overlaps(a, b *EnemyInstance) bool {
+	ax, ay, aw, ah := a.GetBounds()
+	bx, by, bw, bh := b.GetBounds()
+	return ax < bx+bw && ax+aw > bx && ay < by+bh && ay+ah > by
+}
+
+func TestSeparateEnemiesPushesGroundEnemiesApart(t *testing.T) {
+	enemy := &Enemy{Name: "Crawler", Health: 10, Size: MediumEnemy, Behavior: PatrolBehavior}
+	a := NewEnemyInstance(enemy, 100, 200)
+	b := NewEnemyInstance(enemy, 110, 200)
+	// Exactly stacked enemies must split too
+	c := NewEnemyInstance(enemy, 300, 200)
+	d := NewEnemyInstance(enemy, 300, 200)
+
+	SeparateEnemies([]*EnemyInstance{a, b, c, d}, true)
+
+	if overlaps(a, b) || overlaps(c, d) {
+		t.Errorf("enemies still overlap: a %v b %v, c %v d %v", a.X, b.X, c.X, d.X)
+	}
+	if a.X >= b.X {
+		t.Errorf("left enemy pushed to %v, right to %v; want them to keep their sides", a.X, b.X)
+	}
+	for _, e := range []*EnemyInstance{a, b, c, d} {
+		if e.Y != 200 {
+			t.Errorf("ground enemy moved vertically to %v", e.Y)
+		}
+	}
+}
+
+func TestSeparateEnemiesFlyingOverlap(t *testing.T) {
+	bat := &Enemy{Name: "Bat", Health: 10, Size: MediumEnemy, Behavior: FlyingBehavior}
+	crawler := &Enemy{Name: "Crawler", Health: 10, Size: MediumEnemy, Behavior: PatrolBehavior}
+
+	for _, flyingOverlap := range []bool{true, false} {
+		a := NewEnemyInstance(bat, 100, 200)
+		b := NewEnemyInstance(crawler, 105, 205)
+		SeparateEnemies([]*EnemyInstance{a, b}, flyingOverlap)
+		if overlaps(a, b) != flyingOverlap {
+			t.Errorf("flyingOverlap=%v: overlap after separation = %v", flyingOverlap, overlaps(a, b))
+		}
+	}
+}
+
+func TestSeparateEnemiesIgnoresDead(t *testing.T) {
+	enemy := &Enemy{Name: "Crawler", Health: 10, Size: MediumEnemy, Behavior: PatrolBehavior}
+	a := NewEnemyInstance(enemy, 100, 200)
+	b := NewEnemyInstance(enemy, 110, 200)
+	b.CurrentHealth = 0
+
+	SeparateEnemies([]*EnemyInstance{a, b}, true)
+
+	if a.X != 100 || b.X != 110 {
+		t.Errorf("a corpse should not push or be pushed: a %v, b %v", a.X, b.X)
+	}
+}
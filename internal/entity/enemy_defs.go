@@ -0,0 +1,158 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// EnemyDefinition is a hand-authored enemy read from JSON. Definitions are
+// merged into a generated game alongside the procedural enemies, appearing
+// in rooms of their biome.
+type EnemyDefinition struct {
+	Name           string  `json:"name"`
+	Biome          string  `json:"biome"`          // Biome the enemy lives in, e.g. "cave"
+	Kind           string  `json:"kind,omitempty"` // Biome enemy type, e.g. "bat"
+	Health         int     `json:"health"`
+	Damage         int     `json:"damage"`
+	Speed          float64 `json:"speed"`
+	DangerLevel    int     `json:"danger_level,omitempty"`
+	Size           string  `json:"size"`             // small, medium, or large
+	Behavior       string  `json:"behavior"`         // patrol, chase, flee, stationary, flying, jumping
+	Attack         string  `json:"attack,omitempty"` // melee, ranged, area, contact; defaults by size
+	Element        string  `json:"element,omitempty"`
+	Summoner       bool    `json:"summoner,omitempty"`        // Calls in minions; stationary ranged enemies always do
+	Mimic          bool    `json:"mimic,omitempty"`           // Counters the player's habits; cautious medium chasers always do
+	Aggression     string  `json:"aggression,omitempty"`      // cautious, balanced, or reckless; defaults to balanced
+	IgnoresHazards bool    `json:"ignores_hazards,omitempty"` // Paths straight through hazards
+}
+
+// enemyDefinitionFile is the top-level layout of an enemy definition file
+type enemyDefinitionFile struct {
+	Enemies []EnemyDefinition `json:"enemies"`
+}
+
+var enemySizeNames = map[string]EnemySize{
+	"small":  SmallEnemy,
+	"medium": MediumEnemy,
+	"large":  LargeEnemy,
+}
+
+var behaviorNames = map[string]BehaviorPattern{
+	"patrol":     PatrolBehavior,
+	"chase":      ChaseBehavior,
+	"flee":       FleeBehavior,
+	"stationary": StationaryBehavior,
+	"flying":     FlyingBehavior,
+	"jumping":    JumpingBehavior,
+}
+
+var attackTypeNames = map[string]AttackType{
+	"melee":   MeleeAttack,
+	"ranged":  RangedAttack,
+	"area":    AreaAttack,
+	"contact": ContactDamage,
+}
+
+// LoadEnemyDefinitions reads and validates an enemy definition file
+func LoadEnemyDefinitions(path string) ([]EnemyDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read enemy definitions: %w", err)
+	}
+	defs, err := ParseEnemyDefinitions(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return defs, nil
+}
+
+// ParseEnemyDefinitions decodes and validates enemy definitions of the form
+// {"enemies": [...]}. Unknown fields are rejected so typos are caught.
+func ParseEnemyDefinitions(data []byte) ([]EnemyDefinition, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var file enemyDefinitionFile
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("invalid enemy definition JSON: %w", err)
+	}
+
+	for i, def := range file.Enemies {
+		if err := def.Validate(); err != nil {
+			return nil, fmt.Errorf("enemy definition %d: %w", i, err)
+		}
+	}
+	return file.Enemies, nil
+}
+
+// Validate checks that the definition describes a usable enemy
+func (d EnemyDefinition) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+	if d.Biome == "" {
+		return fmt.Errorf("%q: missing biome", d.Name)
+	}
+	if d.Health <= 0 {
+		return fmt.Errorf("%q: health must be positive, got %d", d.Name, d.Health)
+	}
+	if d.Damage < 0 {
+		return fmt.Errorf("%q: damage must not be negative, got %d", d.Name, d.Damage)
+	}
+	if d.Speed < 0 {
+		return fmt.Errorf("%q: speed must not be negative, got %g", d.Name, d.Speed)
+	}
+	if _, ok := enemySizeNames[d.Size]; !ok {
+		return fmt.Errorf("%q: unknown size %q (want small, medium, or large)", d.Name, d.Size)
+	}
+	if _, ok := behaviorNames[d.Behavior]; !ok {
+		return fmt.Errorf("%q: unknown behavior %q", d.Name, d.Behavior)
+	}
+	if _, ok := attackTypeNames[d.Attack]; d.Attack != "" && !ok {
+		return fmt.Errorf("%q: unknown attack %q", d.Name, d.Attack)
+	}
+	if _, ok := aggressionNames[d.Aggression]; d.Aggression != "" && !ok {
+		return fmt.Errorf("%q: unknown aggression %q (want cautious, balanced, or reckless)", d.Name, d.Aggression)
+	}
+	return nil
+}
+
+// Enemy builds the enemy a validated definition describes. Attack type and
+// archetype fall back to the procedural choice for the enemy's size.
+func (d EnemyDefinition) Enemy() *Enemy {
+	enemy := &Enemy{
+		Name:        d.Name,
+		Health:      d.Health,
+		Damage:      d.Damage,
+		Speed:       d.Speed,
+		Size:        enemySizeNames[d.Size],
+		Behavior:    behaviorNames[d.Behavior],
+		DangerLevel: d.DangerLevel,
+		BiomeType:   d.Biome,
+		Kind:        d.Kind,
+		Element:     d.Element,
+		Aggression:  aggressionNames[d.Aggression],
+
+		IgnoresHazards: d.IgnoresHazards,
+	}
+
+	if attack, ok := attackTypeNames[d.Attack]; ok {
+		enemy.AttackType = attack
+	} else {
+		switch enemy.Size {
+		case SmallEnemy:
+			enemy.AttackType = ContactDamage
+		case LargeEnemy:
+			enemy.AttackType = AreaAttack
+		default:
+			enemy.AttackType = MeleeAttack
+		}
+	}
+	enemy.Archetype = SelectArchetype(enemy.Size, enemy.Behavior, enemy.AttackType)
+	enemy.Summoner = d.Summoner || IsSummoner(enemy.Size, enemy.Behavior, enemy.AttackType)
+	enemy.ComboLength = SelectComboLength(enemy.Size, enemy.Aggression)
+	enemy.Mimic = d.Mimic || IsMimic(enemy.Size, enemy.Behavior, enemy.Aggression)
+	return enemy
+}
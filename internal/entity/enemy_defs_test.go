@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleDefinitions = `{
+	"enemies": [
+		{
+			"name": "Ember Wisp",
+			"biome": "cave",
+			"kind": "bat",
+			"health": 40,
+			"damage": 12,
+			"speed": 2.5,
+			"danger_level": 4,
+			"size": "medium",
+			"behavior": "flying",
+			"attack": "ranged",
+			"element": "fire",
+			"aggression": "reckless"
+		},
+		{
+			"name": "Moss Golem",
+			"biome": "forest",
+			"health": 120,
+			"damage": 20,
+			"speed": 0.8,
+			"size": "large",
+			"behavior": "patrol"
+		}
+	]
+}`
+
+func TestParseEnemyDefinitions_BuildsSpecifiedEnemy(t *testing.T) {
+	defs, err := ParseEnemyDefinitions([]byte(sampleDefinitions))
+	if err != nil {
+		t.Fatalf("ParseEnemyDefinitions() error = %v", err)
+	}
+	if len(defs) != 2 {
+		t.Fatalf("Expected 2 definitions, got %d", len(defs))
+	}
+
+	enemy := defs[0].Enemy()
+	if enemy.Name != "Ember Wisp" || enemy.BiomeType != "cave" || enemy.Kind != "bat" {
+		t.Errorf("Unexpected identity: %+v", enemy)
+	}
+	if enemy.Health != 40 || enemy.Damage != 12 || enemy.Speed != 2.5 || enemy.DangerLevel != 4 {
+		t.Errorf("Unexpected stats: %+v", enemy)
+	}
+	if enemy.Size != MediumEnemy || enemy.Behavior != FlyingBehavior || enemy.AttackType != RangedAttack {
+		t.Errorf("Unexpected size/behavior/attack: %+v", enemy)
+	}
+	if enemy.Element != "fire" {
+		t.Errorf("Element = %q, want fire", enemy.Element)
+	}
+	if enemy.Aggression != RecklessAggression {
+		t.Errorf("Aggression = %v, want reckless", enemy.Aggression)
+	}
+
+	// Omitted attack falls back to the size default
+	golem := defs[1].Enemy()
+	if golem.AttackType != AreaAttack || golem.Archetype != SlamArchetype {
+		t.Errorf("Large patrol enemy should default to an area slam, got %v/%v", golem.AttackType, golem.Archetype)
+	}
+}
+
+func TestParseEnemyDefinitions_RejectsMalformedJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"syntax error", `{"enemies": [{"name": "Broken",}]}`, "invalid enemy definition JSON"},
+		{"wrong type", `{"enemies": [{"name": "Broken", "health": "lots"}]}`, "invalid enemy definition JSON"},
+		{"unknown field", `{"enemies": [{"name": "Typo", "helth": 10}]}`, "unknown field"},
+		{"missing name", `{"enemies": [{"biome": "cave", "health": 10, "size": "small", "behavior": "chase"}]}`, "missing name"},
+		{"bad size", `{"enemies": [{"name": "Huge", "biome": "cave", "health": 10, "size": "colossal", "behavior": "chase"}]}`, `unknown size "colossal"`},
+		{"bad behavior", `{"enemies": [{"name": "Odd", "biome": "cave", "health": 10, "size": "small", "behavior": "dance"}]}`, `unknown behavior "dance"`},
+		{"bad aggression", `{"enemies": [{"name": "Odd", "biome": "cave", "health": 10, "size": "small", "behavior": "chase", "aggression": "shy"}]}`, `unknown aggression "shy"`},
+		{"zero health", `{"enemies": [{"name": "Ghost", "biome": "cave", "health": 0, "size": "small", "behavior": "chase"}]}`, "health must be positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEnemyDefinitions([]byte(tt.data))
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Error %q should mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadEnemyDefinitions_ReadsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "enemies.json")
+	if err := os.WriteFile(path, []byte(sampleDefinitions), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	defs, err := LoadEnemyDefinitions(path)
+	if err != nil {
+		t.Fatalf("LoadEnemyDefinitions() error = %v", err)
+	}
+	if len(defs) != 2 {
+		t.Errorf("Expected 2 definitions, got %d", len(defs))
+	}
+
+	if _, err := LoadEnemyDefinitions(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
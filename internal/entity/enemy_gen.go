@@ -16,10 +16,19 @@ type Enemy struct {
 	Size        EnemySize
 	Behavior    BehaviorPattern
 	AttackType  AttackType
-	SpriteData  interface{} // Will hold generated sprite
-	SoundData   interface{} // Will hold generated sounds
+	Archetype   AttackArchetype // How the attack is delivered (dash, slam, ...)
+	SpriteData  interface{}     // Will hold generated sprite
+	SoundData   interface{}     // Will hold generated sounds
 	DangerLevel int
 	BiomeType   string
+	Kind        string // Biome enemy type this enemy embodies (e.g. "bat")
+	Element     string // Elemental affinity from a hand-authored definition, if any
+	Summoner    bool   // Calls in minions while alive (see summon.go)
+	Mimic       bool   // Learns and counters the player's habits (see mimic.go)
+
+	Aggression     AggressionProfile // Temperament of the enemy's AI (see aggression.go)
+	IgnoresHazards bool              // Paths straight through hazards (see pathfind.go)
+	ComboLength    int               // Attacks chained before the full cooldown (see combo.go)
 }
 
 // EnemySize defines enemy dimensions
@@ -61,6 +70,9 @@ type Boss struct {
 	UniqueAttacks []string
 	ArenaLayout   interface{}
 	GrantsAbility string // Ability unlocked when this boss is defeated
+
+	// AttackPatterns holds one scripted pattern per phase (same order as Phases)
+	AttackPatterns []AttackPattern
 }
 
 // BossPhase represents a phase of a boss fight
@@ -201,6 +213,11 @@ func (eg *EnemyGenerator) Generate(biome string, dangerLevel int, seed int64) *E
 
 	// Assign attack type
 	enemy.AttackType = eg.selectAttackType(enemy.Size)
+	enemy.Archetype = SelectArchetype(enemy.Size, enemy.Behavior, enemy.AttackType)
+	enemy.Summoner = IsSummoner(enemy.Size, enemy.Behavior, enemy.AttackType)
+	enemy.Aggression = eg.rollAggression()
+	enemy.ComboLength = SelectComboLength(enemy.Size, enemy.Aggression)
+	enemy.Mimic = IsMimic(enemy.Size, enemy.Behavior, enemy.Aggression)
 
 	return enemy
 }
@@ -300,6 +317,7 @@ func (bg *BossGenerator) GenerateWithAbility(biome string, seed int64, grantsAbi
 		Size:        BossEnemy,
 		BiomeType:   biome,
 		DangerLevel: 10,
+		ComboLength: SelectComboLength(BossEnemy, BalancedAggression),
 	}
 
 	boss := &Boss{
@@ -326,6 +344,12 @@ func (bg *BossGenerator) GenerateWithAbility(biome string, seed int64, grantsAbi
 		boss.UniqueAttacks[i] = bg.generateUniqueAttack(biome)
 	}
 
+	// Script attack patterns last so earlier fields stay stable per seed
+	boss.AttackPatterns = make([]AttackPattern, len(boss.Phases))
+	for i, phase := range boss.Phases {
+		boss.AttackPatterns[i] = buildAttackPattern(phase.AttackPattern, boss.Damage, i, bg.rng)
+	}
+
 	return boss
 }
 
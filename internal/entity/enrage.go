@@ -0,0 +1,58 @@
+package entity
+
+import "image/color"
+
+// Enrage tuning. Enemies that never fall back, bosses and the dash and slam
+// heavy hitters, turn desperate instead once badly hurt.
+const (
+	EnrageHealthFraction   = 0.25 // Health fraction at or below which enrage starts
+	EnrageSpeedMultiplier  = 1.4
+	EnrageDamageMultiplier = 1.5
+)
+
+// EnrageTint is the color an enraged enemy is drawn with
+var EnrageTint = color.RGBA{R: 255, G: 110, B: 110, A: 255}
+
+// CanEnrage reports whether the enemy enrages at low health rather than
+// retreating
+func (ei *EnemyInstance) CanEnrage() bool {
+	return ei.Enemy.Size == BossEnemy || ei.Enemy.Archetype != StrikeArchetype
+}
+
+// updateEnrage enters the enrage state once health drops to the threshold.
+// Enrage lasts until death.
+func (ei *EnemyInstance) updateEnrage(healthPercent float64) {
+	if ei.Enraged || !ei.CanEnrage() || healthPercent > EnrageHealthFraction {
+		return
+	}
+	ei.Enraged = true
+}
+
+// EffectiveSpeed returns the enemy's movement speed, scaled by its size
+// and raised while enraged
+func (ei *EnemyInstance) EffectiveSpeed() float64 {
+	speed := ei.Enemy.Speed * ei.Traits.speed()
+	if ei.Enraged {
+		return speed * EnrageSpeedMultiplier
+	}
+	return speed
+}
+
+// EffectiveDamage returns the damage the enemy's attacks deal, scaled by
+// its size and raised while enraged
+func (ei *EnemyInstance) EffectiveDamage() int {
+	damage := float64(ei.Enemy.Damage) * ei.Traits.damage()
+	if ei.Enraged {
+		damage *= EnrageDamageMultiplier
+	}
+	return int(damage + 0.5)
+}
+
+// Tint returns the color the enemy should be drawn with; ok is false when
+// it is drawn untinted
+func (ei *EnemyInstance) Tint() (tint color.RGBA, ok bool) {
+	if ei.Enraged {
+		return EnrageTint, true
+	}
+	return color.RGBA{}, false
+}
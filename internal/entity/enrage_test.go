@@ -0,0 +1,89 @@
+package entity
+
+import "testing"
+
+func TestEnrageRaisesSpeedDamageAndTints(t *testing.T) {
+	enemy := &Enemy{
+		Health:   100,
+		Damage:   10,
+		Speed:    3.0,
+		Size:     BossEnemy,
+		Behavior: ChaseBehavior,
+	}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+
+	instance.Update(250, 100)
+	calmVelX := instance.VelX
+	if instance.Enraged {
+		t.Fatal("enemy enraged at full health")
+	}
+	if _, ok := instance.Tint(); ok {
+		t.Error("calm enemy has a tint")
+	}
+	calmDamage := instance.EffectiveDamage()
+
+	// Drop just below the threshold
+	instance.TakeDamage(int(float64(enemy.Health)*(1-EnrageHealthFraction)) + 1)
+	instance.Update(250, 100)
+
+	if !instance.Enraged {
+		t.Fatalf("enemy at %d/%d health not enraged", instance.CurrentHealth, enemy.Health)
+	}
+	if instance.VelX <= calmVelX {
+		t.Errorf("enraged VelX = %.2f, want faster than calm %.2f", instance.VelX, calmVelX)
+	}
+	if instance.EffectiveSpeed() != enemy.Speed*EnrageSpeedMultiplier {
+		t.Errorf("EffectiveSpeed() = %.2f, want %.2f", instance.EffectiveSpeed(), enemy.Speed*EnrageSpeedMultiplier)
+	}
+	if instance.EffectiveDamage() <= calmDamage {
+		t.Errorf("enraged damage = %d, want more than %d", instance.EffectiveDamage(), calmDamage)
+	}
+	if tint, ok := instance.Tint(); !ok || tint != EnrageTint {
+		t.Errorf("Tint() = %v, %v; want EnrageTint", tint, ok)
+	}
+}
+
+func TestStrikersRetreatInsteadOfEnraging(t *testing.T) {
+	enemy := &Enemy{
+		Health:    100,
+		Damage:    10,
+		Speed:     3.0,
+		Size:      MediumEnemy,
+		Behavior:  ChaseBehavior,
+		Archetype: StrikeArchetype,
+	}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+	instance.TakeDamage(90)
+	instance.Update(250, 100)
+
+	if instance.Enraged {
+		t.Error("striking enemy enraged; it should fall back to retreating")
+	}
+	if instance.TacticalState != TacticalRetreating {
+		t.Errorf("TacticalState = %v, want TacticalRetreating", instance.TacticalState)
+	}
+}
+
+func TestEnragedEnemyDoesNotRetreat(t *testing.T) {
+	enemy := &Enemy{
+		Health:    100,
+		Damage:    10,
+		Speed:     3.0,
+		Size:      MediumEnemy,
+		Behavior:  ChaseBehavior,
+		Archetype: DashArchetype,
+	}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+	instance.TakeDamage(90)
+	instance.Update(400, 100)
+
+	if !instance.Enraged {
+		t.Fatal("dash enemy did not enrage")
+	}
+	if instance.TacticalState == TacticalRetreating {
+		t.Error("enraged enemy is retreating")
+	}
+}
@@ -0,0 +1,78 @@
+package entity
+
+import "math"
+
+// Flying obstacle avoidance tuning
+const (
+	FlyAvoidRange    = 40.0 // Pixels from a solid tile at which flyers start steering away
+	FlyAvoidStrength = 0.5  // Push away from nearby solids, relative to the flyer's speed
+)
+
+// steerAroundSolids bends a flying enemy's velocity away from nearby solid
+// tiles of its navigation grid. About to touch a surface, heading into it
+// turns into sliding along it, so a flyer works its way around a platform
+// instead of pinning itself against it. Speed is kept unchanged.
+func (ei *EnemyInstance) steerAroundSolids() {
+	speed := math.Hypot(ei.VelX, ei.VelY)
+	if ei.nav == nil || speed == 0 {
+		return
+	}
+	nx, ny, nearest := ei.solidRepulsion()
+	strength := math.Hypot(nx, ny)
+	if strength == 0 {
+		return
+	}
+	nx, ny = nx/strength, ny/strength
+
+	dirX, dirY := ei.VelX/speed, ei.VelY/speed
+	if into := dirX*nx + dirY*ny; into < 0 && nearest <= speed {
+		dirX, dirY = dirX-into*nx, dirY-into*ny
+		// Head-on: go around the side the enemy patrols toward
+		if math.Hypot(dirX, dirY) < 0.1 {
+			dirX, dirY = -ny*ei.PatrolDir, nx*ei.PatrolDir
+		}
+	}
+	push := math.Min(strength, 1) * FlyAvoidStrength
+	dirX, dirY = dirX+nx*push, dirY+ny*push
+
+	length := math.Hypot(dirX, dirY)
+	ei.VelX = dirX / length * speed
+	ei.VelY = dirY / length * speed
+}
+
+// solidRepulsion sums a push away from every solid tile within
+// FlyAvoidRange of the enemy's bounds, stronger the closer the tile, and
+// returns it with the gap to the nearest such tile
+func (ei *EnemyInstance) solidRepulsion() (float64, float64, float64) {
+	x, y, w, h := ei.GetBounds()
+	cx, cy := x+w/2, y+h/2
+	c0, r0 := ei.nav.clampTile(x-FlyAvoidRange, y-FlyAvoidRange)
+	c1, r1 := ei.nav.clampTile(x+w+FlyAvoidRange, y+h+FlyAvoidRange)
+
+	var nx, ny float64
+	nearest := FlyAvoidRange
+	for r := r0; r <= r1; r++ {
+		for c := c0; c <= c1; c++ {
+			if !ei.nav.solid[r*ei.nav.Cols+c] {
+				continue
+			}
+			tx, ty := float64(c)*NavTileSize, float64(r)*NavTileSize
+			gapX := math.Max(0, math.Max(tx-(x+w), x-(tx+NavTileSize)))
+			gapY := math.Max(0, math.Max(ty-(y+h), y-(ty+NavTileSize)))
+			gap := math.Hypot(gapX, gapY)
+			if gap >= FlyAvoidRange {
+				continue
+			}
+			awayX, awayY := cx-(tx+NavTileSize/2), cy-(ty+NavTileSize/2)
+			dist := math.Hypot(awayX, awayY)
+			if dist == 0 {
+				continue
+			}
+			nearest = math.Min(nearest, gap)
+			weight := 1 - gap/FlyAvoidRange
+			nx += awayX / dist * weight
+			ny += awayY / dist * weight
+		}
+	}
+	return nx, ny, nearest
+}
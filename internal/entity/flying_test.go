@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/opd-ai/vania/internal/physics"
+)
+
+// flyToward runs a flying enemy toward a still player for frames, moving
+// it by its velocity without entering platforms, and returns the closest
+// it got and whether it ever touched a platform
+func flyToward(ei *EnemyInstance, grid *NavGrid, platforms [][4]float64, px, py float64, frames int) (float64, bool) {
+	closest := math.Inf(1)
+	touched := false
+	for frame := 0; frame < frames; frame++ {
+		ei.SetNavGrid(grid)
+		ei.Update(px, py)
+		ei.X += ei.VelX
+		ei.Y += ei.VelY
+		x, y, w, h := ei.GetBounds()
+		for _, p := range platforms {
+			if physics.AABBOverlap(x, y, w, h, p[0], p[1], p[2], p[3]) {
+				touched = true
+				// Solid: undo the move, as the room's collision would
+				ei.X -= ei.VelX
+				ei.Y -= ei.VelY
+			}
+		}
+		closest = math.Min(closest, math.Hypot(px-(ei.X+w/2), py-(ei.Y+h/2)))
+	}
+	return closest, touched
+}
+
+func TestFlyingEnemySteersAroundPlatform(t *testing.T) {
+	platform := [4]float64{256, 320, 256, 32}
+	grid := NewNavGrid(960, 640)
+	grid.MarkSolid(platform[0], platform[1], platform[2], platform[3])
+
+	ei := NewEnemyInstance(&Enemy{Name: "Bat", Health: 10, Damage: 2, Speed: 2,
+		Behavior: FlyingBehavior, Size: MediumEnemy}, 368, 200)
+	ei.AggroRange = 1000
+	ei.Alarm()
+
+	closest, touched := flyToward(ei, grid, [][4]float64{platform}, 384, 500, 600)
+	if closest > ei.AttackRange+16 {
+		t.Errorf("flying enemy got within %.0f px of the player, want it to reach attack range %.0f", closest, ei.AttackRange)
+	}
+	if touched {
+		t.Error("flying enemy should steer clear of the platform, not scrape along it")
+	}
+}
+
+func TestSteerAroundSolidsSlidesAlongSurface(t *testing.T) {
+	grid := NewNavGrid(960, 640)
+	grid.MarkSolid(0, 320, 960, 32)
+	ei := NewEnemyInstance(&Enemy{Name: "Bat", Health: 10, Speed: 2,
+		Behavior: FlyingBehavior, Size: MediumEnemy}, 400, 287)
+	ei.SetNavGrid(grid)
+
+	// Diving straight at the floor turns sideways, keeping its speed
+	ei.VelX, ei.VelY = 0.2, 2
+	ei.steerAroundSolids()
+	if ei.VelY > 0 {
+		t.Errorf("VelY = %.2f touching the floor, want no movement into it", ei.VelY)
+	}
+	if math.Abs(ei.VelX) < 1 {
+		t.Errorf("VelX = %.2f, want the dive turned into a slide", ei.VelX)
+	}
+	if speed := math.Hypot(ei.VelX, ei.VelY); math.Abs(speed-math.Hypot(0.2, 2)) > 1e-9 {
+		t.Errorf("speed = %.3f, want it unchanged", speed)
+	}
+
+	// Far from any solid, flight is untouched
+	ei.Y = 100
+	ei.VelX, ei.VelY = 0.2, 2
+	ei.steerAroundSolids()
+	if ei.VelX != 0.2 || ei.VelY != 2 {
+		t.Errorf("velocity = (%.2f, %.2f) in open air, want (0.20, 2.00)", ei.VelX, ei.VelY)
+	}
+}
@@ -0,0 +1,51 @@
+package entity
+
+// Ledge awareness tuning, in pixels
+const (
+	LedgeProbeDepth = 24.0 // How far below the feet ground still counts
+	LedgeLookahead  = 4.0  // How far past the leading edge to probe
+)
+
+// GroundProbe reports whether solid ground lies at x, within
+// LedgeProbeDepth below y
+type GroundProbe func(x, y float64) bool
+
+// SetGroundProbe gives the enemy a way to see the ground ahead. Without one
+// the enemy has no ledge awareness.
+func (ei *EnemyInstance) SetGroundProbe(probe GroundProbe) {
+	ei.groundProbe = probe
+}
+
+// avoidLedges stops a ground enemy about to walk off a ledge, turning a
+// patrol around. Flying and jumping enemies are free to leave the ground.
+func (ei *EnemyInstance) avoidLedges() {
+	if ei.groundProbe == nil || ei.VelX == 0 {
+		return
+	}
+	if ei.Enemy.Behavior == FlyingBehavior || ei.Enemy.Behavior == JumpingBehavior {
+		return
+	}
+
+	x, y, w, h := ei.GetBounds()
+	feet := y + h
+	// Already airborne: nothing to guard
+	if !ei.groundProbe(x+w/2, feet) {
+		return
+	}
+
+	ahead := x + w + LedgeLookahead + ei.VelX
+	if ei.VelX < 0 {
+		ahead = x - LedgeLookahead + ei.VelX
+	}
+	if ei.groundProbe(ahead, feet) {
+		return
+	}
+
+	if ei.State == PatrolState {
+		ei.PatrolDir = 1.0
+		if ei.VelX > 0 {
+			ei.PatrolDir = -1.0
+		}
+	}
+	ei.VelX = 0
+}
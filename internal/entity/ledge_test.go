@@ -0,0 +1,74 @@
+package entity
+
+import "testing"
+
+// ledgeProbe is ground from x=0 to x=200 with its top at y=132, and a gap
+// beyond it
+func ledgeProbe(x, y float64) bool {
+	return x >= 0 && x <= 200 && y <= 132 && 132 <= y+LedgeProbeDepth
+}
+
+// walkToward steps the enemy toward the player for frames frames, moving it
+// by its velocity as the game would, and returns the furthest right edge it
+// reached
+func walkToward(instance *EnemyInstance, playerX float64, frames int) float64 {
+	furthest := 0.0
+	for i := 0; i < frames; i++ {
+		instance.Update(playerX, instance.Y)
+		instance.X += instance.VelX
+		x, _, w, _ := instance.GetBounds()
+		furthest = max(furthest, x+w)
+	}
+	return furthest
+}
+
+func TestPatrollingEnemyTurnsAtLedge(t *testing.T) {
+	instance := NewEnemyInstance(&Enemy{Health: 50, Speed: 2.0, Behavior: PatrolBehavior, Size: MediumEnemy}, 120, 100)
+	instance.PatrolMinX = 0
+	instance.PatrolMaxX = 1000 // Patrol bounds well past the ledge
+	instance.SetGroundProbe(ledgeProbe)
+
+	if furthest := walkToward(instance, 5000, 200); furthest > 200+LedgeLookahead+instance.EffectiveSpeed() {
+		t.Errorf("patrolling enemy walked out to x=%.1f, past the ledge at 200", furthest)
+	}
+	if instance.PatrolDir != -1.0 {
+		t.Errorf("PatrolDir = %v, want the patrol turned around", instance.PatrolDir)
+	}
+}
+
+func TestChasingEnemyStopsAtLedge(t *testing.T) {
+	instance := NewEnemyInstance(&Enemy{Health: 50, Speed: 3.0, Behavior: ChaseBehavior, Size: MediumEnemy}, 120, 100)
+	instance.AttackRange = 0
+	instance.Alarm()
+	instance.SetGroundProbe(ledgeProbe)
+
+	// The player waits just across the gap
+	furthest := walkToward(instance, 260, 120)
+	if furthest > 200+LedgeLookahead+instance.EffectiveSpeed() {
+		t.Errorf("chasing enemy walked out to x=%.1f, past the ledge at 200", furthest)
+	}
+	if instance.VelX != 0 {
+		t.Errorf("VelX = %v at the ledge, want stopped", instance.VelX)
+	}
+}
+
+func TestLedgeExemptions(t *testing.T) {
+	for _, behavior := range []BehaviorPattern{JumpingBehavior, FlyingBehavior} {
+		instance := NewEnemyInstance(&Enemy{Health: 50, Speed: 2.0, Behavior: behavior, Size: MediumEnemy}, 190, 100)
+		instance.SetGroundProbe(ledgeProbe)
+		instance.VelX = 2.0
+		instance.avoidLedges()
+		if instance.VelX != 2.0 {
+			t.Errorf("behavior %v stopped at a ledge; it should be free to leave the ground", behavior)
+		}
+	}
+}
+
+func TestNoProbeMeansNoLedgeAwareness(t *testing.T) {
+	instance := NewEnemyInstance(&Enemy{Health: 50, Speed: 2.0, Behavior: PatrolBehavior, Size: MediumEnemy}, 190, 100)
+	instance.VelX = 2.0
+	instance.avoidLedges()
+	if instance.VelX != 2.0 {
+		t.Error("enemy without a ground probe should not react to ledges")
+	}
+}
@@ -0,0 +1,23 @@
+package entity
+
+// Update level of detail tuning
+const (
+	// LODNearRange is how close, in pixels, an enemy has to be to the
+	// player to think every frame: about half a screen, well beyond any
+	// aggro range
+	LODNearRange = 480.0
+
+	// LODFarInterval is how many frames apart enemies farther away than
+	// LODNearRange run their full AI
+	LODFarInterval = 4
+)
+
+// thinksThisFrame advances the enemy's update schedule and reports whether
+// its full AI runs this frame. Near enemies think every frame; distant ones
+// every LODFarInterval frames starting with their first, so the schedule
+// is the same on every run.
+func (ei *EnemyInstance) thinksThisFrame(distToPlayer float64) bool {
+	frame := ei.lodFrame
+	ei.lodFrame++
+	return distToPlayer <= LODNearRange || frame%LODFarInterval == 0
+}
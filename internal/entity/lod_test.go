@@ -0,0 +1,55 @@
+package entity
+
+import "testing"
+
+// observationsOver updates an enemy for frames with the player dist pixels
+// away and returns how many times its AI memory observed the player
+func observationsOver(dist float64, frames int) int {
+	ei := NewEnemyInstance(&Enemy{Health: 50, Speed: 2.0, Behavior: PatrolBehavior}, 0, 0)
+	for i := 0; i < frames; i++ {
+		ei.Update(dist, 0)
+	}
+	return len(ei.Memory.LastPlayerPositions)
+}
+
+func TestNearEnemyThinksEveryFrame(t *testing.T) {
+	if got := observationsOver(LODNearRange/2, 8); got != 8 {
+		t.Errorf("near enemy thought %d times in 8 frames, want 8", got)
+	}
+}
+
+func TestDistantEnemyThinksAtReducedCadence(t *testing.T) {
+	frames := 4 * LODFarInterval
+	if got := observationsOver(LODNearRange*2, frames); got != frames/LODFarInterval {
+		t.Errorf("distant enemy thought %d times in %d frames, want %d", got, frames, frames/LODFarInterval)
+	}
+}
+
+func TestDistantEnemyKeepsMovingBetweenThinks(t *testing.T) {
+	ei := NewEnemyInstance(&Enemy{Health: 50, Speed: 2.0, Behavior: PatrolBehavior}, 100, 0)
+	ei.PatrolMinX, ei.PatrolMaxX = 0, 1000
+	ei.OnGround = true
+	ei.Update(LODNearRange*3, 0)
+	if ei.VelX == 0 {
+		t.Fatal("distant enemy did not start patrolling on its first frame")
+	}
+	ei.Update(LODNearRange*3, 0)
+	if ei.VelX == 0 {
+		t.Error("distant enemy stopped between scheduled thinks")
+	}
+}
+
+func TestDistantEnemyDoesNotMistakeRunningForDashing(t *testing.T) {
+	const runSpeed = 4.0 // Player pixels per frame at a full run
+	ei := NewEnemyInstance(&Enemy{Health: 50, Speed: 2.0, Behavior: PatrolBehavior}, 0, 0)
+	playerX := LODNearRange * 2
+	ei.Update(playerX, 0) // First sight, from wherever the player started
+	for i := 0; i < 40*LODFarInterval; i++ {
+		playerX += runSpeed
+		ei.Update(playerX, 0)
+	}
+	if ei.Memory.DashFrequency >= MimicHabitThreshold {
+		t.Errorf("dash frequency = %.2f for a running player, want below %.2f",
+			ei.Memory.DashFrequency, MimicHabitThreshold)
+	}
+}
@@ -0,0 +1,111 @@
+package entity
+
+import "math"
+
+// MimicCounter is how a mimic answers the habits it has learned from the
+// player
+type MimicCounter int
+
+const (
+	// MimicMirror keeps to the enemy's usual behavior: the player has shown
+	// no habit worth countering yet
+	MimicMirror MimicCounter = iota
+	// MimicAnticipate heads for where a dash-happy player is about to be
+	// rather than where they are
+	MimicAnticipate
+	// MimicDodge backs out of reach of a player who attacks often while the
+	// mimic cannot strike back
+	MimicDodge
+	// MimicClose runs down a player who keeps backing away
+	MimicClose
+)
+
+// Mimic tuning. Habits are the AIMemory action frequencies, which settle
+// around the share of observations in which the player took the action.
+const (
+	MimicHabitThreshold = 0.15 // Frequency at which a habit is countered
+	MimicLeadSteps      = 20.0 // Observations ahead a dashing player is predicted
+	MimicDodgeReach     = 1.5  // Dodge within this multiple of the attack range
+	MimicKiteGap        = 24.0 // Ground the player must gain to count as kiting
+	mimicArriveGap      = 8.0  // Close enough to the predicted spot to wait there
+)
+
+// IsMimic reports whether an enemy of this kind learns to counter the
+// player: cautious medium enemies that chase on foot, since cautious
+// enemies already learn the player fastest
+func IsMimic(size EnemySize, behavior BehaviorPattern, aggression AggressionProfile) bool {
+	return size == MediumEnemy && behavior == ChaseBehavior && aggression == CautiousAggression
+}
+
+// SeePlayerAttack tells the enemy the player attacked this frame. The
+// attack is counted in the enemy's memory the next time it thinks.
+func (ei *EnemyInstance) SeePlayerAttack() {
+	ei.sawAttack = true
+}
+
+// Counter returns how the mimic answered the player when it last thought
+func (ei *EnemyInstance) Counter() MimicCounter {
+	return ei.counter
+}
+
+// MimicTargetX returns the spot a mimic anticipating a dash is heading for
+func (ei *EnemyInstance) MimicTargetX() float64 {
+	return ei.mimicTargetX
+}
+
+// chooseCounter picks the mimic's answer to the player's habits. Dodging
+// comes first, since it keeps the mimic alive; a dashing player is cut off
+// before a merely retreating one is chased.
+func (ei *EnemyInstance) chooseCounter(distToPlayer float64) MimicCounter {
+	mem := ei.Memory
+	switch {
+	case mem.AttackFrequency >= MimicHabitThreshold &&
+		distToPlayer < ei.AttackRange*MimicDodgeReach && ei.AttackCooldown > 0:
+		return MimicDodge
+	case mem.DashFrequency >= MimicHabitThreshold:
+		return MimicAnticipate
+	case ei.playerKiting():
+		return MimicClose
+	}
+	return MimicMirror
+}
+
+// playerKiting reports whether the player has been opening distance from
+// the enemy over the remembered positions while staying out of reach
+func (ei *EnemyInstance) playerKiting() bool {
+	positions := ei.Memory.LastPlayerPositions
+	if len(positions) < 2 {
+		return false
+	}
+	first := math.Abs(positions[0].X - ei.X)
+	last := math.Abs(positions[len(positions)-1].X - ei.X)
+	return last > ei.AttackRange && last-first >= MimicKiteGap
+}
+
+// updateMimic overrides the enemy's movement with its counter to the
+// player's habits. Attacks in progress are left alone.
+func (ei *EnemyInstance) updateMimic(distToPlayer, dx float64) {
+	ei.counter = MimicMirror
+	if !ei.Enemy.Mimic || !ei.alerted || ei.State == AttackState {
+		return
+	}
+	ei.counter = ei.chooseCounter(distToPlayer)
+
+	switch ei.counter {
+	case MimicDodge:
+		ei.State = FleeState
+		ei.VelX = -math.Copysign(ei.EffectiveSpeed(), dx)
+	case MimicAnticipate:
+		predX, _ := ei.Memory.PredictPlayerPosition(MimicLeadSteps)
+		ei.mimicTargetX = predX
+		ei.State = ChaseState
+		if gap := predX - ei.X; math.Abs(gap) > mimicArriveGap {
+			ei.VelX = math.Copysign(ei.EffectiveSpeed(), gap)
+		} else {
+			ei.VelX = 0 // Lie in wait where the dash will end
+		}
+	case MimicClose:
+		ei.State = ChaseState
+		ei.chasePlayer(dx, 0)
+	}
+}
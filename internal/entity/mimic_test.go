@@ -0,0 +1,136 @@
+package entity
+
+import "testing"
+
+func newTestMimic() *EnemyInstance {
+	enemy := &Enemy{
+		Name:       "Copycat",
+		Health:     40,
+		Damage:     8,
+		Speed:      2.0,
+		Size:       MediumEnemy,
+		Behavior:   ChaseBehavior,
+		AttackType: MeleeAttack,
+		Aggression: CautiousAggression,
+		Mimic:      true,
+	}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+	return instance
+}
+
+// runMimic updates the mimic for frames frames, moving the player with step
+// and counting the frames spent on each counter
+func runMimic(instance *EnemyInstance, frames int, step func(frame int) (float64, bool)) map[MimicCounter]int {
+	counters := make(map[MimicCounter]int)
+	for i := 0; i < frames; i++ {
+		playerX, attacked := step(i)
+		if attacked {
+			instance.SeePlayerAttack()
+		}
+		instance.Alarm()
+		instance.Update(playerX, instance.Y)
+		counters[instance.Counter()]++
+	}
+	return counters
+}
+
+func TestIsMimic(t *testing.T) {
+	if !IsMimic(MediumEnemy, ChaseBehavior, CautiousAggression) {
+		t.Error("cautious medium chaser should be a mimic")
+	}
+	if IsMimic(MediumEnemy, ChaseBehavior, RecklessAggression) {
+		t.Error("reckless enemies should not mimic")
+	}
+	if IsMimic(SmallEnemy, ChaseBehavior, CautiousAggression) {
+		t.Error("small enemies should not mimic")
+	}
+}
+
+func TestMimicAnticipatesDashingPlayer(t *testing.T) {
+	const frames = 120 // Ends on a dash frame
+
+	// The dash-happy player bursts forward every fourth frame
+	dasher := newTestMimic()
+	playerX := 200.0
+	dashCounters := runMimic(dasher, frames, func(frame int) (float64, bool) {
+		if frame%4 == 3 {
+			playerX += 14
+		}
+		return playerX, false
+	})
+
+	// The passive player stands their ground
+	passive := newTestMimic()
+	passiveCounters := runMimic(passive, frames, func(int) (float64, bool) {
+		return 200, false
+	})
+
+	if dasher.Memory.DashFrequency < MimicHabitThreshold {
+		t.Fatalf("dash frequency = %.2f, want at least %.2f", dasher.Memory.DashFrequency, MimicHabitThreshold)
+	}
+	if dasher.Counter() != MimicAnticipate {
+		t.Errorf("mimic facing a dasher counters with %v, want anticipate", dasher.Counter())
+	}
+	if dasher.MimicTargetX() <= playerX {
+		t.Errorf("mimic heads for %.0f, want ahead of the player at %.0f", dasher.MimicTargetX(), playerX)
+	}
+	if dasher.VelX <= 0 {
+		t.Errorf("mimic velocity = %.2f, want toward the predicted spot", dasher.VelX)
+	}
+	if dashCounters[MimicAnticipate] <= passiveCounters[MimicAnticipate] {
+		t.Errorf("anticipated on %d frames against a dasher and %d against a passive player",
+			dashCounters[MimicAnticipate], passiveCounters[MimicAnticipate])
+	}
+	if passiveCounters[MimicAnticipate] != 0 {
+		t.Errorf("mimic anticipated a passive player on %d frames", passiveCounters[MimicAnticipate])
+	}
+}
+
+func TestMimicDodgesFrequentAttacks(t *testing.T) {
+	attacker := newTestMimic()
+	counters := runMimic(attacker, 120, func(frame int) (float64, bool) {
+		return attacker.X + 30, frame%3 == 0
+	})
+	if counters[MimicDodge] == 0 {
+		t.Error("mimic never dodged a player attacking every third frame")
+	}
+
+	passive := newTestMimic()
+	counters = runMimic(passive, 120, func(int) (float64, bool) {
+		return passive.X + 30, false
+	})
+	if counters[MimicDodge] != 0 {
+		t.Errorf("mimic dodged a passive player on %d frames", counters[MimicDodge])
+	}
+}
+
+func TestMimicClosesOnKitingPlayer(t *testing.T) {
+	mimic := newTestMimic()
+	playerX := 180.0
+	runMimic(mimic, 40, func(int) (float64, bool) {
+		playerX += 3 // Backing off steadily, too slowly to count as dashing
+		return playerX, false
+	})
+	if mimic.Counter() != MimicClose {
+		t.Errorf("mimic facing a kiting player counters with %v, want close", mimic.Counter())
+	}
+	if mimic.VelX <= 0 {
+		t.Errorf("mimic velocity = %.2f, want toward the player", mimic.VelX)
+	}
+}
+
+func TestNonMimicIgnoresHabits(t *testing.T) {
+	instance := newTestMimic()
+	instance.Enemy.Mimic = false
+	playerX := 200.0
+	counters := runMimic(instance, 120, func(frame int) (float64, bool) {
+		if frame%4 == 3 {
+			playerX += 14
+		}
+		return playerX, false
+	})
+	if counters[MimicMirror] != 120 {
+		t.Errorf("non-mimic countered the player: %v", counters)
+	}
+}
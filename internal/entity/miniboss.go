@@ -0,0 +1,138 @@
+package entity
+
+import "math/rand"
+
+// Mini-boss tuning. Mini-bosses sit between a biome's regular enemies and
+// its boss: their stats are blended from the strongest regular enemy toward
+// the boss.
+const (
+	MiniBossChance    = 0.2 // Chance a combat room holds a mini-boss
+	MiniBossStatBlend = 0.5 // How far from the strongest regular enemy toward the boss
+
+	// Without a boss to blend toward, stats scale the strongest regular enemy
+	miniBossHealthFallback = 2.0
+	miniBossDamageFallback = 1.5
+)
+
+// miniBossTitles name a mini-boss variant after its base enemy
+var miniBossTitles = []string{"Elite", "Dread", "Elder", "Savage", "Vicious"}
+
+// miniBossSpecials are the special attacks a mini-boss can have, by biome
+var miniBossSpecials = map[string][]string{
+	"cave":    {"boulder_toss", "tremor"},
+	"forest":  {"root_lash", "spore_burst"},
+	"ruins":   {"hex_bolt", "bone_rattle"},
+	"crystal": {"frost_shard", "prism_flash"},
+	"abyss":   {"shadow_lunge", "void_pulse"},
+	"sky":     {"gale_dive", "static_burst"},
+}
+
+// miniBossSpecialPatterns scripts each special attack as one of the boss
+// attack patterns (see boss_pattern.go)
+var miniBossSpecialPatterns = map[string]string{
+	"boulder_toss": "projectile_barrage",
+	"tremor":       "ground_pound",
+	"root_lash":    "triple_strike",
+	"spore_burst":  "summon_minions",
+	"hex_bolt":     "projectile_barrage",
+	"bone_rattle":  "ground_pound",
+	"frost_shard":  "projectile_barrage",
+	"prism_flash":  "area_blast",
+	"shadow_lunge": "charge_attack",
+	"void_pulse":   "area_blast",
+	"gale_dive":    "charge_attack",
+	"static_burst": "summon_minions",
+	"heavy_strike": "charge_attack",
+	"war_cry":      "ground_pound",
+}
+
+// MiniBoss is an elite variant of a regular enemy: tougher, with a special
+// attack, and always carrying loot
+type MiniBoss struct {
+	Enemy
+	BaseName      string        // Name of the regular enemy it is a variant of
+	SpecialAttack string        // Name of the special attack
+	Special       AttackPattern // The special attack, scripted like a boss's
+	Loot          *Item         // Dropped on defeat
+	RoomID        int           // Combat room the mini-boss guards
+}
+
+// MiniBossGenerator generates mini-bosses
+type MiniBossGenerator struct {
+	rng *rand.Rand
+}
+
+// NewMiniBossGenerator creates a new mini-boss generator
+func NewMiniBossGenerator(seed int64) *MiniBossGenerator {
+	return &MiniBossGenerator{
+		rng: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Roll reports whether a combat room generated from seed holds a mini-boss
+func (mg *MiniBossGenerator) Roll(seed int64) bool {
+	mg.rng = rand.New(rand.NewSource(seed))
+	return mg.rng.Float64() < MiniBossChance
+}
+
+// Generate creates a mini-boss from one of a biome's regular enemies, with
+// stats between the strongest of them and the biome's boss, which may be
+// nil. regulars must not be empty.
+func (mg *MiniBossGenerator) Generate(regulars []*Enemy, boss *Boss, seed int64) *MiniBoss {
+	mg.rng = rand.New(rand.NewSource(seed))
+
+	base := regulars[mg.rng.Intn(len(regulars))]
+	strongest := regulars[0]
+	for _, enemy := range regulars {
+		if enemy.Health > strongest.Health {
+			strongest = enemy
+		}
+	}
+	maxDamage := 0
+	for _, enemy := range regulars {
+		maxDamage = max(maxDamage, enemy.Damage)
+	}
+
+	mb := &MiniBoss{Enemy: *base, BaseName: base.Name}
+	mb.Name = miniBossTitles[mg.rng.Intn(len(miniBossTitles))] + " " + base.Name
+	if boss != nil {
+		mb.Health = blendStat(strongest.Health, boss.Health)
+		mb.Damage = blendStat(maxDamage, boss.Damage)
+		mb.Speed = base.Speed + (boss.Speed-base.Speed)*MiniBossStatBlend
+		mb.DangerLevel = blendStat(base.DangerLevel, boss.DangerLevel)
+	} else {
+		mb.Health = int(float64(strongest.Health) * miniBossHealthFallback)
+		mb.Damage = int(float64(maxDamage) * miniBossDamageFallback)
+	}
+	// Keep it tougher than every regular enemy even when the blend rounds down
+	mb.Health = max(mb.Health, strongest.Health+1)
+
+	// A larger body with a heavier attack, chained like a reckless enemy's
+	if mb.Behavior != FlyingBehavior {
+		mb.Size = LargeEnemy
+	}
+	mb.AttackType = AreaAttack
+	mb.Archetype = SelectArchetype(mb.Size, mb.Behavior, mb.AttackType)
+	mb.ComboLength = SelectComboLength(mb.Size, RecklessAggression)
+	mb.Summoner = false
+
+	specials, ok := miniBossSpecials[base.BiomeType]
+	if !ok {
+		specials = []string{"heavy_strike", "war_cry"}
+	}
+	mb.SpecialAttack = specials[mg.rng.Intn(len(specials))]
+
+	lootTypes := []ItemType{WeaponItem, UpgradeItem, ConsumableItem}
+	lootType := lootTypes[mg.rng.Intn(len(lootTypes))]
+	mb.Loot = NewItemGenerator(seed).Generate(lootType, mg.rng.Int63())
+
+	mb.Special = buildAttackPattern(miniBossSpecialPatterns[mb.SpecialAttack], mb.Damage, 0, mg.rng)
+	mb.Special.Name = mb.SpecialAttack
+
+	return mb
+}
+
+// blendStat moves from a regular enemy's stat toward the boss's
+func blendStat(regular, boss int) int {
+	return regular + int(float64(boss-regular)*MiniBossStatBlend)
+}
@@ -0,0 +1,80 @@
+package entity
+
+import "testing"
+
+func miniBossFixtures() ([]*Enemy, *Boss) {
+	eg := NewEnemyGenerator(1)
+	regulars := []*Enemy{
+		eg.Generate("cave", 3, 10),
+		eg.Generate("cave", 3, 11),
+		eg.Generate("cave", 3, 12),
+	}
+	boss := NewBossGenerator(2).Generate("cave", 20)
+	return regulars, boss
+}
+
+func TestMiniBossStatsBetweenRegularsAndBoss(t *testing.T) {
+	regulars, boss := miniBossFixtures()
+	for seed := int64(0); seed < 20; seed++ {
+		mb := NewMiniBossGenerator(seed).Generate(regulars, boss, seed)
+		for _, enemy := range regulars {
+			if mb.Health <= enemy.Health {
+				t.Errorf("seed %d: mini-boss health %d not above regular %d", seed, mb.Health, enemy.Health)
+			}
+			if mb.Damage < enemy.Damage {
+				t.Errorf("seed %d: mini-boss damage %d below regular %d", seed, mb.Damage, enemy.Damage)
+			}
+		}
+		if mb.Health >= boss.Health {
+			t.Errorf("seed %d: mini-boss health %d not below boss %d", seed, mb.Health, boss.Health)
+		}
+		if mb.Damage > boss.Damage {
+			t.Errorf("seed %d: mini-boss damage %d above boss %d", seed, mb.Damage, boss.Damage)
+		}
+	}
+}
+
+func TestMiniBossVariantAndLoot(t *testing.T) {
+	regulars, boss := miniBossFixtures()
+	mb := NewMiniBossGenerator(5).Generate(regulars, boss, 5)
+
+	if mb.Name == mb.BaseName {
+		t.Errorf("mini-boss name %q is not a variant of %q", mb.Name, mb.BaseName)
+	}
+	if mb.SpecialAttack == "" {
+		t.Error("mini-boss has no special attack")
+	}
+	if mb.Special.Name != mb.SpecialAttack || len(mb.Special.Moves) == 0 {
+		t.Errorf("special attack %q scripted as %+v, want its moves", mb.SpecialAttack, mb.Special)
+	}
+	if mb.Loot == nil {
+		t.Fatal("mini-boss has no loot")
+	}
+	if mb.ComboLength < 2 {
+		t.Errorf("mini-boss ComboLength = %d, want a combo", mb.ComboLength)
+	}
+}
+
+func TestMiniBossGenerationIsDeterministic(t *testing.T) {
+	regulars, boss := miniBossFixtures()
+	a := NewMiniBossGenerator(7).Generate(regulars, boss, 7)
+	b := NewMiniBossGenerator(7).Generate(regulars, boss, 7)
+	if a.Name != b.Name || a.Health != b.Health || a.SpecialAttack != b.SpecialAttack || a.Loot.Name != b.Loot.Name {
+		t.Errorf("same seed gave %+v and %+v", a, b)
+	}
+}
+
+func TestMiniBossSpecialsAreScripted(t *testing.T) {
+	for biome, specials := range miniBossSpecials {
+		for _, special := range specials {
+			if _, ok := miniBossSpecialPatterns[special]; !ok {
+				t.Errorf("%s special %q has no scripted pattern", biome, special)
+			}
+		}
+	}
+	for _, special := range []string{"heavy_strike", "war_cry"} {
+		if _, ok := miniBossSpecialPatterns[special]; !ok {
+			t.Errorf("fallback special %q has no scripted pattern", special)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package entity
+
+import (
+	"container/heap"
+	"math"
+)
+
+// Pathfinding tuning
+const (
+	NavTileSize       = 32.0 // Side of one navigation tile, in pixels
+	HazardPathCost    = 8    // Extra cost of crossing a hazard tile for enemies that avoid them
+	PathRefreshFrames = 15   // Frames between path recalculations
+)
+
+// NavGrid is a room divided into tiles for enemy pathfinding. Solid tiles
+// cannot be entered; hazard tiles can, at a cost.
+type NavGrid struct {
+	Cols, Rows int
+	solid      []bool
+	hazard     []bool
+}
+
+// NewNavGrid creates an open grid covering width by height pixels
+func NewNavGrid(width, height float64) *NavGrid {
+	cols := int(math.Ceil(width / NavTileSize))
+	rows := int(math.Ceil(height / NavTileSize))
+	return &NavGrid{
+		Cols:   cols,
+		Rows:   rows,
+		solid:  make([]bool, cols*rows),
+		hazard: make([]bool, cols*rows),
+	}
+}
+
+// MarkSolid blocks every tile the rectangle overlaps
+func (g *NavGrid) MarkSolid(x, y, w, h float64) {
+	g.mark(g.solid, x, y, w, h)
+}
+
+// MarkHazard flags every tile the rectangle overlaps as dangerous
+func (g *NavGrid) MarkHazard(x, y, w, h float64) {
+	g.mark(g.hazard, x, y, w, h)
+}
+
+// mark sets the tiles under a rectangle in layer
+func (g *NavGrid) mark(layer []bool, x, y, w, h float64) {
+	c0, r0 := g.clampTile(x, y)
+	c1, r1 := g.clampTile(x+w-1, y+h-1)
+	for r := r0; r <= r1; r++ {
+		for c := c0; c <= c1; c++ {
+			layer[r*g.Cols+c] = true
+		}
+	}
+}
+
+// clampTile returns the tile holding a point, clamped into the grid
+func (g *NavGrid) clampTile(x, y float64) (col, row int) {
+	col = min(max(int(math.Floor(x/NavTileSize)), 0), g.Cols-1)
+	row = min(max(int(math.Floor(y/NavTileSize)), 0), g.Rows-1)
+	return col, row
+}
+
+// IsHazard reports whether the point lies on a hazard tile
+func (g *NavGrid) IsHazard(x, y float64) bool {
+	return g.at(g.hazard, x, y)
+}
+
+// IsSolid reports whether the point lies on a solid tile
+func (g *NavGrid) IsSolid(x, y float64) bool {
+	return g.at(g.solid, x, y)
+}
+
+// at reports whether the tile holding a point is set in layer; points
+// outside the grid are not
+func (g *NavGrid) at(layer []bool, x, y float64) bool {
+	if x < 0 || y < 0 {
+		return false
+	}
+	col, row := int(x/NavTileSize), int(y/NavTileSize)
+	if col >= g.Cols || row >= g.Rows {
+		return false
+	}
+	return layer[row*g.Cols+col]
+}
+
+// FindPath returns tile-center waypoints from one point to another, not
+// including the starting tile, or nil if the goal cannot be reached. With
+// avoidHazards each hazard tile costs HazardPathCost extra steps, so a
+// detour of up to that length is preferred over crossing it.
+func (g *NavGrid) FindPath(fromX, fromY, toX, toY float64, avoidHazards bool) []Position {
+	sc, sr := g.clampTile(fromX, fromY)
+	gc, gr := g.clampTile(toX, toY)
+	start, goal := sr*g.Cols+sc, gr*g.Cols+gc
+	if start == goal || g.solid[goal] {
+		return nil
+	}
+
+	heuristic := func(tile int) int {
+		c, r := tile%g.Cols, tile/g.Cols
+		return abs(c-gc) + abs(r-gr)
+	}
+
+	cost := map[int]int{start: 0}
+	came := map[int]int{}
+	open := &pathQueue{{tile: start, priority: heuristic(start)}}
+	for open.Len() > 0 {
+		current := heap.Pop(open).(pathNode).tile
+		if current == goal {
+			break
+		}
+		c, r := current%g.Cols, current/g.Cols
+		for _, step := range [4][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			nc, nr := c+step[0], r+step[1]
+			if nc < 0 || nr < 0 || nc >= g.Cols || nr >= g.Rows {
+				continue
+			}
+			next := nr*g.Cols + nc
+			if g.solid[next] {
+				continue
+			}
+			stepCost := 1
+			if avoidHazards && g.hazard[next] {
+				stepCost += HazardPathCost
+			}
+			newCost := cost[current] + stepCost
+			if old, seen := cost[next]; seen && newCost >= old {
+				continue
+			}
+			cost[next] = newCost
+			came[next] = current
+			heap.Push(open, pathNode{tile: next, priority: newCost + heuristic(next)})
+		}
+	}
+
+	if _, reached := came[goal]; !reached {
+		return nil
+	}
+	var path []Position
+	for tile := goal; tile != start; tile = came[tile] {
+		path = append(path, Position{
+			X: (float64(tile%g.Cols) + 0.5) * NavTileSize,
+			Y: (float64(tile/g.Cols) + 0.5) * NavTileSize,
+		})
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// abs returns the absolute value of an int
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// pathNode is a tile waiting in the A* open set
+type pathNode struct {
+	tile, priority int
+}
+
+// pathQueue is a min-heap of path nodes; ties go to the lower tile index
+// so paths are deterministic
+type pathQueue []pathNode
+
+func (q pathQueue) Len() int { return len(q) }
+func (q pathQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority < q[j].priority
+	}
+	return q[i].tile < q[j].tile
+}
+func (q pathQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue) Push(x interface{}) { *q = append(*q, x.(pathNode)) }
+func (q *pathQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
+
+// SetNavGrid gives the enemy the current room's navigation grid. Without
+// one the enemy heads straight for the player and ignores hazards.
+func (ei *EnemyInstance) SetNavGrid(grid *NavGrid) {
+	if grid != ei.nav {
+		ei.path = nil
+		ei.pathTimer = 0
+	}
+	ei.nav = grid
+}
+
+// avoidsHazards reports whether the enemy steers clear of hazards
+func (ei *EnemyInstance) avoidsHazards() bool {
+	return ei.nav != nil && !ei.Enemy.IgnoresHazards
+}
+
+// pathDirection turns the straight line to the player (dx, dy) into the
+// direction of the next waypoint on a path through the room's grid. It
+// falls back to the straight line when there is no grid or no path.
+func (ei *EnemyInstance) pathDirection(dx, dy float64) (float64, float64) {
+	if ei.nav == nil {
+		return dx, dy
+	}
+	x, y, w, h := ei.GetBounds()
+	cx, cy := x+w/2, y+h/2
+
+	if ei.pathTimer <= 0 {
+		ei.path = ei.nav.FindPath(cx, cy, cx+dx, cy+dy, !ei.Enemy.IgnoresHazards)
+		ei.pathTimer = PathRefreshFrames
+	}
+	ei.pathTimer--
+
+	for len(ei.path) > 0 {
+		wx, wy := ei.path[0].X-cx, ei.path[0].Y-cy
+		if math.Hypot(wx, wy) > NavTileSize/2 {
+			return wx, wy
+		}
+		ei.path = ei.path[1:]
+	}
+	return dx, dy
+}
+
+// avoidHazardsAhead stops a ground enemy about to step onto a hazard,
+// turning a patrol around, like a ledge
+func (ei *EnemyInstance) avoidHazardsAhead() {
+	if !ei.avoidsHazards() || ei.VelX == 0 || ei.Enemy.Behavior == FlyingBehavior {
+		return
+	}
+	x, y, w, h := ei.GetBounds()
+	probeY := y + h - 1
+	ahead := x + w + LedgeLookahead + ei.VelX
+	if ei.VelX < 0 {
+		ahead = x - LedgeLookahead + ei.VelX
+	}
+	if !ei.nav.IsHazard(ahead, probeY) {
+		return
+	}
+
+	if ei.State == PatrolState {
+		ei.PatrolDir = 1.0
+		if ei.VelX > 0 {
+			ei.PatrolDir = -1.0
+		}
+	}
+	ei.VelX = 0
+}
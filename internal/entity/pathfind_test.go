@@ -0,0 +1,94 @@
+package entity
+
+import "testing"
+
+// corridorGrid is a 5x3 tile room with a hazard in the middle of the row
+// between the left and right ends
+func corridorGrid() *NavGrid {
+	grid := NewNavGrid(5*NavTileSize, 3*NavTileSize)
+	grid.MarkHazard(2*NavTileSize, NavTileSize, NavTileSize, NavTileSize)
+	return grid
+}
+
+func pathCrossesHazard(grid *NavGrid, path []Position) bool {
+	for _, p := range path {
+		if grid.IsHazard(p.X, p.Y) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestFindPathRoutesAroundHazard(t *testing.T) {
+	grid := corridorGrid()
+	fromX, fromY := 0.5*NavTileSize, 1.5*NavTileSize
+	toX, toY := 4.5*NavTileSize, 1.5*NavTileSize
+
+	safe := grid.FindPath(fromX, fromY, toX, toY, true)
+	if len(safe) == 0 || pathCrossesHazard(grid, safe) {
+		t.Fatalf("avoiding path %v should detour around the hazard", safe)
+	}
+	if len(safe) != 6 {
+		t.Errorf("detour is %d steps, want 6", len(safe))
+	}
+
+	direct := grid.FindPath(fromX, fromY, toX, toY, false)
+	if len(direct) != 4 || !pathCrossesHazard(grid, direct) {
+		t.Errorf("immune path %v should go straight through in 4 steps", direct)
+	}
+}
+
+func TestFindPathTakesHazardWithoutSafeRoute(t *testing.T) {
+	grid := corridorGrid()
+	// Wall off the detours above and below the hazard
+	grid.MarkSolid(2*NavTileSize, 0, NavTileSize, NavTileSize)
+	grid.MarkSolid(2*NavTileSize, 2*NavTileSize, NavTileSize, NavTileSize)
+
+	path := grid.FindPath(0.5*NavTileSize, 1.5*NavTileSize, 4.5*NavTileSize, 1.5*NavTileSize, true)
+	if len(path) != 4 {
+		t.Errorf("with no safe route the path should cross the hazard, got %v", path)
+	}
+
+	grid.MarkSolid(2*NavTileSize, NavTileSize, NavTileSize, NavTileSize)
+	if path := grid.FindPath(0.5*NavTileSize, 1.5*NavTileSize, 4.5*NavTileSize, 1.5*NavTileSize, true); path != nil {
+		t.Errorf("a walled-off goal should have no path, got %v", path)
+	}
+}
+
+func TestFlyingEnemyPathsAroundHazardUnlessImmune(t *testing.T) {
+	for _, immune := range []bool{false, true} {
+		enemy := &Enemy{Name: "Bat", Health: 10, Speed: 2, Size: SmallEnemy, Behavior: FlyingBehavior, IgnoresHazards: immune}
+		_, _, w, h := GetEnemySizeBounds(enemy)
+		// Center the enemy in the left end of the corridor
+		ei := NewEnemyInstance(enemy, 0.5*NavTileSize-w/2, 1.5*NavTileSize-h/2)
+		ei.SetNavGrid(corridorGrid())
+
+		grid := ei.nav
+		ei.pathDirection(4*NavTileSize, 0)
+		if crosses := pathCrossesHazard(grid, ei.path); crosses != immune {
+			t.Errorf("immune=%v enemy path %v crosses hazard = %v", immune, ei.path, crosses)
+		}
+	}
+}
+
+func TestGroundEnemyStopsBeforeHazard(t *testing.T) {
+	grid := NewNavGrid(10*NavTileSize, 4*NavTileSize)
+	grid.MarkHazard(4*NavTileSize, 2*NavTileSize, NavTileSize, NavTileSize)
+
+	for _, immune := range []bool{false, true} {
+		enemy := &Enemy{Name: "Crawler", Health: 10, Speed: 2, Size: MediumEnemy, Behavior: PatrolBehavior, IgnoresHazards: immune}
+		_, _, w, h := GetEnemySizeBounds(enemy)
+		ei := NewEnemyInstance(enemy, 4*NavTileSize-w-2, 3*NavTileSize-h)
+		ei.SetNavGrid(grid)
+		ei.State = PatrolState
+		ei.VelX = 2
+
+		ei.avoidHazardsAhead()
+		if immune && ei.VelX != 2 {
+			t.Error("immune enemy should walk into the hazard")
+		}
+		if !immune && (ei.VelX != 0 || ei.PatrolDir != -1) {
+			t.Errorf("enemy should stop and turn at the hazard, vel %v dir %v", ei.VelX, ei.PatrolDir)
+		}
+	}
+}
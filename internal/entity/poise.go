@@ -0,0 +1,56 @@
+package entity
+
+// Poise tuning. Hits wear an enemy's poise down; only breaking it staggers
+// the enemy, after which it refills. Poise recovers once the enemy has gone
+// PoiseRegenDelay frames without being hit.
+const (
+	StaggerFrames      = 40   // Stun from a broken poise
+	PoiseRegenDelay    = 60   // Frames after a hit before poise recovers
+	PoiseRegenFraction = 0.02 // Share of max poise recovered per frame
+)
+
+// MaxPoise returns the poise an enemy of the given size starts with.
+// Bosses take many hits to stagger.
+func MaxPoise(size EnemySize) float64 {
+	switch size {
+	case SmallEnemy:
+		return 15
+	case MediumEnemy:
+		return 25
+	case LargeEnemy:
+		return 40
+	case BossEnemy:
+		return 150
+	default:
+		return 25
+	}
+}
+
+// ApplyPoiseDamage wears down the enemy's poise and reports whether this
+// hit broke it. A break staggers the enemy for StaggerFrames, playing its
+// hit animation, and refills its poise. Breaking poise is the one way to
+// stagger a stun-immune enemy such as a boss.
+func (ei *EnemyInstance) ApplyPoiseDamage(amount float64) bool {
+	ei.poiseRegenDelay = PoiseRegenDelay
+	ei.Poise -= amount
+	if ei.Poise > 0 {
+		return false
+	}
+
+	ei.Poise = MaxPoise(ei.Enemy.Size)
+	ei.HitStunFrames = max(ei.HitStunFrames, StaggerFrames)
+	if ei.AnimController != nil && ei.CurrentHealth > 0 {
+		ei.AnimController.Play("hit", true)
+	}
+	return true
+}
+
+// updatePoise recovers poise once the enemy has gone unhit for a while
+func (ei *EnemyInstance) updatePoise() {
+	if ei.poiseRegenDelay > 0 {
+		ei.poiseRegenDelay--
+		return
+	}
+	maxPoise := MaxPoise(ei.Enemy.Size)
+	ei.Poise = min(maxPoise, ei.Poise+maxPoise*PoiseRegenFraction)
+}
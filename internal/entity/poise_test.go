@@ -0,0 +1,74 @@
+package entity
+
+import "testing"
+
+// hitsToStagger lands hits of the given damage, with gap frames of updates
+// between them, until the enemy staggers
+func hitsToStagger(t *testing.T, instance *EnemyInstance, damage float64, gap int) int {
+	t.Helper()
+	for hits := 1; hits <= 100; hits++ {
+		if instance.ApplyPoiseDamage(damage) {
+			return hits
+		}
+		for i := 0; i < gap; i++ {
+			instance.updatePoise()
+		}
+	}
+	t.Fatal("enemy never staggered")
+	return 0
+}
+
+func TestLowPoiseEnemyStaggersAfterFewHits(t *testing.T) {
+	small := NewEnemyInstance(&Enemy{Health: 100, Size: SmallEnemy}, 0, 0)
+	hits := hitsToStagger(t, small, 10, 10)
+	if hits > 3 {
+		t.Errorf("small enemy took %d hits to stagger, want at most 3", hits)
+	}
+	if small.HitStunFrames != StaggerFrames {
+		t.Errorf("HitStunFrames = %d after stagger, want %d", small.HitStunFrames, StaggerFrames)
+	}
+	if small.Poise != MaxPoise(SmallEnemy) {
+		t.Errorf("Poise = %v after stagger, want it refilled to %v", small.Poise, MaxPoise(SmallEnemy))
+	}
+}
+
+func TestBossNeedsManyMoreHitsToStagger(t *testing.T) {
+	small := NewEnemyInstance(&Enemy{Health: 100, Size: SmallEnemy}, 0, 0)
+	boss := NewEnemyInstance(&Enemy{Health: 1000, Size: BossEnemy}, 0, 0)
+
+	smallHits := hitsToStagger(t, small, 10, 10)
+	bossHits := hitsToStagger(t, boss, 10, 10)
+	if bossHits < smallHits*4 {
+		t.Errorf("boss staggered after %d hits, small enemy after %d; want the boss to need many more", bossHits, smallHits)
+	}
+	if boss.HitStunFrames != StaggerFrames {
+		t.Errorf("boss HitStunFrames = %d after its poise broke, want %d", boss.HitStunFrames, StaggerFrames)
+	}
+}
+
+func TestPoiseRegeneratesBetweenHits(t *testing.T) {
+	instance := NewEnemyInstance(&Enemy{Health: 100, Size: MediumEnemy}, 0, 0)
+	maxPoise := MaxPoise(MediumEnemy)
+
+	instance.ApplyPoiseDamage(maxPoise / 2)
+	for i := 0; i < PoiseRegenDelay; i++ {
+		instance.updatePoise()
+	}
+	if instance.Poise != maxPoise/2 {
+		t.Errorf("Poise = %v during the regen delay, want %v", instance.Poise, maxPoise/2)
+	}
+	instance.updatePoise()
+	if instance.Poise <= maxPoise/2 {
+		t.Error("poise did not start recovering after the delay")
+	}
+
+	// Hits spaced wider than the recovery never stagger
+	for i := 0; i < 20; i++ {
+		if instance.ApplyPoiseDamage(maxPoise / 2) {
+			t.Fatalf("hit %d staggered despite poise recovering between hits", i+1)
+		}
+		for f := 0; f < PoiseRegenDelay+int(1/PoiseRegenFraction); f++ {
+			instance.updatePoise()
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package entity
+
+// SizeTraits are the behavior modifiers an enemy gets from its size. Small
+// enemies are quick and swarm, large ones are slow but hit hard and shrug
+// off knockback, and bosses cannot be stunned short of breaking their poise.
+type SizeTraits struct {
+	SpeedMultiplier     float64
+	DamageMultiplier    float64
+	KnockbackResistance float64 // Share of knockback ignored, 0 to 1
+	StunImmune          bool    // Hits never stun; only a poise break staggers
+	Swarms              bool    // Notices the player from further away and never falls back
+}
+
+// SwarmAggroMultiplier widens a swarming enemy's aggro range
+const SwarmAggroMultiplier = 1.3
+
+// sizeTraits maps each size to its modifiers. Medium is the baseline.
+var sizeTraits = map[EnemySize]SizeTraits{
+	SmallEnemy:  {SpeedMultiplier: 1.3, DamageMultiplier: 1.0, Swarms: true},
+	MediumEnemy: {SpeedMultiplier: 1.0, DamageMultiplier: 1.0},
+	LargeEnemy:  {SpeedMultiplier: 0.7, DamageMultiplier: 1.4, KnockbackResistance: 0.6},
+	BossEnemy:   {SpeedMultiplier: 1.0, DamageMultiplier: 1.0, KnockbackResistance: 0.9, StunImmune: true},
+}
+
+// SizeTraitsFor returns the modifiers for an enemy size, falling back to
+// the medium baseline
+func SizeTraitsFor(size EnemySize) SizeTraits {
+	if traits, ok := sizeTraits[size]; ok {
+		return traits
+	}
+	return sizeTraits[MediumEnemy]
+}
+
+// speed returns the speed multiplier, treating unset as 1
+func (t SizeTraits) speed() float64 {
+	if t.SpeedMultiplier <= 0 {
+		return 1
+	}
+	return t.SpeedMultiplier
+}
+
+// damage returns the damage multiplier, treating unset as 1
+func (t SizeTraits) damage() float64 {
+	if t.DamageMultiplier <= 0 {
+		return 1
+	}
+	return t.DamageMultiplier
+}
+
+// ApplyKnockback sets the enemy's velocity from a hit's knockback, reduced
+// by its knockback resistance
+func (ei *EnemyInstance) ApplyKnockback(velX, velY float64) {
+	keep := 1 - ei.Traits.KnockbackResistance
+	ei.VelX = velX * keep
+	ei.VelY = velY * keep
+}
+
+// Stun pauses the enemy's AI for at least frames, unless it is stun immune
+func (ei *EnemyInstance) Stun(frames int) {
+	if ei.Traits.StunImmune {
+		return
+	}
+	ei.HitStunFrames = max(ei.HitStunFrames, frames)
+}
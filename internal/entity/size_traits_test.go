@@ -0,0 +1,47 @@
+package entity
+
+import "testing"
+
+func TestSizeTraitsDistinguishSmallAndLarge(t *testing.T) {
+	base := Enemy{Health: 50, Damage: 10, Speed: 2.0, Behavior: ChaseBehavior}
+	smallEnemy, largeEnemy := base, base
+	smallEnemy.Size = SmallEnemy
+	largeEnemy.Size = LargeEnemy
+	small := NewEnemyInstance(&smallEnemy, 100, 100)
+	large := NewEnemyInstance(&largeEnemy, 100, 100)
+
+	if small.EffectiveSpeed() <= large.EffectiveSpeed() {
+		t.Errorf("small speed %v not above large speed %v", small.EffectiveSpeed(), large.EffectiveSpeed())
+	}
+	if small.Traits.KnockbackResistance >= large.Traits.KnockbackResistance {
+		t.Errorf("small knockback resistance %v not below large %v",
+			small.Traits.KnockbackResistance, large.Traits.KnockbackResistance)
+	}
+	if large.EffectiveDamage() <= small.EffectiveDamage() {
+		t.Errorf("large damage %d not above small damage %d", large.EffectiveDamage(), small.EffectiveDamage())
+	}
+	if !small.Traits.Swarms || small.AggroRange <= 200 {
+		t.Errorf("small enemy does not swarm (aggro range %v)", small.AggroRange)
+	}
+
+	small.ApplyKnockback(5, -3)
+	large.ApplyKnockback(5, -3)
+	if large.VelX >= small.VelX {
+		t.Errorf("large knocked back at %v, want less than small at %v", large.VelX, small.VelX)
+	}
+}
+
+func TestBossesAreStunImmune(t *testing.T) {
+	boss := NewEnemyInstance(&Enemy{Health: 200, Damage: 20, Speed: 1.0, Size: BossEnemy}, 100, 100)
+	boss.Stun(30)
+	boss.Launch(5, 30)
+	if boss.HitStunFrames != 0 {
+		t.Errorf("boss HitStunFrames = %d, want 0", boss.HitStunFrames)
+	}
+
+	grunt := NewEnemyInstance(&Enemy{Health: 50, Damage: 5, Speed: 1.0, Size: MediumEnemy}, 100, 100)
+	grunt.Stun(30)
+	if grunt.HitStunFrames != 30 {
+		t.Errorf("medium enemy HitStunFrames = %d, want 30", grunt.HitStunFrames)
+	}
+}
@@ -0,0 +1,28 @@
+package entity
+
+// Movement smoothing. Behaviors pick a target horizontal velocity each
+// frame; the enemy ramps toward it instead of snapping, so switching between
+// patrol and chase or turning around reads as a turn rather than a jerk.
+const (
+	EnemyAccelFraction = 0.25 // Share of the enemy's speed gained per frame
+	MinEnemyAccel      = 0.1  // Floor so very slow enemies still turn
+)
+
+// EnemyAccel returns how much the enemy's horizontal velocity may change in
+// one frame
+func (ei *EnemyInstance) EnemyAccel() float64 {
+	return max(ei.EffectiveSpeed()*EnemyAccelFraction, MinEnemyAccel)
+}
+
+// smoothVelocityX moves VelX from its previous value toward the target the
+// behaviors just set, by at most EnemyAccel
+func (ei *EnemyInstance) smoothVelocityX(prevVelX float64) {
+	target := ei.VelX
+	accel := ei.EnemyAccel()
+	switch {
+	case target > prevVelX+accel:
+		ei.VelX = prevVelX + accel
+	case target < prevVelX-accel:
+		ei.VelX = prevVelX - accel
+	}
+}
@@ -0,0 +1,64 @@
+package entity
+
+import "testing"
+
+func TestEnemyReversalAcceleratesThroughZero(t *testing.T) {
+	instance := NewEnemyInstance(&Enemy{Health: 50, Speed: 3.0, Size: MediumEnemy, Behavior: ChaseBehavior}, 100, 100)
+	instance.AttackRange = 0
+	instance.Alarm()
+
+	// Chase a player to the right until at full speed
+	for i := 0; i < 30; i++ {
+		instance.Update(instance.X+150, 100)
+	}
+	if instance.VelX != 3.0 {
+		t.Fatalf("VelX = %v after chasing right, want 3", instance.VelX)
+	}
+
+	// The player crosses to the left; the enemy must slow down, stop and
+	// speed back up rather than flip straight to -3
+	prev := instance.VelX
+	sawZeroish := false
+	frames := 0
+	for instance.VelX > -3.0 {
+		instance.Update(instance.X-150, 100)
+		frames++
+		if frames > 100 {
+			t.Fatal("enemy never reached full speed leftward")
+		}
+		if step := prev - instance.VelX; step > instance.EnemyAccel()+1e-9 {
+			t.Fatalf("VelX changed by %v in one frame, want at most %v", step, instance.EnemyAccel())
+		}
+		if instance.VelX <= 0 && prev >= 0 {
+			sawZeroish = true
+		}
+		prev = instance.VelX
+	}
+	if !sawZeroish {
+		t.Error("velocity flipped sign without passing through zero")
+	}
+	if frames < 2 {
+		t.Errorf("reversal took %d frames, want a ramp over several", frames)
+	}
+}
+
+func TestEnemyStartsMovingGradually(t *testing.T) {
+	instance := NewEnemyInstance(&Enemy{Health: 50, Speed: 4.0, Behavior: PatrolBehavior}, 100, 0)
+	instance.PatrolMinX = 50
+	instance.PatrolMaxX = 150
+
+	instance.Update(1000, 0)
+	if instance.VelX == 0 {
+		t.Fatal("patrolling enemy did not start moving")
+	}
+	if got := instance.VelX; got > instance.EnemyAccel() || got < -instance.EnemyAccel() {
+		t.Errorf("first-frame VelX = %v, want at most %v", got, instance.EnemyAccel())
+	}
+}
+
+func TestSlowEnemyStillTurns(t *testing.T) {
+	instance := NewEnemyInstance(&Enemy{Health: 50, Speed: 0.1}, 0, 0)
+	if instance.EnemyAccel() != MinEnemyAccel {
+		t.Errorf("EnemyAccel() = %v, want floor %v", instance.EnemyAccel(), MinEnemyAccel)
+	}
+}
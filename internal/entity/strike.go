@@ -0,0 +1,23 @@
+package entity
+
+// EnemyAttackStrikeFrame is the frame of the enemy attack animation on
+// which the blow lands: the middle of its three frames, after the wind-up
+const EnemyAttackStrikeFrame = 1
+
+// AttackAnimating reports whether the enemy is playing its attack animation
+func (ei *EnemyInstance) AttackAnimating() bool {
+	return ei.AnimController != nil && ei.AnimController.GetCurrentAnimation() == "attack"
+}
+
+// OnStrikeFrame reports whether an attacking enemy's blow can connect this
+// frame. Enemies with an attack animation strike only on its
+// EnemyAttackStrikeFrame; those without one strike for the whole attack.
+func (ei *EnemyInstance) OnStrikeFrame() bool {
+	if ei.State != AttackState {
+		return false
+	}
+	if ei.AnimController == nil {
+		return true
+	}
+	return ei.AttackAnimating() && ei.AnimController.GetCurrentFrameIndex() == EnemyAttackStrikeFrame
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/graphics"
+)
+
+func TestOnStrikeFrameOnlyDuringStrikeFrame(t *testing.T) {
+	enemy := &Enemy{Name: "Brute", Health: 50, Damage: 10, Speed: 1, Size: MediumEnemy,
+		Behavior: ChaseBehavior, AttackType: MeleeAttack, BiomeType: "cave",
+		SpriteData: &graphics.Sprite{Width: 32, Height: 32}}
+	inst := NewEnemyInstance(enemy, 0, 0)
+	if inst.OnStrikeFrame() {
+		t.Fatal("idle enemy is on its strike frame")
+	}
+
+	inst.State = AttackState
+	inst.AnimController.Play("attack", true)
+	struck := false
+	for tick := 0; tick < 15; tick++ {
+		want := inst.AnimController.GetCurrentFrameIndex() == EnemyAttackStrikeFrame
+		if got := inst.OnStrikeFrame(); got != want {
+			t.Fatalf("tick %d (frame %d): OnStrikeFrame() = %v, want %v",
+				tick, inst.AnimController.GetCurrentFrameIndex(), got, want)
+		}
+		struck = struck || want
+		inst.AnimController.Update()
+	}
+	if !struck {
+		t.Error("attack animation never reached its strike frame")
+	}
+}
+
+func TestOnStrikeFrameWithoutAnimation(t *testing.T) {
+	inst := NewEnemyInstance(&Enemy{Name: "Blob", Health: 10, Size: SmallEnemy}, 0, 0)
+	inst.State = AttackState
+	if !inst.OnStrikeFrame() {
+		t.Error("enemy without an attack animation should strike for the whole attack")
+	}
+}
@@ -0,0 +1,107 @@
+package entity
+
+// Summoner tuning, in frames at 60 FPS. A summoner keeps calling in weak
+// minions while it lives, so the player is pushed to deal with it first.
+const (
+	SummonIntervalFrames = 240 // Time between summons while alerted
+	SummonMinionCap      = 3   // Most living minions one summoner keeps
+	SummonOffset         = 40.0
+
+	MinionHealthFraction = 0.3 // Minion health as a share of the summoner's
+	MinionDamageFraction = 0.5
+	MinionSpeed          = 2.0
+)
+
+// IsSummoner reports whether an enemy of this kind summons minions: medium
+// or large enemies that hold their ground and attack from range
+func IsSummoner(size EnemySize, behavior BehaviorPattern, attack AttackType) bool {
+	return behavior == StationaryBehavior && attack == RangedAttack && size != SmallEnemy
+}
+
+// NewMinion builds the weak enemy a summoner calls in. Minions share the
+// summoner's look and biome but are small, fragile and chase the player
+// heedless of hazards.
+func NewMinion(summoner *Enemy) *Enemy {
+	return &Enemy{
+		Name:        summoner.Name + " Minion",
+		Health:      max(1, int(float64(summoner.Health)*MinionHealthFraction)),
+		Damage:      max(1, int(float64(summoner.Damage)*MinionDamageFraction)),
+		Speed:       MinionSpeed,
+		Size:        SmallEnemy,
+		Behavior:    ChaseBehavior,
+		AttackType:  ContactDamage,
+		Archetype:   StrikeArchetype,
+		SpriteData:  summoner.SpriteData,
+		DangerLevel: summoner.DangerLevel,
+		BiomeType:   summoner.BiomeType,
+		Kind:        summoner.Kind,
+		Element:     summoner.Element,
+
+		IgnoresHazards: true,
+	}
+}
+
+// LiveMinions returns how many of the enemy's minions are still alive
+func (ei *EnemyInstance) LiveMinions() int {
+	alive := 0
+	for _, minion := range ei.minions {
+		if minion.CurrentHealth > 0 {
+			alive++
+		}
+	}
+	return alive
+}
+
+// updateSummon counts down to the next summon while the summoner is alerted
+// and below its minion cap
+func (ei *EnemyInstance) updateSummon() {
+	if !ei.Enemy.Summoner || !ei.alerted || ei.summonReady {
+		return
+	}
+	if ei.LiveMinions() >= SummonMinionCap {
+		return
+	}
+	ei.summonTimer++
+	if ei.summonTimer >= SummonIntervalFrames {
+		ei.summonTimer = 0
+		ei.summonReady = true
+	}
+}
+
+// TakeSummon returns a minion the summoner is ready to call in, if any. The
+// caller adds it to the room; a summon stays ready until taken, so a full
+// room simply delays it. Minions join the summoner's group.
+func (ei *EnemyInstance) TakeSummon() (*EnemyInstance, bool) {
+	if !ei.summonReady || ei.CurrentHealth <= 0 {
+		return nil, false
+	}
+	ei.summonReady = false
+
+	// Alternate sides of the summoner, standing on the same ground
+	x, y, w, h := ei.GetBounds()
+	minionEnemy := NewMinion(ei.Enemy)
+	_, _, mw, mh := GetEnemySizeBounds(minionEnemy)
+	minionX := x + w + SummonOffset
+	if len(ei.minions)%2 == 1 {
+		minionX = x - SummonOffset - mw
+	}
+	minion := NewEnemyInstance(minionEnemy, minionX, y+h-mh)
+	minion.Alarm()
+
+	if ei.Group == nil {
+		ei.Group = NewEnemyGroup()
+		ei.Group.AddMember(ei)
+	}
+	ei.Group.AddMember(minion)
+	minion.Group = ei.Group
+
+	// Forget minions that have died so the slice stays small
+	live := ei.minions[:0]
+	for _, m := range ei.minions {
+		if m.CurrentHealth > 0 {
+			live = append(live, m)
+		}
+	}
+	ei.minions = append(live, minion)
+	return minion, true
+}
@@ -0,0 +1,104 @@
+package entity
+
+import "testing"
+
+func newTestSummoner() *EnemyInstance {
+	enemy := &Enemy{
+		Name:       "Caller",
+		Health:     60,
+		Damage:     10,
+		Size:       MediumEnemy,
+		Behavior:   StationaryBehavior,
+		AttackType: RangedAttack,
+		Summoner:   true,
+	}
+	instance := NewEnemyInstance(enemy, 100, 100)
+	instance.Alarm()
+	return instance
+}
+
+// runSummoner updates the summoner for frames frames with the player close
+// by, collecting every minion it calls in
+func runSummoner(instance *EnemyInstance, frames int) []*EnemyInstance {
+	var minions []*EnemyInstance
+	for i := 0; i < frames; i++ {
+		instance.Update(instance.X+60, instance.Y)
+		if minion, ok := instance.TakeSummon(); ok {
+			minions = append(minions, minion)
+		}
+	}
+	return minions
+}
+
+func TestIsSummoner(t *testing.T) {
+	if !IsSummoner(MediumEnemy, StationaryBehavior, RangedAttack) {
+		t.Error("medium stationary ranged enemy should summon")
+	}
+	if IsSummoner(SmallEnemy, StationaryBehavior, RangedAttack) {
+		t.Error("small enemies should not summon")
+	}
+	if IsSummoner(MediumEnemy, ChaseBehavior, RangedAttack) {
+		t.Error("chasing enemies should not summon")
+	}
+}
+
+func TestSummonerSpawnsOnCadence(t *testing.T) {
+	instance := newTestSummoner()
+
+	if minions := runSummoner(instance, SummonIntervalFrames-1); len(minions) != 0 {
+		t.Fatalf("summoned %d minions before the interval elapsed", len(minions))
+	}
+	minions := runSummoner(instance, 1)
+	if len(minions) != 1 {
+		t.Fatalf("summoned %d minions at the interval, want 1", len(minions))
+	}
+
+	minion := minions[0]
+	if minion.Enemy.Size != SmallEnemy || minion.Enemy.Health >= instance.Enemy.Health {
+		t.Errorf("minion = %+v, want a small enemy weaker than the summoner", minion.Enemy)
+	}
+	if minion.Awareness() != Alerted {
+		t.Error("minion should arrive already alerted")
+	}
+	if instance.Group == nil || minion.Group != instance.Group || len(instance.Group.Members) != 2 {
+		t.Error("minion should join the summoner's group")
+	}
+}
+
+func TestSummonerStopsAtCap(t *testing.T) {
+	instance := newTestSummoner()
+
+	minions := runSummoner(instance, SummonIntervalFrames*(SummonMinionCap+2))
+	if len(minions) != SummonMinionCap {
+		t.Fatalf("summoned %d minions, want cap %d", len(minions), SummonMinionCap)
+	}
+
+	// Killing a minion frees a slot for the next summon
+	minions[0].CurrentHealth = 0
+	if more := runSummoner(instance, SummonIntervalFrames); len(more) != 1 {
+		t.Errorf("summoned %d minions after one died, want 1", len(more))
+	}
+}
+
+func TestSummonerStopsWhenDefeated(t *testing.T) {
+	instance := newTestSummoner()
+	runSummoner(instance, SummonIntervalFrames-1)
+
+	instance.CurrentHealth = 0
+	if minions := runSummoner(instance, SummonIntervalFrames*2); len(minions) != 0 {
+		t.Errorf("defeated summoner called in %d minions", len(minions))
+	}
+}
+
+func TestSummonWaitsUntilTaken(t *testing.T) {
+	instance := newTestSummoner()
+	for i := 0; i < SummonIntervalFrames*3; i++ {
+		instance.Update(instance.X+60, instance.Y)
+	}
+	if _, ok := instance.TakeSummon(); !ok {
+		t.Fatal("ready summon should wait for the caller")
+	}
+	if _, ok := instance.TakeSummon(); ok {
+		t.Error("a delayed summon should only produce one minion")
+	}
+}
@@ -0,0 +1,116 @@
+package entity
+
+import "math"
+
+// Vision tuning
+const (
+	// DefaultVisionHalfAngle is half the width of an enemy's field of view:
+	// 60 degrees either side of where it faces
+	DefaultVisionHalfAngle = math.Pi / 3
+
+	// CloseDetectRange is how near the player can get before an enemy
+	// notices them regardless of where it is looking
+	CloseDetectRange = 48.0
+
+	// sightStep is the spacing of the samples along a line of sight
+	sightStep = NavTileSize / 2
+)
+
+// VisionCone is the area an enemy can see: up to Range pixels, within
+// HalfAngle radians either side of its facing. A HalfAngle of Pi or more
+// sees all around.
+type VisionCone struct {
+	HalfAngle float64
+	Range     float64
+}
+
+// DefaultVisionCone returns the cone for an enemy with the given aggro
+// range. Bosses see all around, since they are fought in the open.
+func DefaultVisionCone(enemy *Enemy, aggroRange float64) VisionCone {
+	if enemy.Size == BossEnemy {
+		return VisionCone{HalfAngle: math.Pi, Range: aggroRange}
+	}
+	return VisionCone{HalfAngle: DefaultVisionHalfAngle, Range: aggroRange}
+}
+
+// Contains reports whether a point offset (dx, dy) from the viewer is
+// inside the cone when facing facingDir (-1 left, 1 right)
+func (vc VisionCone) Contains(dx, dy, facingDir float64) bool {
+	dist := math.Hypot(dx, dy)
+	if dist >= vc.Range {
+		return false
+	}
+	if vc.HalfAngle >= math.Pi || dist == 0 {
+		return true
+	}
+	// Angle between the facing and the target, from the dot product
+	cos := dx * facingDir / dist
+	return cos >= math.Cos(vc.HalfAngle)
+}
+
+// SetVision replaces the enemy's vision cone
+func (ei *EnemyInstance) SetVision(cone VisionCone) {
+	ei.Vision = cone
+}
+
+// Facing returns the direction the enemy is looking: -1 left, 1 right
+func (ei *EnemyInstance) Facing() float64 {
+	return ei.facing
+}
+
+// updateFacing turns the enemy toward where it is moving
+func (ei *EnemyInstance) updateFacing() {
+	switch {
+	case ei.VelX > 0.1:
+		ei.facing = 1
+	case ei.VelX < -0.1:
+		ei.facing = -1
+	}
+}
+
+// CanSeePlayer reports whether the enemy detects a player offset (dx, dy)
+// from it: always when very close, otherwise only inside its vision cone
+// with nothing solid in between
+func (ei *EnemyInstance) CanSeePlayer(dx, dy float64) bool {
+	if math.Hypot(dx, dy) < CloseDetectRange {
+		return true
+	}
+	if !ei.Vision.Contains(dx, dy, ei.facing) {
+		return false
+	}
+	return ei.hasLineOfSight(ei.X+dx, ei.Y+dy)
+}
+
+// hasLineOfSight reports whether no solid navigation tile lies between the
+// enemy's centre and (x, y). Without a nav grid nothing blocks sight.
+func (ei *EnemyInstance) hasLineOfSight(x, y float64) bool {
+	if ei.nav == nil {
+		return true
+	}
+	ex, ey, ew, eh := ei.GetBounds()
+	fromX, fromY := ex+ew/2, ey+eh/2
+	dist := math.Hypot(x-fromX, y-fromY)
+	steps := int(dist / sightStep)
+	for i := 1; i < steps; i++ {
+		t := float64(i) / float64(steps)
+		if ei.nav.IsSolid(fromX+(x-fromX)*t, fromY+(y-fromY)*t) {
+			return false
+		}
+	}
+	return true
+}
+
+// HearNoise alerts the enemy to a noise made at x and turns it toward the
+// sound. Calmed enemies ignore it.
+func (ei *EnemyInstance) HearNoise(x float64) {
+	if ei.IsDead() || ei.Calmed() {
+		return
+	}
+	ex, _, ew, _ := ei.GetBounds()
+	if x < ex+ew/2 {
+		ei.facing = -1
+	} else {
+		ei.facing = 1
+	}
+	ei.Alarm()
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"math"
+	"testing"
+)
+
+func newVisionTestEnemy() *EnemyInstance {
+	enemy := &Enemy{Health: 50, Speed: 2.0, Behavior: StationaryBehavior, Size: SmallEnemy}
+	return NewEnemyInstance(enemy, 300, 100) // Faces right
+}
+
+func TestVisionConeContains(t *testing.T) {
+	cone := VisionCone{HalfAngle: DefaultVisionHalfAngle, Range: 200}
+	tests := []struct {
+		name      string
+		dx, dy    float64
+		facingDir float64
+		want      bool
+	}{
+		{"straight ahead", 100, 0, 1, true},
+		{"inside the edge", 100, 150, 1, true},
+		{"outside the edge", 50, 150, 1, false},
+		{"behind", -100, 0, 1, false},
+		{"behind, facing back", -100, 0, -1, true},
+		{"out of range", 250, 0, 1, false},
+	}
+	for _, tt := range tests {
+		if got := cone.Contains(tt.dx, tt.dy, tt.facingDir); got != tt.want {
+			t.Errorf("%s: Contains(%v, %v, %v) = %v, want %v", tt.name, tt.dx, tt.dy, tt.facingDir, got, tt.want)
+		}
+	}
+
+	all := VisionCone{HalfAngle: math.Pi, Range: 200}
+	if !all.Contains(-100, 0, 1) {
+		t.Error("a full circle cone should see behind")
+	}
+}
+
+func TestPlayerBehindEnemyStaysUndetected(t *testing.T) {
+	enemy := newVisionTestEnemy()
+	for i := 0; i < AlertRiseFrames*2; i++ {
+		enemy.Update(200, 100) // 100px behind, well within aggro range
+	}
+	if got := enemy.Awareness(); got != Unaware {
+		t.Errorf("Awareness() = %v with the player behind, want Unaware", got)
+	}
+}
+
+func TestEnteringVisionConeTriggersDetection(t *testing.T) {
+	enemy := newVisionTestEnemy()
+	enemy.Update(200, 100) // Behind
+	for i := 0; i < AlertRiseFrames+1; i++ {
+		enemy.Update(400, 100) // In front
+	}
+	if got := enemy.Awareness(); got != Alerted {
+		t.Errorf("Awareness() = %v with the player in view, want Alerted", got)
+	}
+}
+
+func TestCloseRangeDetectsBehind(t *testing.T) {
+	enemy := newVisionTestEnemy()
+	for i := 0; i < AlertRiseFrames+1; i++ {
+		enemy.Update(300-CloseDetectRange/2, 100)
+	}
+	if got := enemy.Awareness(); got != Alerted {
+		t.Errorf("Awareness() = %v with the player right behind, want Alerted", got)
+	}
+}
+
+func TestWallBlocksLineOfSight(t *testing.T) {
+	enemy := newVisionTestEnemy()
+	grid := NewNavGrid(960, 640)
+	grid.MarkSolid(352, 0, 32, 640) // A wall between the enemy and the player
+	enemy.SetNavGrid(grid)
+
+	for i := 0; i < AlertRiseFrames*2; i++ {
+		enemy.Update(450, 100)
+	}
+	if got := enemy.Awareness(); got != Unaware {
+		t.Errorf("Awareness() = %v through a wall, want Unaware", got)
+	}
+}
+
+func TestBossesSeeAllAround(t *testing.T) {
+	boss := NewEnemyInstance(&Enemy{Health: 500, Behavior: StationaryBehavior, Size: BossEnemy}, 300, 100)
+	for i := 0; i < AlertRiseFrames+1; i++ {
+		boss.Update(150, 100)
+	}
+	if got := boss.Awareness(); got != Alerted {
+		t.Errorf("boss Awareness() = %v with the player behind, want Alerted", got)
+	}
+}
@@ -0,0 +1,79 @@
+package graphics
+
+import (
+	"image"
+	"image/color"
+	"math/rand"
+)
+
+// PaletteSwapVariants is how many player palette choices there are,
+// variant zero being the generated colors
+const PaletteSwapVariants = 6
+
+// PaletteSwap recolors a sprite by palette index: each entry replaces the
+// color at that index of the sprite's palette
+type PaletteSwap map[int]color.RGBA
+
+// GeneratePaletteSwap creates a seeded replacement for every index of a
+// count-color sprite palette. Like the generated palettes, hues step evenly
+// from a base hue and brightness rises with the index, so shading reads
+// the same after the swap.
+func GeneratePaletteSwap(seed int64, count int) PaletteSwap {
+	rng := rand.New(rand.NewSource(seed))
+	baseHue := rng.Float64() * 360.0
+
+	swap := make(PaletteSwap, count)
+	for i := 0; i < count; i++ {
+		hue := mod(baseHue+float64(i)*30.0, 360.0)
+		saturation := 0.6 + rng.Float64()*0.3
+		value := 0.4 + float64(i)*0.1
+		swap[i] = hsvToRGB(hue, saturation, value)
+	}
+	return swap
+}
+
+// Recolor returns a copy of the sprite with its palette colors replaced by
+// swap. Each pixel is matched to its palette index, allowing for the
+// shading of the lower half; the outline, and indices swap leaves out, keep
+// their color. Sprites without a palette are copied unchanged.
+func (s *Sprite) Recolor(swap PaletteSwap) *Sprite {
+	recolored := &Sprite{
+		Image:   image.NewRGBA(s.Image.Bounds()),
+		Width:   s.Width,
+		Height:  s.Height,
+		Palette: make([]color.RGBA, len(s.Palette)),
+	}
+	copy(recolored.Image.Pix, s.Image.Pix)
+	for i, c := range s.Palette {
+		recolored.Palette[i] = c
+		if replacement, ok := swap[i]; ok {
+			recolored.Palette[i] = replacement
+		}
+	}
+
+	bounds := s.Image.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		// applyShading darkens the lower half of the sprite
+		shaded := y-bounds.Min.Y >= s.Height/2
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := s.Image.RGBAAt(x, y)
+			if c.A == 0 {
+				continue
+			}
+			for i, original := range s.Palette {
+				if _, ok := swap[i]; !ok {
+					continue
+				}
+				replacement := recolored.Palette[i]
+				if shaded {
+					original, replacement = shade(original), shade(replacement)
+				}
+				if c == original {
+					recolored.Image.SetRGBA(x, y, replacement)
+					break
+				}
+			}
+		}
+	}
+	return recolored
+}
@@ -0,0 +1,63 @@
+package graphics
+
+import "testing"
+
+func TestRecolorSwapsPaletteIndicesDeterministically(t *testing.T) {
+	sprite := NewSpriteGenerator(16, 16, VerticalSymmetry).Generate(12345)
+	if len(sprite.Palette) == 0 {
+		t.Fatal("generated sprite should record its palette")
+	}
+	swap := PaletteSwap{0: {255, 0, 255, 255}}
+
+	recolored := sprite.Recolor(swap)
+	if recolored.Width != sprite.Width || recolored.Height != sprite.Height ||
+		recolored.Image.Bounds() != sprite.Image.Bounds() {
+		t.Fatalf("recolored size = %dx%d %v, want %dx%d %v", recolored.Width, recolored.Height,
+			recolored.Image.Bounds(), sprite.Width, sprite.Height, sprite.Image.Bounds())
+	}
+
+	swapped := 0
+	for y := 0; y < sprite.Height; y++ {
+		original, replacement := sprite.Palette[0], swap[0]
+		if y >= sprite.Height/2 {
+			original, replacement = shade(original), shade(replacement)
+		}
+		for x := 0; x < sprite.Width; x++ {
+			before, after := sprite.Image.RGBAAt(x, y), recolored.Image.RGBAAt(x, y)
+			switch {
+			case before.A > 0 && before == original:
+				if after != replacement {
+					t.Fatalf("pixel (%d,%d) of index 0 = %v, want %v", x, y, after, replacement)
+				}
+				swapped++
+			case after != before:
+				t.Fatalf("pixel (%d,%d) outside index 0 changed from %v to %v", x, y, before, after)
+			}
+		}
+	}
+	if swapped == 0 {
+		t.Fatal("expected some pixels of palette index 0 to be swapped")
+	}
+
+	again := sprite.Recolor(swap)
+	for i := range recolored.Image.Pix {
+		if recolored.Image.Pix[i] != again.Image.Pix[i] {
+			t.Fatal("recoloring the same sprite twice should give identical pixels")
+		}
+	}
+}
+
+func TestGeneratePaletteSwapIsSeeded(t *testing.T) {
+	a, b := GeneratePaletteSwap(7, 6), GeneratePaletteSwap(7, 6)
+	if len(a) != 6 {
+		t.Fatalf("swap has %d entries, want 6", len(a))
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			t.Errorf("index %d differs between equal seeds: %v vs %v", i, a[i], b[i])
+		}
+	}
+	if c := GeneratePaletteSwap(8, 6); c[0] == a[0] && c[1] == a[1] {
+		t.Error("different seeds should give different swaps")
+	}
+}
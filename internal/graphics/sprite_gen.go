@@ -32,6 +32,10 @@ type Sprite struct {
 	Image  *image.RGBA
 	Width  int
 	Height int
+
+	// Palette holds the colors the sprite was filled with, for recoloring
+	// by palette index (see Recolor). Nil for sprites not built from one.
+	Palette []color.RGBA
 }
 
 // SpriteGenerator generates procedural pixel art sprites
@@ -89,6 +93,7 @@ func (sg *SpriteGenerator) Generate(seed int64) *Sprite {
 		shaded = sg.addOutline(shaded)
 	}
 
+	shaded.Palette = palette
 	return shaded
 }
 
@@ -289,17 +294,22 @@ func (sg *SpriteGenerator) applyShading(sprite *Sprite, palette []color.RGBA) *S
 		for x := 0; x < sprite.Width; x++ {
 			c := sprite.Image.RGBAAt(x, y)
 			if c.A > 0 {
-				factor := 0.8
-				c.R = uint8(float64(c.R) * factor)
-				c.G = uint8(float64(c.G) * factor)
-				c.B = uint8(float64(c.B) * factor)
-				sprite.Image.Set(x, y, c)
+				sprite.Image.Set(x, y, shade(c))
 			}
 		}
 	}
 	return sprite
 }
 
+// shade darkens a color the way applyShading does the lower half
+func shade(c color.RGBA) color.RGBA {
+	factor := 0.8
+	c.R = uint8(float64(c.R) * factor)
+	c.G = uint8(float64(c.G) * factor)
+	c.B = uint8(float64(c.B) * factor)
+	return c
+}
+
 // addOutline adds outline to sprite for clarity
 func (sg *SpriteGenerator) addOutline(sprite *Sprite) *Sprite {
 	outlined := image.NewRGBA(sprite.Image.Bounds())
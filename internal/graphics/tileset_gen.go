@@ -15,6 +15,7 @@ const (
 	SpikeTile
 	LiquidTile
 	BackgroundTile
+	ForegroundTile // Overlay drawn in front of entities
 )
 
 // Tileset contains generated tiles
@@ -55,6 +56,7 @@ func (tg *TilesetGenerator) Generate(seed int64) *Tileset {
 	tileset.Tiles[SpikeTile] = tg.generateSpikeTile(rng, palette)
 	tileset.Tiles[LiquidTile] = tg.generateLiquidTile(rng, palette)
 	tileset.Tiles[BackgroundTile] = tg.generateBackgroundTile(rng, palette)
+	tileset.Tiles[ForegroundTile] = tg.generateForegroundTile(rng, palette)
 
 	return tileset
 }
@@ -268,6 +270,31 @@ func (tg *TilesetGenerator) generateBackgroundTile(rng *rand.Rand, palette []col
 	return sprite
 }
 
+// generateForegroundTile creates an overlay tile of hanging growth. Only
+// the top of the tile is filled so entities stay visible behind it.
+func (tg *TilesetGenerator) generateForegroundTile(rng *rand.Rand, palette []color.RGBA) *Sprite {
+	sprite := &Sprite{
+		Image:  image.NewRGBA(image.Rect(0, 0, tg.TileSize, tg.TileSize)),
+		Width:  tg.TileSize,
+		Height: tg.TileSize,
+	}
+
+	// Darkened silhouette of the base color
+	fgColor := palette[1]
+	fgColor.R = fgColor.R / 3
+	fgColor.G = fgColor.G / 3
+	fgColor.B = fgColor.B / 3
+
+	for x := 0; x < tg.TileSize; x++ {
+		length := tg.TileSize/8 + rng.Intn(tg.TileSize/2)
+		for y := 0; y < length; y++ {
+			sprite.Image.Set(x, y, fgColor)
+		}
+	}
+
+	return sprite
+}
+
 // MapGenreToBiome maps a genre ID to an appropriate biome name
 func MapGenreToBiome(genreID string) string {
 	switch genreID {
@@ -62,6 +62,7 @@ func TestGenerate_ContainsAllTileTypes(t *testing.T) {
 		SpikeTile,
 		LiquidTile,
 		BackgroundTile,
+		ForegroundTile,
 	}
 
 	for _, tileType := range requiredTiles {
@@ -289,8 +290,8 @@ func TestGenerate_CaveBiomePalette(t *testing.T) {
 		t.Error("Failed to generate cave tileset")
 	}
 
-	if len(tileset.Tiles) != 5 {
-		t.Errorf("Expected 5 tile types, got %d", len(tileset.Tiles))
+	if len(tileset.Tiles) != 6 {
+		t.Errorf("Expected 6 tile types, got %d", len(tileset.Tiles))
 	}
 }
 
@@ -304,8 +305,8 @@ func TestGenerate_ForestBiomePalette(t *testing.T) {
 		t.Error("Failed to generate forest tileset")
 	}
 
-	if len(tileset.Tiles) != 5 {
-		t.Errorf("Expected 5 tile types, got %d", len(tileset.Tiles))
+	if len(tileset.Tiles) != 6 {
+		t.Errorf("Expected 6 tile types, got %d", len(tileset.Tiles))
 	}
 }
 
@@ -319,8 +320,8 @@ func TestGenerate_RuinsBiomePalette(t *testing.T) {
 		t.Error("Failed to generate ruins tileset")
 	}
 
-	if len(tileset.Tiles) != 5 {
-		t.Errorf("Expected 5 tile types, got %d", len(tileset.Tiles))
+	if len(tileset.Tiles) != 6 {
+		t.Errorf("Expected 6 tile types, got %d", len(tileset.Tiles))
 	}
 }
 
@@ -474,3 +475,20 @@ func TestGenerate_DifferentSeedsDifferentVariation(t *testing.T) {
 		t.Error("Different seeds should still produce same tile types")
 	}
 }
+
+// TestGenerate_ForegroundTileLeavesGaps tests the overlay tile stays see-through
+func TestGenerate_ForegroundTileLeavesGaps(t *testing.T) {
+	tg := NewTilesetGenerator(16, "cave")
+	tileset := tg.Generate(1111)
+
+	sprite := tileset.Tiles[ForegroundTile]
+	if sprite == nil || sprite.Image == nil {
+		t.Fatal("ForegroundTile is nil")
+	}
+	if _, _, _, a := sprite.Image.At(0, 0).RGBA(); a == 0 {
+		t.Error("expected the top edge of the foreground tile to be filled")
+	}
+	if _, _, _, a := sprite.Image.At(0, 15).RGBA(); a != 0 {
+		t.Error("expected the bottom of the foreground tile to be transparent")
+	}
+}
@@ -31,6 +31,11 @@ type InputState struct {
 	BlockPress        bool // True only on the frame block was pressed
 	Pause             bool
 	PausePress        bool
+	AutoRunPress      bool // True only on the frame the auto-run toggle was pressed
+	SwapWeaponPress   bool // True only on the frame weapon swap was pressed
+	Walk              bool // Hold to move at walking pace
+	Crouch            bool // Hold to crouch and move quietly
+	InteractPress     bool // True only on the frame interact was pressed
 }
 
 // BufferedInput tracks buffered action inputs
@@ -44,6 +49,11 @@ type InputHandler struct {
 	prevState  InputState
 	buffered   BufferedInput
 	keyMapping *KeyMapping
+
+	// Auto-run keeps the player moving without a held direction
+	autoRunEnabled bool
+	autoRunDir     int // -1 left, 1 right, 0 not running
+	facing         int // Last direction moved, for starting auto-run
 }
 
 // KeyMapping defines rebindable key bindings for all actions
@@ -57,6 +67,11 @@ type KeyMapping struct {
 	UseAbility   []ebiten.Key
 	Block        []ebiten.Key
 	Pause        []ebiten.Key
+	AutoRun      []ebiten.Key
+	Walk         []ebiten.Key
+	SwapWeapon   []ebiten.Key
+	Crouch       []ebiten.Key
+	Interact     []ebiten.Key
 }
 
 // DefaultKeyMapping returns the default key configuration
@@ -71,6 +86,11 @@ func DefaultKeyMapping() *KeyMapping {
 		UseAbility:   []ebiten.Key{ebiten.KeyL, ebiten.KeyC},
 		Block:        []ebiten.Key{ebiten.KeyS, ebiten.KeyArrowDown, ebiten.KeyShiftLeft},
 		Pause:        []ebiten.Key{ebiten.KeyEscape, ebiten.KeyP},
+		AutoRun:      []ebiten.Key{ebiten.KeyE},
+		Walk:         []ebiten.Key{ebiten.KeyAltLeft},
+		SwapWeapon:   []ebiten.Key{ebiten.KeyQ},
+		Crouch:       []ebiten.Key{ebiten.KeyB}, // Not Ctrl: Ctrl+Q quits
+		Interact:     []ebiten.Key{ebiten.KeyF},
 	}
 }
 
@@ -97,6 +117,11 @@ func (ih *InputHandler) SetKeyMapping(mapping *KeyMapping) {
 	ih.keyMapping = mapping
 }
 
+// KeyMapping returns the key bindings in use
+func (ih *InputHandler) KeyMapping() *KeyMapping {
+	return ih.keyMapping
+}
+
 // isAnyKeyPressed checks if any key in the list is pressed
 func (ih *InputHandler) isAnyKeyPressed(keys []ebiten.Key) bool {
 	for _, key := range keys {
@@ -163,12 +188,71 @@ func (ih *InputHandler) Update() InputState {
 	state.Pause = ih.isAnyKeyPressed(ih.keyMapping.Pause)
 	state.PausePress = ih.isAnyKeyJustPressed(ih.keyMapping.Pause)
 
+	// Auto-run toggle, walk modifier, and crouch
+	state.AutoRunPress = ih.isAnyKeyJustPressed(ih.keyMapping.AutoRun)
+	state.Walk = ih.isAnyKeyPressed(ih.keyMapping.Walk)
+	state.Crouch = ih.isAnyKeyPressed(ih.keyMapping.Crouch)
+	ih.applyAutoRun(&state)
+
+	// Weapon swap and interact (not buffered)
+	state.SwapWeaponPress = ih.isAnyKeyJustPressed(ih.keyMapping.SwapWeapon)
+	state.InteractPress = ih.isAnyKeyJustPressed(ih.keyMapping.Interact)
+
 	// Update previous state
 	ih.prevState = state
 
 	return state
 }
 
+// SetAutoRun enables or disables the auto-run toggle. Disabling it stops
+// any run in progress.
+func (ih *InputHandler) SetAutoRun(enabled bool) {
+	ih.autoRunEnabled = enabled
+	if !enabled {
+		ih.autoRunDir = 0
+	}
+}
+
+// AutoRunning reports whether auto-run is currently moving the player
+func (ih *InputHandler) AutoRunning() bool {
+	return ih.autoRunDir != 0
+}
+
+// applyAutoRun holds the auto-run direction in state. The toggle starts a
+// run in the direction last moved and stops one in progress; pressing the
+// opposite direction also stops it.
+func (ih *InputHandler) applyAutoRun(state *InputState) {
+	switch {
+	case state.MoveRight && !state.MoveLeft:
+		ih.facing = 1
+	case state.MoveLeft && !state.MoveRight:
+		ih.facing = -1
+	}
+
+	if !ih.autoRunEnabled {
+		return
+	}
+
+	if state.AutoRunPress {
+		if ih.autoRunDir != 0 {
+			ih.autoRunDir = 0
+		} else if ih.facing != 0 {
+			ih.autoRunDir = ih.facing
+		} else {
+			ih.autoRunDir = 1
+		}
+	}
+
+	switch {
+	case ih.autoRunDir > 0 && state.MoveLeft, ih.autoRunDir < 0 && state.MoveRight:
+		ih.autoRunDir = 0
+	case ih.autoRunDir > 0:
+		state.MoveRight = true
+	case ih.autoRunDir < 0:
+		state.MoveLeft = true
+	}
+}
+
 // BufferAttack buffers an attack input for execution when conditions are met
 func (ih *InputHandler) BufferAttack() {
 	ih.buffered.AttackBuffer = BufferFrames
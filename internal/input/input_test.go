@@ -77,6 +77,25 @@ func TestDefaultKeyMapping(t *testing.T) {
 	}
 }
 
+// TestDefaultKeyMappingAvoidsQuitChord checks that no default binding is a
+// Ctrl key, since holding one while pressing Q (swap weapon) would quit
+func TestDefaultKeyMappingAvoidsQuitChord(t *testing.T) {
+	km := DefaultKeyMapping()
+	bindings := [][]ebiten.Key{
+		km.MoveLeft, km.MoveRight, km.Jump, km.Attack, km.RangedAttack,
+		km.Dash, km.UseAbility, km.Block, km.Pause, km.AutoRun, km.Walk,
+		km.SwapWeapon, km.Crouch, km.Interact,
+	}
+	for _, keys := range bindings {
+		for _, key := range keys {
+			switch key {
+			case ebiten.KeyControl, ebiten.KeyControlLeft, ebiten.KeyControlRight:
+				t.Errorf("default binding %v overlaps the Ctrl+Q quit chord", key)
+			}
+		}
+	}
+}
+
 func TestInputStateInitialization(t *testing.T) {
 	state := InputState{}
 
@@ -320,3 +339,74 @@ func TestKeyMappingFromSettingsEmptyBindings(t *testing.T) {
 // which needs X11/graphics libraries. These tests verify the data structures,
 // buffering logic, and settings integration are correctly implemented.
 // Integration tests with actual keyboard input should be run in a graphical environment.
+
+func TestAutoRunKeepsMovingWithoutHeldInput(t *testing.T) {
+	ih := NewInputHandler()
+	ih.SetAutoRun(true)
+
+	// Move right once, then toggle auto-run and let go
+	state := InputState{MoveRight: true}
+	ih.applyAutoRun(&state)
+	state = InputState{AutoRunPress: true}
+	ih.applyAutoRun(&state)
+
+	for i := 0; i < 30; i++ {
+		state = InputState{}
+		ih.applyAutoRun(&state)
+		if !state.MoveRight || state.MoveLeft {
+			t.Fatalf("frame %d: auto-run state = %+v, want moving right", i, state)
+		}
+	}
+	if !ih.AutoRunning() {
+		t.Error("AutoRunning() = false during a run")
+	}
+
+	// Pressing the toggle again stops the run
+	state = InputState{AutoRunPress: true}
+	ih.applyAutoRun(&state)
+	state = InputState{}
+	ih.applyAutoRun(&state)
+	if state.MoveRight || ih.AutoRunning() {
+		t.Error("second toggle did not stop auto-run")
+	}
+}
+
+func TestAutoRunCancelledByOppositeDirection(t *testing.T) {
+	ih := NewInputHandler()
+	ih.SetAutoRun(true)
+
+	state := InputState{MoveLeft: true, AutoRunPress: true}
+	ih.applyAutoRun(&state)
+	state = InputState{}
+	ih.applyAutoRun(&state)
+	if !state.MoveLeft {
+		t.Fatal("auto-run did not start toward the held direction")
+	}
+
+	state = InputState{MoveRight: true}
+	ih.applyAutoRun(&state)
+	if state.MoveLeft {
+		t.Error("opposite input still moving left")
+	}
+	if ih.AutoRunning() {
+		t.Error("opposite input did not cancel auto-run")
+	}
+
+	state = InputState{}
+	ih.applyAutoRun(&state)
+	if state.MoveLeft || state.MoveRight {
+		t.Errorf("state after cancel = %+v, want no movement", state)
+	}
+}
+
+func TestAutoRunToggleIgnoredWhenDisabled(t *testing.T) {
+	ih := NewInputHandler()
+
+	state := InputState{AutoRunPress: true}
+	ih.applyAutoRun(&state)
+	state = InputState{}
+	ih.applyAutoRun(&state)
+	if state.MoveLeft || state.MoveRight || ih.AutoRunning() {
+		t.Error("auto-run started while the option is off")
+	}
+}
@@ -17,6 +17,7 @@ func KeyMappingFromSettings(controls *settings.ControlSettings) *KeyMapping {
 		Attack:     []ebiten.Key{},
 		Dash:       []ebiten.Key{},
 		UseAbility: []ebiten.Key{},
+		Interact:   []ebiten.Key{},
 		Pause:      []ebiten.Key{},
 	}
 
@@ -34,8 +35,9 @@ func KeyMappingFromSettings(controls *settings.ControlSettings) *KeyMapping {
 		case settings.ActionDash:
 			mapping.Dash = append(mapping.Dash, key)
 		case settings.ActionInteract:
-			// Map Interact to UseAbility
+			// Interact also uses abilities, as it did before prompts
 			mapping.UseAbility = append(mapping.UseAbility, key)
+			mapping.Interact = append(mapping.Interact, key)
 		case settings.ActionPause:
 			mapping.Pause = append(mapping.Pause, key)
 		case settings.ActionMenu:
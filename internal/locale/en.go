@@ -0,0 +1,216 @@
+package locale
+
+// english is the reference table. Every key used in the game must be here;
+// other languages may leave keys out and fall back to it.
+var english = map[string]string{
+	// Menu titles
+	"menu.title.main":      "VANIA - Procedural Metroidvania",
+	"menu.title.pause":     "Game Paused",
+	"menu.title.settings":  "Settings",
+	"menu.title.save_load": "Save / Load",
+	"menu.title.game_over": "Game Over",
+	"menu.title.presets":   "Load Preset",
+	"menu.title.victory":   "Victory!",
+	"menu.title.run_stats": "Run Statistics",
+	"menu.title.boss_rush": "Boss Rush Complete",
+	"menu.title.default":   "Menu",
+
+	// Menu items
+	"menu.new_game_random":    "New Game (Random Seed)",
+	"menu.new_game_seed":      "New Game (Seed: %d)",
+	"menu.load_game":          "Load Game",
+	"menu.save_game":          "Save Game",
+	"menu.load_preset":        "Load Preset",
+	"menu.save_preset":        "Save World Preset",
+	"menu.settings":           "Settings",
+	"menu.resume":             "Resume",
+	"menu.main_menu":          "Main Menu",
+	"menu.quit":               "Quit",
+	"menu.quit_game":          "Quit Game",
+	"menu.back":               "Back",
+	"menu.try_again":          "Try Again",
+	"menu.run_stats":          "Run Statistics",
+	"menu.new_game_plus":      "Continue to New Game+",
+	"menu.configure_controls": "Configure Controls",
+	"menu.reset_defaults":     "Reset to Defaults",
+	"menu.slot":               "Slot %d",
+	"menu.slot_saved":         "Slot %d - %dh %dm (Seed: %d)",
+	"menu.slot_empty":         "Slot %d - Empty",
+	"menu.slot_assist":        " [Assist]",
+	"menu.slot_rewind":        " [Rewind]",
+	"menu.boss_rush":          "Boss Rush",
+	"menu.boss_rush_again":    "Race the Same Bosses Again",
+	"menu.completion":         "Completion: %d%%",
+
+	// Settings
+	"settings.master_volume":        "Master Volume: %.0f%%",
+	"settings.sfx_volume":           "SFX Volume: %.0f%%",
+	"settings.music_volume":         "Music Volume: %.0f%%",
+	"settings.captions":             "Captions: %v",
+	"settings.fullscreen":           "Fullscreen: %v",
+	"settings.show_fps":             "Show FPS: %v",
+	"settings.quality":              "Graphics Quality: %v",
+	"settings.scaling":              "Scaling: %v",
+	"settings.scaling.pixel":        "Pixel-Perfect",
+	"settings.scaling.smooth":       "Smooth",
+	"settings.palette":              "Player Colors: %s",
+	"settings.max_enemies":          "Max Active Enemies: %d",
+	"settings.palette.original":     "Original",
+	"settings.palette.variant":      "Variant %d",
+	"settings.enemy_respawn":        "Enemy Respawn: %s",
+	"settings.respawn.reentry":      "On Re-entry",
+	"settings.respawn.never":        "Never",
+	"settings.respawn.timed":        "Timed",
+	"settings.movement":             "Movement: %s",
+	"settings.movement.instant":     "Instant",
+	"settings.movement.accelerated": "Accelerated",
+	"settings.hit_stop":             "Hit-Stop: %v",
+	"settings.assist_mode":          "Assist Mode: %v",
+	"settings.rewind_on_death":      "Rewind on Death: %v",
+	"settings.aim_assist":           "Aim Assist: %s",
+	"settings.aim_assist.off":       "Off",
+	"settings.aim_assist.soft":      "Soft",
+	"settings.aim_assist.strong":    "Strong",
+	"settings.tutorial":             "Tutorial: %v",
+	"settings.gore":                 "Gore: %v",
+	"settings.auto_run":             "Auto-Run Toggle: %v",
+	"settings.hitboxes":             "Show Hitboxes: %v",
+	"settings.language":             "Language: %s",
+	"settings.hud":                  "%s: %s",
+	"settings.hud.health_bar":       "Health Bar",
+	"settings.hud.ability_icons":    "Ability Icons",
+	"settings.hud.debug_info":       "Debug Info",
+	"settings.hud.minimap":          "Minimap",
+	"settings.hud.default":          "Default",
+	"settings.hud.top-left":         "Top-Left",
+	"settings.hud.top-right":        "Top-Right",
+	"settings.hud.bottom-left":      "Bottom-Left",
+	"settings.hud.bottom-right":     "Bottom-Right",
+	"settings.hud.hidden":           "Hidden",
+
+	// Controls
+	"controls.move_left":  "Move Left: %s",
+	"controls.move_right": "Move Right: %s",
+	"controls.jump":       "Jump: %s",
+	"controls.attack":     "Attack: %s",
+	"controls.dash":       "Dash: %s",
+	"controls.ability":    "Use Ability: %s",
+
+	// In-game toasts
+	"toast.collected":        "Collected: %s",
+	"toast.equipped":         "Equipped: %s",
+	"toast.ability_unlocked": "Ability Unlocked: %s",
+	"toast.door_mechanism":   "Sealed by a mechanism",
+	"toast.door_guardian":    "Defeat the guardian to proceed",
+	"toast.door_hub":         "Reach this region on foot to open the way",
+	"toast.rewound":          "Rewound",
+	"toast.rested":           "Rested and saved",
+	"toast.rested_unsaved":   "Rested",
+	"prompt.interact":        "[%s] %s",
+	"prompt.rest":            "Rest",
+	"toast.door_requires":    "Requires: %s",
+	"toast.door_hint":        "Requires: %s - the means to pass lies in %s",
+	"toast.door_locked":      "Door is locked",
+	"toast.door_unlocked":    "Door unlocked!",
+	"toast.quicksaved":       "Quicksaved",
+	"toast.quickloaded":      "Quickloaded",
+	"toast.quicksave_failed": "Quicksave failed",
+	"toast.quickload_failed": "No quicksave to load",
+
+	// Boss rush
+	"hud.boss_rush":            "Boss %d/%d   %s   Deaths: %d",
+	"results.boss_rush_time":   "Time:    %s",
+	"results.boss_rush_bosses": "Bosses:  %d",
+	"results.boss_rush_deaths": "Deaths:  %d",
+
+	// Room descriptions shown on entry, by theme and biome
+	"room.fantasy.cave":      "Ancient stones whisper forgotten secrets...",
+	"room.fantasy.forest":    "Twisted roots pierce through crumbling walls...",
+	"room.fantasy.dungeon":   "The air grows heavy with untold mysteries...",
+	"room.fantasy.castle":    "Echoes of a fallen kingdom linger here...",
+	"room.fantasy.corridor":  "Shadows dance along weathered corridors...",
+	"room.fantasy.chamber":   "A sanctum of old power awaits...",
+	"room.scifi.cave":        "Bio-luminescent fungi illuminate the passage...",
+	"room.scifi.forest":      "Synthetic vines entangle abandoned machinery...",
+	"room.scifi.dungeon":     "Malfunctioning systems flicker in the dark...",
+	"room.scifi.castle":      "The command deck lies in silent ruin...",
+	"room.scifi.corridor":    "Emergency lighting guides through debris...",
+	"room.scifi.chamber":     "A reactor core hums with residual power...",
+	"room.horror.cave":       "Something unspeakable stirs in the darkness...",
+	"room.horror.forest":     "The trees seem to watch with malice...",
+	"room.horror.dungeon":    "Chains rattle in unseen corners...",
+	"room.horror.castle":     "The walls bleed memories of suffering...",
+	"room.horror.corridor":   "Each step echoes like a death knell...",
+	"room.horror.chamber":    "An altar of nightmares awaits the unwary...",
+	"room.mystical.cave":     "Crystal formations pulse with ethereal light...",
+	"room.mystical.forest":   "Spirit wisps dance between ancient oaks...",
+	"room.mystical.dungeon":  "Arcane symbols shimmer on every surface...",
+	"room.mystical.castle":   "The veil between worlds grows thin here...",
+	"room.mystical.corridor": "Reality bends around each corner...",
+	"room.mystical.chamber":  "A nexus of magical convergence beckons...",
+	"room.postapoc.cave":     "Radiation warnings mark forgotten shelters...",
+	"room.postapoc.forest":   "Mutated vegetation reclaims the ruins...",
+	"room.postapoc.dungeon":  "Pre-war bunkers hold decaying secrets...",
+	"room.postapoc.castle":   "A fortress against the wasteland crumbles...",
+	"room.postapoc.corridor": "Dust settles on the bones of civilization...",
+	"room.postapoc.chamber":  "Survival caches lie hidden in the rubble...",
+	"room.default.cave":      "A passage stretches into darkness...",
+	"room.default.corridor":  "The way forward lies uncertain...",
+	"room.generic.fantasy":   "Ancient magic pervades this %s...",
+	"room.generic.scifi":     "Systems detect this %s...",
+	"room.generic.horror":    "Dread fills this %s...",
+	"room.generic.mystical":  "Mystical energy surrounds this %s...",
+	"room.generic.postapoc":  "Ruins mark this %s...",
+	"room.generic.default":   "You enter this %s...",
+
+	// Narrative lore
+	"narrative.item.weapon.0":     "A %s blade forged in the fires of the %s.",
+	"narrative.item.weapon.1":     "This %s weapon has seen countless battles.",
+	"narrative.item.weapon.2":     "The %s craftsmanship is evident in every detail.",
+	"narrative.item.key_item.0":   "A %s artifact of immense power.",
+	"narrative.item.key_item.1":   "This %s object holds the key to secrets long forgotten.",
+	"narrative.item.key_item.2":   "The %s nature of this item is unmistakable.",
+	"narrative.item.consumable.0": "A %s potion that glows with inner light.",
+	"narrative.item.consumable.1": "This %s elixir was crafted by master alchemists.",
+	"narrative.item.consumable.2": "The %s properties make it invaluable.",
+	"narrative.item.unknown":      "A remarkable item of unknown origin.",
+
+	"narrative.adj.enchanted":    "enchanted",
+	"narrative.adj.ancient":      "ancient",
+	"narrative.adj.blessed":      "blessed",
+	"narrative.adj.legendary":    "legendary",
+	"narrative.adj.advanced":     "advanced",
+	"narrative.adj.prototype":    "prototype",
+	"narrative.adj.quantum":      "quantum",
+	"narrative.adj.neural":       "neural",
+	"narrative.adj.cursed":       "cursed",
+	"narrative.adj.twisted":      "twisted",
+	"narrative.adj.forbidden":    "forbidden",
+	"narrative.adj.eldritch":     "eldritch",
+	"narrative.adj.ethereal":     "ethereal",
+	"narrative.adj.transcendent": "transcendent",
+	"narrative.adj.sacred":       "sacred",
+	"narrative.adj.cosmic":       "cosmic",
+	"narrative.adj.salvaged":     "salvaged",
+	"narrative.adj.modified":     "modified",
+	"narrative.adj.reinforced":   "reinforced",
+	"narrative.adj.makeshift":    "makeshift",
+	"narrative.adj.mysterious":   "mysterious",
+	"narrative.adj.powerful":     "powerful",
+	"narrative.adj.rare":         "rare",
+	"narrative.adj.valuable":     "valuable",
+	"narrative.place.ancients":   "ancients",
+	"narrative.place.fallen":     "fallen kingdom",
+	"narrative.place.first_age":  "first age",
+	"narrative.place.old_world":  "old world",
+	"narrative.room.combat.0":    "The chamber echoes with the sounds of battle.",
+	"narrative.room.combat.1":    "Danger lurks in every shadow of this arena.",
+	"narrative.room.combat.2":    "Ancient weapons line the walls of this proving ground.",
+	"narrative.room.treasure.0":  "Glittering prizes await those brave enough to claim them.",
+	"narrative.room.treasure.1":  "The air shimmers with the promise of riches.",
+	"narrative.room.treasure.2":  "Valuable artifacts rest on ornate pedestals.",
+	"narrative.room.puzzle.0":    "Strange mechanisms hint at hidden solutions.",
+	"narrative.room.puzzle.1":    "Cryptic symbols cover every surface.",
+	"narrative.room.puzzle.2":    "The room holds secrets waiting to be unraveled.",
+	"narrative.room.unknown":     "A mysterious chamber awaits exploration.",
+}
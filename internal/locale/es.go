@@ -0,0 +1,111 @@
+package locale
+
+// spanish covers the menus and toasts. The menu font is ASCII only, so the
+// text is written without accents. Narrative lore falls back to English.
+var spanish = map[string]string{
+	"menu.title.main":      "VANIA - Metroidvania Procedural",
+	"menu.title.pause":     "Juego en Pausa",
+	"menu.title.settings":  "Opciones",
+	"menu.title.save_load": "Guardar / Cargar",
+	"menu.title.game_over": "Fin del Juego",
+	"menu.title.presets":   "Cargar Plantilla",
+	"menu.title.victory":   "Victoria!",
+	"menu.title.run_stats": "Estadisticas",
+	"menu.title.boss_rush": "Desafio de Jefes Completado",
+	"menu.title.default":   "Menu",
+
+	"menu.new_game_random":    "Nueva Partida (Semilla Aleatoria)",
+	"menu.new_game_seed":      "Nueva Partida (Semilla: %d)",
+	"menu.load_game":          "Cargar Partida",
+	"menu.save_game":          "Guardar Partida",
+	"menu.load_preset":        "Cargar Plantilla",
+	"menu.save_preset":        "Guardar Plantilla del Mundo",
+	"menu.settings":           "Opciones",
+	"menu.resume":             "Continuar",
+	"menu.main_menu":          "Menu Principal",
+	"menu.quit":               "Salir",
+	"menu.quit_game":          "Salir del Juego",
+	"menu.back":               "Volver",
+	"menu.try_again":          "Reintentar",
+	"menu.run_stats":          "Estadisticas",
+	"menu.new_game_plus":      "Continuar a Nueva Partida+",
+	"menu.configure_controls": "Configurar Controles",
+	"menu.reset_defaults":     "Restablecer",
+	"menu.slot":               "Ranura %d",
+	"menu.slot_saved":         "Ranura %d - %dh %dm (Semilla: %d)",
+	"menu.slot_empty":         "Ranura %d - Vacia",
+	"menu.slot_assist":        " [Asistido]",
+	"menu.slot_rewind":        " [Rebobinado]",
+	"menu.boss_rush":          "Desafio de Jefes",
+	"menu.boss_rush_again":    "Repetir los Mismos Jefes",
+	"menu.completion":         "Progreso: %d%%",
+
+	"settings.master_volume":        "Volumen General: %.0f%%",
+	"settings.sfx_volume":           "Volumen de Efectos: %.0f%%",
+	"settings.music_volume":         "Volumen de Musica: %.0f%%",
+	"settings.captions":             "Subtitulos: %v",
+	"settings.fullscreen":           "Pantalla Completa: %v",
+	"settings.show_fps":             "Mostrar FPS: %v",
+	"settings.quality":              "Calidad Grafica: %v",
+	"settings.scaling":              "Escalado: %v",
+	"settings.scaling.pixel":        "Pixel Perfecto",
+	"settings.scaling.smooth":       "Suave",
+	"settings.palette":              "Colores del Jugador: %s",
+	"settings.max_enemies":          "Enemigos Activos Max: %d",
+	"settings.palette.original":     "Original",
+	"settings.palette.variant":      "Variante %d",
+	"settings.enemy_respawn":        "Reaparicion: %s",
+	"settings.respawn.reentry":      "Al Volver",
+	"settings.respawn.never":        "Nunca",
+	"settings.respawn.timed":        "Temporizada",
+	"settings.movement":             "Movimiento: %s",
+	"settings.movement.instant":     "Instantaneo",
+	"settings.movement.accelerated": "Acelerado",
+	"settings.assist_mode":          "Modo Asistido: %v",
+	"settings.rewind_on_death":      "Rebobinar al Morir: %v",
+	"settings.aim_assist":           "Asistencia de Punteria: %s",
+	"settings.aim_assist.off":       "Desactivada",
+	"settings.aim_assist.soft":      "Suave",
+	"settings.aim_assist.strong":    "Fuerte",
+	"settings.tutorial":             "Tutorial: %v",
+	"settings.hitboxes":             "Mostrar Hitboxes: %v",
+	"settings.language":             "Idioma: %s",
+	"settings.hud.health_bar":       "Barra de Vida",
+	"settings.hud.ability_icons":    "Iconos de Habilidad",
+	"settings.hud.minimap":          "Minimapa",
+	"settings.hud.default":          "Predeterminado",
+	"settings.hud.hidden":           "Oculto",
+
+	"controls.move_left":  "Izquierda: %s",
+	"controls.move_right": "Derecha: %s",
+	"controls.jump":       "Saltar: %s",
+	"controls.attack":     "Atacar: %s",
+	"controls.dash":       "Impulso: %s",
+	"controls.ability":    "Usar Habilidad: %s",
+
+	"toast.collected":        "Obtenido: %s",
+	"toast.equipped":         "Equipado: %s",
+	"toast.ability_unlocked": "Habilidad Obtenida: %s",
+	"toast.door_mechanism":   "Sellada por un mecanismo",
+	"toast.door_guardian":    "Derrota al guardian para pasar",
+	"toast.door_hub":         "Llega a esta region a pie para abrir el paso",
+	"toast.rewound":          "Rebobinado",
+	"toast.rested":           "Descansado y guardado",
+	"toast.rested_unsaved":   "Descansado",
+	"prompt.interact":        "[%s] %s",
+	"prompt.rest":            "Descansar",
+	"toast.door_requires":    "Requiere: %s",
+	"toast.door_hint":        "Requiere: %s - el medio para pasar esta en %s",
+	"toast.door_locked":      "La puerta esta cerrada",
+	"toast.door_unlocked":    "Puerta abierta!",
+	"toast.quicksaved":       "Partida guardada",
+	"toast.quickloaded":      "Partida cargada",
+	"toast.quicksave_failed": "No se pudo guardar",
+	"toast.quickload_failed": "No hay partida rapida",
+
+	// Boss rush
+	"hud.boss_rush":            "Jefe %d/%d   %s   Muertes: %d",
+	"results.boss_rush_time":   "Tiempo:  %s",
+	"results.boss_rush_bosses": "Jefes:   %d",
+	"results.boss_rush_deaths": "Muertes: %d",
+}
@@ -0,0 +1,119 @@
+// Package locale resolves UI and narrative text from per-language string
+// tables. Code asks for text by key, with substitution data, and the
+// active language supplies the wording; keys a language lacks fall back to
+// English.
+package locale
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DefaultLanguage is the language every table falls back to
+const DefaultLanguage = "en"
+
+// tables maps a language code to its strings, keyed by ID
+var tables = map[string]map[string]string{
+	"en": english,
+	"es": spanish,
+}
+
+// languageNames are the languages' names in their own language
+var languageNames = map[string]string{
+	"en": "English",
+	"es": "Espanol",
+}
+
+// Message is text that has not been resolved yet: a string table key and
+// the values substituted into it. Args that are themselves Messages are
+// resolved in the same language.
+type Message struct {
+	Key  string
+	Args []interface{}
+}
+
+// NewMessage creates a message for key with substitution data
+func NewMessage(key string, args ...interface{}) Message {
+	return Message{Key: key, Args: args}
+}
+
+// Localizer resolves keys in one language
+type Localizer struct {
+	language string
+}
+
+// NewLocalizer creates a localizer for language, or for English when the
+// language has no table
+func NewLocalizer(language string) *Localizer {
+	l := &Localizer{language: DefaultLanguage}
+	_ = l.SetLanguage(language)
+	return l
+}
+
+// SetLanguage switches the active language
+func (l *Localizer) SetLanguage(language string) error {
+	if _, ok := tables[language]; !ok {
+		return fmt.Errorf("unsupported language %q", language)
+	}
+	l.language = language
+	return nil
+}
+
+// Language returns the active language code
+func (l *Localizer) Language() string {
+	return l.language
+}
+
+// Text resolves key in the active language, falling back to English and
+// then to the key itself. Args are substituted with fmt verbs.
+func (l *Localizer) Text(key string, args ...interface{}) string {
+	format, ok := tables[l.language][key]
+	if !ok {
+		format, ok = english[key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return format
+	}
+
+	resolved := make([]interface{}, len(args))
+	for i, arg := range args {
+		if msg, isMsg := arg.(Message); isMsg {
+			arg = l.Resolve(msg)
+		}
+		resolved[i] = arg
+	}
+	return fmt.Sprintf(format, resolved...)
+}
+
+// Resolve turns a message into text in the active language
+func (l *Localizer) Resolve(msg Message) string {
+	return l.Text(msg.Key, msg.Args...)
+}
+
+// English resolves a message in English, for callers without a localizer
+func English(msg Message) string {
+	return NewLocalizer(DefaultLanguage).Resolve(msg)
+}
+
+// Languages returns the supported language codes, English first
+func Languages() []string {
+	codes := make([]string, 0, len(tables))
+	for code := range tables {
+		if code != DefaultLanguage {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return append([]string{DefaultLanguage}, codes...)
+}
+
+// LanguageName returns a language's name in that language
+func LanguageName(language string) string {
+	if name, ok := languageNames[language]; ok {
+		return name
+	}
+	return language
+}
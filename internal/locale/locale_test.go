@@ -0,0 +1,62 @@
+package locale
+
+import "testing"
+
+func TestSwitchLanguage(t *testing.T) {
+	l := NewLocalizer(DefaultLanguage)
+	if got := l.Text("menu.resume"); got != "Resume" {
+		t.Errorf("English resume = %q", got)
+	}
+
+	if err := l.SetLanguage("es"); err != nil {
+		t.Fatalf("SetLanguage(es) error = %v", err)
+	}
+	if got := l.Text("menu.resume"); got != "Continuar" {
+		t.Errorf("Spanish resume = %q, want Continuar", got)
+	}
+	if got := l.Text("toast.collected", "Llave"); got != "Obtenido: Llave" {
+		t.Errorf("Spanish toast = %q", got)
+	}
+}
+
+func TestMissingKeysFallBack(t *testing.T) {
+	l := NewLocalizer("es")
+
+	// Narrative lore has no Spanish table yet
+	if got := l.Text("narrative.room.unknown"); got != english["narrative.room.unknown"] {
+		t.Errorf("missing Spanish key resolved to %q, want the English text", got)
+	}
+	if got := l.Text("no.such.key"); got != "no.such.key" {
+		t.Errorf("unknown key resolved to %q, want the key itself", got)
+	}
+}
+
+func TestUnsupportedLanguage(t *testing.T) {
+	l := NewLocalizer("xx")
+	if l.Language() != DefaultLanguage {
+		t.Errorf("NewLocalizer(xx) language = %q, want English", l.Language())
+	}
+	if err := l.SetLanguage("xx"); err == nil {
+		t.Error("SetLanguage(xx) should fail")
+	}
+}
+
+func TestNestedMessagesResolveInLanguage(t *testing.T) {
+	msg := NewMessage("toast.door_requires", NewMessage("menu.title.victory"))
+	if got := English(msg); got != "Requires: Victory!" {
+		t.Errorf("English nested = %q", got)
+	}
+	if got := NewLocalizer("es").Resolve(msg); got != "Requiere: Victoria!" {
+		t.Errorf("Spanish nested = %q", got)
+	}
+}
+
+func TestTranslationsHaveEnglishKeys(t *testing.T) {
+	for language, table := range tables {
+		for key := range table {
+			if _, ok := english[key]; !ok {
+				t.Errorf("%s key %q has no English text", language, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package menu
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// ShowBossRushResults displays the end of a finished boss rush: lines holds
+// the results, and seed lets the player race the same bosses again
+func (mm *MenuManager) ShowBossRushResults(lines []string, seed int64) {
+	mm.bossRushResults = lines
+	mm.bossRushSeed = seed
+	mm.currentMenu = BossRushResultsMenu
+	mm.state = MenuStateActive
+	mm.selectedIndex = 0
+	mm.buildBossRushResultsItems()
+}
+
+// buildBossRushResultsItems creates the boss rush results menu items
+func (mm *MenuManager) buildBossRushResultsItems() {
+	mm.items = []*MenuItem{
+		{
+			Text:    mm.text("menu.boss_rush_again"),
+			Enabled: mm.onBossRush != nil,
+			Action: func() error {
+				return mm.onBossRush(mm.bossRushSeed)
+			},
+		},
+		{
+			Text:    mm.text("menu.main_menu"),
+			Enabled: true,
+			Action: func() error {
+				mm.ShowMainMenu()
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("menu.quit"),
+			Enabled: true,
+			Action: func() error {
+				if mm.onQuitGame != nil {
+					return mm.onQuitGame()
+				}
+				return ebiten.Termination
+			},
+		},
+	}
+}
+
+// drawBossRushResults draws the results under the title and returns the y
+// position for the menu items below them
+func (mm *MenuManager) drawBossRushResults(screen *ebiten.Image) int {
+	y := MenuTitleY + MenuItemSpacing
+	for _, line := range mm.bossRushResults {
+		mm.drawColoredText(screen, line, 220, y, mm.textColor)
+		y += RunStatsLineSpacing
+	}
+	return max(y+RunStatsLineSpacing, MenuStartY)
+}
@@ -153,7 +153,7 @@ func (mm *MenuManager) buildControlsMenuItems() {
 
 	mm.items = []*MenuItem{
 		{
-			Text:    fmt.Sprintf("Move Left: %s", getKeyName(controls.KeyBindings[settings.ActionMoveLeft])),
+			Text:    mm.text("controls.move_left", getKeyName(controls.KeyBindings[settings.ActionMoveLeft])),
 			Enabled: true,
 			Action: func() error {
 				mm.controlsMenu.StartRebind(settings.ActionMoveLeft)
@@ -161,7 +161,7 @@ func (mm *MenuManager) buildControlsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("Move Right: %s", getKeyName(controls.KeyBindings[settings.ActionMoveRight])),
+			Text:    mm.text("controls.move_right", getKeyName(controls.KeyBindings[settings.ActionMoveRight])),
 			Enabled: true,
 			Action: func() error {
 				mm.controlsMenu.StartRebind(settings.ActionMoveRight)
@@ -169,7 +169,7 @@ func (mm *MenuManager) buildControlsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("Jump: %s", getKeyName(controls.KeyBindings[settings.ActionJump])),
+			Text:    mm.text("controls.jump", getKeyName(controls.KeyBindings[settings.ActionJump])),
 			Enabled: true,
 			Action: func() error {
 				mm.controlsMenu.StartRebind(settings.ActionJump)
@@ -177,7 +177,7 @@ func (mm *MenuManager) buildControlsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("Attack: %s", getKeyName(controls.KeyBindings[settings.ActionAttack])),
+			Text:    mm.text("controls.attack", getKeyName(controls.KeyBindings[settings.ActionAttack])),
 			Enabled: true,
 			Action: func() error {
 				mm.controlsMenu.StartRebind(settings.ActionAttack)
@@ -185,7 +185,7 @@ func (mm *MenuManager) buildControlsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("Dash: %s", getKeyName(controls.KeyBindings[settings.ActionDash])),
+			Text:    mm.text("controls.dash", getKeyName(controls.KeyBindings[settings.ActionDash])),
 			Enabled: true,
 			Action: func() error {
 				mm.controlsMenu.StartRebind(settings.ActionDash)
@@ -193,7 +193,7 @@ func (mm *MenuManager) buildControlsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("Use Ability: %s", getKeyName(controls.KeyBindings[settings.ActionInteract])),
+			Text:    mm.text("controls.ability", getKeyName(controls.KeyBindings[settings.ActionInteract])),
 			Enabled: true,
 			Action: func() error {
 				mm.controlsMenu.StartRebind(settings.ActionInteract)
@@ -201,7 +201,7 @@ func (mm *MenuManager) buildControlsMenuItems() {
 			},
 		},
 		{
-			Text:    "Reset to Defaults",
+			Text:    mm.text("menu.reset_defaults"),
 			Enabled: true,
 			Action: func() error {
 				// Reset only control settings
@@ -225,7 +225,7 @@ func (mm *MenuManager) buildControlsMenuItems() {
 			},
 		},
 		{
-			Text:    "Back",
+			Text:    mm.text("menu.back"),
 			Enabled: true,
 			Action: func() error {
 				mm.ShowSettingsMenu()
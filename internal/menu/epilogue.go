@@ -0,0 +1,64 @@
+package menu
+
+import (
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	// EpilogueX is the left edge of the epilogue on the victory screen
+	EpilogueX = 80
+
+	// EpilogueLineChars is how many characters fit on an epilogue line
+	EpilogueLineChars = (ScreenWidth - 2*EpilogueX) / CharWidth
+
+	// EpilogueLineSpacing is the vertical gap between epilogue lines; each
+	// paragraph is followed by half a line more
+	EpilogueLineSpacing = 14
+)
+
+// SetEpilogue sets the ending shown on the victory screen, one paragraph
+// per string. Pass nil to show none.
+func (mm *MenuManager) SetEpilogue(paragraphs []string) {
+	mm.epilogue = paragraphs
+}
+
+// wrapText breaks text into lines of at most width characters, splitting
+// at spaces. A word longer than width gets a line of its own.
+func wrapText(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		switch {
+		case line == "":
+			line = word
+		case len(line)+1+len(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// drawEpilogue draws the ending under the title and returns the y position
+// for the menu items below it
+func (mm *MenuManager) drawEpilogue(screen *ebiten.Image) int {
+	if len(mm.epilogue) == 0 {
+		return MenuStartY
+	}
+	y := MenuTitleY + MenuItemSpacing
+	for _, paragraph := range mm.epilogue {
+		for _, line := range wrapText(paragraph, EpilogueLineChars) {
+			mm.drawColoredText(screen, line, EpilogueX, y, mm.textColor)
+			y += EpilogueLineSpacing
+		}
+		y += EpilogueLineSpacing / 2
+	}
+	return max(y+EpilogueLineSpacing, MenuStartY)
+}
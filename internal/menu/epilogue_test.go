@@ -0,0 +1,23 @@
+package menu
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWrapText(t *testing.T) {
+	got := wrapText("the quick brown fox jumps over", 10)
+	want := []string{"the quick", "brown fox", "jumps over"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrapText() = %q, want %q", got, want)
+	}
+
+	long := strings.Repeat("x", 15)
+	if got := wrapText("a "+long+" b", 10); !reflect.DeepEqual(got, []string{"a", long, "b"}) {
+		t.Errorf("wrapText() with a long word = %q", got)
+	}
+	if got := wrapText("   ", 10); len(got) != 0 {
+		t.Errorf("wrapText() of blank text = %q, want none", got)
+	}
+}
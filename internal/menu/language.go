@@ -0,0 +1,38 @@
+package menu
+
+import "github.com/opd-ai/vania/internal/locale"
+
+// text resolves a string key in the menu's language
+func (mm *MenuManager) text(key string, args ...interface{}) string {
+	return mm.loc.Text(key, args...)
+}
+
+// Localizer returns the localizer for the language chosen in the settings,
+// so in-game text can follow the menus
+func (mm *MenuManager) Localizer() *locale.Localizer {
+	return mm.loc
+}
+
+// SetLanguage switches the menus to language and saves the choice. Menus
+// opened afterwards use the new language.
+func (mm *MenuManager) SetLanguage(language string) error {
+	if err := mm.loc.SetLanguage(language); err != nil {
+		return err
+	}
+	gameplay := mm.settingsManager.GetSettings().Gameplay
+	gameplay.Language = language
+	mm.settingsManager.UpdateGameplaySettings(gameplay)
+	return nil
+}
+
+// cycleLanguage switches to the next supported language
+func (mm *MenuManager) cycleLanguage() {
+	languages := locale.Languages()
+	next := languages[0]
+	for i, language := range languages {
+		if language == mm.loc.Language() && i+1 < len(languages) {
+			next = languages[i+1]
+		}
+	}
+	_ = mm.SetLanguage(next)
+}
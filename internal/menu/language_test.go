@@ -0,0 +1,57 @@
+package menu
+
+import "testing"
+
+func TestSwitchingLanguageChangesMenus(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	mm := NewMenuManager()
+	mm.ShowMainMenu()
+	if got := mm.items[0].Text; got != "New Game (Random Seed)" {
+		t.Fatalf("English first item = %q", got)
+	}
+
+	if err := mm.SetLanguage("es"); err != nil {
+		t.Fatalf("SetLanguage(es) error = %v", err)
+	}
+	mm.ShowMainMenu()
+	if got := mm.items[0].Text; got != "Nueva Partida (Semilla Aleatoria)" {
+		t.Errorf("Spanish first item = %q", got)
+	}
+	if got := mm.getMenuTitle(); got != "VANIA - Metroidvania Procedural" {
+		t.Errorf("Spanish title = %q", got)
+	}
+	if mm.GetGameplaySettings().Language != "es" {
+		t.Error("language choice was not saved to the settings")
+	}
+
+	// Hit-stop has no Spanish label yet and falls back to English
+	mm.ShowSettingsMenu()
+	found := false
+	for _, item := range mm.items {
+		if item.Text == "Hit-Stop: true" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("settings menu should fall back to the English hit-stop label")
+	}
+
+	if err := mm.SetLanguage("xx"); err == nil {
+		t.Error("SetLanguage(xx) should fail")
+	}
+}
+
+func TestLanguageSettingCycles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	mm := NewMenuManager()
+	start := mm.Localizer().Language()
+
+	mm.cycleLanguage()
+	if mm.Localizer().Language() == start {
+		t.Fatal("cycleLanguage() kept the same language")
+	}
+	mm.cycleLanguage()
+	if mm.Localizer().Language() != start {
+		t.Errorf("two languages should cycle back to %q, got %q", start, mm.Localizer().Language())
+	}
+}
@@ -4,13 +4,14 @@
 package menu
 
 import (
-	"fmt"
 	"image/color"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	gfx "github.com/opd-ai/vania/internal/graphics"
 	"github.com/opd-ai/vania/internal/input"
+	"github.com/opd-ai/vania/internal/locale"
 	"github.com/opd-ai/vania/internal/render"
 	"github.com/opd-ai/vania/internal/save"
 	settingspkg "github.com/opd-ai/vania/internal/settings"
@@ -41,6 +42,10 @@ const (
 	SettingsMenu
 	SaveLoadMenu
 	GameOverMenu
+	PresetMenu
+	VictoryMenu
+	RunStatsMenu
+	BossRushResultsMenu
 )
 
 // MenuState represents current menu state
@@ -75,11 +80,29 @@ type MenuManager struct {
 	onSettings   func() error
 	onQuitGame   func() error
 	onResumeGame func() error
+	onSaveGame   func() error
+
+	// Boss rush mode
+	onBossRush      func(seed int64) error
+	bossRushResults []string // Lines of the results screen (see boss_rush.go)
+	bossRushSeed    int64
+
+	// Generation presets
+	listPresets    func() ([]string, error)
+	onLoadPreset   func(name string) error
+	onExportPreset func() error
+
+	// End-of-run recap
+	onNewGamePlus  func() error
+	runStats       *RunStats
+	runStatsReturn MenuType
+	epilogue       []string // Ending paragraphs shown on victory
 
 	// Settings
 	settings        *GameSettings
 	settingsManager *settingspkg.SettingsManager
 	controlsMenu    *ControlsMenu
+	loc             *locale.Localizer
 
 	// Visual properties
 	backgroundColor color.Color
@@ -131,6 +154,7 @@ func NewMenuManager() *MenuManager {
 		saveManager:     saveManager,
 		settings:        settings,
 		settingsManager: settingsManager,
+		loc:             locale.NewLocalizer(settingsManager.GetSettings().Gameplay.Language),
 		backgroundColor: color.RGBA{20, 20, 30, 255},
 		textColor:       color.RGBA{200, 200, 200, 255},
 		selectedColor:   color.RGBA{255, 255, 100, 255},
@@ -154,6 +178,31 @@ func (mm *MenuManager) SetCallbacks(
 	mm.onResumeGame = onResumeGame
 }
 
+// SetSaveCallback enables the pause menu's Save Game item, which calls
+// onSaveGame to save the running game (nil disables the item)
+func (mm *MenuManager) SetSaveCallback(onSaveGame func() error) {
+	mm.onSaveGame = onSaveGame
+}
+
+// SetBossRushCallback adds a Boss Rush item to the main menu, which calls
+// onBossRush with a random seed (nil hides the item)
+func (mm *MenuManager) SetBossRushCallback(onBossRush func(seed int64) error) {
+	mm.onBossRush = onBossRush
+}
+
+// SetPresetCallbacks enables generation presets: listPresets names the
+// saved presets, onLoadPreset starts the named one, and onExportPreset
+// saves the current world as a preset (nil hides the pause menu option)
+func (mm *MenuManager) SetPresetCallbacks(
+	listPresets func() ([]string, error),
+	onLoadPreset func(name string) error,
+	onExportPreset func() error,
+) {
+	mm.listPresets = listPresets
+	mm.onLoadPreset = onLoadPreset
+	mm.onExportPreset = onExportPreset
+}
+
 // SetGenre applies genre-themed UI colors to the menu system
 func (mm *MenuManager) SetGenre(genreID string) {
 	mm.currentGenre = genreID
@@ -218,6 +267,14 @@ func (mm *MenuManager) ShowSettingsMenu() {
 	mm.buildSettingsMenuItems()
 }
 
+// ShowPresetMenu displays the list of saved generation presets
+func (mm *MenuManager) ShowPresetMenu() {
+	mm.currentMenu = PresetMenu
+	mm.state = MenuStateActive
+	mm.selectedIndex = 0
+	mm.buildPresetMenuItems()
+}
+
 // ShowGameOverMenu displays the game over menu
 func (mm *MenuManager) ShowGameOverMenu() {
 	mm.currentMenu = GameOverMenu
@@ -288,6 +345,14 @@ func (mm *MenuManager) Draw(screen *ebiten.Image) {
 
 	// Draw menu items with visual feedback
 	startY := MenuStartY
+	switch mm.currentMenu {
+	case RunStatsMenu:
+		startY = mm.drawRunStats(screen)
+	case VictoryMenu:
+		startY = mm.drawEpilogue(screen)
+	case BossRushResultsMenu:
+		startY = mm.drawBossRushResults(screen)
+	}
 	for i, item := range mm.items {
 		y := startY + i*MenuItemSpacing
 
@@ -410,17 +475,25 @@ func (mm *MenuManager) drawChar(screen *ebiten.Image, char rune, x, y, width, he
 func (mm *MenuManager) getMenuTitle() string {
 	switch mm.currentMenu {
 	case MainMenu:
-		return "VANIA - Procedural Metroidvania"
+		return mm.text("menu.title.main")
 	case PauseMenu:
-		return "Game Paused"
+		return mm.text("menu.title.pause")
 	case SettingsMenu:
-		return "Settings"
+		return mm.text("menu.title.settings")
 	case SaveLoadMenu:
-		return "Save / Load"
+		return mm.text("menu.title.save_load")
 	case GameOverMenu:
-		return "Game Over"
+		return mm.text("menu.title.game_over")
+	case PresetMenu:
+		return mm.text("menu.title.presets")
+	case VictoryMenu:
+		return mm.text("menu.title.victory")
+	case RunStatsMenu:
+		return mm.text("menu.title.run_stats")
+	case BossRushResultsMenu:
+		return mm.text("menu.title.boss_rush")
 	default:
-		return "Menu"
+		return mm.text("menu.title.default")
 	}
 }
 
@@ -428,7 +501,7 @@ func (mm *MenuManager) getMenuTitle() string {
 func (mm *MenuManager) buildMainMenuItems() {
 	mm.items = []*MenuItem{
 		{
-			Text:    "New Game (Random Seed)",
+			Text:    mm.text("menu.new_game_random"),
 			Enabled: true,
 			Action: func() error {
 				if mm.onNewGame != nil {
@@ -438,7 +511,7 @@ func (mm *MenuManager) buildMainMenuItems() {
 			},
 		},
 		{
-			Text:    "New Game (Seed: 42)",
+			Text:    mm.text("menu.new_game_seed", 42),
 			Enabled: true,
 			Action: func() error {
 				if mm.onNewGame != nil {
@@ -448,7 +521,7 @@ func (mm *MenuManager) buildMainMenuItems() {
 			},
 		},
 		{
-			Text:    "Load Game",
+			Text:    mm.text("menu.load_game"),
 			Enabled: mm.saveManager != nil && mm.hasSaveFiles(),
 			Action: func() error {
 				mm.ShowSaveLoadMenu()
@@ -456,7 +529,7 @@ func (mm *MenuManager) buildMainMenuItems() {
 			},
 		},
 		{
-			Text:    "Settings",
+			Text:    mm.text("menu.settings"),
 			Enabled: true,
 			Action: func() error {
 				mm.ShowSettingsMenu()
@@ -464,7 +537,7 @@ func (mm *MenuManager) buildMainMenuItems() {
 			},
 		},
 		{
-			Text:    "Quit",
+			Text:    mm.text("menu.quit"),
 			Enabled: true,
 			Action: func() error {
 				if mm.onQuitGame != nil {
@@ -474,13 +547,79 @@ func (mm *MenuManager) buildMainMenuItems() {
 			},
 		},
 	}
+
+	// Offer boss rush before Settings when it is available
+	if mm.onBossRush != nil {
+		rushItem := &MenuItem{
+			Text:    mm.text("menu.boss_rush"),
+			Enabled: true,
+			Action: func() error {
+				return mm.onBossRush(0) // 0 = random seed
+			},
+		}
+		mm.items = append(mm.items[:3], append([]*MenuItem{rushItem}, mm.items[3:]...)...)
+	}
+
+	// Offer presets only once some have been saved
+	if len(mm.presetNames()) > 0 {
+		presetItem := &MenuItem{
+			Text:    mm.text("menu.load_preset"),
+			Enabled: true,
+			Action: func() error {
+				mm.ShowPresetMenu()
+				return nil
+			},
+		}
+		// Place it after Load Game
+		mm.items = append(mm.items[:3], append([]*MenuItem{presetItem}, mm.items[3:]...)...)
+	}
+}
+
+// presetNames returns the saved preset names, or none if presets are
+// unavailable
+func (mm *MenuManager) presetNames() []string {
+	if mm.listPresets == nil {
+		return nil
+	}
+	names, err := mm.listPresets()
+	if err != nil {
+		return nil
+	}
+	return names
+}
+
+// buildPresetMenuItems creates one item per saved preset
+func (mm *MenuManager) buildPresetMenuItems() {
+	mm.items = make([]*MenuItem, 0)
+
+	for _, name := range mm.presetNames() {
+		presetName := name // Capture for closure
+		mm.items = append(mm.items, &MenuItem{
+			Text:    presetName,
+			Enabled: true,
+			Action: func() error {
+				if mm.onLoadPreset != nil {
+					return mm.onLoadPreset(presetName)
+				}
+				return nil
+			},
+		})
+	}
+
+	mm.items = append(mm.items, &MenuItem{
+		Text:    mm.text("menu.back"),
+		Enabled: true,
+		Action: func() error {
+			return mm.handleBack()
+		},
+	})
 }
 
 // buildPauseMenuItems creates pause menu items
 func (mm *MenuManager) buildPauseMenuItems() {
 	mm.items = []*MenuItem{
 		{
-			Text:    "Resume",
+			Text:    mm.text("menu.resume"),
 			Enabled: true,
 			Action: func() error {
 				if mm.onResumeGame != nil {
@@ -491,19 +630,22 @@ func (mm *MenuManager) buildPauseMenuItems() {
 			},
 		},
 		{
-			Text:    "Save Game",
-			Enabled: mm.saveManager != nil,
+			Text:    mm.text("menu.save_game"),
+			Enabled: mm.onSaveGame != nil,
 			Action: func() error {
-				// Default to slot 0 for quick save
-				if mm.saveManager != nil {
-					// Would need game state - for now just hide menu
-					mm.Hide()
+				// Save to the quicksave slot, then return to the game
+				if err := mm.onSaveGame(); err != nil {
+					return err
 				}
+				if mm.onResumeGame != nil {
+					return mm.onResumeGame()
+				}
+				mm.Hide()
 				return nil
 			},
 		},
 		{
-			Text:    "Load Game",
+			Text:    mm.text("menu.load_game"),
 			Enabled: mm.saveManager != nil && mm.hasSaveFiles(),
 			Action: func() error {
 				mm.ShowSaveLoadMenu()
@@ -511,7 +653,17 @@ func (mm *MenuManager) buildPauseMenuItems() {
 			},
 		},
 		{
-			Text:    "Settings",
+			Text:    mm.text("menu.save_preset"),
+			Enabled: mm.onExportPreset != nil,
+			Action: func() error {
+				if mm.onExportPreset != nil {
+					return mm.onExportPreset()
+				}
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("menu.settings"),
 			Enabled: true,
 			Action: func() error {
 				mm.ShowSettingsMenu()
@@ -519,7 +671,7 @@ func (mm *MenuManager) buildPauseMenuItems() {
 			},
 		},
 		{
-			Text:    "Main Menu",
+			Text:    mm.text("menu.main_menu"),
 			Enabled: true,
 			Action: func() error {
 				mm.ShowMainMenu()
@@ -527,7 +679,7 @@ func (mm *MenuManager) buildPauseMenuItems() {
 			},
 		},
 		{
-			Text:    "Quit Game",
+			Text:    mm.text("menu.quit_game"),
 			Enabled: true,
 			Action: func() error {
 				if mm.onQuitGame != nil {
@@ -539,11 +691,123 @@ func (mm *MenuManager) buildPauseMenuItems() {
 	}
 }
 
+// nextRespawnMode cycles the enemy respawn setting
+var nextRespawnMode = map[string]string{
+	"reentry": "never",
+	"never":   "timed",
+	"timed":   "reentry",
+}
+
+// nextAimAssist cycles the aim assist strength setting
+var nextAimAssist = map[string]string{
+	"off":    "soft",
+	"soft":   "strong",
+	"strong": "off",
+}
+
+// movementLabel returns the string key naming the movement feel setting
+func movementLabel(instant bool) string {
+	if instant {
+		return "settings.movement.instant"
+	}
+	return "settings.movement.accelerated"
+}
+
+// scalingLabel returns the string key naming the scaling filter setting
+func scalingLabel(smooth bool) string {
+	if smooth {
+		return "settings.scaling.smooth"
+	}
+	return "settings.scaling.pixel"
+}
+
+// paletteLabel names a player palette variant for the settings menu
+func (mm *MenuManager) paletteLabel(variant int) string {
+	if variant == 0 {
+		return mm.text("settings.palette.original")
+	}
+	return mm.text("settings.palette.variant", variant)
+}
+
+// maxEnemiesCycle is the order the active-enemy cap setting cycles through
+var maxEnemiesCycle = []int{4, 8, 12, 16, 24}
+
+// nextMaxEnemies returns the active-enemy cap after current in the cycle;
+// a value off the cycle moves to its first step
+func nextMaxEnemies(current int) int {
+	for i, limit := range maxEnemiesCycle {
+		if limit == current {
+			return maxEnemiesCycle[(i+1)%len(maxEnemiesCycle)]
+		}
+	}
+	return maxEnemiesCycle[0]
+}
+
+// hudAnchorCycle is the order a HUD element's setting cycles through, the
+// empty anchor being the element's usual corner. Hidden follows the last.
+var hudAnchorCycle = []string{"", "top-left", "top-right", "bottom-left", "bottom-right"}
+
+// hudMenuItem builds a settings item that cycles one HUD element through
+// each corner and then hides it. nameKey is the string key of the
+// element's name, and field picks its hide flag and anchor out of the HUD
+// settings.
+func (mm *MenuManager) hudMenuItem(nameKey string, field func(*settingspkg.HUDSettings) (*bool, *string)) *MenuItem {
+	graphics := mm.settingsManager.GetSettings().Graphics
+	hidden, anchor := field(&graphics.HUD)
+	labelKey := "settings.hud." + *anchor
+	switch {
+	case *hidden:
+		labelKey = "settings.hud.hidden"
+	case *anchor == "":
+		labelKey = "settings.hud.default"
+	}
+
+	return &MenuItem{
+		Text:    mm.text("settings.hud", mm.text(nameKey), mm.text(labelKey)),
+		Enabled: true,
+		Action: func() error {
+			graphics := mm.settingsManager.GetSettings().Graphics
+			hidden, anchor := field(&graphics.HUD)
+			switch {
+			case *hidden:
+				*hidden, *anchor = false, hudAnchorCycle[0]
+			case *anchor == hudAnchorCycle[len(hudAnchorCycle)-1]:
+				*hidden = true
+			default:
+				for i, a := range hudAnchorCycle {
+					if a == *anchor {
+						*anchor = hudAnchorCycle[i+1]
+						break
+					}
+				}
+			}
+			mm.settingsManager.UpdateGraphicsSettings(graphics)
+			mm.buildSettingsMenuItems() // Rebuild to update display
+			return nil
+		},
+	}
+}
+
+// GetGameplaySettings returns the persisted gameplay settings
+func (mm *MenuManager) GetGameplaySettings() settingspkg.GameplaySettings {
+	return mm.settingsManager.GetSettings().Gameplay
+}
+
+// GetAudioSettings returns the persisted audio settings
+func (mm *MenuManager) GetAudioSettings() settingspkg.AudioSettings {
+	return mm.settingsManager.GetSettings().Audio
+}
+
+// GetGraphicsSettings returns the persisted graphics settings
+func (mm *MenuManager) GetGraphicsSettings() settingspkg.GraphicsSettings {
+	return mm.settingsManager.GetSettings().Graphics
+}
+
 // buildSettingsMenuItems creates settings menu items
 func (mm *MenuManager) buildSettingsMenuItems() {
 	mm.items = []*MenuItem{
 		{
-			Text:    fmt.Sprintf("Master Volume: %.0f%%", mm.settings.MasterVolume*100),
+			Text:    mm.text("settings.master_volume", mm.settings.MasterVolume*100),
 			Enabled: true,
 			Action: func() error {
 				mm.settings.MasterVolume += 0.1
@@ -555,7 +819,7 @@ func (mm *MenuManager) buildSettingsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("SFX Volume: %.0f%%", mm.settings.SFXVolume*100),
+			Text:    mm.text("settings.sfx_volume", mm.settings.SFXVolume*100),
 			Enabled: true,
 			Action: func() error {
 				mm.settings.SFXVolume += 0.1
@@ -567,7 +831,7 @@ func (mm *MenuManager) buildSettingsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("Music Volume: %.0f%%", mm.settings.MusicVolume*100),
+			Text:    mm.text("settings.music_volume", mm.settings.MusicVolume*100),
 			Enabled: true,
 			Action: func() error {
 				mm.settings.MusicVolume += 0.1
@@ -579,7 +843,18 @@ func (mm *MenuManager) buildSettingsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("Fullscreen: %v", mm.settings.FullScreen),
+			Text:    mm.text("settings.captions", mm.settingsManager.GetSettings().Audio.Captions),
+			Enabled: true,
+			Action: func() error {
+				audio := mm.settingsManager.GetSettings().Audio
+				audio.Captions = !audio.Captions
+				mm.settingsManager.UpdateAudioSettings(audio)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.fullscreen", mm.settings.FullScreen),
 			Enabled: true,
 			Action: func() error {
 				mm.settings.FullScreen = !mm.settings.FullScreen
@@ -593,7 +868,51 @@ func (mm *MenuManager) buildSettingsMenuItems() {
 			},
 		},
 		{
-			Text:    fmt.Sprintf("Show FPS: %v", mm.settings.ShowFPS),
+			Text:    mm.text("settings.quality", mm.settingsManager.GetSettings().Graphics.Quality),
+			Enabled: true,
+			Action: func() error {
+				graphics := mm.settingsManager.GetSettings().Graphics
+				graphics.Quality = (graphics.Quality + 1) % (settingspkg.QualityUltra + 1)
+				mm.settingsManager.UpdateGraphicsSettings(graphics)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.scaling", mm.text(scalingLabel(mm.settingsManager.GetSettings().Graphics.SmoothScaling))),
+			Enabled: true,
+			Action: func() error {
+				graphics := mm.settingsManager.GetSettings().Graphics
+				graphics.SmoothScaling = !graphics.SmoothScaling
+				mm.settingsManager.UpdateGraphicsSettings(graphics)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.palette", mm.paletteLabel(mm.settingsManager.GetSettings().Graphics.PlayerPalette)),
+			Enabled: true,
+			Action: func() error {
+				graphics := mm.settingsManager.GetSettings().Graphics
+				graphics.PlayerPalette = (graphics.PlayerPalette + 1) % gfx.PaletteSwapVariants
+				mm.settingsManager.UpdateGraphicsSettings(graphics)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.max_enemies", mm.settingsManager.GetSettings().Graphics.MaxEnemies),
+			Enabled: true,
+			Action: func() error {
+				graphics := mm.settingsManager.GetSettings().Graphics
+				graphics.MaxEnemies = nextMaxEnemies(graphics.MaxEnemies)
+				mm.settingsManager.UpdateGraphicsSettings(graphics)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.show_fps", mm.settings.ShowFPS),
 			Enabled: true,
 			Action: func() error {
 				mm.settings.ShowFPS = !mm.settings.ShowFPS
@@ -602,7 +921,138 @@ func (mm *MenuManager) buildSettingsMenuItems() {
 			},
 		},
 		{
-			Text:    "Configure Controls",
+			Text:    mm.text("settings.enemy_respawn", mm.text("settings.respawn."+mm.settingsManager.GetSettings().Gameplay.EnemyRespawn)),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.EnemyRespawn = nextRespawnMode[gameplay.EnemyRespawn]
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.movement", mm.text(movementLabel(mm.settingsManager.GetSettings().Gameplay.InstantMovement))),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.InstantMovement = !gameplay.InstantMovement
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.hit_stop", !mm.settingsManager.GetSettings().Gameplay.DisableHitStop),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.DisableHitStop = !gameplay.DisableHitStop
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.assist_mode", mm.settingsManager.GetSettings().Gameplay.AssistMode),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.AssistMode = !gameplay.AssistMode
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.rewind_on_death", mm.settingsManager.GetSettings().Gameplay.RewindOnDeath),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.RewindOnDeath = !gameplay.RewindOnDeath
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.aim_assist", mm.text("settings.aim_assist."+mm.settingsManager.GetSettings().Gameplay.AimAssist)),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.AimAssist = nextAimAssist[gameplay.AimAssist]
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.hitboxes", mm.settingsManager.GetSettings().Gameplay.ShowHitboxes),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.ShowHitboxes = !gameplay.ShowHitboxes
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.language", locale.LanguageName(mm.loc.Language())),
+			Enabled: true,
+			Action: func() error {
+				mm.cycleLanguage()
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.tutorial", !mm.settingsManager.GetSettings().Gameplay.SkipTutorial),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.SkipTutorial = !gameplay.SkipTutorial
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.gore", !mm.settingsManager.GetSettings().Gameplay.DisableGore),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.DisableGore = !gameplay.DisableGore
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("settings.auto_run", mm.settingsManager.GetSettings().Gameplay.AutoRun),
+			Enabled: true,
+			Action: func() error {
+				gameplay := mm.settingsManager.GetSettings().Gameplay
+				gameplay.AutoRun = !gameplay.AutoRun
+				mm.settingsManager.UpdateGameplaySettings(gameplay)
+				mm.buildSettingsMenuItems() // Rebuild to update display
+				return nil
+			},
+		},
+		mm.hudMenuItem("settings.hud.health_bar", func(h *settingspkg.HUDSettings) (*bool, *string) {
+			return &h.HideHealthBar, &h.HealthBarAnchor
+		}),
+		mm.hudMenuItem("settings.hud.ability_icons", func(h *settingspkg.HUDSettings) (*bool, *string) {
+			return &h.HideAbilityIcons, &h.AbilityIconsAnchor
+		}),
+		mm.hudMenuItem("settings.hud.debug_info", func(h *settingspkg.HUDSettings) (*bool, *string) {
+			return &h.HideDebugInfo, &h.DebugInfoAnchor
+		}),
+		mm.hudMenuItem("settings.hud.minimap", func(h *settingspkg.HUDSettings) (*bool, *string) {
+			return &h.HideMinimap, &h.MinimapAnchor
+		}),
+		{
+			Text:    mm.text("menu.configure_controls"),
 			Enabled: true,
 			Action: func() error {
 				if mm.controlsMenu == nil {
@@ -613,7 +1063,7 @@ func (mm *MenuManager) buildSettingsMenuItems() {
 			},
 		},
 		{
-			Text:    "Back",
+			Text:    mm.text("menu.back"),
 			Enabled: true,
 			Action: func() error {
 				return mm.handleBack()
@@ -626,7 +1076,7 @@ func (mm *MenuManager) buildSettingsMenuItems() {
 func (mm *MenuManager) buildGameOverMenuItems() {
 	mm.items = []*MenuItem{
 		{
-			Text:    "Try Again",
+			Text:    mm.text("menu.try_again"),
 			Enabled: true,
 			Action: func() error {
 				if mm.onNewGame != nil {
@@ -635,8 +1085,9 @@ func (mm *MenuManager) buildGameOverMenuItems() {
 				return nil
 			},
 		},
+		mm.runStatsMenuItem(),
 		{
-			Text:    "Load Game",
+			Text:    mm.text("menu.load_game"),
 			Enabled: mm.saveManager != nil && mm.hasSaveFiles(),
 			Action: func() error {
 				mm.ShowSaveLoadMenu()
@@ -644,7 +1095,7 @@ func (mm *MenuManager) buildGameOverMenuItems() {
 			},
 		},
 		{
-			Text:    "Main Menu",
+			Text:    mm.text("menu.main_menu"),
 			Enabled: true,
 			Action: func() error {
 				mm.ShowMainMenu()
@@ -652,7 +1103,7 @@ func (mm *MenuManager) buildGameOverMenuItems() {
 			},
 		},
 		{
-			Text:    "Quit",
+			Text:    mm.text("menu.quit"),
 			Enabled: true,
 			Action: func() error {
 				if mm.onQuitGame != nil {
@@ -678,15 +1129,21 @@ func (mm *MenuManager) buildSaveLoadMenuItems() {
 
 	// Add save slots
 	for i := 0; i < 5; i++ {
-		slotText := fmt.Sprintf("Slot %d", i+1)
+		slotText := mm.text("menu.slot", i+1)
 		if mm.saveManager != nil {
 			if saveData, err := mm.saveManager.LoadGame(i); err == nil {
 				// Format play time
 				hours := saveData.PlayTime / 3600
 				minutes := (saveData.PlayTime % 3600) / 60
-				slotText = fmt.Sprintf("Slot %d - %dh %dm (Seed: %d)", i+1, hours, minutes, saveData.Seed)
+				slotText = mm.text("menu.slot_saved", i+1, hours, minutes, saveData.Seed)
+				if saveData.AssistMode {
+					slotText += mm.text("menu.slot_assist")
+				}
+				if saveData.RewindUsed {
+					slotText += mm.text("menu.slot_rewind")
+				}
 			} else {
-				slotText = fmt.Sprintf("Slot %d - Empty", i+1)
+				slotText = mm.text("menu.slot_empty", i+1)
 			}
 		}
 
@@ -705,7 +1162,7 @@ func (mm *MenuManager) buildSaveLoadMenuItems() {
 
 	// Add back option
 	mm.items = append(mm.items, &MenuItem{
-		Text:    "Back",
+		Text:    mm.text("menu.back"),
 		Enabled: true,
 		Action: func() error {
 			return mm.handleBack()
@@ -773,12 +1230,18 @@ func (mm *MenuManager) handleBack() error {
 			return mm.onResumeGame()
 		}
 		mm.Hide()
-	case SettingsMenu, SaveLoadMenu:
+	case SettingsMenu, SaveLoadMenu, PresetMenu:
 		// Go back to previous menu
 		mm.ShowMainMenu()
-	case GameOverMenu:
-		// Go to main menu from game over
+	case GameOverMenu, VictoryMenu, BossRushResultsMenu:
+		// Go to main menu from the end of a run
 		mm.ShowMainMenu()
+	case RunStatsMenu:
+		if mm.runStatsReturn == VictoryMenu {
+			mm.ShowVictoryMenu()
+		} else {
+			mm.ShowGameOverMenu()
+		}
 	}
 	return nil
 }
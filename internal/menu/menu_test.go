@@ -259,3 +259,104 @@ func TestKeyBindings(t *testing.T) {
 		t.Error("Jump binding should have at least one key")
 	}
 }
+
+func TestPresetMenu(t *testing.T) {
+	mm := NewMenuManager()
+	mm.ShowMainMenu()
+	for _, item := range mm.items {
+		if item.Text == "Load Preset" {
+			t.Error("Load Preset should be hidden when no presets exist")
+		}
+	}
+
+	var loaded string
+	mm.SetPresetCallbacks(
+		func() ([]string, error) { return []string{"castle", "station"}, nil },
+		func(name string) error { loaded = name; return nil },
+		nil,
+	)
+	mm.ShowMainMenu()
+
+	var presetItem *MenuItem
+	for _, item := range mm.items {
+		if item.Text == "Load Preset" {
+			presetItem = item
+		}
+	}
+	if presetItem == nil {
+		t.Fatal("Main menu should offer Load Preset once presets exist")
+	}
+	if err := presetItem.Action(); err != nil {
+		t.Fatalf("Load Preset action failed: %v", err)
+	}
+	if mm.currentMenu != PresetMenu {
+		t.Fatal("Should be showing preset menu")
+	}
+
+	// One item per preset plus Back
+	if len(mm.items) != 3 {
+		t.Fatalf("Expected 3 preset menu items, got %d", len(mm.items))
+	}
+	if err := mm.items[1].Action(); err != nil {
+		t.Fatalf("Preset item action failed: %v", err)
+	}
+	if loaded != "station" {
+		t.Errorf("Expected preset 'station' to load, got %q", loaded)
+	}
+
+	if err := mm.items[2].Action(); err != nil {
+		t.Fatalf("Back action failed: %v", err)
+	}
+	if mm.currentMenu != MainMenu {
+		t.Error("Back should return to the main menu")
+	}
+}
+
+func TestBossRushMenuItem(t *testing.T) {
+	mm := NewMenuManager()
+	mm.ShowMainMenu()
+	for _, item := range mm.items {
+		if item.Text == "Boss Rush" {
+			t.Error("Boss Rush should be hidden without a callback")
+		}
+	}
+
+	started := false
+	mm.SetBossRushCallback(func(seed int64) error { started = true; return nil })
+	mm.ShowMainMenu()
+	for _, item := range mm.items {
+		if item.Text == "Boss Rush" {
+			if err := item.Action(); err != nil {
+				t.Fatalf("Boss Rush action error = %v", err)
+			}
+		}
+	}
+	if !started {
+		t.Error("selecting Boss Rush did not start a rush")
+	}
+}
+
+func TestBossRushResultsMenu(t *testing.T) {
+	mm := NewMenuManager()
+	var seeds []int64
+	mm.SetBossRushCallback(func(seed int64) error { seeds = append(seeds, seed); return nil })
+	mm.ShowBossRushResults([]string{"Time:    3:05.50", "Bosses:  4", "Deaths:  2"}, 99)
+
+	if mm.GetCurrentMenu() != BossRushResultsMenu {
+		t.Fatalf("current menu = %v, want the boss rush results", mm.GetCurrentMenu())
+	}
+	if title := mm.getMenuTitle(); title != "Boss Rush Complete" {
+		t.Errorf("title = %q, want the boss rush results title", title)
+	}
+	for _, item := range mm.items {
+		if item.Text == "Continue to New Game+" {
+			t.Error("boss rush results offer New Game Plus")
+		}
+	}
+	if err := mm.items[0].Action(); err != nil {
+		t.Fatalf("race again action error = %v", err)
+	}
+	if len(seeds) != 1 || seeds[0] != 99 {
+		t.Errorf("race again started seeds %v, want the same seed 99", seeds)
+	}
+}
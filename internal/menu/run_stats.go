@@ -0,0 +1,175 @@
+package menu
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/achievement"
+)
+
+const (
+	// RunStatsLineSpacing is the vertical gap between recap lines, tighter
+	// than MenuItemSpacing so the whole recap fits above the Back item
+	RunStatsLineSpacing = 20
+
+	// MaxRunStatsAchievements caps how many earned achievements are listed
+	// by name; the rest are summarised as a count
+	MaxRunStatsAchievements = 6
+)
+
+// RunStats is the recap shown on the run statistics screen
+type RunStats struct {
+	Stats        achievement.Statistics
+	Achievements []string // Names of achievements earned this run
+}
+
+// NewRunStats captures tracker's statistics and the names of every
+// achievement it has unlocked. The tracker only stores play time when the
+// game is saved, so the caller passes the live value in playTime.
+func NewRunStats(tracker *achievement.AchievementTracker, playTime time.Duration) RunStats {
+	rs := RunStats{Stats: tracker.GetStatistics()}
+	rs.Stats.PlayTime = int64(playTime.Seconds())
+
+	for _, unlocked := range tracker.GetUnlockedAchievements() {
+		if a := tracker.GetAchievement(unlocked.AchievementID); a != nil {
+			rs.Achievements = append(rs.Achievements, a.Name)
+		}
+	}
+	sort.Strings(rs.Achievements)
+	return rs
+}
+
+// Lines formats the recap one statistic per line
+func (rs RunStats) Lines() []string {
+	s := rs.Stats
+	hours := s.PlayTime / 3600
+	minutes := (s.PlayTime % 3600) / 60
+	seconds := s.PlayTime % 60
+
+	lines := []string{
+		fmt.Sprintf("Enemies Defeated: %d", s.EnemiesDefeated),
+		fmt.Sprintf("Bosses Defeated:  %d", s.BossesDefeated),
+		fmt.Sprintf("Rooms Visited:    %d", s.RoomsVisited),
+		fmt.Sprintf("Items Collected:  %d", s.ItemsCollected),
+		fmt.Sprintf("Damage Dealt:     %d", s.TotalDamageDealt),
+		fmt.Sprintf("Damage Taken:     %d", s.DamageTaken),
+		fmt.Sprintf("Deaths:           %d", s.DeathCount),
+		fmt.Sprintf("Play Time:        %dh %02dm %02ds", hours, minutes, seconds),
+		fmt.Sprintf("Longest Combo:    %d", s.LongestCombo),
+	}
+
+	if len(rs.Achievements) == 0 {
+		return append(lines, "Achievements: None")
+	}
+	lines = append(lines, fmt.Sprintf("Achievements (%d):", len(rs.Achievements)))
+	shown := rs.Achievements
+	if len(shown) > MaxRunStatsAchievements {
+		shown = shown[:MaxRunStatsAchievements]
+	}
+	for _, name := range shown {
+		lines = append(lines, "  "+name)
+	}
+	if extra := len(rs.Achievements) - len(shown); extra > 0 {
+		lines = append(lines, fmt.Sprintf("  ...and %d more", extra))
+	}
+	return lines
+}
+
+// SetRunStats stores the recap for the game-over and victory menus. Pass
+// nil when no run is in progress to hide their Run Statistics item.
+func (mm *MenuManager) SetRunStats(stats *RunStats) {
+	mm.runStats = stats
+}
+
+// SetVictoryCallback sets the action for the victory menu's New Game+ item
+func (mm *MenuManager) SetVictoryCallback(onNewGamePlus func() error) {
+	mm.onNewGamePlus = onNewGamePlus
+}
+
+// ShowVictoryMenu displays the menu shown once every boss is defeated
+func (mm *MenuManager) ShowVictoryMenu() {
+	mm.currentMenu = VictoryMenu
+	mm.state = MenuStateActive
+	mm.selectedIndex = 0
+	mm.buildVictoryMenuItems()
+}
+
+// ShowRunStatsMenu displays the run recap. Back returns to whichever menu
+// opened it.
+func (mm *MenuManager) ShowRunStatsMenu() {
+	if mm.currentMenu != RunStatsMenu {
+		mm.runStatsReturn = mm.currentMenu
+	}
+	mm.currentMenu = RunStatsMenu
+	mm.state = MenuStateActive
+	mm.selectedIndex = 0
+	mm.items = []*MenuItem{
+		{
+			Text:    mm.text("menu.back"),
+			Enabled: true,
+			Action: func() error {
+				return mm.handleBack()
+			},
+		},
+	}
+}
+
+// runStatsMenuItem opens the recap, disabled when none has been set
+func (mm *MenuManager) runStatsMenuItem() *MenuItem {
+	return &MenuItem{
+		Text:    mm.text("menu.run_stats"),
+		Enabled: mm.runStats != nil,
+		Action: func() error {
+			mm.ShowRunStatsMenu()
+			return nil
+		},
+	}
+}
+
+// buildVictoryMenuItems creates victory menu items
+func (mm *MenuManager) buildVictoryMenuItems() {
+	mm.items = []*MenuItem{
+		{
+			Text:    mm.text("menu.new_game_plus"),
+			Enabled: mm.onNewGamePlus != nil,
+			Action: func() error {
+				return mm.onNewGamePlus()
+			},
+		},
+		mm.runStatsMenuItem(),
+		{
+			Text:    mm.text("menu.main_menu"),
+			Enabled: true,
+			Action: func() error {
+				mm.ShowMainMenu()
+				return nil
+			},
+		},
+		{
+			Text:    mm.text("menu.quit"),
+			Enabled: true,
+			Action: func() error {
+				if mm.onQuitGame != nil {
+					return mm.onQuitGame()
+				}
+				return ebiten.Termination
+			},
+		},
+	}
+}
+
+// drawRunStats draws the recap under the title and returns the y position
+// for the menu items below it
+func (mm *MenuManager) drawRunStats(screen *ebiten.Image) int {
+	if mm.runStats == nil {
+		return MenuStartY
+	}
+	y := MenuTitleY + MenuItemSpacing
+	for _, line := range mm.runStats.Lines() {
+		mm.drawColoredText(screen, line, 220, y, mm.textColor)
+		y += RunStatsLineSpacing
+	}
+	return y + RunStatsLineSpacing
+}
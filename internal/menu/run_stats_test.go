@@ -0,0 +1,87 @@
+package menu
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opd-ai/vania/internal/achievement"
+)
+
+func TestNewRunStatsReadsTracker(t *testing.T) {
+	tracker := achievement.NewAchievementTracker()
+	tracker.RecordEnemyKill(false)
+	tracker.RecordEnemyKill(false)
+	tracker.RecordBossKill(120, false)
+	tracker.RecordRoomVisit(false)
+	tracker.RecordItemCollected()
+	tracker.RecordDamage(45, 12)
+	tracker.RecordDeath()
+	tracker.RecordCombo(7)
+
+	rs := NewRunStats(tracker, 3723*time.Second)
+	stats := tracker.GetStatistics()
+
+	if rs.Stats.EnemiesDefeated != stats.EnemiesDefeated ||
+		rs.Stats.BossesDefeated != stats.BossesDefeated ||
+		rs.Stats.TotalDamageDealt != 45 || rs.Stats.DamageTaken != 12 ||
+		rs.Stats.DeathCount != 1 || rs.Stats.LongestCombo != 7 {
+		t.Errorf("run stats %+v do not match tracker %+v", rs.Stats, stats)
+	}
+	if rs.Stats.PlayTime != 3723 {
+		t.Errorf("PlayTime = %d, want 3723", rs.Stats.PlayTime)
+	}
+
+	want := map[string]bool{}
+	for _, u := range tracker.GetUnlockedAchievements() {
+		want[tracker.GetAchievement(u.AchievementID).Name] = true
+	}
+	if len(rs.Achievements) != len(want) || len(want) == 0 {
+		t.Fatalf("achievements = %v, want %v", rs.Achievements, want)
+	}
+	for _, name := range rs.Achievements {
+		if !want[name] {
+			t.Errorf("unexpected achievement %q", name)
+		}
+	}
+
+	lines := rs.Lines()
+	expected := []string{
+		"Enemies Defeated: 2",
+		"Bosses Defeated:  1",
+		"Rooms Visited:    1",
+		"Items Collected:  1",
+		"Damage Dealt:     45",
+		"Damage Taken:     12",
+		"Deaths:           1",
+		"Play Time:        1h 02m 03s",
+		"Longest Combo:    7",
+	}
+	for i, line := range expected {
+		if lines[i] != line {
+			t.Errorf("line %d = %q, want %q", i, lines[i], line)
+		}
+	}
+}
+
+func TestRunStatsMenuReturnsToOpener(t *testing.T) {
+	mm := NewMenuManager()
+	mm.ShowGameOverMenu()
+	if item := mm.items[1]; item.Text != "Run Statistics" || item.Enabled {
+		t.Fatalf("expected disabled Run Statistics item without stats, got %+v", item)
+	}
+
+	mm.SetRunStats(&RunStats{})
+	mm.ShowVictoryMenu()
+	if err := mm.runStatsMenuItem().Action(); err != nil {
+		t.Fatal(err)
+	}
+	if mm.currentMenu != RunStatsMenu {
+		t.Fatalf("current menu = %v, want RunStatsMenu", mm.currentMenu)
+	}
+	if err := mm.handleBack(); err != nil {
+		t.Fatal(err)
+	}
+	if mm.currentMenu != VictoryMenu {
+		t.Errorf("back from run stats went to %v, want VictoryMenu", mm.currentMenu)
+	}
+}
@@ -279,3 +279,27 @@ func TestSaveLoadMenuTimeFormatting(t *testing.T) {
 		})
 	}
 }
+
+func TestPauseMenuSaveGame(t *testing.T) {
+	mm := NewMenuManager()
+	mm.ShowPauseMenu()
+	saveItem := mm.items[1]
+	if saveItem.Enabled {
+		t.Error("Save Game should be disabled without a save callback")
+	}
+
+	saved, resumed := 0, 0
+	mm.SetSaveCallback(func() error { saved++; return nil })
+	mm.SetCallbacks(nil, nil, nil, nil, func() error { resumed++; return nil })
+	mm.ShowPauseMenu()
+	saveItem = mm.items[1]
+	if !saveItem.Enabled {
+		t.Fatal("Save Game should be enabled with a save callback")
+	}
+	if err := saveItem.Action(); err != nil {
+		t.Fatalf("Save Game action error = %v", err)
+	}
+	if saved != 1 || resumed != 1 {
+		t.Errorf("Save Game saved %d times and resumed %d times, want once each", saved, resumed)
+	}
+}
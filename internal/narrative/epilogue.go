@@ -0,0 +1,122 @@
+package narrative
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// EpilogueStats are the run accomplishments an epilogue reflects
+type EpilogueStats struct {
+	BossesDefeated int
+	TotalBosses    int
+	SecretsFound   int
+	ItemsCollected int
+	Deaths         int
+}
+
+// epilogueLands names the world each theme's ending speaks of
+var epilogueLands = map[StoryTheme]string{
+	FantasyTheme:  "the realm",
+	SciFiTheme:    "the sector",
+	HorrorTheme:   "the accursed land",
+	MysticalTheme: "the spirit-touched world",
+	PostApocTheme: "the wasteland",
+}
+
+// epilogueOpenings recall the catastrophe. Each takes the land, the
+// catastrophe, and the civilization that endured it.
+var epilogueOpenings = []string{
+	"Ever since %[2]s, %[1]s had known no peace, and the %[3]s had all but faded from memory.",
+	"For an age %[1]s bore the scars of the day %[2]s, and the %[3]s waited for an end.",
+	"Few remember the %[3]s as it was before %[2]s, but %[1]s remembers.",
+}
+
+// epilogueFates describe what becomes of a faction, keyed by relationship
+var epilogueFates = map[string][]string{
+	"ally": {
+		"%s stood beside you at the last and now lead the rebuilding.",
+		"%s raise a banner in your honor over the ruins.",
+	},
+	"enemy": {
+		"%s, robbed of their schemes, scatter into the dark.",
+		"%s swear vengeance, though none now fear them.",
+	},
+	"neutral": {
+		"%s watched from afar and still cannot decide what you are.",
+		"%s go on as they always have, a little warier of strangers.",
+	},
+}
+
+// GenerateEpilogue writes the ending shown on victory, one paragraph per
+// string. It recalls the catastrophe, settles the factions, and weighs the
+// player's run. The same seed, context, and stats always give the same text.
+func GenerateEpilogue(ctx *WorldContext, seed int64, stats EpilogueStats) []string {
+	rng := rand.New(rand.NewSource(seed))
+
+	land, ok := epilogueLands[ctx.Theme]
+	if !ok {
+		land = "the world"
+	}
+
+	paragraphs := []string{
+		fmt.Sprintf(epilogueOpenings[rng.Intn(len(epilogueOpenings))], land, ctx.Catastrophe, ctx.CivilizationType),
+		epilogueVictory(land, stats),
+	}
+
+	var fates []string
+	for _, faction := range ctx.Factions {
+		options, ok := epilogueFates[faction.Relationship]
+		if !ok {
+			continue
+		}
+		fates = append(fates, fmt.Sprintf(options[rng.Intn(len(options))], faction.Name))
+	}
+	if len(fates) > 0 {
+		paragraphs = append(paragraphs, strings.Join(fates, " "))
+	}
+
+	paragraphs = append(paragraphs, epilogueClosing(ctx, stats))
+	return paragraphs
+}
+
+// epilogueVictory sums up the bosses beaten and the path taken
+func epilogueVictory(land string, stats EpilogueStats) string {
+	var s string
+	switch {
+	case stats.TotalBosses > 0 && stats.BossesDefeated >= stats.TotalBosses:
+		s = fmt.Sprintf("You struck down all %d guardians, and %s breathes again.", stats.TotalBosses, land)
+	case stats.BossesDefeated > 0:
+		s = fmt.Sprintf("You struck down %d guardians, and %s stirs toward hope.", stats.BossesDefeated, land)
+	default:
+		s = fmt.Sprintf("You slipped past every guardian, and %s barely noticed your passing.", land)
+	}
+
+	switch {
+	case stats.Deaths == 0:
+		s += " Not once did you fall."
+	case stats.Deaths > 10:
+		s += fmt.Sprintf(" You fell %d times and rose every time.", stats.Deaths)
+	}
+	return s
+}
+
+// epilogueClosing ends on what the player uncovered and set out to do
+func epilogueClosing(ctx *WorldContext, stats EpilogueStats) string {
+	var s string
+	switch {
+	case stats.SecretsFound >= 5:
+		s = fmt.Sprintf("The %d secrets you uncovered are told as legends now.", stats.SecretsFound)
+	case stats.SecretsFound > 0:
+		s = "The few secrets you uncovered hint at more still buried."
+	default:
+		s = "Its deepest secrets remain buried, waiting for another."
+	}
+	if stats.ItemsCollected > 0 {
+		s += fmt.Sprintf(" You leave with %d relics of the journey.", stats.ItemsCollected)
+	}
+	if ctx.PlayerMotivation != "" {
+		s += fmt.Sprintf(" You came to %s. It is done.", ctx.PlayerMotivation)
+	}
+	return s
+}
@@ -0,0 +1,57 @@
+package narrative
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerateEpilogueDeterministic(t *testing.T) {
+	stats := EpilogueStats{BossesDefeated: 3, TotalBosses: 3, SecretsFound: 2, ItemsCollected: 9, Deaths: 4}
+	for _, seed := range []int64{1, 42, 9001} {
+		ctx := NewNarrativeGenerator(seed).Generate(seed)
+		first := GenerateEpilogue(ctx, seed, stats)
+		again := GenerateEpilogue(NewNarrativeGenerator(seed).Generate(seed), seed, stats)
+		if !reflect.DeepEqual(first, again) {
+			t.Errorf("seed %d: epilogue differs between runs:\n%q\n%q", seed, first, again)
+		}
+	}
+}
+
+func TestGenerateEpilogueReferencesWorld(t *testing.T) {
+	for _, genre := range []string{"fantasy", "scifi", "horror", "cyberpunk", "postapoc"} {
+		ng := NewNarrativeGenerator(7)
+		ng.SetGenre(genre)
+		ctx := ng.Generate(7)
+		text := strings.Join(GenerateEpilogue(ctx, 7, EpilogueStats{BossesDefeated: 1, TotalBosses: 2}), " ")
+
+		if !strings.Contains(text, epilogueLands[ctx.Theme]) {
+			t.Errorf("%s: epilogue does not mention %q: %s", genre, epilogueLands[ctx.Theme], text)
+		}
+		if !strings.Contains(text, ctx.Catastrophe) {
+			t.Errorf("%s: epilogue does not mention the catastrophe %q: %s", genre, ctx.Catastrophe, text)
+		}
+		for _, faction := range ctx.Factions {
+			if !strings.Contains(text, faction.Name) {
+				t.Errorf("%s: epilogue leaves out faction %q", genre, faction.Name)
+			}
+		}
+	}
+}
+
+func TestGenerateEpilogueReflectsStats(t *testing.T) {
+	ctx := NewNarrativeGenerator(3).Generate(3)
+
+	full := strings.Join(GenerateEpilogue(ctx, 3, EpilogueStats{BossesDefeated: 4, TotalBosses: 4, SecretsFound: 6}), " ")
+	if !strings.Contains(full, "all 4 guardians") || !strings.Contains(full, "6 secrets") {
+		t.Errorf("complete run epilogue missing accomplishments: %s", full)
+	}
+
+	partial := strings.Join(GenerateEpilogue(ctx, 3, EpilogueStats{BossesDefeated: 1, TotalBosses: 4, Deaths: 2}), " ")
+	if partial == full {
+		t.Error("different stats produced the same epilogue")
+	}
+	if strings.Contains(partial, "all 4 guardians") {
+		t.Errorf("partial run claims every guardian: %s", partial)
+	}
+}
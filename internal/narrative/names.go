@@ -0,0 +1,130 @@
+package narrative
+
+import (
+	"math/rand"
+	"strings"
+)
+
+// NamePools are the word lists names are built from. Theme pools give the
+// flavor of the setting; biome pools tie a name to where the creature lives.
+type NamePools struct {
+	Adjectives map[StoryTheme][]string // Enemy name openers per theme
+	Creatures  map[StoryTheme][]string // Enemy nouns per theme
+	Titles     map[StoryTheme][]string // Boss titles per theme
+	Syllables  map[StoryTheme][]string // Pieces of a boss's given name
+	Places     map[string][]string     // Biome words, e.g. "Depths" for cave
+}
+
+// DefaultNamePools returns the built-in word lists
+func DefaultNamePools() NamePools {
+	return NamePools{
+		Adjectives: map[StoryTheme][]string{
+			FantasyTheme:  {"Runed", "Elder", "Thorned", "Gilded", "Feral"},
+			SciFiTheme:    {"Rogue", "Derelict", "Plasma", "Null", "Overclocked"},
+			HorrorTheme:   {"Weeping", "Hollow", "Rotting", "Stitched", "Pale"},
+			MysticalTheme: {"Astral", "Veiled", "Dreaming", "Luminous", "Whispering"},
+			PostApocTheme: {"Scrap", "Irradiated", "Rusted", "Scavenger", "Mutant"},
+		},
+		Creatures: map[StoryTheme][]string{
+			FantasyTheme:  {"Wyrmling", "Ghoul", "Imp", "Troll", "Wisp"},
+			SciFiTheme:    {"Drone", "Sentinel", "Crawler", "Unit", "Swarmer"},
+			HorrorTheme:   {"Wretch", "Thing", "Mourner", "Husk", "Shade"},
+			MysticalTheme: {"Spirit", "Echo", "Seer", "Wraith", "Familiar"},
+			PostApocTheme: {"Raider", "Ghoul", "Mutt", "Stalker", "Brute"},
+		},
+		Titles: map[StoryTheme][]string{
+			FantasyTheme:  {"Tyrant", "Warden", "King", "Dragon"},
+			SciFiTheme:    {"Overseer", "Core", "Prime", "Director"},
+			HorrorTheme:   {"Matriarch", "Butcher", "Mother", "Keeper"},
+			MysticalTheme: {"Oracle", "Archon", "Dreamer", "Sage"},
+			PostApocTheme: {"Warlord", "Boss", "Chief", "Baron"},
+		},
+		Syllables: map[StoryTheme][]string{
+			FantasyTheme:  {"vor", "gath", "el", "dra", "mor", "thas", "ryn"},
+			SciFiTheme:    {"zen", "ix", "ka", "tron", "vex", "ul", "dyn"},
+			HorrorTheme:   {"mal", "grue", "sil", "ach", "morr", "eth", "ul"},
+			MysticalTheme: {"ae", "lum", "sha", "ri", "vel", "ion", "qua"},
+			PostApocTheme: {"rak", "dust", "gor", "tin", "jax", "bo", "rr"},
+		},
+		Places: map[string][]string{
+			"cave":    {"Cavern", "Deep", "Burrow", "Stone"},
+			"forest":  {"Grove", "Thicket", "Bramble", "Root"},
+			"ruins":   {"Crypt", "Ruin", "Tomb", "Ash"},
+			"crystal": {"Prism", "Shard", "Frost", "Geode"},
+			"abyss":   {"Void", "Rift", "Pit", "Maw"},
+			"sky":     {"Storm", "Cloud", "Gale", "Spire"},
+		},
+	}
+}
+
+// NameGenerator builds enemy and boss names from a theme's word pools. Each
+// name is derived from the generator's seed and a caller-supplied key, so a
+// name does not depend on how many others were generated before it.
+type NameGenerator struct {
+	Pools NamePools
+	theme StoryTheme
+	seed  int64
+}
+
+// NewNameGenerator creates a name generator for a theme using the default
+// pools. Replace Pools to customize the vocabulary.
+func NewNameGenerator(seed int64, theme StoryTheme) *NameGenerator {
+	return &NameGenerator{
+		Pools: DefaultNamePools(),
+		theme: theme,
+		seed:  seed,
+	}
+}
+
+// EnemyName returns a name like "Rusted Pit Raider" for an enemy of the
+// given biome
+func (ng *NameGenerator) EnemyName(biome string, key int64) string {
+	rng := ng.rng(key)
+	adjective := pick(rng, ng.themePool(ng.Pools.Adjectives), "Strange")
+	place := pick(rng, ng.Pools.Places[biome], "")
+	creature := pick(rng, ng.themePool(ng.Pools.Creatures), "Creature")
+
+	// Half the names skip the biome word to keep them short
+	if place == "" || rng.Intn(2) == 0 {
+		return adjective + " " + creature
+	}
+	return adjective + " " + place + " " + creature
+}
+
+// BossName returns a name like "Vorgath, Tyrant of the Deep" for a boss of
+// the given biome
+func (ng *NameGenerator) BossName(biome string, key int64) string {
+	rng := ng.rng(key)
+	syllables := ng.themePool(ng.Pools.Syllables)
+	given := pick(rng, syllables, "nam")
+	for n := 1 + rng.Intn(2); n > 0; n-- {
+		given += pick(rng, syllables, "")
+	}
+	given = strings.ToUpper(given[:1]) + given[1:]
+
+	title := pick(rng, ng.themePool(ng.Pools.Titles), "Lord")
+	place := pick(rng, ng.Pools.Places[biome], "Unknown")
+	return given + ", " + title + " of the " + place
+}
+
+// rng returns a random source for one name
+func (ng *NameGenerator) rng(key int64) *rand.Rand {
+	return rand.New(rand.NewSource(ng.seed*31 + key))
+}
+
+// themePool returns the generator theme's list from pools, falling back to
+// fantasy for themes without one
+func (ng *NameGenerator) themePool(pools map[StoryTheme][]string) []string {
+	if list, ok := pools[ng.theme]; ok && len(list) > 0 {
+		return list
+	}
+	return pools[FantasyTheme]
+}
+
+// pick returns a random entry of list, or fallback when it is empty
+func pick(rng *rand.Rand, list []string, fallback string) string {
+	if len(list) == 0 {
+		return fallback
+	}
+	return list[rng.Intn(len(list))]
+}
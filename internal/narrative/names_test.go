@@ -0,0 +1,80 @@
+package narrative
+
+import (
+	"strings"
+	"testing"
+)
+
+var allThemes = []StoryTheme{FantasyTheme, SciFiTheme, HorrorTheme, MysticalTheme, PostApocTheme}
+
+// TestNameGenerator_Deterministic tests that a seed and key always give the
+// same name
+func TestNameGenerator_Deterministic(t *testing.T) {
+	for _, theme := range allThemes {
+		a := NewNameGenerator(42, theme)
+		b := NewNameGenerator(42, theme)
+		for key := int64(0); key < 20; key++ {
+			if a.EnemyName("cave", key) != b.EnemyName("cave", key) {
+				t.Errorf("%s: enemy name for key %d differs between generators", theme, key)
+			}
+			if a.BossName("abyss", key) != b.BossName("abyss", key) {
+				t.Errorf("%s: boss name for key %d differs between generators", theme, key)
+			}
+		}
+	}
+}
+
+// TestNameGenerator_NonEmpty tests names for every theme and biome,
+// including biomes without a word pool
+func TestNameGenerator_NonEmpty(t *testing.T) {
+	biomes := []string{"cave", "forest", "ruins", "crystal", "abyss", "sky", "unknown"}
+	for _, theme := range allThemes {
+		ng := NewNameGenerator(7, theme)
+		for _, biome := range biomes {
+			for key := int64(0); key < 10; key++ {
+				if name := ng.EnemyName(biome, key); strings.TrimSpace(name) == "" {
+					t.Errorf("%s/%s: empty enemy name", theme, biome)
+				}
+				boss := ng.BossName(biome, key)
+				if !strings.Contains(boss, ", ") || !strings.Contains(boss, " of the ") {
+					t.Errorf("%s/%s: boss name %q not in \"Name, Title of the Place\" form", theme, biome, boss)
+				}
+				if boss[:1] != strings.ToUpper(boss[:1]) {
+					t.Errorf("%s/%s: boss name %q should be capitalized", theme, biome, boss)
+				}
+			}
+		}
+	}
+}
+
+// TestNameGenerator_VariesAcrossThemes tests that themes draw on different
+// vocabulary
+func TestNameGenerator_VariesAcrossThemes(t *testing.T) {
+	seen := make(map[string]StoryTheme)
+	for _, theme := range allThemes {
+		ng := NewNameGenerator(42, theme)
+		names := make(map[string]bool)
+		for key := int64(0); key < 10; key++ {
+			names[ng.EnemyName("cave", key)] = true
+		}
+		for name := range names {
+			if other, ok := seen[name]; ok {
+				t.Errorf("name %q generated for both %s and %s", name, other, theme)
+			}
+			seen[name] = theme
+		}
+	}
+}
+
+// TestNameGenerator_CustomPools tests that replacing the pools changes the
+// vocabulary
+func TestNameGenerator_CustomPools(t *testing.T) {
+	ng := NewNameGenerator(1, FantasyTheme)
+	ng.Pools.Adjectives = map[StoryTheme][]string{FantasyTheme: {"Tiny"}}
+	ng.Pools.Creatures = map[StoryTheme][]string{FantasyTheme: {"Newt"}}
+	ng.Pools.Places = nil
+
+	if got := ng.EnemyName("cave", 3); got != "Tiny Newt" {
+		t.Errorf("EnemyName() = %q, want %q", got, "Tiny Newt")
+	}
+}
@@ -6,7 +6,8 @@ package narrative
 import (
 	"fmt"
 	"math/rand"
-	"strings"
+
+	"github.com/opd-ai/vania/internal/locale"
 )
 
 // StoryTheme defines the narrative theme
@@ -371,8 +372,14 @@ func (ng *NarrativeGenerator) GenerateCharacter(role string) *Character {
 	}
 }
 
-// GenerateItemDescription generates item lore
+// GenerateItemDescription generates item lore in English
 func (ng *NarrativeGenerator) GenerateItemDescription(itemType string, theme StoryTheme) string {
+	return locale.English(ng.GenerateItemDescriptionMessage(itemType, theme))
+}
+
+// GenerateItemDescriptionMessage generates item lore as a string table
+// message, for display in the player's language
+func (ng *NarrativeGenerator) GenerateItemDescriptionMessage(itemType string, theme StoryTheme) locale.Message {
 	adjectives := map[StoryTheme][]string{
 		FantasyTheme:  {"enchanted", "ancient", "blessed", "legendary"},
 		SciFiTheme:    {"advanced", "prototype", "quantum", "neural"},
@@ -381,22 +388,11 @@ func (ng *NarrativeGenerator) GenerateItemDescription(itemType string, theme Sto
 		PostApocTheme: {"salvaged", "modified", "reinforced", "makeshift"},
 	}
 
-	templates := map[string][]string{
-		"weapon": {
-			"A %s blade forged in the fires of the %s.",
-			"This %s weapon has seen countless battles.",
-			"The %s craftsmanship is evident in every detail.",
-		},
-		"key_item": {
-			"A %s artifact of immense power.",
-			"This %s object holds the key to secrets long forgotten.",
-			"The %s nature of this item is unmistakable.",
-		},
-		"consumable": {
-			"A %s potion that glows with inner light.",
-			"This %s elixir was crafted by master alchemists.",
-			"The %s properties make it invaluable.",
-		},
+	// Templates per item type; the first weapon template also names a place
+	templateCounts := map[string]int{
+		"weapon":     3,
+		"key_item":   3,
+		"consumable": 3,
 	}
 
 	// Use default if theme not found
@@ -406,45 +402,36 @@ func (ng *NarrativeGenerator) GenerateItemDescription(itemType string, theme Sto
 	}
 
 	// Use default if item type not found
-	tmplList, ok := templates[itemType]
-	if !ok || len(tmplList) == 0 {
-		return "A remarkable item of unknown origin."
+	count, ok := templateCounts[itemType]
+	if !ok {
+		return locale.NewMessage("narrative.item.unknown")
 	}
 
-	adj := adjList[ng.rng.Intn(len(adjList))]
-	tmpl := tmplList[ng.rng.Intn(len(tmplList))]
+	adj := locale.NewMessage("narrative.adj." + adjList[ng.rng.Intn(len(adjList))])
+	tmpl := ng.rng.Intn(count)
+	key := fmt.Sprintf("narrative.item.%s.%d", itemType, tmpl)
 
-	if strings.Contains(tmpl, "fires of the %s") {
-		locations := []string{"ancients", "fallen kingdom", "first age", "old world"}
-		return fmt.Sprintf(tmpl, adj, locations[ng.rng.Intn(len(locations))])
+	if itemType == "weapon" && tmpl == 0 {
+		locations := []string{"ancients", "fallen", "first_age", "old_world"}
+		place := locale.NewMessage("narrative.place." + locations[ng.rng.Intn(len(locations))])
+		return locale.NewMessage(key, adj, place)
 	}
 
-	return fmt.Sprintf(tmpl, adj)
+	return locale.NewMessage(key, adj)
 }
 
-// GenerateRoomDescription generates room description
+// GenerateRoomDescription generates room description in English
 func (ng *NarrativeGenerator) GenerateRoomDescription(roomType string, theme StoryTheme) string {
-	descriptions := map[string][]string{
-		"combat": {
-			"The chamber echoes with the sounds of battle.",
-			"Danger lurks in every shadow of this arena.",
-			"Ancient weapons line the walls of this proving ground.",
-		},
-		"treasure": {
-			"Glittering prizes await those brave enough to claim them.",
-			"The air shimmers with the promise of riches.",
-			"Valuable artifacts rest on ornate pedestals.",
-		},
-		"puzzle": {
-			"Strange mechanisms hint at hidden solutions.",
-			"Cryptic symbols cover every surface.",
-			"The room holds secrets waiting to be unraveled.",
-		},
-	}
+	return locale.English(ng.GenerateRoomDescriptionMessage(roomType, theme))
+}
 
-	if descs, ok := descriptions[roomType]; ok {
-		return descs[ng.rng.Intn(len(descs))]
+// GenerateRoomDescriptionMessage generates a room description as a string
+// table message, for display in the player's language
+func (ng *NarrativeGenerator) GenerateRoomDescriptionMessage(roomType string, theme StoryTheme) locale.Message {
+	switch roomType {
+	case "combat", "treasure", "puzzle":
+		return locale.NewMessage(fmt.Sprintf("narrative.room.%s.%d", roomType, ng.rng.Intn(3)))
 	}
 
-	return "A mysterious chamber awaits exploration."
+	return locale.NewMessage("narrative.room.unknown")
 }
@@ -0,0 +1,105 @@
+package particle
+
+import (
+	"image/color"
+	"math"
+	"strings"
+)
+
+// DeathEffect is how an enemy bursts apart when it dies
+type DeathEffect string
+
+const (
+	DeathExplosion DeathEffect = "explosion" // The generic fiery burst
+	DeathShatter   DeathEffect = "shatter"   // Crystalline shards
+	DeathAsh       DeathEffect = "ash"       // Drifting grey ash
+	DeathLeaves    DeathEffect = "leaves"    // A scatter of leaves
+	DeathCinders   DeathEffect = "cinders"   // Rising embers
+)
+
+// elementDeathEffects picks a death effect from an enemy's element
+var elementDeathEffects = map[string]DeathEffect{
+	"ice":     DeathShatter,
+	"crystal": DeathShatter,
+	"fire":    DeathCinders,
+	"lava":    DeathCinders,
+	"shadow":  DeathAsh,
+	"void":    DeathAsh,
+	"nature":  DeathLeaves,
+	"plant":   DeathLeaves,
+}
+
+// biomeDeathEffects picks a death effect from the biome an enemy dies in
+var biomeDeathEffects = map[string]DeathEffect{
+	"crystal": DeathShatter,
+	"abyss":   DeathAsh,
+	"forest":  DeathLeaves,
+}
+
+// DeathEffectFor picks the death effect for an enemy of the given element
+// dying in the given biome. The element wins; enemies with neither a known
+// element nor biome explode as before.
+func DeathEffectFor(biome, element string) DeathEffect {
+	if effect, ok := elementDeathEffects[strings.ToLower(element)]; ok {
+		return effect
+	}
+	if effect, ok := biomeDeathEffects[strings.ToLower(biome)]; ok {
+		return effect
+	}
+	return DeathExplosion
+}
+
+// CreateDeathEffect creates a one-shot death burst at the specified
+// position
+func (pp *ParticlePresets) CreateDeathEffect(x, y float64, effect DeathEffect) *ParticleEmitter {
+	var emitter *ParticleEmitter
+	switch effect {
+	case DeathShatter:
+		emitter = pp.newEmitter(x, y, Shards)
+		emitter.Speed = 6.0
+		emitter.SpeedVariance = 2.5
+		emitter.Life = 30
+		emitter.LifeVariance = 10
+		emitter.Size = 3.0
+		emitter.SizeVariance = 1.5
+		emitter.Gravity = 0.25
+		emitter.Color = color.RGBA{170, 230, 255, 255} // Icy cyan glass
+	case DeathAsh:
+		emitter = pp.newEmitter(x, y, Ash)
+		emitter.Speed = 1.5
+		emitter.SpeedVariance = 1.0
+		emitter.Life = 70
+		emitter.LifeVariance = 20
+		emitter.Size = 3.0
+		emitter.SizeVariance = 1.0
+		emitter.Gravity = -0.03                     // Drifts upward
+		emitter.Color = color.RGBA{90, 85, 95, 200} // Dusky grey
+	case DeathLeaves:
+		emitter = pp.newEmitter(x, y, Leaves)
+		emitter.Speed = 3.0
+		emitter.SpeedVariance = 1.5
+		emitter.Life = 60
+		emitter.LifeVariance = 20
+		emitter.Size = 3.5
+		emitter.SizeVariance = 1.0
+		emitter.Gravity = 0.05                       // Flutters down
+		emitter.Color = color.RGBA{90, 170, 60, 255} // Leaf green
+	case DeathCinders:
+		emitter = pp.newEmitter(x, y, Embers)
+		emitter.Speed = 2.5
+		emitter.SpeedVariance = 1.5
+		emitter.Life = 50
+		emitter.LifeVariance = 15
+		emitter.Size = 2.5
+		emitter.SizeVariance = 1.0
+		emitter.Gravity = -0.08                       // Rise upward
+		emitter.Color = color.RGBA{255, 110, 40, 230} // Glowing orange
+	default:
+		return pp.CreateExplosion(x, y, 1.0)
+	}
+	emitter.EmitRate = 30
+	emitter.Spread = math.Pi * 2 // 360 degrees
+	emitter.OneShot = true
+
+	return emitter
+}
@@ -0,0 +1,40 @@
+package particle
+
+import "testing"
+
+func TestDeathEffectFor(t *testing.T) {
+	tests := []struct {
+		biome, element string
+		want           DeathEffect
+	}{
+		{"crystal", "", DeathShatter},
+		{"abyss", "", DeathAsh},
+		{"forest", "", DeathLeaves},
+		{"cave", "", DeathExplosion},
+		{"", "", DeathExplosion},
+		{"forest", "Fire", DeathCinders}, // Element wins over biome
+		{"cave", "ice", DeathShatter},
+	}
+	for _, tt := range tests {
+		if got := DeathEffectFor(tt.biome, tt.element); got != tt.want {
+			t.Errorf("DeathEffectFor(%q, %q) = %q, want %q", tt.biome, tt.element, got, tt.want)
+		}
+	}
+}
+
+func TestCreateDeathEffectParticleTypes(t *testing.T) {
+	pp := &ParticlePresets{}
+	want := map[DeathEffect]ParticleType{
+		DeathExplosion: Explosion,
+		DeathShatter:   Shards,
+		DeathAsh:       Ash,
+		DeathLeaves:    Leaves,
+		DeathCinders:   Embers,
+	}
+	for effect, ptype := range want {
+		emitter := pp.CreateDeathEffect(100, 100, effect)
+		if emitter.Type != ptype || !emitter.OneShot {
+			t.Errorf("%s effect: type = %v, one-shot = %v, want type %v and one-shot", effect, emitter.Type, emitter.OneShot, ptype)
+		}
+	}
+}
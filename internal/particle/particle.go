@@ -34,6 +34,14 @@ const (
 	Explosion
 	Smoke
 	Lightning
+
+	// ImpactDust is the bloodless stand-in for BloodSplatter
+	ImpactDust
+
+	// Death effects (see death_effects.go)
+	Shards
+	Ash
+	Leaves
 )
 
 // Particle represents a single particle
@@ -49,7 +57,7 @@ type Particle struct {
 	Type           ParticleType
 	Rotation       float64
 	RotationSpeed  float64
-	Data           interface{} // Custom data (e.g., damage number text)
+	Data           interface{} `json:"-"` // Custom data (e.g., damage number text); not serialized
 }
 
 // ParticleEmitter generates and manages particles
@@ -59,16 +67,19 @@ type ParticleEmitter struct {
 	EmitRate      int // particles per frame
 	EmitTimer     int
 	Spread        float64 // angle spread in radians
+	Angle         float64 // direction the spread is centered on, in radians; 0 points right
 	Speed         float64 // initial velocity
 	SpeedVariance float64
 	Life          int // particle lifetime in frames
 	LifeVariance  int
+	LifeScale     float64 // multiplies each emitted lifetime; 0 means 1
 	Size          float64
 	SizeVariance  float64
 	Gravity       float64
 	Type          ParticleType
 	Color         color.RGBA
-	OneShot       bool // emit once then deactivate
+	OneShot       bool       // emit once then deactivate
+	Rand          *rand.Rand `json:"-"` // source of variance; nil uses the global source
 	Particles     []*Particle
 }
 
@@ -257,24 +268,27 @@ func (e *ParticleEmitter) Update() {
 // EmitParticles emits a number of particles
 func (e *ParticleEmitter) EmitParticles(count int) {
 	for i := 0; i < count; i++ {
-		// Random angle within spread
-		angle := (rand.Float64() - 0.5) * e.Spread
+		// Random angle within spread, around the base angle
+		angle := e.Angle + (e.float64()-0.5)*e.Spread
 
 		// Random speed with variance
-		speed := e.Speed + (rand.Float64()-0.5)*e.SpeedVariance
+		speed := e.Speed + (e.float64()-0.5)*e.SpeedVariance
 
 		// Calculate velocity from angle and speed
 		velX := math.Cos(angle) * speed
 		velY := math.Sin(angle) * speed
 
 		// Random life with variance
-		life := e.Life + rand.Intn(e.LifeVariance*2) - e.LifeVariance
+		life := e.Life + e.intn(e.LifeVariance*2) - e.LifeVariance
+		if e.LifeScale > 0 {
+			life = int(math.Round(float64(life) * e.LifeScale))
+		}
 		if life < 1 {
 			life = 1
 		}
 
 		// Random size with variance
-		size := e.Size + (rand.Float64()-0.5)*e.SizeVariance
+		size := e.Size + (e.float64()-0.5)*e.SizeVariance
 		if size < 0.5 {
 			size = 0.5
 		}
@@ -292,6 +306,20 @@ func (e *ParticleEmitter) EmitParticles(count int) {
 	}
 }
 
+func (e *ParticleEmitter) float64() float64 {
+	if e.Rand != nil {
+		return e.Rand.Float64()
+	}
+	return rand.Float64()
+}
+
+func (e *ParticleEmitter) intn(n int) int {
+	if e.Rand != nil {
+		return e.Rand.Intn(n)
+	}
+	return rand.Intn(n)
+}
+
 // Start activates the emitter
 func (e *ParticleEmitter) Start() {
 	e.Active = true
@@ -2,6 +2,7 @@ package particle
 
 import (
 	"image/color"
+	"reflect"
 	"testing"
 )
 
@@ -327,3 +328,29 @@ func TestParticleAlphaFade(t *testing.T) {
 		t.Errorf("Expected alpha to fade, got %d (initial: %d)", p.Alpha, initialAlpha)
 	}
 }
+
+func TestParticleSystemSnapshotRestore(t *testing.T) {
+	ps := NewParticleSystem(1000)
+	emitter := NewParticleEmitter(100, 100, Explosion)
+	emitter.Burst(10)
+	ps.AddEmitter(emitter)
+	ps.AddParticle(NewParticle(5, 6, 1, 2, 30, 2, color.RGBA{255, 0, 0, 255}, HitSpark))
+
+	snap := ps.Snapshot()
+	ps.Update()
+	ps.Update()
+
+	// Snapshots are deep copies, untouched by later updates
+	if snap.Particles[0].X != 5 || len(snap.Emitters[0].Particles) != 10 {
+		t.Fatal("snapshot shares particles with the live system")
+	}
+
+	restored := NewParticleSystem(1000)
+	restored.Restore(snap, nil)
+	if got, want := restored.GetParticleCount(), 11; got != want {
+		t.Errorf("restored %d particles, want %d", got, want)
+	}
+	if got := restored.Snapshot(); !reflect.DeepEqual(got, snap) {
+		t.Error("restored system does not match the snapshot")
+	}
+}
@@ -5,14 +5,49 @@ package particle
 import (
 	"image/color"
 	"math"
+	"math/rand"
 )
 
 // ParticlePresets provides factory methods for common particle effects
-type ParticlePresets struct{}
+type ParticlePresets struct {
+	// Rand is given to every emitter created, so effects can draw from a
+	// saved, reproducible source. Nil uses the global source.
+	Rand *rand.Rand
+
+	// LifeScale is given to every emitter created, stretching or shortening
+	// each effect's particle lifetimes. Zero leaves them as designed.
+	LifeScale float64
+}
+
+// newEmitter creates an emitter that draws from the presets' source
+func (pp *ParticlePresets) newEmitter(x, y float64, ptype ParticleType) *ParticleEmitter {
+	emitter := NewParticleEmitter(x, y, ptype)
+	emitter.Rand = pp.Rand
+	emitter.LifeScale = pp.LifeScale
+	return emitter
+}
 
-// CreateHitEffect creates a hit spark effect at the specified position
+// CreateHitEffect creates a hit spark effect at the specified position,
+// spraying right for a positive direction and left for a negative one
 func (pp *ParticlePresets) CreateHitEffect(x, y, direction float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, HitSpark)
+	angle := 0.0
+	if direction < 0 {
+		angle = math.Pi
+	}
+	return pp.CreateAngledHitEffect(x, y, angle)
+}
+
+// ImpactAngle returns the direction of a hit, in radians, from the
+// attacker's position toward the target's; sparks fly on along it. Zero
+// points right and angles grow clockwise on screen, since y points down.
+func ImpactAngle(attackerX, attackerY, targetX, targetY float64) float64 {
+	return math.Atan2(targetY-attackerY, targetX-attackerX)
+}
+
+// CreateAngledHitEffect creates a hit spark effect at the specified
+// position, spraying around angle (see ImpactAngle)
+func (pp *ParticlePresets) CreateAngledHitEffect(x, y, angle float64) *ParticleEmitter {
+	emitter := pp.newEmitter(x, y, HitSpark)
 	emitter.EmitRate = 20
 	emitter.Spread = math.Pi / 3 // 60 degrees
 	emitter.Speed = 4.0
@@ -24,18 +59,14 @@ func (pp *ParticlePresets) CreateHitEffect(x, y, direction float64) *ParticleEmi
 	emitter.Gravity = 0.1
 	emitter.Color = color.RGBA{255, 200, 100, 255} // Orange-yellow
 	emitter.OneShot = true
-
-	// Adjust direction based on hit direction
-	if direction < 0 {
-		emitter.Spread = -emitter.Spread
-	}
+	emitter.Angle = angle
 
 	return emitter
 }
 
 // CreateDashTrail creates a dash trail effect
 func (pp *ParticlePresets) CreateDashTrail(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, DashTrail)
+	emitter := pp.newEmitter(x, y, DashTrail)
 	emitter.EmitRate = 10
 	emitter.Spread = math.Pi // 180 degrees
 	emitter.Speed = 1.0
@@ -53,7 +84,7 @@ func (pp *ParticlePresets) CreateDashTrail(x, y float64) *ParticleEmitter {
 
 // CreateJumpDust creates dust particles when jumping
 func (pp *ParticlePresets) CreateJumpDust(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, JumpDust)
+	emitter := pp.newEmitter(x, y, JumpDust)
 	emitter.EmitRate = 15
 	emitter.Spread = math.Pi / 4 // 45 degrees up
 	emitter.Speed = 2.0
@@ -71,7 +102,7 @@ func (pp *ParticlePresets) CreateJumpDust(x, y float64) *ParticleEmitter {
 
 // CreateLandDust creates dust particles when landing
 func (pp *ParticlePresets) CreateLandDust(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, LandDust)
+	emitter := pp.newEmitter(x, y, LandDust)
 	emitter.EmitRate = 20
 	emitter.Spread = math.Pi // Spread outward
 	emitter.Speed = 3.0
@@ -89,7 +120,7 @@ func (pp *ParticlePresets) CreateLandDust(x, y float64) *ParticleEmitter {
 
 // CreateWalkDust creates subtle dust for walking
 func (pp *ParticlePresets) CreateWalkDust(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, WalkDust)
+	emitter := pp.newEmitter(x, y, WalkDust)
 	emitter.EmitRate = 5
 	emitter.Spread = math.Pi / 6 // 30 degrees
 	emitter.Speed = 0.5
@@ -107,7 +138,7 @@ func (pp *ParticlePresets) CreateWalkDust(x, y float64) *ParticleEmitter {
 
 // CreateBloodSplatter creates blood particles when enemy is hit
 func (pp *ParticlePresets) CreateBloodSplatter(x, y, direction float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, BloodSplatter)
+	emitter := pp.newEmitter(x, y, BloodSplatter)
 	emitter.EmitRate = 15
 	emitter.Spread = math.Pi / 2 // 90 degrees
 	emitter.Speed = 3.5
@@ -123,9 +154,28 @@ func (pp *ParticlePresets) CreateBloodSplatter(x, y, direction float64) *Particl
 	return emitter
 }
 
+// CreateImpactDust creates neutral dust and grit when an enemy is hit,
+// used in place of blood splatter when gore is turned off
+func (pp *ParticlePresets) CreateImpactDust(x, y, direction float64) *ParticleEmitter {
+	emitter := pp.newEmitter(x, y, ImpactDust)
+	emitter.EmitRate = 15
+	emitter.Spread = math.Pi / 2 // 90 degrees
+	emitter.Speed = 3.0
+	emitter.SpeedVariance = 1.5
+	emitter.Life = 25
+	emitter.LifeVariance = 8
+	emitter.Size = 2.5
+	emitter.SizeVariance = 1.0
+	emitter.Gravity = 0.2
+	emitter.Color = color.RGBA{190, 180, 160, 220} // Pale grit
+	emitter.OneShot = true
+
+	return emitter
+}
+
 // CreateExplosion creates an explosion effect
 func (pp *ParticlePresets) CreateExplosion(x, y, size float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, Explosion)
+	emitter := pp.newEmitter(x, y, Explosion)
 	emitter.EmitRate = 30
 	emitter.Spread = math.Pi * 2 // 360 degrees
 	emitter.Speed = 5.0 * size
@@ -143,7 +193,7 @@ func (pp *ParticlePresets) CreateExplosion(x, y, size float64) *ParticleEmitter
 
 // CreateSmoke creates smoke particles
 func (pp *ParticlePresets) CreateSmoke(x, y float64, continuous bool) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, Smoke)
+	emitter := pp.newEmitter(x, y, Smoke)
 	emitter.EmitRate = 3
 	emitter.Spread = math.Pi / 4 // 45 degrees upward
 	emitter.Speed = 1.0
@@ -161,7 +211,7 @@ func (pp *ParticlePresets) CreateSmoke(x, y float64, continuous bool) *ParticleE
 
 // CreateRain creates rain particles
 func (pp *ParticlePresets) CreateRain(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, Rain)
+	emitter := pp.newEmitter(x, y, Rain)
 	emitter.EmitRate = 10
 	emitter.Spread = 0.1 // Nearly vertical
 	emitter.Speed = 8.0
@@ -179,7 +229,7 @@ func (pp *ParticlePresets) CreateRain(x, y float64) *ParticleEmitter {
 
 // CreateSnow creates snow particles
 func (pp *ParticlePresets) CreateSnow(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, Snow)
+	emitter := pp.newEmitter(x, y, Snow)
 	emitter.EmitRate = 5
 	emitter.Spread = 0.3 // Slight spread
 	emitter.Speed = 1.0
@@ -197,7 +247,7 @@ func (pp *ParticlePresets) CreateSnow(x, y float64) *ParticleEmitter {
 
 // CreateEmbers creates ember particles
 func (pp *ParticlePresets) CreateEmbers(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, Embers)
+	emitter := pp.newEmitter(x, y, Embers)
 	emitter.EmitRate = 3
 	emitter.Spread = math.Pi / 3 // 60 degrees upward
 	emitter.Speed = 1.5
@@ -215,7 +265,7 @@ func (pp *ParticlePresets) CreateEmbers(x, y float64) *ParticleEmitter {
 
 // CreateSparkles creates sparkle particles
 func (pp *ParticlePresets) CreateSparkles(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, Sparkles)
+	emitter := pp.newEmitter(x, y, Sparkles)
 	emitter.EmitRate = 5
 	emitter.Spread = math.Pi * 2 // 360 degrees
 	emitter.Speed = 0.5
@@ -233,7 +283,7 @@ func (pp *ParticlePresets) CreateSparkles(x, y float64) *ParticleEmitter {
 
 // CreateBubbles creates bubble particles
 func (pp *ParticlePresets) CreateBubbles(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, Bubbles)
+	emitter := pp.newEmitter(x, y, Bubbles)
 	emitter.EmitRate = 2
 	emitter.Spread = math.Pi / 6 // 30 degrees upward
 	emitter.Speed = 1.0
@@ -251,7 +301,7 @@ func (pp *ParticlePresets) CreateBubbles(x, y float64) *ParticleEmitter {
 
 // CreateLightning creates lightning flash particles
 func (pp *ParticlePresets) CreateLightning(x, y float64) *ParticleEmitter {
-	emitter := NewParticleEmitter(x, y, Lightning)
+	emitter := pp.newEmitter(x, y, Lightning)
 	emitter.EmitRate = 50
 	emitter.Spread = math.Pi * 2 // 360 degrees
 	emitter.Speed = 6.0
@@ -3,6 +3,7 @@ package particle
 import (
 	"image/color"
 	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -55,9 +56,58 @@ func TestParticlePresets_CreateHitEffect_Direction(t *testing.T) {
 			if emitter == nil {
 				t.Error("CreateHitEffect returned nil")
 			}
-			// Negative direction should invert spread
-			if tt.direction < 0 && emitter.Spread >= 0 {
-				t.Error("Expected negative spread for negative direction")
+			// Negative direction should spray to the left
+			want := 0.0
+			if tt.direction < 0 {
+				want = math.Pi
+			}
+			if emitter.Angle != want {
+				t.Errorf("Expected angle %v, got %v", want, emitter.Angle)
+			}
+			if emitter.Spread <= 0 {
+				t.Error("Expected a positive spread")
+			}
+		})
+	}
+}
+
+// TestImpactAngle tests that hits from every side get their true angle
+func TestImpactAngle(t *testing.T) {
+	tests := []struct {
+		name                 string
+		attackerX, attackerY float64
+		want                 float64
+	}{
+		{"from the left", 0, 100, 0},
+		{"from the right", 200, 100, math.Pi},
+		{"from above", 100, 0, math.Pi / 2},
+		{"from below", 100, 200, -math.Pi / 2},
+		{"from upper left", 0, 0, math.Pi / 4},
+		{"from lower right", 200, 200, -3 * math.Pi / 4},
+	}
+
+	pp := &ParticlePresets{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			angle := ImpactAngle(tt.attackerX, tt.attackerY, 100, 100)
+			if math.Abs(angle-tt.want) > 1e-9 {
+				t.Fatalf("ImpactAngle = %v, want %v", angle, tt.want)
+			}
+
+			emitter := pp.CreateAngledHitEffect(100, 100, angle)
+			if emitter.Angle != angle {
+				t.Errorf("emitter angle = %v, want %v", emitter.Angle, angle)
+			}
+
+			// Every spark leaves within half the spread of the impact angle
+			emitter.Rand = rand.New(rand.NewSource(1))
+			emitter.Burst(20)
+			for _, p := range emitter.Particles {
+				off := math.Atan2(p.VelY, p.VelX) - angle
+				off = math.Atan2(math.Sin(off), math.Cos(off))
+				if math.Abs(off) > emitter.Spread/2+1e-9 {
+					t.Fatalf("spark at %v rad is %v off the impact angle, spread %v", math.Atan2(p.VelY, p.VelX), off, emitter.Spread)
+				}
 			}
 		})
 	}
@@ -719,3 +769,52 @@ func TestParticlePresets_DamageNumberProperties(t *testing.T) {
 		t.Error("Damage number should have white color")
 	}
 }
+
+func TestParticlePresets_RandReproducible(t *testing.T) {
+	emit := func() []*Particle {
+		pp := &ParticlePresets{Rand: rand.New(rand.NewSource(9))}
+		emitter := pp.CreateHitEffect(100, 100, 1)
+		emitter.Burst(5)
+		return emitter.Particles
+	}
+
+	first, second := emit(), emit()
+	for i := range first {
+		if first[i].VelX != second[i].VelX || first[i].Life != second[i].Life || first[i].Size != second[i].Size {
+			t.Errorf("particle %d differs between runs with the same source", i)
+		}
+	}
+}
+
+// TestParticlePresets_LifeScale tests that the presets' lifetime scale
+// stretches and shortens emitted particle life
+func TestParticlePresets_LifeScale(t *testing.T) {
+	burstLives := func(scale float64) []int {
+		pp := &ParticlePresets{Rand: rand.New(rand.NewSource(7)), LifeScale: scale}
+		emitter := pp.CreateHitEffect(0, 0, 1)
+		emitter.Burst(20)
+		lives := make([]int, len(emitter.Particles))
+		for i, p := range emitter.Particles {
+			lives[i] = p.Life
+		}
+		return lives
+	}
+
+	base := burstLives(0)
+	for _, scale := range []float64{0.5, 2.0} {
+		scaled := burstLives(scale)
+		for i, life := range scaled {
+			want := int(math.Round(float64(base[i]) * scale))
+			if life != want {
+				t.Errorf("scale %v particle %d life = %d, want %d", scale, i, life, want)
+			}
+		}
+	}
+
+	// Even a tiny scale leaves every particle at least one frame
+	for _, life := range burstLives(0.01) {
+		if life != 1 {
+			t.Errorf("scaled life = %d, want the 1-frame minimum", life)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package particle
+
+import "math/rand"
+
+// Snapshot is a serializable copy of a particle system's emitters and
+// particles, used to reproduce an exact visual state
+type Snapshot struct {
+	Emitters  []ParticleEmitter `json:"emitters"`
+	Particles []Particle        `json:"particles"`
+}
+
+// Snapshot deep-copies the system's current emitters and particles. Emitter
+// random sources and particle custom data are not captured.
+func (ps *ParticleSystem) Snapshot() Snapshot {
+	snap := Snapshot{
+		Emitters:  make([]ParticleEmitter, len(ps.emitters)),
+		Particles: make([]Particle, len(ps.particles)),
+	}
+	for i, e := range ps.emitters {
+		snap.Emitters[i] = *e
+		snap.Emitters[i].Rand = nil
+		snap.Emitters[i].Particles = copyParticles(e.Particles)
+	}
+	for i, p := range ps.particles {
+		snap.Particles[i] = *p
+		snap.Particles[i].Data = nil
+	}
+	return snap
+}
+
+// Restore replaces the system's emitters and particles with those in snap.
+// Restored emitters draw their variance from rnd; nil uses the global source.
+func (ps *ParticleSystem) Restore(snap Snapshot, rnd *rand.Rand) {
+	ps.emitters = make([]*ParticleEmitter, len(snap.Emitters))
+	for i := range snap.Emitters {
+		e := snap.Emitters[i]
+		e.Rand = rnd
+		e.Particles = copyParticles(snap.Emitters[i].Particles)
+		ps.emitters[i] = &e
+	}
+	ps.particles = make([]*Particle, len(snap.Particles))
+	for i := range snap.Particles {
+		p := snap.Particles[i]
+		ps.particles[i] = &p
+	}
+}
+
+// copyParticles copies particles into fresh values without custom data
+func copyParticles(particles []*Particle) []*Particle {
+	copied := make([]*Particle, len(particles))
+	for i, p := range particles {
+		c := *p
+		c.Data = nil
+		copied[i] = &c
+	}
+	return copied
+}
@@ -0,0 +1,62 @@
+package pcg
+
+import "github.com/opd-ai/vania/internal/world"
+
+// BossDangerMultiplier scales a boss room's danger above its biome's
+const BossDangerMultiplier = 2
+
+// PathStep is one room along the critical path
+type PathStep struct {
+	RoomID     int `json:"room_id"`
+	Danger     int `json:"danger"`
+	Cumulative int `json:"cumulative"` // Danger of this room and all before it
+}
+
+// PathAnalysis describes the run's length and difficulty pacing along the
+// shortest route from the start room to the final boss
+type PathAnalysis struct {
+	Length      int        `json:"length"`       // Rooms on the path, start and final boss included
+	TotalDanger int        `json:"total_danger"` // Sum of room danger along the path
+	PeakDanger  int        `json:"peak_danger"`
+	PeakIndex   int        `json:"peak_index"`  // Path position of the first most dangerous room
+	Regressions int        `json:"regressions"` // Steps where danger drops from the room before
+	Curve       []PathStep `json:"curve"`
+}
+
+// RoomDanger rates how dangerous a room is: its biome's danger level, raised
+// for boss rooms. The start room and safe havens are safe.
+func RoomDanger(room *world.Room) int {
+	if room.Type == world.StartRoom || room.IsSafeHaven() || room.Biome == nil {
+		return 0
+	}
+	if room.Type == world.BossRoom {
+		return room.Biome.DangerLevel * BossDangerMultiplier
+	}
+	return room.Biome.DangerLevel
+}
+
+// AnalyzeCriticalPath measures the critical path of w. The result is empty
+// when the final boss cannot be reached.
+func AnalyzeCriticalPath(w *world.World) PathAnalysis {
+	analysis := PathAnalysis{Curve: []PathStep{}}
+	path := world.BossPath(w)
+
+	for i, room := range path {
+		danger := RoomDanger(room)
+		analysis.TotalDanger += danger
+		analysis.Curve = append(analysis.Curve, PathStep{
+			RoomID:     room.ID,
+			Danger:     danger,
+			Cumulative: analysis.TotalDanger,
+		})
+		if danger > analysis.PeakDanger {
+			analysis.PeakDanger = danger
+			analysis.PeakIndex = i
+		}
+		if i > 0 && danger < analysis.Curve[i-1].Danger {
+			analysis.Regressions++
+		}
+	}
+	analysis.Length = len(path)
+	return analysis
+}
@@ -0,0 +1,88 @@
+package pcg
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// pacingFixture builds start -> combat -> save -> combat -> boss, with a
+// dangerous side room hanging off the first combat room
+func pacingFixture() *world.World {
+	cave := &world.Biome{Name: "cave", DangerLevel: 2}
+	abyss := &world.Biome{Name: "abyss", DangerLevel: 5}
+	rooms := []*world.Room{
+		{ID: 0, Type: world.StartRoom, Biome: cave},
+		{ID: 1, Type: world.CombatRoom, Biome: cave},
+		{ID: 2, Type: world.SaveRoom, Biome: cave},
+		{ID: 3, Type: world.CombatRoom, Biome: abyss},
+		{ID: 4, Type: world.BossRoom, Biome: abyss},
+		{ID: 5, Type: world.CombatRoom, Biome: &world.Biome{Name: "sky", DangerLevel: 9}},
+	}
+	rooms[0].Connections = []*world.Room{rooms[1]}
+	rooms[1].Connections = []*world.Room{rooms[2], rooms[5]}
+	rooms[2].Connections = []*world.Room{rooms[3]}
+	rooms[3].Connections = []*world.Room{rooms[4]}
+
+	graph := &world.WorldGraph{Nodes: make(map[int]*world.GraphNode)}
+	for depth, room := range rooms[:5] {
+		graph.Nodes[room.ID] = &world.GraphNode{RoomID: room.ID, Depth: depth, Required: true}
+	}
+	graph.Nodes[5] = &world.GraphNode{RoomID: 5, Depth: 2}
+
+	return &world.World{
+		Rooms:     rooms,
+		StartRoom: rooms[0],
+		BossRooms: []*world.Room{rooms[4]},
+		Graph:     graph,
+	}
+}
+
+func TestAnalyzeCriticalPath(t *testing.T) {
+	analysis := AnalyzeCriticalPath(pacingFixture())
+
+	if analysis.Length != 5 {
+		t.Errorf("Length = %d, want 5", analysis.Length)
+	}
+	// 0 (start) + 2 + 0 (save) + 5 + 10 (boss)
+	if analysis.TotalDanger != 17 {
+		t.Errorf("TotalDanger = %d, want 17", analysis.TotalDanger)
+	}
+	if analysis.PeakDanger != 10 || analysis.PeakIndex != 4 {
+		t.Errorf("peak = %d at %d, want 10 at 4", analysis.PeakDanger, analysis.PeakIndex)
+	}
+	if analysis.Regressions != 1 {
+		t.Errorf("Regressions = %d, want 1 (the save room)", analysis.Regressions)
+	}
+
+	wantIDs := []int{0, 1, 2, 3, 4}
+	wantCumulative := []int{0, 2, 2, 7, 17}
+	for i, step := range analysis.Curve {
+		if step.RoomID != wantIDs[i] || step.Cumulative != wantCumulative[i] {
+			t.Errorf("Curve[%d] = %+v, want room %d with cumulative %d", i, step, wantIDs[i], wantCumulative[i])
+		}
+	}
+}
+
+func TestAnalyzeCriticalPathUnreachableBoss(t *testing.T) {
+	w := pacingFixture()
+	w.Rooms[2].Connections = nil
+
+	analysis := AnalyzeCriticalPath(w)
+	if analysis.Length != 0 || analysis.TotalDanger != 0 || len(analysis.Curve) != 0 {
+		t.Errorf("analysis = %+v, want empty for an unreachable boss", analysis)
+	}
+}
+
+func TestAnalyzeGeneratedWorld(t *testing.T) {
+	w := world.NewWorldGenerator(15, 10, 80, 5).Generate(42, nil)
+	analysis := AnalyzeCriticalPath(w)
+
+	if analysis.Length != len(world.BossPath(w)) || analysis.Length < 2 {
+		t.Fatalf("Length = %d, want the boss path length", analysis.Length)
+	}
+	last := analysis.Curve[len(analysis.Curve)-1]
+	if last.Cumulative != analysis.TotalDanger {
+		t.Errorf("final cumulative %d != TotalDanger %d", last.Cumulative, analysis.TotalDanger)
+	}
+}
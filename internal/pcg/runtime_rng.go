@@ -0,0 +1,56 @@
+package pcg
+
+import "math/rand"
+
+// RuntimeRNG is a deterministic random source for gameplay randomness
+// (hit effects, variance, loot rolls) whose complete state is a single
+// uint64, so it can be written into a save and restored to replay the
+// same outcomes after loading.
+type RuntimeRNG struct {
+	*rand.Rand
+	src *splitMix64
+}
+
+// NewRuntimeRNG creates a runtime RNG seeded from seed
+func NewRuntimeRNG(seed int64) *RuntimeRNG {
+	src := &splitMix64{state: uint64(seed)}
+	return &RuntimeRNG{
+		Rand: rand.New(src),
+		src:  src,
+	}
+}
+
+// State returns the generator state for saving
+func (r *RuntimeRNG) State() uint64 {
+	return r.src.state
+}
+
+// SetState restores a state previously returned by State
+func (r *RuntimeRNG) SetState(state uint64) {
+	r.src.state = state
+}
+
+// splitMix64 is a rand.Source64 whose state is one counter. Unlike the
+// standard library source its state can be read back and restored.
+type splitMix64 struct {
+	state uint64
+}
+
+// Uint64 returns the next value in the sequence
+func (s *splitMix64) Uint64() uint64 {
+	s.state += 0x9E3779B97F4A7C15
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+// Int63 implements rand.Source
+func (s *splitMix64) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// Seed implements rand.Source
+func (s *splitMix64) Seed(seed int64) {
+	s.state = uint64(seed)
+}
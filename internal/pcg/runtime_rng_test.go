@@ -0,0 +1,30 @@
+package pcg
+
+import "testing"
+
+func TestRuntimeRNG_Deterministic(t *testing.T) {
+	a := NewRuntimeRNG(42)
+	b := NewRuntimeRNG(42)
+	for i := 0; i < 100; i++ {
+		if x, y := a.Int63(), b.Int63(); x != y {
+			t.Fatalf("draw %d: %d != %d", i, x, y)
+		}
+	}
+}
+
+func TestRuntimeRNG_StateRestore(t *testing.T) {
+	rng := NewRuntimeRNG(7)
+	rng.Intn(10)
+	state := rng.State()
+
+	first := []float64{rng.Float64(), rng.Float64(), float64(rng.Intn(1000))}
+
+	rng.SetState(state)
+	second := []float64{rng.Float64(), rng.Float64(), float64(rng.Intn(1000))}
+
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("draw %d after restore = %v, want %v", i, second[i], first[i])
+		}
+	}
+}
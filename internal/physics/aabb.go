@@ -0,0 +1,34 @@
+package physics
+
+// AABBOverlap reports whether two axis-aligned boxes, each given by its
+// top-left corner and size, overlap. Boxes that only touch along an edge or
+// corner do not.
+func AABBOverlap(x1, y1, w1, h1, x2, y2, w2, h2 float64) bool {
+	return x1 < x2+w2 &&
+		x1+w1 > x2 &&
+		y1 < y2+h2 &&
+		y1+h1 > y2
+}
+
+// AABBPenetration returns how far the first box must move along each axis
+// to stop overlapping the second, ending up touching it. Each push points
+// away from the second box's center, going left (or up) when the centers
+// line up. Both are zero when the boxes do not overlap; callers usually
+// resolve along the axis with the smaller push.
+func AABBPenetration(x1, y1, w1, h1, x2, y2, w2, h2 float64) (dx, dy float64) {
+	if !AABBOverlap(x1, y1, w1, h1, x2, y2, w2, h2) {
+		return 0, 0
+	}
+	// Measured to the far edge, so a box inside the other is pushed fully out
+	if x1+w1/2 <= x2+w2/2 {
+		dx = x2 - (x1 + w1)
+	} else {
+		dx = x2 + w2 - x1
+	}
+	if y1+h1/2 <= y2+h2/2 {
+		dy = y2 - (y1 + h1)
+	} else {
+		dy = y2 + h2 - y1
+	}
+	return dx, dy
+}
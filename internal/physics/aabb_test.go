@@ -0,0 +1,89 @@
+package physics
+
+import "testing"
+
+// inlineOverlap is the check the call sites used to repeat inline
+func inlineOverlap(x1, y1, w1, h1, x2, y2, w2, h2 float64) bool {
+	return x1 < x2+w2 && x1+w1 > x2 && y1 < y2+h2 && y1+h1 > y2
+}
+
+func TestAABBOverlap(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b [4]float64
+		want bool
+	}{
+		{"partial overlap", [4]float64{0, 0, 10, 10}, [4]float64{5, 5, 10, 10}, true},
+		{"identical", [4]float64{0, 0, 10, 10}, [4]float64{0, 0, 10, 10}, true},
+		{"a contains b", [4]float64{0, 0, 20, 20}, [4]float64{5, 5, 2, 2}, true},
+		{"b contains a", [4]float64{5, 5, 2, 2}, [4]float64{0, 0, 20, 20}, true},
+		{"cross shape", [4]float64{4, 0, 2, 10}, [4]float64{0, 4, 10, 2}, true},
+		{"touching right edge", [4]float64{0, 0, 10, 10}, [4]float64{10, 0, 10, 10}, false},
+		{"touching left edge", [4]float64{10, 0, 10, 10}, [4]float64{0, 0, 10, 10}, false},
+		{"touching bottom edge", [4]float64{0, 0, 10, 10}, [4]float64{0, 10, 10, 10}, false},
+		{"touching top edge", [4]float64{0, 10, 10, 10}, [4]float64{0, 0, 10, 10}, false},
+		{"touching corner", [4]float64{0, 0, 10, 10}, [4]float64{10, 10, 10, 10}, false},
+		{"apart horizontally", [4]float64{0, 0, 10, 10}, [4]float64{30, 0, 10, 10}, false},
+		{"apart vertically", [4]float64{0, 0, 10, 10}, [4]float64{0, 30, 10, 10}, false},
+		{"overlap x only", [4]float64{0, 0, 10, 10}, [4]float64{5, 20, 10, 10}, false},
+		{"overlap y only", [4]float64{0, 0, 10, 10}, [4]float64{20, 5, 10, 10}, false},
+		{"fractional sliver", [4]float64{0, 0, 10, 10}, [4]float64{9.5, 9.5, 10, 10}, true},
+		{"zero-size inside", [4]float64{5, 5, 0, 0}, [4]float64{0, 0, 10, 10}, true},
+		{"negative coordinates", [4]float64{-10, -10, 8, 8}, [4]float64{-5, -5, 8, 8}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := tt.a, tt.b
+			got := AABBOverlap(a[0], a[1], a[2], a[3], b[0], b[1], b[2], b[3])
+			if got != tt.want {
+				t.Errorf("AABBOverlap(%v, %v) = %v, want %v", a, b, got, tt.want)
+			}
+			if inline := inlineOverlap(a[0], a[1], a[2], a[3], b[0], b[1], b[2], b[3]); got != inline {
+				t.Errorf("AABBOverlap(%v, %v) = %v, inline check gave %v", a, b, got, inline)
+			}
+			if reversed := AABBOverlap(b[0], b[1], b[2], b[3], a[0], a[1], a[2], a[3]); reversed != got {
+				t.Errorf("AABBOverlap is not symmetric for %v and %v", a, b)
+			}
+			if body := CheckCollision(AABB{a[0], a[1], a[2], a[3]}, AABB{b[0], b[1], b[2], b[3]}); body != got {
+				t.Errorf("CheckCollision(%v, %v) = %v, want %v", a, b, body, got)
+			}
+		})
+	}
+}
+
+func TestAABBPenetration(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   [4]float64
+		dx, dy float64
+	}{
+		{"a left of and above b", [4]float64{0, 0, 10, 10}, [4]float64{6, 8, 10, 10}, -4, -2},
+		{"a right of and below b", [4]float64{6, 8, 10, 10}, [4]float64{0, 0, 10, 10}, 4, 2},
+		{"centers aligned push left and up", [4]float64{0, 0, 10, 10}, [4]float64{0, 0, 10, 10}, -10, -10},
+		{"a contains b", [4]float64{0, 0, 20, 20}, [4]float64{12, 2, 4, 4}, -8, 6},
+		{"b contains a", [4]float64{12, 2, 4, 4}, [4]float64{0, 0, 20, 20}, 8, -6},
+		{"touching edge", [4]float64{0, 0, 10, 10}, [4]float64{10, 0, 10, 10}, 0, 0},
+		{"apart", [4]float64{0, 0, 10, 10}, [4]float64{50, 50, 10, 10}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := tt.a, tt.b
+			dx, dy := AABBPenetration(a[0], a[1], a[2], a[3], b[0], b[1], b[2], b[3])
+			if dx != tt.dx || dy != tt.dy {
+				t.Fatalf("AABBPenetration(%v, %v) = (%v, %v), want (%v, %v)", a, b, dx, dy, tt.dx, tt.dy)
+			}
+			if dx == 0 {
+				return
+			}
+			// Either push alone separates the boxes, leaving them touching
+			if AABBOverlap(a[0]+dx, a[1], a[2], a[3], b[0], b[1], b[2], b[3]) {
+				t.Errorf("moving by dx=%v still overlaps", dx)
+			}
+			if AABBOverlap(a[0], a[1]+dy, a[2], a[3], b[0], b[1], b[2], b[3]) {
+				t.Errorf("moving by dy=%v still overlaps", dy)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package physics
+
+import "github.com/opd-ai/vania/internal/world"
+
+// CrouchHeight is the body's height while crouched (pixels): below
+// world.CrawlSpaceHeight so a crouched body fits through crawl spaces
+const CrouchHeight = 16.0
+
+// Crouch lowers the body to CrouchHeight, keeping its feet where they are:
+// on the ceiling when gravity is inverted
+func (b *Body) Crouch() {
+	if b.Crouched {
+		return
+	}
+	b.standHeight = b.Position.Height
+	if !b.InvertedGravity {
+		b.Position.Y += b.Position.Height - CrouchHeight
+	}
+	b.Position.Height = CrouchHeight
+	b.Crouched = true
+}
+
+// StandUp restores a crouched body to full height if nothing is overhead.
+// It reports whether the body is standing; a body under a low ceiling
+// stays crouched.
+func (b *Body) StandUp(platforms []world.Platform) bool {
+	if !b.Crouched {
+		return true
+	}
+	standing := b.Position
+	if !b.InvertedGravity {
+		standing.Y -= b.standHeight - b.Position.Height
+	}
+	standing.Height = b.standHeight
+	if !ledgeClear(standing, platforms) {
+		return false
+	}
+	b.Position = standing
+	b.Crouched = false
+	return true
+}
@@ -0,0 +1,81 @@
+package physics
+
+import (
+	"testing"
+
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// crawlSpacePlatforms is a floor at y=600 with a slab leaving a
+// world.CrawlSpaceHeight gap above it between x=200 and x=400
+func crawlSpacePlatforms() []world.Platform {
+	return []world.Platform{
+		{X: 0, Y: 600, Width: 960, Height: 40},
+		{X: 200, Y: 600 - world.CrawlSpaceHeight - 32, Width: 200, Height: 32},
+	}
+}
+
+// TestCrouchReducesHeight verifies crouching lowers the top of the body to
+// CrouchHeight while the feet stay put, and standing restores it
+func TestCrouchReducesHeight(t *testing.T) {
+	if CrouchHeight >= world.CrawlSpaceHeight || world.CrawlSpaceHeight >= PlayerHeight {
+		t.Fatalf("crouch height %v must fit under a crawl space of %d that a %d-high player cannot",
+			CrouchHeight, world.CrawlSpaceHeight, PlayerHeight)
+	}
+
+	body := NewBody(100, 600-PlayerHeight, PlayerWidth, PlayerHeight)
+	body.Crouch()
+	if !body.Crouched || body.Position.Height != CrouchHeight {
+		t.Fatalf("crouched height = %v, want %v", body.Position.Height, CrouchHeight)
+	}
+	if feet := body.Position.Y + body.Position.Height; feet != 600 {
+		t.Errorf("crouching moved the feet to %v, want 600", feet)
+	}
+
+	if !body.StandUp(crawlSpacePlatforms()) {
+		t.Fatal("body in the open could not stand up")
+	}
+	if body.Crouched || body.Position.Height != PlayerHeight || body.Position.Y != 600-PlayerHeight {
+		t.Errorf("stood up to y=%v height=%v, want y=%v height=%v",
+			body.Position.Y, body.Position.Height, 600-PlayerHeight, PlayerHeight)
+	}
+}
+
+// walkRight moves the body right for the given frames and returns its x
+func walkRight(body *Body, platforms []world.Platform, frames int) float64 {
+	for i := 0; i < frames; i++ {
+		body.MoveHorizontal(1)
+		body.ApplyGravity(false)
+		body.Update()
+		body.ResolveCollisionWithPlatforms(platforms)
+	}
+	return body.Position.X
+}
+
+// TestCrouchPassesCrawlSpace verifies a crawl space stops a standing body
+// but lets a crouched one through, and that the body cannot stand up under
+// the slab
+func TestCrouchPassesCrawlSpace(t *testing.T) {
+	platforms := crawlSpacePlatforms()
+
+	standing := NewBody(100, 600-PlayerHeight, PlayerWidth, PlayerHeight)
+	if x := walkRight(standing, platforms, 60); x+PlayerWidth > 200 {
+		t.Errorf("standing body reached x=%v, into the crawl space", x)
+	}
+
+	crouched := NewBody(100, 600-PlayerHeight, PlayerWidth, PlayerHeight)
+	crouched.Crouch()
+	walkRight(crouched, platforms, 30)
+	if crouched.Position.X <= 200 {
+		t.Fatalf("crouched body stopped at x=%v, want it inside the crawl space", crouched.Position.X)
+	}
+	if crouched.StandUp(platforms) {
+		t.Error("body stood up under the crawl space slab")
+	}
+	if x := walkRight(crouched, platforms, 60); x < 400 {
+		t.Errorf("crouched body stopped at x=%v, want it through the crawl space", x)
+	}
+	if !crouched.StandUp(platforms) {
+		t.Error("body could not stand up after leaving the crawl space")
+	}
+}
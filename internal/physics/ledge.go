@@ -0,0 +1,131 @@
+package physics
+
+import "github.com/opd-ai/vania/internal/world"
+
+const (
+	// LedgeGrabWindow is how far below the top of the body a platform top may
+	// be and still be caught (pixels). Larger values make grabs more forgiving.
+	LedgeGrabWindow = 12.0
+
+	// LedgeReach is the horizontal gap allowed between the body and a
+	// platform side for a grab to register (pixels).
+	LedgeReach = 2.0
+
+	// LedgeRegrabFrames is the delay after dropping from a ledge before the
+	// body can grab again, so a drop does not immediately re-catch the edge.
+	LedgeRegrabFrames = 15
+)
+
+// LedgeState tracks a body's hold on a platform edge
+type LedgeState struct {
+	Enabled     bool    // Whether the body can grab ledges at all
+	Grabbing    bool    // Currently hanging from a ledge
+	X, Y        float64 // The grabbed corner: platform side and top
+	Side        int     // -1 when the ledge is on the left, 1 on the right
+	regrabTimer int
+}
+
+// checkLedgeGrab catches the body on a platform edge when it falls past one
+// with its top within LedgeGrabWindow of the platform top. The space above
+// the edge must be clear so the body can climb onto it.
+func (b *Body) checkLedgeGrab(platforms []world.Platform) {
+	if b.Ledge.regrabTimer > 0 {
+		b.Ledge.regrabTimer--
+		return
+	}
+	if !b.Ledge.Enabled || b.Ledge.Grabbing || b.OnGround || b.Grappling || b.Velocity.Y < 0 || b.InvertedGravity {
+		return
+	}
+
+	for _, platform := range platforms {
+		top := float64(platform.Y)
+		if top < b.Position.Y || top > b.Position.Y+LedgeGrabWindow {
+			continue
+		}
+
+		left := float64(platform.X)
+		right := left + float64(platform.Width)
+		var side int
+		var edgeX float64
+		switch {
+		case b.Position.X+b.Position.Width <= left && left-(b.Position.X+b.Position.Width) <= LedgeReach:
+			side, edgeX = 1, left
+		case b.Position.X >= right && b.Position.X-right <= LedgeReach:
+			side, edgeX = -1, right
+		default:
+			continue
+		}
+
+		if !ledgeClear(b.climbTarget(edgeX, top, side), platforms) {
+			continue
+		}
+
+		b.Ledge.Grabbing = true
+		b.Ledge.X = edgeX
+		b.Ledge.Y = top
+		b.Ledge.Side = side
+		b.Position.Y = top
+		if side > 0 {
+			b.Position.X = edgeX - b.Position.Width
+		} else {
+			b.Position.X = edgeX
+		}
+		b.Velocity = Vector2D{}
+		b.OnWall = false
+		b.WallSide = 0
+		return
+	}
+}
+
+// climbTarget returns where the body ends up after climbing the ledge at
+// (edgeX, top) on side
+func (b *Body) climbTarget(edgeX, top float64, side int) AABB {
+	target := AABB{Y: top - b.Position.Height, Width: b.Position.Width, Height: b.Position.Height}
+	if side > 0 {
+		target.X = edgeX
+	} else {
+		target.X = edgeX - b.Position.Width
+	}
+	return target
+}
+
+// ledgeClear reports whether area overlaps none of the platforms
+func ledgeClear(area AABB, platforms []world.Platform) bool {
+	for _, platform := range platforms {
+		p := AABB{
+			X:      float64(platform.X),
+			Y:      float64(platform.Y),
+			Width:  float64(platform.Width),
+			Height: float64(platform.Height),
+		}
+		if CheckCollision(area, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// ClimbLedge pulls a hanging body up onto the ledge it holds. It reports
+// whether the body was hanging.
+func (b *Body) ClimbLedge() bool {
+	if !b.Ledge.Grabbing {
+		return false
+	}
+	target := b.climbTarget(b.Ledge.X, b.Ledge.Y, b.Ledge.Side)
+	b.Position.X = target.X
+	b.Position.Y = target.Y
+	b.Velocity = Vector2D{}
+	b.Ledge.Grabbing = false
+	b.OnGround = true
+	b.FramesSinceGrounded = 0
+	return true
+}
+
+// DropLedge lets go of the ledge the body holds, if any
+func (b *Body) DropLedge() {
+	if !b.Ledge.Grabbing {
+		return
+	}
+	b.Ledge.Grabbing = false
+	b.Ledge.regrabTimer = LedgeRegrabFrames
+}
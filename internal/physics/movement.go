@@ -0,0 +1,89 @@
+package physics
+
+// MovementMode selects how horizontal input turns into velocity
+type MovementMode int
+
+const (
+	// InstantMovement sets full speed the moment a direction is held
+	InstantMovement MovementMode = iota
+	// AcceleratedMovement ramps speed up over several frames
+	AcceleratedMovement
+)
+
+// MovementConfig holds the horizontal movement tunables for a body
+type MovementConfig struct {
+	Mode MovementMode
+	// MaxSpeed is the top running speed (units/frame).
+	MaxSpeed float64
+	// GroundAccel is the speed gained per frame on the ground when
+	// accelerating.
+	GroundAccel float64
+	// AirAccel is the speed gained per frame in the air when accelerating.
+	AirAccel float64
+
+	// Air control is tuned apart from the ground so jump arcs can be made
+	// more committed. AirMaxSpeed caps steering speed in the air (0 uses
+	// MaxSpeed). GroundFriction and AirDrag scale velocity each frame
+	// while no direction is held.
+	AirMaxSpeed    float64
+	GroundFriction float64
+	AirDrag        float64
+}
+
+// DefaultMovementConfig returns the snappy instant movement bodies start with
+func DefaultMovementConfig() MovementConfig {
+	return MovementConfig{
+		Mode:           InstantMovement,
+		MaxSpeed:       PlayerSpeed,
+		GroundAccel:    PlayerSpeed,
+		AirAccel:       PlayerSpeed,
+		GroundFriction: 0.8,
+		AirDrag:        0.95,
+	}
+}
+
+// AcceleratedMovementConfig returns movement that reaches full speed in
+// about eight frames on the ground and twice that in the air
+func AcceleratedMovementConfig() MovementConfig {
+	return MovementConfig{
+		Mode:           AcceleratedMovement,
+		MaxSpeed:       PlayerSpeed,
+		GroundAccel:    PlayerSpeed / 8,
+		AirAccel:       PlayerSpeed / 16,
+		GroundFriction: 0.8,
+		AirDrag:        0.95,
+	}
+}
+
+// Accel returns the per-frame acceleration for the grounded state
+func (mc MovementConfig) Accel(onGround bool) float64 {
+	if onGround {
+		return mc.GroundAccel
+	}
+	return mc.AirAccel
+}
+
+// TopSpeed returns the maximum steering speed for the grounded state
+func (mc MovementConfig) TopSpeed(onGround bool) float64 {
+	if !onGround && mc.AirMaxSpeed > 0 {
+		return mc.AirMaxSpeed
+	}
+	return mc.MaxSpeed
+}
+
+// Damping returns the velocity multiplier applied per frame with no input
+// for the grounded state
+func (mc MovementConfig) Damping(onGround bool) float64 {
+	if onGround {
+		return mc.GroundFriction
+	}
+	return mc.AirDrag
+}
+
+// approach moves current toward target by at most step
+func approach(current, target, step float64) float64 {
+	if current < target {
+		return min(current+step, target)
+	}
+	return max(current-step, target)
+}
@@ -72,6 +72,11 @@ type Body struct {
 	GrappleLength       float64
 	GrappleAngle        float64
 	GrappleAngularVel   float64
+	Movement            MovementConfig // Horizontal movement feel
+	Ledge               LedgeState     // Ledge grab state; grabbing is off unless Ledge.Enabled
+	Crouched            bool           // Lowered to CrouchHeight; see Crouch and StandUp
+	InvertedGravity     bool           // Gravity pulls up: the body falls to and stands on ceilings
+	standHeight         float64        // Full height to restore when standing up
 }
 
 // Vector2D represents a 2D vector
@@ -94,27 +99,44 @@ func NewBody(x, y, width, height float64) *Body {
 		WallSide:            0,
 		FramesSinceGrounded: 0,
 		JumpBufferTimer:     0,
+		Movement:            DefaultMovementConfig(),
 	}
 }
 
 // ApplyGravity applies gravity to the body with wall-slide and glide support.
 // When gliding is active, fall speed is capped at GlideFallSpeed.
 func (b *Body) ApplyGravity(gliding bool) {
-	if !b.OnGround && !b.Grappling {
-		b.Velocity.Y += Gravity
+	if !b.OnGround && !b.Grappling && !b.Ledge.Grabbing {
+		down := b.GravitySign()
+		b.Velocity.Y += Gravity * down
 
 		// Glide: very slow fall speed when gliding
-		if gliding && b.Velocity.Y > GlideFallSpeed {
-			b.Velocity.Y = GlideFallSpeed
-		} else if b.OnWall && b.Velocity.Y > WallSlideSpeed {
+		fall := b.Velocity.Y * down
+		if gliding && fall > GlideFallSpeed {
+			b.Velocity.Y = GlideFallSpeed * down
+		} else if b.OnWall && fall > WallSlideSpeed {
 			// Wall-slide: slow fall speed when sliding down a wall
-			b.Velocity.Y = WallSlideSpeed
-		} else if b.Velocity.Y > MaxFallSpeed {
-			b.Velocity.Y = MaxFallSpeed
+			b.Velocity.Y = WallSlideSpeed * down
+		} else if fall > MaxFallSpeed {
+			b.Velocity.Y = MaxFallSpeed * down
 		}
 	}
 }
 
+// GravitySign returns 1 when gravity pulls the body down the screen and -1
+// when it is inverted
+func (b *Body) GravitySign() float64 {
+	if b.InvertedGravity {
+		return -1
+	}
+	return 1
+}
+
+// Falling reports whether the body is moving the way gravity pulls
+func (b *Body) Falling() bool {
+	return b.Velocity.Y*b.GravitySign() > 0
+}
+
 // Update updates the body position
 func (b *Body) Update() {
 	b.Position.X += b.Velocity.X
@@ -123,13 +145,12 @@ func (b *Body) Update() {
 
 // CheckCollision checks if two AABBs collide
 func CheckCollision(a, b AABB) bool {
-	return a.X < b.X+b.Width &&
-		a.X+a.Width > b.X &&
-		a.Y < b.Y+b.Height &&
-		a.Y+a.Height > b.Y
+	return AABBOverlap(a.X, a.Y, a.Width, a.Height, b.X, b.Y, b.Width, b.Height)
 }
 
-// ResolveCollisionWithPlatforms checks and resolves collisions with platforms
+// ResolveCollisionWithPlatforms checks and resolves collisions with
+// platforms. The body stands on a platform's top, or under inverted gravity
+// on its underside.
 func (b *Body) ResolveCollisionWithPlatforms(platforms []world.Platform) {
 	// Store previous state for coyote-time tracking
 	wasOnGround := b.OnGround
@@ -154,11 +175,12 @@ func (b *Body) ResolveCollisionWithPlatforms(platforms []world.Platform) {
 				// Collision from top
 				b.Position.Y = platformAABB.Y - b.Position.Height
 				b.Velocity.Y = 0
-				b.OnGround = true
+				b.OnGround = b.OnGround || !b.InvertedGravity
 			} else if b.Velocity.Y < 0 && b.Position.Y-b.Velocity.Y >= platformAABB.Y+platformAABB.Height {
 				// Collision from bottom
 				b.Position.Y = platformAABB.Y + platformAABB.Height
 				b.Velocity.Y = 0
+				b.OnGround = b.OnGround || b.InvertedGravity
 			} else if b.Velocity.X > 0 {
 				// Collision from left
 				b.Position.X = platformAABB.X - b.Position.Width
@@ -175,15 +197,17 @@ func (b *Body) ResolveCollisionWithPlatforms(platforms []world.Platform) {
 		}
 	}
 
-	// Check screen boundaries (floor at bottom)
+	// Check screen boundaries (floor at bottom, or at the top when
+	// gravity is inverted)
 	if b.Position.Y+b.Position.Height >= 640 {
 		b.Position.Y = 640 - b.Position.Height
 		b.Velocity.Y = 0
+		b.OnGround = b.OnGround || !b.InvertedGravity
+	}
+	if b.InvertedGravity && b.Position.Y <= 0 {
+		b.Position.Y = 0
+		b.Velocity.Y = 0
 		b.OnGround = true
-		// Detach grapple on ground contact
-		if b.Grappling {
-			b.ReleaseGrapple()
-		}
 	}
 
 	// Keep player on screen (left/right boundaries)
@@ -196,12 +220,14 @@ func (b *Body) ResolveCollisionWithPlatforms(platforms []world.Platform) {
 		b.Velocity.X = 0
 	}
 
+	b.checkLedgeGrab(platforms)
+
 	// Update coyote-time tracking
 	if b.OnGround {
 		b.FramesSinceGrounded = 0
 		// Execute buffered jump if any
 		if b.JumpBufferTimer > 0 {
-			b.Velocity.Y = PlayerJumpSpeed
+			b.Velocity.Y = PlayerJumpSpeed * b.GravitySign()
 			b.JumpBufferTimer = 0
 		}
 		// Detach grapple on landing
@@ -229,33 +255,40 @@ func (b *Body) ResolveCollisionWithPlatforms(platforms []world.Platform) {
 
 // MoveHorizontal applies horizontal movement
 func (b *Body) MoveHorizontal(direction float64) {
-	b.Velocity.X = direction * PlayerSpeed
+	b.MoveHorizontalScaled(direction, 1.0)
 }
 
 // MoveHorizontalScaled moves the body with a speed multiplier applied on top of
-// PlayerSpeed.  A multiplier of 1.0 is identical to MoveHorizontal; values below
-// 1.0 slow the body (e.g., status Freeze/Slow) and above 1.0 speed it up (Haste).
+// the configured max speed.  A multiplier of 1.0 is identical to MoveHorizontal;
+// values below 1.0 slow the body (e.g., status Freeze/Slow) and above 1.0 speed
+// it up (Haste). In accelerated mode the velocity ramps toward that speed.
 func (b *Body) MoveHorizontalScaled(direction, multiplier float64) {
-	b.Velocity.X = direction * PlayerSpeed * multiplier
+	target := direction * b.Movement.TopSpeed(b.OnGround) * multiplier
+	if b.Movement.Mode == InstantMovement {
+		b.Velocity.X = target
+		return
+	}
+	b.Velocity.X = approach(b.Velocity.X, target, b.Movement.Accel(b.OnGround)*multiplier)
 }
 
 // Jump makes the body jump if on ground, in coyote-time window, or wall.
 // Returns true if jump was executed.
 func (b *Body) Jump(hasDoubleJump bool, doubleJumpUsed *bool) bool {
 	// Ground jump or coyote-time jump
+	jumpSpeed := PlayerJumpSpeed * b.GravitySign()
 	if b.OnGround || b.FramesSinceGrounded <= CoyoteFrames {
-		b.Velocity.Y = PlayerJumpSpeed
+		b.Velocity.Y = jumpSpeed
 		*doubleJumpUsed = false
 		b.JumpBufferTimer = 0 // Consume buffered jump
 		return true
 	} else if hasDoubleJump && !*doubleJumpUsed {
-		b.Velocity.Y = PlayerJumpSpeed
+		b.Velocity.Y = jumpSpeed
 		*doubleJumpUsed = true
 		b.JumpBufferTimer = 0
 		return true
 	} else if b.OnWall {
 		// Wall jump
-		b.Velocity.Y = PlayerJumpSpeed
+		b.Velocity.Y = jumpSpeed
 		b.Velocity.X = float64(-b.WallSide) * PlayerSpeed * 1.5
 		b.JumpBufferTimer = 0
 		return true
@@ -270,12 +303,12 @@ func (b *Body) BufferJump() {
 }
 
 // ReleaseJump applies variable-height jump mechanics.
-// When called during upward movement (negative Y velocity), it reduces
-// the jump height by damping the velocity. This allows for short-hop jumps
-// when the jump button is released early.
+// When called while rising against gravity, it reduces the jump height by
+// damping the velocity. This allows for short-hop jumps when the jump
+// button is released early.
 func (b *Body) ReleaseJump() {
-	// Only apply damping when moving upward (negative Y velocity)
-	if b.Velocity.Y < 0 {
+	// Only apply damping while rising against gravity
+	if b.Velocity.Y*b.GravitySign() < 0 {
 		b.Velocity.Y *= JumpReleaseDamping
 	}
 }
@@ -289,15 +322,11 @@ func (b *Body) Dash(direction float64) {
 
 // ApplyFriction applies friction to horizontal movement
 func (b *Body) ApplyFriction() {
-	if b.OnGround {
-		b.Velocity.X *= 0.8
-		// Stop if moving very slowly
-		if b.Velocity.X > -0.1 && b.Velocity.X < 0.1 {
-			b.Velocity.X = 0
-		}
-	} else {
-		// Air resistance
-		b.Velocity.X *= 0.95
+	// Ground friction or air resistance
+	b.Velocity.X *= b.Movement.Damping(b.OnGround)
+	// Stop if moving very slowly on the ground
+	if b.OnGround && b.Velocity.X > -0.1 && b.Velocity.X < 0.1 {
+		b.Velocity.X = 0
 	}
 }
 
@@ -492,8 +521,5 @@ func (ps *PhysicsSystem) HazardConfig() GenreHazardConfig {
 // OverlapsHazard returns true when the given AABB overlaps the provided hazard
 // zone rectangle.  This is a pure AABB test with no side effects.
 func (ps *PhysicsSystem) OverlapsHazard(body AABB, hazardX, hazardY, hazardW, hazardH float64) bool {
-	return body.X < hazardX+hazardW &&
-		body.X+body.Width > hazardX &&
-		body.Y < hazardY+hazardH &&
-		body.Y+body.Height > hazardY
+	return AABBOverlap(body.X, body.Y, body.Width, body.Height, hazardX, hazardY, hazardW, hazardH)
 }
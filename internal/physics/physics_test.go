@@ -1,6 +1,7 @@
 package physics
 
 import (
+	"math"
 	"testing"
 
 	"github.com/opd-ai/vania/internal/world"
@@ -751,3 +752,230 @@ func TestJumpConsumesBuffer(t *testing.T) {
 		t.Error("Jump should consume buffer timer")
 	}
 }
+
+func TestAcceleratedMovementRampsUp(t *testing.T) {
+	body := NewBody(100, 100, 32, 32)
+	body.Movement = AcceleratedMovementConfig()
+	body.OnGround = true
+
+	body.MoveHorizontal(1.0)
+	if body.Velocity.X <= 0 || body.Velocity.X >= PlayerSpeed {
+		t.Fatalf("First frame should start moving without reaching max speed, got %f", body.Velocity.X)
+	}
+
+	prev := body.Velocity.X
+	frames := 1
+	for body.Velocity.X < PlayerSpeed {
+		body.MoveHorizontal(1.0)
+		if body.Velocity.X <= prev {
+			t.Fatalf("Speed should keep rising, went from %f to %f", prev, body.Velocity.X)
+		}
+		prev = body.Velocity.X
+		frames++
+		if frames > 60 {
+			t.Fatal("Never reached max speed")
+		}
+	}
+	if frames != 8 {
+		t.Errorf("Expected 8 frames to reach max speed on the ground, took %d", frames)
+	}
+
+	// Holding on never overshoots
+	body.MoveHorizontal(1.0)
+	if body.Velocity.X != PlayerSpeed {
+		t.Errorf("Speed should hold at max, got %f", body.Velocity.X)
+	}
+}
+
+func TestAcceleratedMovementIsSlowerInAir(t *testing.T) {
+	ground := NewBody(100, 100, 32, 32)
+	ground.Movement = AcceleratedMovementConfig()
+	ground.OnGround = true
+	air := NewBody(100, 100, 32, 32)
+	air.Movement = AcceleratedMovementConfig()
+
+	ground.MoveHorizontal(1.0)
+	air.MoveHorizontal(1.0)
+	if air.Velocity.X >= ground.Velocity.X {
+		t.Errorf("Air acceleration (%f) should be below ground acceleration (%f)", air.Velocity.X, ground.Velocity.X)
+	}
+}
+
+func TestInstantMovementReachesMaxSpeedImmediately(t *testing.T) {
+	body := NewBody(100, 100, 32, 32)
+	body.Movement = DefaultMovementConfig()
+	body.Movement.MaxSpeed = 6.0
+
+	body.MoveHorizontal(-1.0)
+	if body.Velocity.X != -6.0 {
+		t.Errorf("Instant mode should reach max speed at once, got %f", body.Velocity.X)
+	}
+}
+
+func TestAirControlUsesAirParameters(t *testing.T) {
+	config := MovementConfig{
+		Mode:           AcceleratedMovement,
+		MaxSpeed:       4.0,
+		GroundAccel:    1.0,
+		AirAccel:       0.25,
+		AirMaxSpeed:    3.0,
+		GroundFriction: 0.8,
+		AirDrag:        0.95,
+	}
+	if config.Accel(true) == config.Accel(false) {
+		t.Fatal("Air and ground acceleration should differ")
+	}
+
+	body := NewBody(100, 100, 32, 32)
+	body.Movement = config
+
+	// Grounded: ground acceleration
+	body.OnGround = true
+	body.MoveHorizontal(1.0)
+	if body.Velocity.X != 1.0 {
+		t.Errorf("Grounded body should gain GroundAccel, got %f", body.Velocity.X)
+	}
+
+	// Airborne: air acceleration from the same starting speed
+	body.OnGround = false
+	body.MoveHorizontal(1.0)
+	if body.Velocity.X != 1.25 {
+		t.Errorf("Airborne body should gain AirAccel, got %f", body.Velocity.X)
+	}
+
+	// Air steering is capped at AirMaxSpeed
+	for i := 0; i < 100; i++ {
+		body.MoveHorizontal(1.0)
+	}
+	if body.Velocity.X != 3.0 {
+		t.Errorf("Air speed should cap at AirMaxSpeed, got %f", body.Velocity.X)
+	}
+
+	// Letting go applies air drag rather than ground friction
+	body.ApplyFriction()
+	if math.Abs(body.Velocity.X-3.0*0.95) > 1e-9 {
+		t.Errorf("Airborne body should slow by AirDrag, got %f", body.Velocity.X)
+	}
+	body.OnGround = true
+	body.ApplyFriction()
+	if math.Abs(body.Velocity.X-3.0*0.95*0.8) > 1e-9 {
+		t.Errorf("Grounded body should slow by GroundFriction, got %f", body.Velocity.X)
+	}
+}
+
+func TestLedgeGrabWhileFallingPastEdge(t *testing.T) {
+	platforms := []world.Platform{{X: 200, Y: 300, Width: 128, Height: 32}}
+
+	// Falling just left of the platform, top of the body just above its top
+	body := NewBody(200-PlayerWidth+1, 300-4, PlayerWidth, PlayerHeight)
+	body.Ledge.Enabled = true
+	body.Velocity = Vector2D{X: 1, Y: 3}
+
+	body.Update()
+	body.ResolveCollisionWithPlatforms(platforms)
+
+	if !body.Ledge.Grabbing {
+		t.Fatalf("expected ledge grab, body at (%.1f, %.1f)", body.Position.X, body.Position.Y)
+	}
+	if body.Ledge.Side != 1 {
+		t.Errorf("expected ledge on the right, got side %d", body.Ledge.Side)
+	}
+	if body.Position.Y != 300 || body.Position.X != 200-PlayerWidth {
+		t.Errorf("expected hang at (%d, 300), got (%.1f, %.1f)", 200-PlayerWidth, body.Position.X, body.Position.Y)
+	}
+
+	// Hanging holds position against gravity
+	body.ApplyGravity(false)
+	if body.Velocity.Y != 0 {
+		t.Errorf("expected no gravity while hanging, got VelY %.2f", body.Velocity.Y)
+	}
+
+	if !body.ClimbLedge() {
+		t.Fatal("expected climb to succeed")
+	}
+	if body.Position.Y != 300-PlayerHeight || body.Position.X != 200 {
+		t.Errorf("expected body atop platform at (200, %d), got (%.1f, %.1f)", 300-PlayerHeight, body.Position.X, body.Position.Y)
+	}
+	if !body.OnGround || body.Ledge.Grabbing {
+		t.Error("expected body grounded and released after climbing")
+	}
+
+	// Standing on the platform after the climb
+	for i := 0; i < 4; i++ {
+		body.ApplyGravity(false)
+		body.Update()
+		body.ResolveCollisionWithPlatforms(platforms)
+		if body.Position.Y != 300-PlayerHeight {
+			t.Fatalf("frame %d: expected body to stay atop platform, got Y %.1f", i, body.Position.Y)
+		}
+	}
+}
+
+func TestLedgeGrabRequiresWindowAndAbility(t *testing.T) {
+	platforms := []world.Platform{{X: 200, Y: 300, Width: 128, Height: 32}}
+
+	tests := []struct {
+		name    string
+		enabled bool
+		y       float64
+	}{
+		{"disabled", false, 300 - 4},
+		{"below window", true, 300 - LedgeGrabWindow - 10},
+		{"past edge", true, 300 + 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := NewBody(200-PlayerWidth+1, tt.y-3, PlayerWidth, PlayerHeight)
+			body.Ledge.Enabled = tt.enabled
+			body.Velocity = Vector2D{X: 1, Y: 3}
+			body.Update()
+			body.ResolveCollisionWithPlatforms(platforms)
+			if body.Ledge.Grabbing {
+				t.Errorf("expected no grab, body at (%.1f, %.1f)", body.Position.X, body.Position.Y)
+			}
+		})
+	}
+}
+
+func TestDropLedgeDelaysRegrab(t *testing.T) {
+	platforms := []world.Platform{{X: 200, Y: 300, Width: 128, Height: 32}}
+	body := NewBody(200-PlayerWidth, 300, PlayerWidth, PlayerHeight)
+	body.Ledge.Enabled = true
+	body.ResolveCollisionWithPlatforms(platforms)
+	if !body.Ledge.Grabbing {
+		t.Fatal("expected grab at the ledge")
+	}
+
+	body.DropLedge()
+	body.ResolveCollisionWithPlatforms(platforms)
+	if body.Ledge.Grabbing {
+		t.Error("expected drop to prevent an immediate regrab")
+	}
+}
+
+func TestInvertedGravityFallsUpOntoCeilingPlatform(t *testing.T) {
+	body := NewBody(100, 300, 32, 32)
+	body.InvertedGravity = true
+	ceiling := []world.Platform{{X: 0, Y: 100, Width: 400, Height: 32}}
+
+	for frame := 0; frame < 120 && !body.OnGround; frame++ {
+		body.ApplyGravity(false)
+		if frame == 0 && body.Velocity.Y >= 0 {
+			t.Fatalf("velocity Y = %v after gravity, want upward", body.Velocity.Y)
+		}
+		body.Update()
+		body.ResolveCollisionWithPlatforms(ceiling)
+	}
+
+	if !body.OnGround {
+		t.Fatal("body never landed under inverted gravity")
+	}
+	if body.Position.Y != 132 {
+		t.Errorf("body Y = %v, want 132 (standing on the platform's underside)", body.Position.Y)
+	}
+
+	doubleJumpUsed := false
+	if !body.Jump(false, &doubleJumpUsed) || body.Velocity.Y <= 0 {
+		t.Errorf("jump from a ceiling gave velocity Y = %v, want downward", body.Velocity.Y)
+	}
+}
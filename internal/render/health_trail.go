@@ -0,0 +1,48 @@
+package render
+
+// HealthTrailFrames is how many frames a health bar's damage trail takes to
+// drain down to the new health value
+const HealthTrailFrames = 30
+
+// HealthTrail tracks the health value a bar displays behind its fill. After
+// a hit the trail starts at the old value and drains to the actual value
+// over HealthTrailFrames, drawn as a lighter secondary fill. Healing is
+// shown immediately.
+type HealthTrail struct {
+	displayed float64
+	from      float64
+	to        float64
+	frame     int
+}
+
+// NewHealthTrail creates a trail resting at health
+func NewHealthTrail(health int) *HealthTrail {
+	h := float64(health)
+	return &HealthTrail{displayed: h, from: h, to: h, frame: HealthTrailFrames}
+}
+
+// Update advances the trail one frame toward actual
+func (ht *HealthTrail) Update(actual int) {
+	target := float64(actual)
+	if target >= ht.displayed {
+		ht.displayed, ht.from, ht.to = target, target, target
+		ht.frame = HealthTrailFrames
+		return
+	}
+
+	// A new hit restarts the drain from wherever the trail currently is
+	if target != ht.to {
+		ht.from = ht.displayed
+		ht.to = target
+		ht.frame = 0
+	}
+	if ht.frame < HealthTrailFrames {
+		ht.frame++
+		ht.displayed = ht.from + (ht.to-ht.from)*float64(ht.frame)/HealthTrailFrames
+	}
+}
+
+// Displayed returns the health value the trail currently shows
+func (ht *HealthTrail) Displayed() float64 {
+	return ht.displayed
+}
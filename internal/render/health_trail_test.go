@@ -0,0 +1,55 @@
+package render
+
+import "testing"
+
+func TestHealthTrail_InterpolatesAfterDrop(t *testing.T) {
+	trail := NewHealthTrail(100)
+	trail.Update(60)
+
+	prev := trail.Displayed()
+	if prev >= 100 || prev <= 60 {
+		t.Fatalf("Displayed() = %v one frame after dropping to 60, want between 60 and 100", prev)
+	}
+
+	for frame := 2; frame <= HealthTrailFrames; frame++ {
+		trail.Update(60)
+		got := trail.Displayed()
+		if got >= prev {
+			t.Fatalf("frame %d: Displayed() = %v, want below previous %v", frame, got, prev)
+		}
+		prev = got
+	}
+	if prev != 60 {
+		t.Errorf("Displayed() = %v after %d frames, want 60", prev, HealthTrailFrames)
+	}
+
+	// Halfway through a drain the trail is halfway between old and new
+	trail = NewHealthTrail(100)
+	for i := 0; i < HealthTrailFrames/2; i++ {
+		trail.Update(40)
+	}
+	if got := trail.Displayed(); got != 70 {
+		t.Errorf("Displayed() halfway = %v, want 70", got)
+	}
+}
+
+func TestHealthTrail_HealSnaps(t *testing.T) {
+	trail := NewHealthTrail(50)
+	trail.Update(80)
+	if got := trail.Displayed(); got != 80 {
+		t.Errorf("Displayed() after heal = %v, want 80", got)
+	}
+}
+
+func TestHealthTrail_SecondHitRestartsFromTrail(t *testing.T) {
+	trail := NewHealthTrail(100)
+	for i := 0; i < 10; i++ {
+		trail.Update(70)
+	}
+	mid := trail.Displayed()
+
+	trail.Update(40)
+	if got := trail.Displayed(); got >= mid || got <= 40 {
+		t.Errorf("Displayed() after second hit = %v, want between 40 and %v", got, mid)
+	}
+}
@@ -0,0 +1,61 @@
+package render
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// HitboxKind is what a collision rectangle belongs to
+type HitboxKind int
+
+const (
+	PlayerHitbox HitboxKind = iota
+	EnemyHitbox
+	HazardHitbox
+	DoorHitbox
+)
+
+// Hitbox is a collision rectangle, in world pixels, to outline
+type Hitbox struct {
+	Kind       HitboxKind
+	X, Y, W, H float64
+}
+
+// hitboxColors outline each kind of hitbox in its own color
+var hitboxColors = map[HitboxKind]color.RGBA{
+	PlayerHitbox: {0, 255, 0, 255},   // Green
+	EnemyHitbox:  {255, 0, 0, 255},   // Red
+	HazardHitbox: {255, 200, 0, 255}, // Amber
+	DoorHitbox:   {0, 160, 255, 255}, // Blue
+}
+
+// HitboxColor returns the outline color for a kind of hitbox
+func HitboxColor(kind HitboxKind) color.RGBA {
+	return hitboxColors[kind]
+}
+
+// RenderHitboxes outlines collision rectangles, offset by the camera
+func (r *Renderer) RenderHitboxes(screen *ebiten.Image, hitboxes []Hitbox) {
+	if len(hitboxes) == 0 {
+		return
+	}
+	pixel := ebiten.NewImage(1, 1)
+	pixel.Fill(color.White)
+
+	edge := func(x, y, w, h float64, c color.RGBA) {
+		opts := &ebiten.DrawImageOptions{}
+		opts.GeoM.Scale(w, h)
+		opts.GeoM.Translate(x, y)
+		opts.ColorScale.ScaleWithColor(c)
+		screen.DrawImage(pixel, opts)
+	}
+	for _, hb := range hitboxes {
+		x, y := hb.X-r.camera.X, hb.Y-r.camera.Y
+		c := HitboxColor(hb.Kind)
+		edge(x, y, hb.W, 1, c)
+		edge(x, y+hb.H-1, hb.W, 1, c)
+		edge(x, y, 1, hb.H, c)
+		edge(x+hb.W-1, y, 1, hb.H, c)
+	}
+}
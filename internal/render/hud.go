@@ -0,0 +1,140 @@
+package render
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// HUDAnchor is the screen corner a HUD element is drawn in
+type HUDAnchor int
+
+const (
+	AnchorDefault HUDAnchor = iota // The element's usual corner
+	AnchorTopLeft
+	AnchorTopRight
+	AnchorBottomLeft
+	AnchorBottomRight
+)
+
+var hudAnchorNames = map[HUDAnchor]string{
+	AnchorDefault:     "",
+	AnchorTopLeft:     "top-left",
+	AnchorTopRight:    "top-right",
+	AnchorBottomLeft:  "bottom-left",
+	AnchorBottomRight: "bottom-right",
+}
+
+// String returns the anchor's settings name, "" for the default
+func (a HUDAnchor) String() string {
+	return hudAnchorNames[a]
+}
+
+// ParseHUDAnchor converts a settings name such as "bottom-right" into an
+// anchor. The empty name selects the element's default corner.
+func ParseHUDAnchor(name string) (HUDAnchor, error) {
+	for anchor, anchorName := range hudAnchorNames {
+		if anchorName == name {
+			return anchor, nil
+		}
+	}
+	return AnchorDefault, fmt.Errorf("unknown HUD anchor %q", name)
+}
+
+// HUDLayout chooses which HUD elements are drawn and where. The zero
+// layout shows everything in its usual place.
+type HUDLayout struct {
+	HideHealthBar    bool
+	HideAbilityIcons bool
+	HideDebugInfo    bool
+	HideMinimap      bool
+
+	HealthBar    HUDAnchor // Default top-left
+	AbilityIcons HUDAnchor // Default top-left, beside the health bar
+	DebugInfo    HUDAnchor // Default top-left, below the icons
+	Minimap      HUDAnchor // Default top-right
+}
+
+// SetHUDLayout changes which HUD elements are drawn and where
+func (r *Renderer) SetHUDLayout(layout HUDLayout) {
+	r.hud = layout
+}
+
+// HUDLayout returns the current HUD layout
+func (r *Renderer) HUDLayout() HUDLayout {
+	return r.hud
+}
+
+// resolve returns anchor, or def for the default anchor
+func (a HUDAnchor) resolve(def HUDAnchor) HUDAnchor {
+	if a == AnchorDefault {
+		return def
+	}
+	return a
+}
+
+// anchorPosition returns the top-left corner of a width×height element
+// placed in the anchor's screen corner, inset by UIMargin
+func anchorPosition(anchor HUDAnchor, width, height int) (x, y int) {
+	x, y = UIMargin, UIMargin
+	if anchor == AnchorTopRight || anchor == AnchorBottomRight {
+		x = ScreenWidth - UIMargin - width
+	}
+	if anchor == AnchorBottomLeft || anchor == AnchorBottomRight {
+		y = ScreenHeight - UIMargin - height
+	}
+	return x, y
+}
+
+// isBottom reports whether the anchor is one of the bottom corners
+func (a HUDAnchor) isBottom() bool {
+	return a == AnchorBottomLeft || a == AnchorBottomRight
+}
+
+// HealthBarPosition returns the top-left corner of the health bar
+func (r *Renderer) HealthBarPosition() (x, y int) {
+	return anchorPosition(r.hud.HealthBar.resolve(AnchorTopLeft), HealthBarWidth, HealthBarHeight)
+}
+
+// abilityIconsWidth returns the width of the row of ability icons
+func abilityIconsWidth() int {
+	return len(hudAbilityNames)*(AbilityIconSize+AbilityIconSpacing) - AbilityIconSpacing
+}
+
+// AbilityIconsPosition returns the top-left corner of the ability icon row.
+// Sharing a corner with the visible health bar, the row stacks beside it:
+// below the bar at the top of the screen, above it at the bottom.
+func (r *Renderer) AbilityIconsPosition() (x, y int) {
+	anchor := r.hud.AbilityIcons.resolve(AnchorTopLeft)
+	x, y = anchorPosition(anchor, abilityIconsWidth(), AbilityIconSize)
+	if r.hud.HideHealthBar || anchor != r.hud.HealthBar.resolve(AnchorTopLeft) {
+		return x, y
+	}
+	_, barY := r.HealthBarPosition()
+	if anchor.isBottom() {
+		return x, barY - UIMargin - AbilityIconSize
+	}
+	return x, barY + HealthBarHeight + UIMargin
+}
+
+// DebugInfoPosition returns the top-left corner of a debug text block of
+// the given size. In the top-left corner it sits below the health bar,
+// ability icons and message area.
+func (r *Renderer) DebugInfoPosition(width, height int) (x, y int) {
+	anchor := r.hud.DebugInfo.resolve(AnchorTopLeft)
+	if anchor == AnchorTopLeft {
+		return UIMargin, AbilityIconY + AbilityIconSize + UIMargin + MessageHeight + UIMargin
+	}
+	return anchorPosition(anchor, width, height)
+}
+
+// RenderDebugInfo draws the debug text block where the HUD layout places it
+func (r *Renderer) RenderDebugInfo(screen *ebiten.Image, info string) {
+	if r.hud.HideDebugInfo {
+		return
+	}
+	w, h := r.MeasureText(info)
+	x, y := r.DebugInfoPosition(w, h)
+	r.RenderText(screen, info, x, y, color.RGBA{255, 255, 255, 255})
+}
@@ -0,0 +1,69 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestHiddenAbilityIconsSkipDrawing(t *testing.T) {
+	screen := ebiten.NewImage(ScreenWidth, ScreenHeight)
+	abilities := map[string]bool{"dash": true}
+
+	r := NewRenderer()
+	r.SetHUDLayout(HUDLayout{HideAbilityIcons: true})
+	r.RenderUI(screen, 50, 100, 50, abilities)
+	if len(r.abilityIconCache) != 0 {
+		t.Errorf("hidden ability icons built %d cached icons, want none", len(r.abilityIconCache))
+	}
+
+	r.SetHUDLayout(HUDLayout{})
+	r.RenderUI(screen, 50, 100, 50, abilities)
+	if len(r.abilityIconCache) == 0 {
+		t.Error("visible ability icons were not drawn")
+	}
+}
+
+func TestHUDAnchorPositions(t *testing.T) {
+	r := NewRenderer()
+
+	// The default layout keeps the usual top-left placement
+	if x, y := r.HealthBarPosition(); x != HealthBarX || y != HealthBarY {
+		t.Errorf("default health bar at (%d, %d), want (%d, %d)", x, y, HealthBarX, HealthBarY)
+	}
+	if x, y := r.AbilityIconsPosition(); x != UIMargin || y != AbilityIconY {
+		t.Errorf("default ability icons at (%d, %d), want (%d, %d)", x, y, UIMargin, AbilityIconY)
+	}
+
+	r.SetHUDLayout(HUDLayout{HealthBar: AnchorBottomRight})
+	x, y := r.HealthBarPosition()
+	if x+HealthBarWidth != ScreenWidth-UIMargin || y+HealthBarHeight != ScreenHeight-UIMargin {
+		t.Errorf("bottom-right health bar spans (%d, %d) to (%d, %d), want it to end at (%d, %d)",
+			x, y, x+HealthBarWidth, y+HealthBarHeight, ScreenWidth-UIMargin, ScreenHeight-UIMargin)
+	}
+
+	// Icons sharing the bar's bottom corner stack above it
+	r.SetHUDLayout(HUDLayout{HealthBar: AnchorBottomLeft, AbilityIcons: AnchorBottomLeft})
+	_, barY := r.HealthBarPosition()
+	if ix, iy := r.AbilityIconsPosition(); ix != UIMargin || iy+AbilityIconSize+UIMargin != barY {
+		t.Errorf("stacked ability icons at (%d, %d), want above the bar at y=%d", ix, iy, barY)
+	}
+
+	// Without the bar, the icons take the corner themselves
+	r.SetHUDLayout(HUDLayout{HideHealthBar: true, AbilityIcons: AnchorTopRight})
+	if ix, iy := r.AbilityIconsPosition(); ix+abilityIconsWidth() != ScreenWidth-UIMargin || iy != UIMargin {
+		t.Errorf("top-right ability icons at (%d, %d), want the row to end at x=%d", ix, iy, ScreenWidth-UIMargin)
+	}
+}
+
+func TestParseHUDAnchor(t *testing.T) {
+	for _, anchor := range []HUDAnchor{AnchorDefault, AnchorTopLeft, AnchorTopRight, AnchorBottomLeft, AnchorBottomRight} {
+		got, err := ParseHUDAnchor(anchor.String())
+		if err != nil || got != anchor {
+			t.Errorf("ParseHUDAnchor(%q) = %v, %v; want %v", anchor.String(), got, err, anchor)
+		}
+	}
+	if _, err := ParseHUDAnchor("middle"); err == nil {
+		t.Error("ParseHUDAnchor accepted an unknown anchor")
+	}
+}
@@ -0,0 +1,152 @@
+package render
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/graphics"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// TileLayer is one of a room's tile drawing layers, listed in draw order
+type TileLayer int
+
+const (
+	BackgroundLayer TileLayer = iota // Backdrop filling the room
+	MidgroundLayer                   // Platforms the player stands on
+	ForegroundLayer                  // Overlay drawn in front of entities
+)
+
+// tileLayerOrder lists the layers in the order they are drawn
+var tileLayerOrder = []TileLayer{BackgroundLayer, MidgroundLayer, ForegroundLayer}
+
+// AboveEntities reports whether the layer is drawn over the player and
+// enemies rather than behind them
+func (l TileLayer) AboveEntities() bool {
+	return l == ForegroundLayer
+}
+
+// RenderForeground draws the room's foreground overlay. Call it after the
+// entities so the overlay sits in front of them.
+func (r *Renderer) RenderForeground(screen *ebiten.Image, room *world.Room, tilesets map[string]*graphics.Tileset) {
+	if room == nil {
+		return
+	}
+	r.renderTileLayers(screen, room, tilesets, true)
+}
+
+// renderTileLayers draws, in order, the layers on one side of the entities
+func (r *Renderer) renderTileLayers(screen *ebiten.Image, room *world.Room, tilesets map[string]*graphics.Tileset, aboveEntities bool) {
+	layers := roomTileLayers(room)
+	for _, layer := range tileLayerOrder {
+		if layer.AboveEntities() != aboveEntities {
+			continue
+		}
+		tileset := tilesets[layerTilesetName(layers, layer)]
+		if tileset == nil {
+			continue
+		}
+		switch layer {
+		case BackgroundLayer:
+			r.renderRoomBackground(screen, tileset)
+		case MidgroundLayer:
+			r.renderPlatforms(screen, room, tileset)
+		case ForegroundLayer:
+			r.renderForegroundOverlay(screen, tileset)
+		}
+		if r.onTileLayer != nil {
+			r.onTileLayer(layer)
+		}
+	}
+}
+
+// roomTileLayers returns the room's layered tilesets. Rooms that were never
+// assigned layers draw their own biome behind entities and no overlay.
+func roomTileLayers(room *world.Room) world.TileLayers {
+	if room.TileLayers != (world.TileLayers{}) {
+		return room.TileLayers
+	}
+	if room.Biome == nil {
+		return world.TileLayers{}
+	}
+	return world.TileLayers{Background: room.Biome.Name, Midground: room.Biome.Name}
+}
+
+// layerTilesetName picks the tileset name for one layer
+func layerTilesetName(layers world.TileLayers, layer TileLayer) string {
+	switch layer {
+	case BackgroundLayer:
+		return layers.Background
+	case MidgroundLayer:
+		return layers.Midground
+	default:
+		return layers.Foreground
+	}
+}
+
+// renderRoomBackground draws the room's background tiles
+func (r *Renderer) renderRoomBackground(screen *ebiten.Image, tileset *graphics.Tileset) {
+	bgTile, ok := tileset.Tiles[graphics.BackgroundTile]
+	if !ok || bgTile == nil || bgTile.Image == nil {
+		return
+	}
+
+	bgImage := ebiten.NewImageFromImage(bgTile.Image)
+
+	// Calculate room dimensions in tiles
+	roomWidthTiles := ScreenWidth / TileSize
+	roomHeightTiles := ScreenHeight / TileSize
+
+	for y := 0; y < roomHeightTiles; y++ {
+		for x := 0; x < roomWidthTiles; x++ {
+			opts := r.scaledDrawOptions(bgImage, float64(x*TileSize), float64(y*TileSize), TileSize, TileSize)
+			screen.DrawImage(bgImage, opts)
+		}
+	}
+}
+
+// renderPlatforms draws platforms in the room
+func (r *Renderer) renderPlatforms(screen *ebiten.Image, room *world.Room, tileset *graphics.Tileset) {
+	// Select platform tile (use solid tile if available)
+	platformTile, ok := tileset.Tiles[graphics.SolidTile]
+	if !ok || platformTile == nil || platformTile.Image == nil {
+		return
+	}
+
+	platformImg := ebiten.NewImageFromImage(platformTile.Image)
+
+	// Render each platform
+	for _, platform := range room.Platforms {
+		// Platform Width/Height are in pixels; convert to tile count for rendering
+		tilesWide := platform.Width / TileSize
+		if tilesWide < 1 {
+			tilesWide = 1
+		}
+		tilesTall := platform.Height / TileSize
+		if tilesTall < 1 {
+			tilesTall = 1
+		}
+		for px := 0; px < tilesWide; px++ {
+			for py := 0; py < tilesTall; py++ {
+				opts := r.scaledDrawOptions(platformImg,
+					float64(platform.X+px*TileSize), float64(platform.Y+py*TileSize),
+					TileSize, TileSize)
+				screen.DrawImage(platformImg, opts)
+			}
+		}
+	}
+}
+
+// renderForegroundOverlay draws the overlay along the top of the room, where
+// it frames the scene without hiding the floor the player fights on
+func (r *Renderer) renderForegroundOverlay(screen *ebiten.Image, tileset *graphics.Tileset) {
+	fgTile, ok := tileset.Tiles[graphics.ForegroundTile]
+	if !ok || fgTile == nil || fgTile.Image == nil {
+		return
+	}
+
+	fgImage := ebiten.NewImageFromImage(fgTile.Image)
+
+	for x := 0; x < ScreenWidth/TileSize; x++ {
+		opts := r.scaledDrawOptions(fgImage, float64(x*TileSize), 0, TileSize, TileSize)
+		screen.DrawImage(fgImage, opts)
+	}
+}
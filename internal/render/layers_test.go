@@ -0,0 +1,86 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/graphics"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// layeredRoom returns a room with all three layers and tilesets for them
+func layeredRoom() (*world.Room, map[string]*graphics.Tileset) {
+	room := &world.Room{
+		Biome:     &world.Biome{Name: "cave"},
+		Platforms: []world.Platform{{X: 0, Y: 400, Width: 64, Height: 32}},
+		TileLayers: world.TileLayers{
+			Background: "forest",
+			Midground:  "cave",
+			Foreground: "cave",
+		},
+	}
+	tilesets := map[string]*graphics.Tileset{
+		"cave":   graphics.NewTilesetGenerator(16, "cave").Generate(1),
+		"forest": graphics.NewTilesetGenerator(16, "forest").Generate(2),
+	}
+	return room, tilesets
+}
+
+func TestTileLayersDrawAroundEntities(t *testing.T) {
+	r := NewRenderer()
+	room, tilesets := layeredRoom()
+	screen := ebiten.NewImage(ScreenWidth, ScreenHeight)
+
+	var order []string
+	r.onTileLayer = func(layer TileLayer) {
+		order = append(order, map[TileLayer]string{
+			BackgroundLayer: "background",
+			MidgroundLayer:  "midground",
+			ForegroundLayer: "foreground",
+		}[layer])
+	}
+
+	// The same sequence the game runner draws a frame in
+	r.RenderWorld(screen, room, tilesets)
+	order = append(order, "entities")
+	r.RenderPlayer(screen, 100, 100, nil)
+	r.RenderForeground(screen, room, tilesets)
+
+	want := []string{"background", "midground", "entities", "foreground"}
+	if len(order) != len(want) {
+		t.Fatalf("draw order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("draw order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestOnlyForegroundLayerIsAboveEntities(t *testing.T) {
+	if BackgroundLayer.AboveEntities() || MidgroundLayer.AboveEntities() {
+		t.Error("expected background and midground to draw behind entities")
+	}
+	if !ForegroundLayer.AboveEntities() {
+		t.Error("expected the foreground to draw over entities")
+	}
+}
+
+func TestRoomWithoutLayersDrawsNoOverlay(t *testing.T) {
+	r := NewRenderer()
+	room, tilesets := layeredRoom()
+	room.TileLayers = world.TileLayers{}
+	screen := ebiten.NewImage(ScreenWidth, ScreenHeight)
+
+	drawn := make(map[TileLayer]bool)
+	r.onTileLayer = func(layer TileLayer) { drawn[layer] = true }
+	r.RenderWorld(screen, room, tilesets)
+	r.RenderForeground(screen, room, tilesets)
+
+	if !drawn[BackgroundLayer] || !drawn[MidgroundLayer] {
+		t.Error("expected the biome tileset to fill background and midground")
+	}
+	if drawn[ForegroundLayer] {
+		t.Error("expected no overlay for a room without assigned layers")
+	}
+}
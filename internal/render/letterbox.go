@@ -0,0 +1,79 @@
+package render
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Default letterbox look: bars an eighth of the screen tall, sliding in
+// over half a second
+const (
+	DefaultLetterboxHeight = ScreenHeight / 8
+	DefaultLetterboxFrames = 30
+)
+
+// Letterbox animates cinematic bars in from the top and bottom of the
+// screen and back out. The bars cover the world only; the HUD is drawn over
+// them in its usual place.
+type Letterbox struct {
+	height float64 // Full bar height, in pixels
+	frames int     // Frames to slide fully in or out
+	frame  int     // Progress from 0 (hidden) to frames (fully in)
+	active bool
+}
+
+// NewLetterbox creates hidden bars of the given height that take frames to
+// slide in or out
+func NewLetterbox(height float64, frames int) *Letterbox {
+	return &Letterbox{height: height, frames: max(frames, 1)}
+}
+
+// Show starts the bars sliding in
+func (lb *Letterbox) Show() {
+	lb.active = true
+}
+
+// Hide starts the bars sliding out
+func (lb *Letterbox) Hide() {
+	lb.active = false
+}
+
+// Active reports whether the bars are in or on their way in
+func (lb *Letterbox) Active() bool {
+	return lb.active
+}
+
+// Update moves the bars one frame toward shown or hidden
+func (lb *Letterbox) Update() {
+	if lb.active && lb.frame < lb.frames {
+		lb.frame++
+	} else if !lb.active && lb.frame > 0 {
+		lb.frame--
+	}
+}
+
+// BarHeight returns each bar's current height, eased in and out
+func (lb *Letterbox) BarHeight() float64 {
+	t := float64(lb.frame) / float64(lb.frames)
+	return lb.height * t * t * (3 - 2*t)
+}
+
+// RenderLetterbox draws bars of the given height along the top and bottom
+// of the screen
+func (r *Renderer) RenderLetterbox(screen *ebiten.Image, barHeight float64) {
+	if barHeight <= 0 {
+		return
+	}
+	h := int(barHeight + 0.5)
+	if h <= 0 {
+		return
+	}
+	barImg := ebiten.NewImage(ScreenWidth, h)
+	barImg.Fill(color.RGBA{0, 0, 0, 255})
+
+	screen.DrawImage(barImg, &ebiten.DrawImageOptions{})
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(0, float64(ScreenHeight-h))
+	screen.DrawImage(barImg, opts)
+}
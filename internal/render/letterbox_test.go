@@ -0,0 +1,49 @@
+package render
+
+import "testing"
+
+func TestLetterboxSlidesInOverDuration(t *testing.T) {
+	lb := NewLetterbox(80, 30)
+	if lb.BarHeight() != 0 {
+		t.Fatalf("hidden bar height = %v, want 0", lb.BarHeight())
+	}
+
+	lb.Show()
+	prev := 0.0
+	for i := 0; i < 29; i++ {
+		lb.Update()
+		h := lb.BarHeight()
+		if h <= prev || h >= 80 {
+			t.Fatalf("frame %d: bar height %v, want growing and below 80", i+1, h)
+		}
+		prev = h
+	}
+	lb.Update()
+	if lb.BarHeight() != 80 {
+		t.Errorf("bar height after 30 frames = %v, want 80", lb.BarHeight())
+	}
+	lb.Update()
+	if lb.BarHeight() != 80 {
+		t.Errorf("bar height kept growing past 80: %v", lb.BarHeight())
+	}
+}
+
+func TestLetterboxRetractsOnHide(t *testing.T) {
+	lb := NewLetterbox(80, 30)
+	lb.Show()
+	for i := 0; i < 30; i++ {
+		lb.Update()
+	}
+
+	lb.Hide()
+	lb.Update()
+	if lb.BarHeight() >= 80 {
+		t.Errorf("bar height %v did not start shrinking", lb.BarHeight())
+	}
+	for i := 0; i < 29; i++ {
+		lb.Update()
+	}
+	if lb.BarHeight() != 0 {
+		t.Errorf("bar height after retracting = %v, want 0", lb.BarHeight())
+	}
+}
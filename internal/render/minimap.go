@@ -0,0 +1,123 @@
+package render
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/opd-ai/vania/internal/world"
+)
+
+// Minimap layout
+const (
+	MinimapCellSize  = 10 // Pixels per room grid cell
+	MinimapCellGap   = 2
+	MinimapTop       = UIMargin + 24 // Below the top-right controls hint
+	MinimapLegendGap = 6
+)
+
+// RenderMinimap draws the visited rooms in the top-right corner, or the
+// corner the HUD layout picks, each cell filled with its biome region's
+// color, with a legend naming the regions discovered so far and, when
+// given, a completion line beneath it. The current room is outlined and
+// its region's label highlighted.
+func (r *Renderer) RenderMinimap(screen *ebiten.Image, w *world.World, regions []*world.BiomeRegion, visited map[int]bool, currentRoomID int, completion string) {
+	if r.hud.HideMinimap || w == nil || w.Width <= 0 || w.Height <= 0 {
+		return
+	}
+
+	discovered := make([]*world.BiomeRegion, 0, len(regions))
+	for _, region := range regions {
+		for _, room := range region.Rooms {
+			if visited[room.ID] || room.ID == currentRoomID {
+				discovered = append(discovered, region)
+				break
+			}
+		}
+	}
+
+	pitch := MinimapCellSize + MinimapCellGap
+	mapW := w.Width*pitch + MinimapCellGap
+	mapH := w.Height*pitch + MinimapCellGap
+	legendH := 0
+	legend := make([]string, 0, len(discovered)+1)
+	for _, region := range discovered {
+		legend = append(legend, region.Label)
+	}
+	if completion != "" {
+		legend = append(legend, completion)
+	}
+	for _, line := range legend {
+		_, textH := r.MeasureText(line)
+		legendH += textH + 2
+	}
+	if legendH > 0 {
+		legendH += MinimapLegendGap
+	}
+
+	anchor := r.hud.Minimap.resolve(AnchorTopRight)
+	originX, originY := anchorPosition(anchor, mapW, mapH+legendH)
+	if anchor == AnchorTopRight {
+		originY = MinimapTop // Below the controls hint
+	}
+	rightAligned := anchor == AnchorTopRight || anchor == AnchorBottomRight
+
+	drawFilledRect(screen, originX, originY, mapW, mapH, color.RGBA{0, 0, 0, 160})
+
+	var current *world.BiomeRegion
+	for _, region := range discovered {
+		fill := region.Color()
+		for _, room := range region.Rooms {
+			if !visited[room.ID] && room.ID != currentRoomID {
+				continue
+			}
+			x := originX + MinimapCellGap + room.X*pitch
+			y := originY + MinimapCellGap + room.Y*pitch
+			if room.ID == currentRoomID {
+				current = region
+				drawFilledRect(screen, x-1, y-1, MinimapCellSize+2, MinimapCellSize+2, color.RGBA{255, 255, 255, 255})
+			}
+			drawFilledRect(screen, x, y, MinimapCellSize, MinimapCellSize, fill)
+		}
+	}
+
+	// Legend of discovered regions below the map, aligned to its screen edge
+	legendY := originY + mapH + MinimapLegendGap
+	for _, region := range discovered {
+		textCol := color.RGBA{180, 180, 180, 255}
+		if region == current {
+			textCol = color.RGBA{255, 255, 255, 255}
+		}
+		textW, textH := r.MeasureText(region.Label)
+		swatchX := originX
+		textX := swatchX + MinimapCellSize + MinimapLegendGap
+		if rightAligned {
+			textX = originX + mapW - textW
+			swatchX = textX - MinimapCellSize - MinimapLegendGap
+		}
+		drawFilledRect(screen, swatchX, legendY+(textH-MinimapCellSize)/2, MinimapCellSize, MinimapCellSize, region.Color())
+		r.RenderText(screen, region.Label, textX, legendY, textCol)
+		legendY += textH + 2
+	}
+
+	// Completion under the regions, without a swatch
+	if completion != "" {
+		textX := originX
+		if rightAligned {
+			textW, _ := r.MeasureText(completion)
+			textX = originX + mapW - textW
+		}
+		r.RenderText(screen, completion, textX, legendY, color.RGBA{255, 215, 0, 255})
+	}
+}
+
+// drawFilledRect fills a screen-space rectangle with col
+func drawFilledRect(screen *ebiten.Image, x, y, width, height int, col color.Color) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	img := ebiten.NewImage(width, height)
+	img.Fill(col)
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(float64(x), float64(y))
+	screen.DrawImage(img, opts)
+}
@@ -5,6 +5,7 @@ package render
 
 import (
 	"image/color"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -54,8 +55,22 @@ type Camera struct {
 	X, Y   float64
 	Width  int
 	Height int
+
+	// Dead zone: a box around the view center the target can move within
+	// without scrolling the camera
+	DeadZoneWidth  float64
+	DeadZoneHeight float64
+
+	// Furthest the camera may scroll; zero for screen-sized rooms
+	MaxX, MaxY float64
 }
 
+// Default camera dead zone, in pixels
+const (
+	DefaultDeadZoneWidth  = 96.0
+	DefaultDeadZoneHeight = 64.0
+)
+
 // Renderer handles all game rendering
 type Renderer struct {
 	screen      *ebiten.Image
@@ -71,6 +86,18 @@ type Renderer struct {
 	// Genre-specific visual state
 	currentGenre string
 	genreBgColor color.Color
+
+	// Which HUD elements are drawn and where (see hud.go)
+	hud HUDLayout
+
+	// Filter for sprites and tiles drawn scaled (see scaling.go)
+	scaleFilter ebiten.Filter
+
+	// Test hook observing each tile layer as it is drawn (see layers.go)
+	onTileLayer func(TileLayer)
+
+	// Draw the player upside down, for inverted gravity rooms
+	playerInverted bool
 }
 
 // NewRenderer creates a new renderer
@@ -81,6 +108,9 @@ func NewRenderer() *Renderer {
 			Y:      0,
 			Width:  ScreenWidth,
 			Height: ScreenHeight,
+
+			DeadZoneWidth:  DefaultDeadZoneWidth,
+			DeadZoneHeight: DefaultDeadZoneHeight,
 		},
 		tileImages:       make(map[string]*ebiten.Image),
 		bgColor:          color.RGBA{20, 20, 30, 255}, // Dark blue background
@@ -89,6 +119,7 @@ func NewRenderer() *Renderer {
 		lastAbilities:    make(map[string]bool),
 		currentGenre:     "fantasy",
 		genreBgColor:     color.RGBA{20, 20, 30, 255},
+		scaleFilter:      ebiten.FilterNearest,
 	}
 }
 
@@ -135,11 +166,8 @@ func (r *Renderer) RenderWorld(screen *ebiten.Image, currentRoom *world.Room, ti
 		return
 	}
 
-	// Render room background
-	r.renderRoomBackground(screen, currentRoom, tilesets)
-
-	// Render platforms
-	r.renderPlatforms(screen, currentRoom, tilesets)
+	// Render the tile layers behind entities: background, then platforms
+	r.renderTileLayers(screen, currentRoom, tilesets, false)
 
 	// Render hazards
 	r.renderHazards(screen, currentRoom)
@@ -148,85 +176,6 @@ func (r *Renderer) RenderWorld(screen *ebiten.Image, currentRoom *world.Room, ti
 	r.renderDoors(screen, currentRoom)
 }
 
-// renderRoomBackground draws the room's background tiles
-func (r *Renderer) renderRoomBackground(screen *ebiten.Image, room *world.Room, tilesets map[string]*graphics.Tileset) {
-	if room.Biome == nil {
-		return
-	}
-
-	// Get tileset for this biome
-	tileset, ok := tilesets[room.Biome.Name]
-	if !ok || tileset == nil {
-		return
-	}
-
-	// Calculate room dimensions in tiles
-	roomWidthTiles := ScreenWidth / TileSize
-	roomHeightTiles := ScreenHeight / TileSize
-
-	// Render background tiles
-	// Get background tile
-	bgTile, ok := tileset.Tiles[graphics.BackgroundTile]
-	if !ok || bgTile == nil || bgTile.Image == nil {
-		return
-	}
-
-	bgImage := ebiten.NewImageFromImage(bgTile.Image)
-
-	for y := 0; y < roomHeightTiles; y++ {
-		for x := 0; x < roomWidthTiles; x++ {
-			// Draw tile
-			opts := &ebiten.DrawImageOptions{}
-			opts.GeoM.Translate(float64(x*TileSize), float64(y*TileSize))
-			screen.DrawImage(bgImage, opts)
-		}
-	}
-}
-
-// renderPlatforms draws platforms in the room
-func (r *Renderer) renderPlatforms(screen *ebiten.Image, room *world.Room, tilesets map[string]*graphics.Tileset) {
-	if room.Biome == nil {
-		return
-	}
-
-	// Get tileset for this biome
-	tileset, ok := tilesets[room.Biome.Name]
-	if !ok || tileset == nil {
-		return
-	}
-
-	// Select platform tile (use solid tile if available)
-	platformTile, ok := tileset.Tiles[graphics.SolidTile]
-	if !ok || platformTile == nil || platformTile.Image == nil {
-		return
-	}
-
-	platformImg := ebiten.NewImageFromImage(platformTile.Image)
-
-	// Render each platform
-	for _, platform := range room.Platforms {
-		// Platform Width/Height are in pixels; convert to tile count for rendering
-		tilesWide := platform.Width / TileSize
-		if tilesWide < 1 {
-			tilesWide = 1
-		}
-		tilesTall := platform.Height / TileSize
-		if tilesTall < 1 {
-			tilesTall = 1
-		}
-		for px := 0; px < tilesWide; px++ {
-			for py := 0; py < tilesTall; py++ {
-				opts := &ebiten.DrawImageOptions{}
-				opts.GeoM.Translate(
-					float64(platform.X+px*TileSize),
-					float64(platform.Y+py*TileSize),
-				)
-				screen.DrawImage(platformImg, opts)
-			}
-		}
-	}
-}
-
 // renderHazards draws hazards in the room
 func (r *Renderer) renderHazards(screen *ebiten.Image, room *world.Room) {
 	for _, hazard := range room.Hazards {
@@ -286,40 +235,69 @@ func (r *Renderer) renderDoors(screen *ebiten.Image, room *world.Room) {
 	}
 }
 
+// SetPlayerInverted sets whether the player is drawn upside down, standing
+// on the ceiling of an inverted gravity room
+func (r *Renderer) SetPlayerInverted(inverted bool) {
+	r.playerInverted = inverted
+}
+
 // RenderPlayer draws the player sprite
 func (r *Renderer) RenderPlayer(screen *ebiten.Image, x, y float64, sprite *graphics.Sprite) {
+	r.drawPlayer(screen, x, y, 1, sprite)
+}
+
+// RenderCrouchingPlayer draws the player sprite squashed to height, with
+// its top at y, for a player crouched below full size
+func (r *Renderer) RenderCrouchingPlayer(screen *ebiten.Image, x, y, height float64, sprite *graphics.Sprite) {
+	fullHeight := 32.0
+	if sprite != nil && sprite.Image != nil {
+		fullHeight = float64(sprite.Image.Bounds().Dy())
+	}
+	r.drawPlayer(screen, x, y, height/fullHeight, sprite)
+}
+
+// drawPlayer draws the player sprite at (x, y), scaled vertically by scaleY
+func (r *Renderer) drawPlayer(screen *ebiten.Image, x, y, scaleY float64, sprite *graphics.Sprite) {
+	var playerImg *ebiten.Image
 	if sprite == nil || sprite.Image == nil {
 		// Draw a simple colored square as fallback
-		playerImg := ebiten.NewImage(32, 32)
+		playerImg = ebiten.NewImage(32, 32)
 		playerImg.Fill(color.RGBA{100, 200, 100, 255}) // Green
-
-		opts := &ebiten.DrawImageOptions{}
-		opts.GeoM.Translate(x, y)
-		screen.DrawImage(playerImg, opts)
-		return
+	} else {
+		// Convert sprite to ebiten image
+		playerImg = ebiten.NewImageFromImage(sprite.Image)
 	}
 
-	// Convert sprite to ebiten image
-	playerImg := ebiten.NewImageFromImage(sprite.Image)
-
 	opts := &ebiten.DrawImageOptions{}
+	if r.playerInverted {
+		// Flip about the sprite's middle so it still fills (x, y)
+		opts.GeoM.Scale(1, -scaleY)
+		opts.GeoM.Translate(0, float64(playerImg.Bounds().Dy())*scaleY)
+	} else {
+		opts.GeoM.Scale(1, scaleY)
+	}
 	opts.GeoM.Translate(x, y)
 	screen.DrawImage(playerImg, opts)
 }
 
-// RenderUI draws the user interface (health, abilities, etc.)
-func (r *Renderer) RenderUI(screen *ebiten.Image, health, maxHealth int, abilities map[string]bool) {
-	barX, barY, barHeight := r.renderEnhancedHealthBar(screen, health, maxHealth)
-	r.renderAbilityIcons(screen, abilities, barX, barY+barHeight+10)
+// RenderUI draws the user interface (health, abilities, etc.). trailHealth
+// is the damage trail value from a HealthTrail, drawn behind the health fill.
+func (r *Renderer) RenderUI(screen *ebiten.Image, health, maxHealth int, trailHealth float64, abilities map[string]bool) {
+	if !r.hud.HideHealthBar {
+		barX, barY := r.HealthBarPosition()
+		r.renderEnhancedHealthBar(screen, health, maxHealth, trailHealth, barX, barY)
+	}
+	if !r.hud.HideAbilityIcons {
+		iconX, iconY := r.AbilityIconsPosition()
+		r.renderAbilityIcons(screen, abilities, iconX, iconY)
+	}
 }
 
 // renderEnhancedHealthBar draws an improved health bar with segments and color coding
-func (r *Renderer) renderEnhancedHealthBar(screen *ebiten.Image, health, maxHealth int) (int, int, int) {
+func (r *Renderer) renderEnhancedHealthBar(screen *ebiten.Image, health, maxHealth int, trailHealth float64, barX, barY int) {
 	// Use layout constants
 	barWidth := HealthBarWidth
 	barHeight := HealthBarHeight
-	barX := HealthBarX
-	barY := HealthBarY
 	borderWidth := HealthBarBorder
 
 	// Validate health bounds
@@ -362,6 +340,18 @@ func (r *Renderer) renderEnhancedHealthBar(screen *ebiten.Image, health, maxHeal
 		healthColor = color.RGBA{200, 50, 50, 255}
 	}
 
+	// Draw the damage trail behind the fill, draining toward current health
+	if maxHealth > 0 && trailHealth > float64(health) {
+		trailWidth := int(float64(barWidth) * math.Min(trailHealth, float64(maxHealth)) / float64(maxHealth))
+		if trailWidth > 0 {
+			trailImg := ebiten.NewImage(trailWidth, barHeight)
+			trailImg.Fill(color.RGBA{240, 220, 200, 255}) // Pale trail
+			opts = &ebiten.DrawImageOptions{}
+			opts.GeoM.Translate(float64(barX), float64(barY))
+			screen.DrawImage(trailImg, opts)
+		}
+	}
+
 	// Draw health fill with calculated color
 	if maxHealth > 0 && health > 0 {
 		fillWidth := int(float64(barWidth) * healthPercent)
@@ -390,10 +380,11 @@ func (r *Renderer) renderEnhancedHealthBar(screen *ebiten.Image, health, maxHeal
 			screen.DrawImage(segmentImg, opts)
 		}
 	}
-
-	return barX, barY, barHeight
 }
 
+// hudAbilityNames are the abilities shown as HUD icons, in order
+var hudAbilityNames = []string{"double_jump", "dash", "wall_jump", "glide"}
+
 // renderAbilityIcons draws ability indicators with cached procedural icons
 func (r *Renderer) renderAbilityIcons(screen *ebiten.Image, abilities map[string]bool, startX, startY int) {
 	abilitySize := AbilityIconSize
@@ -406,8 +397,7 @@ func (r *Renderer) renderAbilityIcons(screen *ebiten.Image, abilities map[string
 	}
 
 	// Use cached icons for rendering
-	abilityNames := []string{"double_jump", "dash", "wall_jump", "glide"}
-	for i, abilityName := range abilityNames {
+	for i, abilityName := range hudAbilityNames {
 		hasAbility := abilities[abilityName]
 		x := startX + i*(abilitySize+abilitySpacing)
 
@@ -703,26 +693,43 @@ func (r *Renderer) generateAbilityIcon(ability string, size int, unlocked bool)
 	return iconImg
 }
 
-// UpdateCamera updates camera position to follow target, clamped to room bounds
+// UpdateCamera follows target, clamped to room bounds. The camera only
+// scrolls once the target leaves the dead zone, and then just far enough to
+// keep it on the dead zone's edge.
 func (r *Renderer) UpdateCamera(targetX, targetY float64) {
-	// Simple camera that centers on target
-	r.camera.X = targetX - float64(r.camera.Width)/2
-	r.camera.Y = targetY - float64(r.camera.Height)/2
+	r.camera.X = followDeadZone(r.camera.X, float64(r.camera.Width), r.camera.DeadZoneWidth, targetX)
+	r.camera.Y = followDeadZone(r.camera.Y, float64(r.camera.Height), r.camera.DeadZoneHeight, targetY)
 
-	// Clamp camera to room bounds (rooms are screen-sized 960×640)
-	if r.camera.X < 0 {
-		r.camera.X = 0
-	}
-	if r.camera.Y < 0 {
-		r.camera.Y = 0
-	}
-	// For screen-sized rooms, camera should not exceed 0
-	if r.camera.X > 0 {
-		r.camera.X = 0
-	}
-	if r.camera.Y > 0 {
-		r.camera.Y = 0
-	}
+	r.camera.X = math.Max(0, math.Min(r.camera.X, r.camera.MaxX))
+	r.camera.Y = math.Max(0, math.Min(r.camera.Y, r.camera.MaxY))
+}
+
+// followDeadZone returns the camera's new position along one axis, given
+// its position, view size, dead zone size and the target
+func followDeadZone(pos, view, deadZone, target float64) float64 {
+	center := pos + view/2
+	half := deadZone / 2
+	switch {
+	case target < center-half:
+		return target + half - view/2
+	case target > center+half:
+		return target - half - view/2
+	}
+	return pos
+}
+
+// SetCameraDeadZone sets the size of the box the target can move within
+// without scrolling the camera. Zero makes the camera center on the target.
+func (r *Renderer) SetCameraDeadZone(width, height float64) {
+	r.camera.DeadZoneWidth = math.Max(0, width)
+	r.camera.DeadZoneHeight = math.Max(0, height)
+}
+
+// SetCameraBounds sets the size of the world the camera scrolls over. Rooms
+// no larger than the screen keep the camera fixed.
+func (r *Renderer) SetCameraBounds(worldWidth, worldHeight float64) {
+	r.camera.MaxX = math.Max(0, worldWidth-float64(r.camera.Width))
+	r.camera.MaxY = math.Max(0, worldHeight-float64(r.camera.Height))
 }
 
 // GetCameraOffset returns the camera offset for positioning
@@ -730,6 +737,18 @@ func (r *Renderer) GetCameraOffset() (float64, float64) {
 	return -r.camera.X, -r.camera.Y
 }
 
+// CameraPosition returns the camera's top-left corner in world coordinates
+func (r *Renderer) CameraPosition() (float64, float64) {
+	return r.camera.X, r.camera.Y
+}
+
+// SetCameraPosition moves the camera's top-left corner to (x, y) in world
+// coordinates, e.g. to reproduce a captured frame
+func (r *Renderer) SetCameraPosition(x, y float64) {
+	r.camera.X = x
+	r.camera.Y = y
+}
+
 // RenderText renders text using the text rendering abstraction
 func (r *Renderer) RenderText(screen *ebiten.Image, text string, x, y int, col color.Color) {
 	if r.textManager != nil {
@@ -757,7 +776,7 @@ func (r *Renderer) SetTextColorMode(enabled bool) {
 }
 
 // RenderEnemy draws an enemy to the screen
-func (r *Renderer) RenderEnemy(screen *ebiten.Image, x, y, width, height float64, health, maxHealth int, isInvulnerable bool, sprite *graphics.Sprite) {
+func (r *Renderer) RenderEnemy(screen *ebiten.Image, x, y, width, height float64, health, maxHealth int, trailHealth float64, isInvulnerable bool, sprite *graphics.Sprite) {
 	// Apply camera offset (world-to-screen: subtract camera position)
 	screenX := x - r.camera.X
 	screenY := y - r.camera.Y
@@ -773,12 +792,12 @@ func (r *Renderer) RenderEnemy(screen *ebiten.Image, x, y, width, height float64
 		// Use the animated sprite
 		enemyImg := ebiten.NewImageFromImage(sprite.Image)
 
-		// Apply transparency when invulnerable
-		opts := &ebiten.DrawImageOptions{}
+		// Stretch the sprite over the enemy's bounds, and apply
+		// transparency when invulnerable
+		opts := r.scaledDrawOptions(enemyImg, screenX, screenY, width, height)
 		if isInvulnerable {
 			opts.ColorM.Scale(1, 1, 1, 0.5) // Half transparency
 		}
-		opts.GeoM.Translate(screenX, screenY)
 		screen.DrawImage(enemyImg, opts)
 	} else {
 		// Fallback to colored rectangle if no sprite
@@ -810,6 +829,16 @@ func (r *Renderer) RenderEnemy(screen *ebiten.Image, x, y, width, height float64
 		opts.GeoM.Translate(screenX, barY)
 		screen.DrawImage(bgImg, opts)
 
+		// Damage trail
+		trailWidth := barWidth * math.Min(trailHealth, float64(maxHealth)) / float64(maxHealth)
+		if trailHealth > float64(health) && trailWidth >= 1 {
+			trailImg := ebiten.NewImage(int(trailWidth), int(barHeight))
+			trailImg.Fill(color.RGBA{240, 220, 200, 255})
+			opts = &ebiten.DrawImageOptions{}
+			opts.GeoM.Translate(screenX, barY)
+			screen.DrawImage(trailImg, opts)
+		}
+
 		// Health fill
 		fillWidth := barWidth * float64(health) / float64(maxHealth)
 		if fillWidth > 0 {
@@ -822,6 +851,68 @@ func (r *Renderer) RenderEnemy(screen *ebiten.Image, x, y, width, height float64
 	}
 }
 
+// RenderAlertIndicator draws an enemy's awareness symbol ("?" or "!")
+// centred above its health bar. An empty symbol draws nothing.
+func (r *Renderer) RenderAlertIndicator(screen *ebiten.Image, x, y, width float64, symbol string) {
+	if symbol == "" {
+		return
+	}
+	textW, textH := r.MeasureText(symbol)
+	screenX := int(x-r.camera.X+width/2) - textW/2
+	screenY := int(y-r.camera.Y-EnemyHealthBarOffset) - textH - 2
+
+	col := color.RGBA{255, 220, 80, 255} // Yellow while suspicious
+	if symbol == "!" {
+		col = color.RGBA{255, 70, 50, 255} // Red once alerted
+	}
+	r.RenderText(screen, symbol, screenX, screenY, col)
+}
+
+// RenderEnemyCorpse draws a dead enemy at the given opacity, without a
+// health bar
+func (r *Renderer) RenderEnemyCorpse(screen *ebiten.Image, x, y, width, height, alpha float64, sprite *graphics.Sprite) {
+	screenX := x - r.camera.X
+	screenY := y - r.camera.Y
+
+	if alpha <= 0 || screenX+width < 0 || screenX > float64(ScreenWidth) ||
+		screenY+height < 0 || screenY > float64(ScreenHeight) {
+		return
+	}
+
+	var corpseImg *ebiten.Image
+	if sprite != nil && sprite.Image != nil {
+		corpseImg = ebiten.NewImageFromImage(sprite.Image)
+	} else {
+		corpseImg = ebiten.NewImage(int(width), int(height))
+		corpseImg.Fill(color.RGBA{200, 50, 50, 255})
+	}
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.ColorM.Scale(1, 1, 1, alpha)
+	opts.GeoM.Translate(screenX, screenY)
+	screen.DrawImage(corpseImg, opts)
+}
+
+// RenderEnemyTint redraws an enemy's sprite shaded by tint, e.g. the red of
+// an enraged enemy. Enemies without a sprite are left as drawn.
+func (r *Renderer) RenderEnemyTint(screen *ebiten.Image, x, y float64, sprite *graphics.Sprite, tint color.RGBA) {
+	if sprite == nil || sprite.Image == nil {
+		return
+	}
+	screenX := x - r.camera.X
+	screenY := y - r.camera.Y
+	bounds := sprite.Image.Bounds()
+	if screenX+float64(bounds.Dx()) < 0 || screenX > float64(ScreenWidth) ||
+		screenY+float64(bounds.Dy()) < 0 || screenY > float64(ScreenHeight) {
+		return
+	}
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.ColorM.Scale(float64(tint.R)/255, float64(tint.G)/255, float64(tint.B)/255, float64(tint.A)/255)
+	opts.GeoM.Translate(screenX, screenY)
+	screen.DrawImage(ebiten.NewImageFromImage(sprite.Image), opts)
+}
+
 // RenderAttackEffect draws player attack visual effect
 func (r *Renderer) RenderAttackEffect(screen *ebiten.Image, x, y, width, height float64) {
 	if width <= 0 || height <= 0 {
@@ -841,6 +932,95 @@ func (r *Renderer) RenderAttackEffect(screen *ebiten.Image, x, y, width, height
 	screen.DrawImage(attackImg, opts)
 }
 
+// RenderPuzzleElement renders a pressure plate or switch. Active elements
+// are drawn green, inactive ones grey.
+func (r *Renderer) RenderPuzzleElement(screen *ebiten.Image, x, y, width, height float64, active bool) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	screenX := x - r.camera.X
+	screenY := y - r.camera.Y
+
+	fill := color.RGBA{110, 110, 120, 255}
+	if active {
+		fill = color.RGBA{80, 220, 120, 255}
+	}
+
+	elemImg := ebiten.NewImage(int(width), int(height))
+	elemImg.Fill(fill)
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(screenX, screenY)
+	screen.DrawImage(elemImg, opts)
+}
+
+// RenderEnemyAttackEffect renders an enemy attack area. While telegraphing the
+// area is drawn faintly as a warning; once active it is drawn solid red.
+func (r *Renderer) RenderEnemyAttackEffect(screen *ebiten.Image, x, y, width, height float64, telegraphing bool) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	screenX := x - r.camera.X
+	screenY := y - r.camera.Y
+
+	fill := color.RGBA{255, 60, 40, 140}
+	if telegraphing {
+		fill = color.RGBA{255, 120, 40, 48}
+	}
+
+	attackImg := ebiten.NewImage(int(width), int(height))
+	attackImg.Fill(fill)
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(screenX, screenY)
+	screen.DrawImage(attackImg, opts)
+}
+
+// RenderUnblockableAttackEffect renders an attack area that cannot be
+// parried. It is drawn violet instead of red so the player knows to dodge.
+func (r *Renderer) RenderUnblockableAttackEffect(screen *ebiten.Image, x, y, width, height float64, telegraphing bool) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	fill := color.RGBA{200, 40, 255, 150}
+	if telegraphing {
+		fill = color.RGBA{180, 60, 255, 64}
+	}
+
+	attackImg := ebiten.NewImage(int(width), int(height))
+	attackImg.Fill(fill)
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(x-r.camera.X, y-r.camera.Y)
+	screen.DrawImage(attackImg, opts)
+}
+
+// RenderProjectile renders a projectile centred on (x, y) in world space.
+// Hostile projectiles are drawn red, player projectiles yellow.
+func (r *Renderer) RenderProjectile(screen *ebiten.Image, x, y, size float64, hostile bool) {
+	if size <= 0 {
+		return
+	}
+
+	screenX := x - size/2 - r.camera.X
+	screenY := y - size/2 - r.camera.Y
+
+	fill := color.RGBA{255, 240, 120, 255}
+	if hostile {
+		fill = color.RGBA{230, 50, 50, 255}
+	}
+
+	projImg := ebiten.NewImage(int(size), int(size))
+	projImg.Fill(fill)
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(screenX, screenY)
+	screen.DrawImage(projImg, opts)
+}
+
 // RenderTransitionEffect renders the active transition effect
 func (r *Renderer) RenderTransitionEffect(screen *ebiten.Image, progress float64, transitionType, slideDirection string) {
 	if progress <= 0 {
@@ -996,13 +1176,22 @@ func (r *Renderer) RenderParticles(screen *ebiten.Image, particles []*particle.P
 	}
 }
 
-// RenderItem draws a collectible item to the screen
-func (r *Renderer) RenderItem(screen *ebiten.Image, x, y, width, height float64, collected bool, sprite *graphics.Sprite) {
+// RenderItem draws a collectible item to the screen, haloed in glow
+func (r *Renderer) RenderItem(screen *ebiten.Image, x, y, width, height float64, collected bool, glow color.RGBA, sprite *graphics.Sprite) {
 	// Don't render if collected
 	if collected {
 		return
 	}
 
+	// Glow effect (larger, semi-transparent)
+	glowSize := int(width * 1.5)
+	glowImg := ebiten.NewImage(glowSize, glowSize)
+	glowImg.Fill(glow)
+
+	glowOpts := &ebiten.DrawImageOptions{}
+	glowOpts.GeoM.Translate(x-float64(glowSize-int(width))/2, y-float64(glowSize-int(height))/2)
+	screen.DrawImage(glowImg, glowOpts)
+
 	// If sprite is available, use it
 	if sprite != nil && sprite.Image != nil {
 		itemImg := ebiten.NewImageFromImage(sprite.Image)
@@ -1012,15 +1201,7 @@ func (r *Renderer) RenderItem(screen *ebiten.Image, x, y, width, height float64,
 		return
 	}
 
-	// Fallback: Draw a simple colored box with glow effect
-	// Create glow effect (larger, semi-transparent)
-	glowSize := int(width * 1.5)
-	glowImg := ebiten.NewImage(glowSize, glowSize)
-	glowImg.Fill(color.RGBA{255, 215, 0, 60}) // Golden glow
-
-	glowOpts := &ebiten.DrawImageOptions{}
-	glowOpts.GeoM.Translate(x-float64(glowSize-int(width))/2, y-float64(glowSize-int(height))/2)
-	screen.DrawImage(glowImg, glowOpts)
+	// Fallback: Draw a simple colored box
 
 	// Draw main item box
 	itemImg := ebiten.NewImage(int(width), int(height))
@@ -1040,6 +1221,58 @@ func (r *Renderer) RenderItem(screen *ebiten.Image, x, y, width, height float64,
 	screen.DrawImage(innerImg, innerOpts)
 }
 
+// RenderAbilityPedestal draws a stone pedestal with a pulsing orb holding an
+// uncollected ability
+func (r *Renderer) RenderAbilityPedestal(screen *ebiten.Image, x, y, width, height float64, frame int) {
+	baseH := int(height / 3)
+	drawFilledRect(screen, int(x), int(y+height)-baseH, int(width), baseH, color.RGBA{110, 110, 120, 255})
+	drawFilledRect(screen, int(x)+2, int(y+height)-baseH, int(width)-4, 3, color.RGBA{150, 150, 160, 255})
+
+	// Orb bobs gently above the base
+	orbSize := int(width / 2)
+	bob := int(3 * math.Sin(float64(frame)*0.08))
+	orbX := int(x) + (int(width)-orbSize)/2
+	orbY := int(y) + bob
+	drawFilledRect(screen, orbX-3, orbY-3, orbSize+6, orbSize+6, color.RGBA{120, 200, 255, 70})
+	drawFilledRect(screen, orbX, orbY, orbSize, orbSize, color.RGBA{150, 220, 255, 255})
+}
+
+// RenderAbilityBanner draws the ability-unlock banner across the middle of
+// the screen
+func (r *Renderer) RenderAbilityBanner(screen *ebiten.Image, name, description string) {
+	const bannerH = 64
+	bannerY := ScreenHeight/3 - bannerH/2
+	drawFilledRect(screen, 0, bannerY, ScreenWidth, bannerH, color.RGBA{0, 0, 0, 190})
+	drawFilledRect(screen, 0, bannerY, ScreenWidth, 2, color.RGBA{150, 220, 255, 255})
+	drawFilledRect(screen, 0, bannerY+bannerH-2, ScreenWidth, 2, color.RGBA{150, 220, 255, 255})
+
+	title := "ABILITY UNLOCKED: " + name
+	titleW, titleH := r.MeasureText(title)
+	r.RenderText(screen, title, (ScreenWidth-titleW)/2, bannerY+12, color.RGBA{150, 220, 255, 255})
+	if description != "" {
+		descW, _ := r.MeasureText(description)
+		r.RenderText(screen, description, (ScreenWidth-descW)/2, bannerY+12+titleH+8, color.RGBA{220, 220, 220, 255})
+	}
+}
+
+// RenderRestSpot draws the bench-like rest spot in a save room
+func (r *Renderer) RenderRestSpot(screen *ebiten.Image, x, y, width, height float64) {
+	screenX := int(x - r.camera.X)
+	screenY := int(y - r.camera.Y)
+	drawFilledRect(screen, screenX, screenY, int(width), int(height), color.RGBA{120, 90, 60, 255})
+	drawFilledRect(screen, screenX, screenY, int(width), 3, color.RGBA{180, 150, 100, 255})
+}
+
+// RenderInteractPrompt draws a contextual action prompt centred above the
+// world point (x, y)
+func (r *Renderer) RenderInteractPrompt(screen *ebiten.Image, prompt string, x, y float64) {
+	w, h := r.MeasureText(prompt)
+	px := int(x-r.camera.X) - w/2
+	py := int(y-r.camera.Y) - h - 10
+	drawFilledRect(screen, px-4, py-2, w+8, h+4, color.RGBA{0, 0, 0, 170})
+	r.RenderText(screen, prompt, px, py, color.RGBA{255, 255, 255, 255})
+}
+
 // RenderDamageNumbers draws floating damage numbers to the screen
 func (r *Renderer) RenderDamageNumbers(screen *ebiten.Image, damageNumbers []DamageNumber) {
 	for _, dmg := range damageNumbers {
@@ -45,6 +45,46 @@ func TestUpdateCamera(t *testing.T) {
 	}
 }
 
+func TestCameraDeadZone(t *testing.T) {
+	r := NewRenderer()
+	r.SetCameraBounds(ScreenWidth*3, ScreenHeight*3)
+	r.SetCameraDeadZone(100, 60)
+
+	// Center the view on the player
+	r.camera.X, r.camera.Y = 500, 400
+	centerX := 500 + float64(ScreenWidth)/2
+	centerY := 400 + float64(ScreenHeight)/2
+
+	// Small moves inside the dead zone leave the camera alone
+	for _, dx := range []float64{-50, -20, 0, 30, 50} {
+		r.UpdateCamera(centerX+dx, centerY+dx/2)
+		if x, y := r.CameraPosition(); x != 500 || y != 400 {
+			t.Fatalf("target %.0f px from center moved camera to (%.0f, %.0f)", dx, x, y)
+		}
+	}
+
+	// Leaving the dead zone scrolls just enough to keep the player on its edge
+	r.UpdateCamera(centerX+80, centerY)
+	if x, _ := r.CameraPosition(); x != 530 {
+		t.Errorf("camera X = %.0f after target left the dead zone by 30, want 530", x)
+	}
+	r.UpdateCamera(centerX+80, centerY-100)
+	if _, y := r.CameraPosition(); y != 330 {
+		t.Errorf("camera Y = %.0f after target left the dead zone by 70 upward, want 330", y)
+	}
+}
+
+func TestCameraDeadZoneZeroCentersOnTarget(t *testing.T) {
+	r := NewRenderer()
+	r.SetCameraBounds(ScreenWidth*3, ScreenHeight*3)
+	r.SetCameraDeadZone(0, 0)
+
+	r.UpdateCamera(1000, 700)
+	if x, y := r.CameraPosition(); x != 1000-float64(ScreenWidth)/2 || y != 700-float64(ScreenHeight)/2 {
+		t.Errorf("camera at (%.0f, %.0f), want centered on the target", x, y)
+	}
+}
+
 func TestGetCameraOffset(t *testing.T) {
 	r := NewRenderer()
 	r.camera.X = 100
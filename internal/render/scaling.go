@@ -0,0 +1,31 @@
+package render
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// SetSmoothScaling picks the filter used for sprites and tiles drawn at a
+// size other than their own: linear smoothing, or nearest-neighbor for
+// crisp pixels
+func (r *Renderer) SetSmoothScaling(smooth bool) {
+	if smooth {
+		r.scaleFilter = ebiten.FilterLinear
+	} else {
+		r.scaleFilter = ebiten.FilterNearest
+	}
+}
+
+// ScaleFilter returns the filter used for scaled sprites and tiles
+func (r *Renderer) ScaleFilter() ebiten.Filter {
+	return r.scaleFilter
+}
+
+// scaledDrawOptions returns options that draw img stretched to w by h with
+// its top-left corner at (x, y), filtered with the scaling filter
+func (r *Renderer) scaledDrawOptions(img *ebiten.Image, x, y, w, h float64) *ebiten.DrawImageOptions {
+	opts := &ebiten.DrawImageOptions{Filter: r.scaleFilter}
+	bounds := img.Bounds()
+	if bounds.Dx() > 0 && bounds.Dy() > 0 {
+		opts.GeoM.Scale(w/float64(bounds.Dx()), h/float64(bounds.Dy()))
+	}
+	opts.GeoM.Translate(x, y)
+	return opts
+}
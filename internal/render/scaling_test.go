@@ -0,0 +1,30 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestScaledDrawOptionsUseScaleFilter(t *testing.T) {
+	r := NewRenderer()
+	img := ebiten.NewImage(16, 16)
+
+	if got := r.scaledDrawOptions(img, 0, 0, 32, 32).Filter; got != ebiten.FilterNearest {
+		t.Errorf("default filter = %v, want FilterNearest", got)
+	}
+
+	r.SetSmoothScaling(true)
+	opts := r.scaledDrawOptions(img, 100, 50, 32, 32)
+	if opts.Filter != ebiten.FilterLinear {
+		t.Errorf("smooth filter = %v, want FilterLinear", opts.Filter)
+	}
+	if x, y := opts.GeoM.Apply(16, 16); x != 132 || y != 82 {
+		t.Errorf("far corner lands at (%v, %v), want (132, 82)", x, y)
+	}
+
+	r.SetSmoothScaling(false)
+	if got := r.ScaleFilter(); got != ebiten.FilterNearest {
+		t.Errorf("filter after turning smoothing off = %v, want FilterNearest", got)
+	}
+}
@@ -37,6 +37,17 @@ type SaveData struct {
 	// Progress tracking
 	BossesDefeated []int `json:"bosses_defeated"`
 	CheckpointID   int   `json:"checkpoint_id"`
+	NGPlusLevel    int   `json:"ng_plus_level,omitempty"` // New Game Plus cycle (0 = first run)
+	AssistMode     bool  `json:"assist_mode,omitempty"`   // Assist mode was on at some point this run
+	RewindUsed     bool  `json:"rewind_used,omitempty"`   // Rewind on death was on at some point this run
+
+	// DefeatedBossNames lists the bosses beaten, and Completion the overall
+	// completion percentage (0-100) when saved
+	DefeatedBossNames []string `json:"defeated_boss_names,omitempty"`
+	Completion        float64  `json:"completion,omitempty"`
+
+	// Runtime RNG state, so random outcomes replay identically after loading
+	RNGState uint64 `json:"rng_state,omitempty"`
 
 	// Achievement statistics (optional for backward compatibility)
 	AchievementStats *AchievementStatistics `json:"achievement_stats,omitempty"`
@@ -58,6 +69,7 @@ type AchievementStatistics struct {
 	PerfectRooms      int `json:"perfect_rooms"`
 	ConsecutiveKills  int `json:"consecutive_kills"`
 	LongestCombo      int `json:"longest_combo"`
+	HazardKills       int `json:"hazard_kills"`
 }
 
 // SaveManager handles all save/load operations
@@ -73,6 +85,10 @@ const (
 	autoSaveID  = 0 // Slot 0 is reserved for auto-save
 )
 
+// QuickSaveSlot is the reserved slot written by quicksave. It lies well
+// outside the numbered slots so quicksaving never overwrites a manual save.
+const QuickSaveSlot = 99
+
 // NewSaveManager creates a new save manager
 func NewSaveManager(saveDir string) (*SaveManager, error) {
 	// Default save directory in user's home config
@@ -98,7 +114,7 @@ func NewSaveManager(saveDir string) (*SaveManager, error) {
 
 // SaveGame saves the game state to a specific slot
 func (sm *SaveManager) SaveGame(data *SaveData, slotID int) error {
-	if slotID < 0 || slotID >= maxSlots {
+	if !validSlot(slotID) {
 		return fmt.Errorf("invalid slot ID: %d (must be 0-%d)", slotID, maxSlots-1)
 	}
 
@@ -125,7 +141,7 @@ func (sm *SaveManager) SaveGame(data *SaveData, slotID int) error {
 
 // LoadGame loads game state from a specific slot
 func (sm *SaveManager) LoadGame(slotID int) (*SaveData, error) {
-	if slotID < 0 || slotID >= maxSlots {
+	if !validSlot(slotID) {
 		return nil, fmt.Errorf("invalid slot ID: %d (must be 0-%d)", slotID, maxSlots-1)
 	}
 
@@ -162,9 +178,24 @@ func (sm *SaveManager) AutoSave(data *SaveData) error {
 	return sm.SaveGame(data, sm.autoSaveSlot)
 }
 
+// QuickSave saves to the reserved quicksave slot
+func (sm *SaveManager) QuickSave(data *SaveData) error {
+	return sm.SaveGame(data, QuickSaveSlot)
+}
+
+// QuickLoad loads from the reserved quicksave slot
+func (sm *SaveManager) QuickLoad() (*SaveData, error) {
+	return sm.LoadGame(QuickSaveSlot)
+}
+
+// validSlot reports whether slotID is a numbered slot or the quicksave slot
+func validSlot(slotID int) bool {
+	return (slotID >= 0 && slotID < maxSlots) || slotID == QuickSaveSlot
+}
+
 // DeleteSave removes a save file
 func (sm *SaveManager) DeleteSave(slotID int) error {
-	if slotID < 0 || slotID >= maxSlots {
+	if !validSlot(slotID) {
 		return fmt.Errorf("invalid slot ID: %d (must be 0-%d)", slotID, maxSlots-1)
 	}
 
@@ -244,6 +275,8 @@ func (sm *SaveManager) GetSaveInfo(slotID int) (SaveInfo, error) {
 		PlayerHealth: data.PlayerHealth,
 		RoomID:       data.CurrentRoomID,
 		FileSize:     stat.Size(),
+		AssistMode:   data.AssistMode,
+		RewindUsed:   data.RewindUsed,
 	}, nil
 }
 
@@ -259,6 +292,8 @@ type SaveInfo struct {
 	PlayerHealth int
 	RoomID       int
 	FileSize     int64
+	AssistMode   bool // Run used assist mode
+	RewindUsed   bool // Run used rewind on death
 }
 
 // getSlotFilename returns the filename for a given slot
@@ -266,6 +301,9 @@ func (sm *SaveManager) getSlotFilename(slotID int) string {
 	if slotID == sm.autoSaveSlot {
 		return filepath.Join(sm.saveDir, "autosave.json")
 	}
+	if slotID == QuickSaveSlot {
+		return filepath.Join(sm.saveDir, "quicksave.json")
+	}
 	return filepath.Join(sm.saveDir, fmt.Sprintf("save_%d.json", slotID))
 }
 
@@ -72,6 +72,7 @@ func TestSaveAndLoadGame(t *testing.T) {
 		UnlockedDoors:   map[string]bool{"door_1": true},
 		BossesDefeated:  []int{1},
 		CheckpointID:    3,
+		NGPlusLevel:     2,
 	}
 
 	// Save the game
@@ -105,6 +106,9 @@ func TestSaveAndLoadGame(t *testing.T) {
 	if !loadedData.PlayerAbilities["double_jump"] {
 		t.Error("Expected double_jump ability to be unlocked")
 	}
+	if loadedData.NGPlusLevel != originalData.NGPlusLevel {
+		t.Errorf("Expected NG+ level %d, got %d", originalData.NGPlusLevel, loadedData.NGPlusLevel)
+	}
 }
 
 func TestSaveGameInvalidSlot(t *testing.T) {
@@ -392,3 +396,39 @@ func TestGetters(t *testing.T) {
 		t.Errorf("Expected current slot 3, got %d", sm.GetCurrentSlot())
 	}
 }
+
+func TestQuickSaveSlot(t *testing.T) {
+	tempDir := t.TempDir()
+	sm, err := NewSaveManager(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create SaveManager: %v", err)
+	}
+
+	if _, err := sm.QuickLoad(); err == nil {
+		t.Error("Expected error loading a missing quicksave")
+	}
+
+	data := &SaveData{Seed: 7, PlayerHealth: 42, CurrentRoomID: 3}
+	if err := sm.QuickSave(data); err != nil {
+		t.Fatalf("QuickSave failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, "quicksave.json")); err != nil {
+		t.Errorf("Expected quicksave.json to be written: %v", err)
+	}
+
+	loaded, err := sm.QuickLoad()
+	if err != nil {
+		t.Fatalf("QuickLoad failed: %v", err)
+	}
+	if loaded.Seed != 7 || loaded.PlayerHealth != 42 || loaded.CurrentRoomID != 3 {
+		t.Errorf("QuickLoad returned %+v, want the quicksaved data", loaded)
+	}
+
+	// Numbered slots are untouched
+	saves, _ := sm.ListSaves()
+	for _, info := range saves {
+		if info.Exists {
+			t.Errorf("Quicksave leaked into slot %d", info.SlotID)
+		}
+	}
+}
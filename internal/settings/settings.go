@@ -81,12 +81,29 @@ func (q GraphicsQuality) String() string {
 	}
 }
 
+// ParticleLifetimeScale returns how long particles live at this quality
+// relative to their presets: shorter at low quality to save fill rate,
+// longer at high quality for lingering effects
+func (q GraphicsQuality) ParticleLifetimeScale() float64 {
+	switch q {
+	case QualityLow:
+		return 0.5
+	case QualityHigh:
+		return 1.25
+	case QualityUltra:
+		return 1.5
+	default:
+		return 1.0
+	}
+}
+
 // AudioSettings holds audio-related configuration
 type AudioSettings struct {
 	MasterVolume float64 `json:"master_volume"`
 	SFXVolume    float64 `json:"sfx_volume"`
 	MusicVolume  float64 `json:"music_volume"`
 	Muted        bool    `json:"muted"`
+	Captions     bool    `json:"captions"` // Show on-screen captions for sound cues
 }
 
 // GraphicsSettings holds graphics-related configuration
@@ -100,6 +117,24 @@ type GraphicsSettings struct {
 	ParticleEffects bool            `json:"particle_effects"`
 	ScreenShake     bool            `json:"screen_shake"`
 	UIScale         float64         `json:"ui_scale"`
+	SmoothScaling   bool            `json:"smooth_scaling"` // Smooth scaled sprites and tiles; off keeps pixels crisp
+	PlayerPalette   int             `json:"player_palette"` // Cosmetic player palette variant; 0 keeps the generated colors
+	MaxEnemies      int             `json:"max_enemies"`    // Living enemies updated and drawn at once; the farthest beyond it freeze
+	HUD             HUDSettings     `json:"hud"`
+}
+
+// HUDSettings chooses which HUD elements are shown and the screen corner
+// each is anchored to: "top-left", "top-right", "bottom-left" or
+// "bottom-right". An empty anchor keeps the element's usual corner.
+type HUDSettings struct {
+	HideHealthBar      bool   `json:"hide_health_bar"`
+	HideAbilityIcons   bool   `json:"hide_ability_icons"`
+	HideDebugInfo      bool   `json:"hide_debug_info"`
+	HideMinimap        bool   `json:"hide_minimap"`
+	HealthBarAnchor    string `json:"health_bar_anchor,omitempty"`
+	AbilityIconsAnchor string `json:"ability_icons_anchor,omitempty"`
+	DebugInfoAnchor    string `json:"debug_info_anchor,omitempty"`
+	MinimapAnchor      string `json:"minimap_anchor,omitempty"`
 }
 
 // GameplaySettings holds gameplay-related configuration
@@ -110,6 +145,18 @@ type GameplaySettings struct {
 	InputBuffering   bool    `json:"input_buffering"`
 	CameraSmoothing  float64 `json:"camera_smoothing"`
 	MouseSensitivity float64 `json:"mouse_sensitivity"`
+	EnemyRespawn     string  `json:"enemy_respawn"`    // "reentry", "never", or "timed"
+	RespawnSeconds   int     `json:"respawn_seconds"`  // Play time before a timed respawn
+	InstantMovement  bool    `json:"instant_movement"` // Full speed at once instead of accelerating
+	DisableHitStop   bool    `json:"disable_hit_stop"` // Skip the brief freeze when hits land
+	AutoRun          bool    `json:"auto_run"`         // Allow the auto-run toggle key
+	DisableGore      bool    `json:"disable_gore"`     // Replace blood on hits with neutral dust
+	AssistMode       bool    `json:"assist_mode"`      // More health, slower and weaker enemies
+	RewindOnDeath    bool    `json:"rewind_on_death"`  // A fatal hit rewinds a few seconds instead
+	AimAssist        string  `json:"aim_assist"`       // Pull on ranged shots toward enemies: "off", "soft", or "strong"
+	SkipTutorial     bool    `json:"skip_tutorial"`    // Skip the intro prompts in the start room
+	Language         string  `json:"language"`         // UI and narrative language code, e.g. "en"
+	ShowHitboxes     bool    `json:"show_hitboxes"`    // Outline collision rectangles during play
 }
 
 // ControlSettings holds key mapping configuration
@@ -175,6 +222,7 @@ func (sm *SettingsManager) createDefaultSettings() *Settings {
 			ParticleEffects: true,
 			ScreenShake:     true,
 			UIScale:         1.0,
+			MaxEnemies:      12,
 		},
 		Gameplay: GameplaySettings{
 			Difficulty:       1, // Normal
@@ -183,6 +231,10 @@ func (sm *SettingsManager) createDefaultSettings() *Settings {
 			InputBuffering:   true,
 			CameraSmoothing:  0.1,
 			MouseSensitivity: 1.0,
+			EnemyRespawn:     "reentry",
+			AimAssist:        "off",
+			RespawnSeconds:   120,
+			Language:         "en",
 		},
 		Controls: ControlSettings{
 			KeyBindings: map[ControlAction]ebiten.Key{
@@ -259,6 +311,9 @@ func (sm *SettingsManager) validateAndMergeSettings(loaded *Settings) *Settings
 	if loaded.Graphics.UIScale <= 0 {
 		loaded.Graphics.UIScale = defaults.Graphics.UIScale
 	}
+	if loaded.Graphics.MaxEnemies <= 0 {
+		loaded.Graphics.MaxEnemies = defaults.Graphics.MaxEnemies
+	}
 
 	// Merge gameplay settings
 	if loaded.Gameplay.CameraSmoothing <= 0 {
@@ -267,6 +322,22 @@ func (sm *SettingsManager) validateAndMergeSettings(loaded *Settings) *Settings
 	if loaded.Gameplay.MouseSensitivity <= 0 {
 		loaded.Gameplay.MouseSensitivity = defaults.Gameplay.MouseSensitivity
 	}
+	switch loaded.Gameplay.EnemyRespawn {
+	case "reentry", "never", "timed":
+	default:
+		loaded.Gameplay.EnemyRespawn = defaults.Gameplay.EnemyRespawn
+	}
+	switch loaded.Gameplay.AimAssist {
+	case "off", "soft", "strong":
+	default:
+		loaded.Gameplay.AimAssist = defaults.Gameplay.AimAssist
+	}
+	if loaded.Gameplay.RespawnSeconds <= 0 {
+		loaded.Gameplay.RespawnSeconds = defaults.Gameplay.RespawnSeconds
+	}
+	if loaded.Gameplay.Language == "" {
+		loaded.Gameplay.Language = defaults.Gameplay.Language
+	}
 
 	// Ensure all key bindings exist
 	if loaded.Controls.KeyBindings == nil {
@@ -258,6 +258,20 @@ func TestGetQualitySettings(t *testing.T) {
 	}
 }
 
+func TestParticleLifetimeScale(t *testing.T) {
+	if got := QualityMedium.ParticleLifetimeScale(); got != 1.0 {
+		t.Errorf("medium quality scale = %v, want 1", got)
+	}
+	prev := 0.0
+	for q := QualityLow; q <= QualityUltra; q++ {
+		scale := q.ParticleLifetimeScale()
+		if scale < prev {
+			t.Errorf("%v scale %v is below the lower quality's %v", q, scale, prev)
+		}
+		prev = scale
+	}
+}
+
 func TestGetDifficultyName(t *testing.T) {
 	sm := NewSettingsManager()
 
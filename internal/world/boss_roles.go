@@ -0,0 +1,128 @@
+package world
+
+import "sort"
+
+// BossRole says whether beating a room's boss is required to finish the run
+type BossRole int
+
+const (
+	NoBoss        BossRole = iota // Not a boss room
+	MandatoryBoss                 // On the critical path; its exits stay locked until it falls
+	OptionalBoss                  // Off the critical path, guarding a side branch
+)
+
+// OptionalBossCount is how many side-branch dead ends get an optional boss
+const OptionalBossCount = 2
+
+// placeOptionalBosses turns the deepest side-branch dead ends into boss
+// rooms. Ties go to the lower room ID, so placement draws no randomness.
+func (wg *WorldGenerator) placeOptionalBosses(world *World) {
+	leaves := make([]*Room, 0)
+	for _, room := range world.Rooms {
+		node, ok := world.Graph.Nodes[room.ID]
+		if ok && !node.Required && len(room.Connections) == 0 {
+			leaves = append(leaves, room)
+		}
+	}
+	sort.SliceStable(leaves, func(i, j int) bool {
+		return world.Graph.Nodes[leaves[i].ID].Depth > world.Graph.Nodes[leaves[j].ID].Depth
+	})
+
+	for i := 0; i < len(leaves) && i < OptionalBossCount; i++ {
+		leaves[i].Type = BossRoom
+	}
+
+	// Keep BossRooms in room order
+	world.BossRooms = world.BossRooms[:0]
+	for _, room := range world.Rooms {
+		if room.Type == BossRoom {
+			world.BossRooms = append(world.BossRooms, room)
+		}
+	}
+}
+
+// FinalBossRoom returns the boss room ending the critical path, whose defeat
+// is the run's end condition, or nil
+func FinalBossRoom(world *World) *Room {
+	var final *Room
+	for _, room := range CriticalPath(world) {
+		if room.Type == BossRoom {
+			final = room
+		}
+	}
+	return final
+}
+
+// BossPath returns the shortest chain of rooms from the start room to the
+// final boss, found breadth-first along room connections outside the hub,
+// or nil when the final boss cannot be reached
+func BossPath(world *World) []*Room {
+	final := FinalBossRoom(world)
+	if world.StartRoom == nil || final == nil {
+		return nil
+	}
+
+	prev := map[*Room]*Room{world.StartRoom: nil}
+	queue := []*Room{world.StartRoom}
+	for len(queue) > 0 {
+		room := queue[0]
+		queue = queue[1:]
+		if room == final {
+			break
+		}
+		for _, next := range room.Connections {
+			// Hub exits only open onto rooms already reached, so the
+			// hub is never a way forward
+			if next.Type == HubRoom {
+				continue
+			}
+			if _, seen := prev[next]; !seen {
+				prev[next] = room
+				queue = append(queue, next)
+			}
+		}
+	}
+	if _, reached := prev[final]; !reached {
+		return nil
+	}
+
+	path := make([]*Room, 0)
+	for room := final; room != nil; room = prev[room] {
+		path = append([]*Room{room}, path...)
+	}
+	return path
+}
+
+// AssignBossRoles marks boss rooms on the path from the start to the final
+// boss as mandatory and all others as optional. The exit of each mandatory
+// boss room onward along that path is locked until its boss is defeated,
+// so the final boss cannot be reached around it.
+func AssignBossRoles(world *World) {
+	onPath := make(map[*Room]int)
+	path := BossPath(world)
+	for i, room := range path {
+		onPath[room] = i
+	}
+
+	for _, room := range world.Rooms {
+		if room.Type != BossRoom {
+			room.BossRole = NoBoss
+			continue
+		}
+		i, ok := onPath[room]
+		if !ok {
+			room.BossRole = OptionalBoss
+			continue
+		}
+		room.BossRole = MandatoryBoss
+		if i+1 >= len(path) {
+			continue // The final boss has nowhere further to guard
+		}
+		for d := range room.Doors {
+			if room.Doors[d].LeadsTo == path[i+1] {
+				room.Doors[d].Locked = true
+				room.Doors[d].BossGated = true
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package world
+
+import "testing"
+
+func TestBossRolesFollowCriticalPath(t *testing.T) {
+	for _, seed := range []int64{1, 42, 999} {
+		w := NewWorldGenerator(15, 10, 80, 5).Generate(seed, nil)
+
+		path := BossPath(w)
+		if len(path) == 0 {
+			t.Fatalf("seed %d: no path from start to the final boss", seed)
+		}
+		if path[0] != w.StartRoom || path[len(path)-1] != FinalBossRoom(w) {
+			t.Fatalf("seed %d: path runs from room %d to %d, want start to final boss", seed, path[0].ID, path[len(path)-1].ID)
+		}
+		onPath := make(map[*Room]bool)
+		for _, room := range path {
+			onPath[room] = true
+		}
+
+		optional := 0
+		for _, room := range w.BossRooms {
+			switch room.BossRole {
+			case MandatoryBoss:
+				if !onPath[room] {
+					t.Errorf("seed %d: mandatory boss room %d is off the critical path", seed, room.ID)
+				}
+			case OptionalBoss:
+				optional++
+				if onPath[room] {
+					t.Errorf("seed %d: optional boss room %d is on the critical path", seed, room.ID)
+				}
+			default:
+				t.Errorf("seed %d: boss room %d has no boss role", seed, room.ID)
+			}
+		}
+		if optional == 0 {
+			t.Errorf("seed %d: no optional bosses placed", seed)
+		}
+	}
+}
+
+func TestMandatoryBossesCannotBeSkipped(t *testing.T) {
+	w := NewWorldGenerator(15, 10, 80, 5).Generate(42, nil)
+	final := FinalBossRoom(w)
+
+	mandatory := 0
+	for _, room := range w.BossRooms {
+		if room.BossRole != MandatoryBoss {
+			continue
+		}
+		mandatory++
+		if room == final {
+			continue
+		}
+		gated := false
+		for _, door := range room.Doors {
+			if door.BossGated && door.Locked {
+				gated = true
+			}
+		}
+		if !gated {
+			t.Errorf("mandatory boss room %d has no boss-gated exit", room.ID)
+		}
+	}
+	if mandatory < 2 {
+		t.Skipf("only %d mandatory bosses; nothing to skip", mandatory)
+	}
+
+	// With boss-gated doors shut, the final boss is out of reach
+	blocked := make(map[*Room]map[*Room]bool)
+	for _, room := range w.Rooms {
+		for _, door := range room.Doors {
+			if door.BossGated {
+				if blocked[room] == nil {
+					blocked[room] = make(map[*Room]bool)
+				}
+				blocked[room][door.LeadsTo] = true
+			}
+		}
+	}
+	seen := map[*Room]bool{w.StartRoom: true}
+	queue := []*Room{w.StartRoom}
+	for len(queue) > 0 {
+		room := queue[0]
+		queue = queue[1:]
+		for _, next := range room.Connections {
+			if !seen[next] && !blocked[room][next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	if seen[final] {
+		t.Error("final boss reachable without defeating the mandatory bosses before it")
+	}
+}
@@ -0,0 +1,134 @@
+package world
+
+import (
+	"math"
+	"sort"
+)
+
+// NeutralConstraint is the level of a narrative constraint that leaves
+// generation as it would be without one
+const NeutralConstraint = 5
+
+// Narrative constraint tuning
+const (
+	// dangerDensityStep is the change in enemy density per danger level
+	// away from neutral
+	dangerDensityStep = 0.15
+
+	// secretChancePerMystery is the chance, per mystery level, that a side
+	// branch's dead end is a secret room
+	secretChancePerMystery = 0.1
+)
+
+// NarrativeConstraints are the world constraints the story sets, each on a
+// 1-10 scale: how dangerous, how mysterious, and how technologically
+// advanced the world is
+type NarrativeConstraints struct {
+	Danger  int
+	Mystery int
+	Tech    int
+
+	hasTech bool // Tech was given; without it biomes keep their usual order
+}
+
+// ParseNarrativeConstraints reads "dangerLevel", "mysteryLevel" and
+// "techLevel" from a generation constraints map. Missing or malformed
+// values are NeutralConstraint; the rest are clamped to 1-10.
+func ParseNarrativeConstraints(constraints map[string]interface{}) NarrativeConstraints {
+	level := func(key string) (int, bool) {
+		v, ok := constraints[key].(int)
+		if !ok {
+			return NeutralConstraint, false
+		}
+		return min(max(v, 1), 10), true
+	}
+	nc := NarrativeConstraints{}
+	nc.Danger, _ = level("dangerLevel")
+	nc.Mystery, _ = level("mysteryLevel")
+	nc.Tech, nc.hasTech = level("techLevel")
+	return nc
+}
+
+// EnemyDensityScale returns the multiplier danger puts on enemy density
+func (nc NarrativeConstraints) EnemyDensityScale() float64 {
+	return 1 + float64(nc.Danger-NeutralConstraint)*dangerDensityStep
+}
+
+// DangerOffset returns how much danger raises or lowers each biome's
+// danger level, and with it the stats of the enemies generated there
+func (nc NarrativeConstraints) DangerOffset() int {
+	return nc.Danger - NeutralConstraint
+}
+
+// SecretChance returns the chance a side branch's dead end is a secret room
+func (nc NarrativeConstraints) SecretChance() float64 {
+	return float64(nc.Mystery) * secretChancePerMystery
+}
+
+// EnemyDensity returns the enemy density in effect: the world's density
+// scaled by its narrative danger level
+func (w *World) EnemyDensity() float64 {
+	density := DefaultDensity().Enemies
+	if w.Density != nil {
+		density = w.Density.Enemies
+	}
+	if w.dangerScale > 0 {
+		density *= w.dangerScale
+	}
+	return math.Min(MaxDensity, density)
+}
+
+// biomeOrder lists the biome types in world depth order
+var biomeOrder = []string{"cave", "forest", "ruins", "crystal", "abyss", "sky"}
+
+// biomeTech is how technological each biome's inhabitants are, 1-10: the
+// ruins' golems and constructs are the most, the forest's beasts the least
+var biomeTech = map[string]int{
+	"cave":    3,
+	"forest":  1,
+	"ruins":   9,
+	"crystal": 6,
+	"abyss":   4,
+	"sky":     7,
+}
+
+// BiomeTypes returns the count biome types a world uses, in depth order.
+// With a tech level, the biomes whose inhabitants are nearest that level
+// are chosen; otherwise the first count of the usual order.
+func (nc NarrativeConstraints) BiomeTypes(count int) []string {
+	if !nc.hasTech || count >= len(biomeOrder) {
+		types := make([]string, count)
+		for i := range types {
+			types[i] = biomeOrder[i%len(biomeOrder)]
+		}
+		return types
+	}
+
+	rank := make(map[string]int, len(biomeOrder))
+	byTech := make([]string, len(biomeOrder))
+	for i, name := range biomeOrder {
+		rank[name] = i
+		byTech[i] = name
+	}
+	techGap := func(name string) int {
+		gap := biomeTech[name] - nc.Tech
+		return max(gap, -gap)
+	}
+	sort.SliceStable(byTech, func(i, j int) bool { return techGap(byTech[i]) < techGap(byTech[j]) })
+	types := byTech[:count]
+	sort.Slice(types, func(i, j int) bool { return rank[types[i]] < rank[types[j]] })
+	return types
+}
+
+// markSecretRooms makes some side branches end in a secret room, each dead
+// end with the constraints' secret chance
+func (wg *WorldGenerator) markSecretRooms(world *World, nc NarrativeConstraints) {
+	chance := nc.SecretChance()
+	for _, room := range world.Rooms {
+		node := world.Graph.Nodes[room.ID]
+		if node == nil || node.Required || len(room.Connections) != 1 {
+			continue
+		}
+		room.Secret = wg.rng.Float64() < chance
+	}
+}
@@ -0,0 +1,84 @@
+package world
+
+import "testing"
+
+// generateWithConstraints generates the same seed under the given
+// narrative constraint levels
+func generateWithConstraints(danger, mystery, tech int) *World {
+	wg := NewWorldGenerator(15, 10, 40, 4)
+	return wg.Generate(42, map[string]interface{}{
+		"dangerLevel":  danger,
+		"mysteryLevel": mystery,
+		"techLevel":    tech,
+	})
+}
+
+func TestDangerRaisesEnemyDensityAndBiomeDanger(t *testing.T) {
+	calm := generateWithConstraints(2, NeutralConstraint, NeutralConstraint)
+	deadly := generateWithConstraints(9, NeutralConstraint, NeutralConstraint)
+
+	if calm.EnemyDensity() >= deadly.EnemyDensity() {
+		t.Errorf("enemy density %v at danger 2, %v at danger 9; want higher with danger",
+			calm.EnemyDensity(), deadly.EnemyDensity())
+	}
+	for i := range calm.Biomes {
+		if calm.Biomes[i].DangerLevel >= deadly.Biomes[i].DangerLevel {
+			t.Errorf("biome %s danger %d at danger 2, %d at danger 9; want higher with danger",
+				calm.Biomes[i].Name, calm.Biomes[i].DangerLevel, deadly.Biomes[i].DangerLevel)
+		}
+	}
+}
+
+func TestTechLevelChoosesBiomes(t *testing.T) {
+	has := func(w *World, name string) bool {
+		for _, b := range w.Biomes {
+			if b.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	primitive := generateWithConstraints(NeutralConstraint, NeutralConstraint, 1)
+	advanced := generateWithConstraints(NeutralConstraint, NeutralConstraint, 10)
+	if !has(primitive, "forest") || has(primitive, "ruins") {
+		t.Errorf("tech 1 biomes = %v, want the forest and not the ruins", biomeNames(primitive))
+	}
+	if !has(advanced, "ruins") || has(advanced, "forest") {
+		t.Errorf("tech 10 biomes = %v, want the ruins and not the forest", biomeNames(advanced))
+	}
+
+	// Without a tech level the usual biomes are kept
+	usual := NewWorldGenerator(15, 10, 40, 4).Generate(42, nil)
+	for i, want := range []string{"cave", "forest", "ruins", "crystal"} {
+		if got := usual.Biomes[i].Name; got != want {
+			t.Errorf("biome %d = %s without constraints, want %s", i, got, want)
+		}
+	}
+}
+
+func TestMysteryRaisesSecretRooms(t *testing.T) {
+	secrets := func(w *World) int {
+		n := 0
+		for _, room := range w.Rooms {
+			if room.Secret {
+				n++
+			}
+		}
+		return n
+	}
+
+	plain, mysterious := secrets(generateWithConstraints(NeutralConstraint, 1, NeutralConstraint)),
+		secrets(generateWithConstraints(NeutralConstraint, 9, NeutralConstraint))
+	if mysterious <= plain {
+		t.Errorf("%d secret rooms at mystery 1, %d at mystery 9; want more with mystery", plain, mysterious)
+	}
+}
+
+func biomeNames(w *World) []string {
+	names := make([]string, len(w.Biomes))
+	for i, b := range w.Biomes {
+		names[i] = b.Name
+	}
+	return names
+}
@@ -0,0 +1,62 @@
+package world
+
+// Crawl space tuning
+const (
+	// CrawlSpaceHeight is the clearance under a crawl-space slab: too low
+	// to stand in, high enough to crouch through
+	CrawlSpaceHeight = 24
+
+	crawlSlabHeight    = 32  // Thickness of the slab roofing a crawl space
+	crawlSpaceChance   = 0.5 // Share of corridors given a crawl space
+	crawlSpaceMinW     = 96  // Shortest crawl space, in pixels
+	crawlSpaceMaxW     = 192 // Longest crawl space, in pixels
+	crawlSpaceMargin   = 150 // Gap kept from the room's side walls
+	crawlSpaceAttempts = 8   // Random positions tried before giving up
+)
+
+// addCrawlSpace roofs a stretch of a corridor's ground with a low slab the
+// player must crouch to pass under. The slab keeps clear of doors and other
+// platforms; the corridor is left alone when no position fits.
+func (pg *PlatformGenerator) addCrawlSpace(room *Room) {
+	if room.Type != CorridorRoom || pg.rng.Float64() >= crawlSpaceChance {
+		return
+	}
+	groundY := room.GroundY()
+	if groundY >= RoomPixelHeight {
+		return
+	}
+
+	width := crawlSpaceMinW + pg.rng.Intn(crawlSpaceMaxW-crawlSpaceMinW+1)
+	slabY := groundY - CrawlSpaceHeight - crawlSlabHeight
+	for i := 0; i < crawlSpaceAttempts; i++ {
+		x := crawlSpaceMargin + pg.rng.Intn(RoomPixelWidth-2*crawlSpaceMargin-width)
+		slab := Platform{X: x, Y: slabY, Width: width, Height: crawlSlabHeight}
+		// The whole tunnel, slab down to the ground, must be free
+		tunnel := Platform{X: x, Y: slabY, Width: width, Height: groundY - slabY}
+		if crawlSpaceFits(room, tunnel) {
+			room.Platforms = append(room.Platforms, slab)
+			return
+		}
+	}
+}
+
+// crawlSpaceFits reports whether area overlaps no door and no platform
+// other than the ground beneath it
+func crawlSpaceFits(room *Room, area Platform) bool {
+	for _, door := range room.Doors {
+		if rectsOverlap(area.X, area.Y, area.Width, area.Height, door.X, door.Y, door.Width, door.Height) {
+			return false
+		}
+	}
+	for _, p := range room.Platforms {
+		if rectsOverlap(area.X, area.Y, area.Width, area.Height, p.X, p.Y, p.Width, p.Height) {
+			return false
+		}
+	}
+	return true
+}
+
+// rectsOverlap reports whether two rectangles share any area
+func rectsOverlap(x1, y1, w1, h1, x2, y2, w2, h2 int) bool {
+	return x1 < x2+w2 && x1+w1 > x2 && y1 < y2+h2 && y1+h1 > y2
+}
@@ -0,0 +1,34 @@
+package world
+
+import "testing"
+
+// TestCorridorsGetCrawlSpaces verifies generated corridors include crawl
+// spaces that leave exactly CrawlSpaceHeight above the ground and stay clear
+// of doors
+func TestCorridorsGetCrawlSpaces(t *testing.T) {
+	found := 0
+	for _, seed := range []int64{42, 12345, 67890} {
+		wg := NewWorldGenerator(15, 10, 40, 4)
+		world := wg.Generate(seed, nil)
+		for _, room := range world.Rooms {
+			groundY := room.GroundY()
+			for _, p := range room.Platforms {
+				if p.Height != crawlSlabHeight || p.Y+p.Height != groundY-CrawlSpaceHeight {
+					continue
+				}
+				if room.Type != CorridorRoom {
+					t.Errorf("seed %d room %d (type %d) has a crawl space", seed, room.ID, room.Type)
+				}
+				for _, door := range room.Doors {
+					if rectsOverlap(p.X, p.Y, p.Width, groundY-p.Y, door.X, door.Y, door.Width, door.Height) {
+						t.Errorf("seed %d room %d crawl space overlaps a door", seed, room.ID)
+					}
+				}
+				found++
+			}
+		}
+	}
+	if found == 0 {
+		t.Error("no crawl spaces generated")
+	}
+}
@@ -0,0 +1,48 @@
+package world
+
+import (
+	"fmt"
+	"math"
+)
+
+// MaxDensity is the largest density multiplier accepted
+const MaxDensity = 4.0
+
+// Density scales how much a generated world holds. Each field multiplies
+// the normal amount: 1 is the default, 0.5 a sparse world, 2 a packed one.
+type Density struct {
+	Enemies float64 `json:"enemies"` // Enemies per combat room
+	Items   float64 `json:"items"`   // Items per treasure room
+	Hazards float64 `json:"hazards"` // Hazards per combat and boss room
+}
+
+// DefaultDensity returns the normal amount of everything
+func DefaultDensity() Density {
+	return Density{Enemies: 1, Items: 1, Hazards: 1}
+}
+
+// Validate checks that every multiplier is between 0 and MaxDensity
+func (d Density) Validate() error {
+	for _, field := range []struct {
+		name  string
+		value float64
+	}{
+		{"enemy", d.Enemies},
+		{"item", d.Items},
+		{"hazard", d.Hazards},
+	} {
+		if math.IsNaN(field.value) || field.value < 0 || field.value > MaxDensity {
+			return fmt.Errorf("%s density must be between 0 and %g, got %g", field.name, MaxDensity, field.value)
+		}
+	}
+	return nil
+}
+
+// ScaleCount applies a density multiplier to a base count, rounding to the
+// nearest whole number
+func ScaleCount(base int, factor float64) int {
+	if factor == 1 {
+		return base
+	}
+	return max(0, int(math.Round(float64(base)*factor)))
+}
@@ -0,0 +1,97 @@
+package world
+
+import "testing"
+
+func TestDensityValidate(t *testing.T) {
+	if err := DefaultDensity().Validate(); err != nil {
+		t.Errorf("default density invalid: %v", err)
+	}
+	if err := (Density{Enemies: 0, Items: 0, Hazards: MaxDensity}).Validate(); err != nil {
+		t.Errorf("boundary density invalid: %v", err)
+	}
+	for _, d := range []Density{
+		{Enemies: -1, Items: 1, Hazards: 1},
+		{Enemies: 1, Items: MaxDensity + 1, Hazards: 1},
+	} {
+		if err := d.Validate(); err == nil {
+			t.Errorf("Validate(%+v) = nil, want an error", d)
+		}
+	}
+}
+
+func TestScaleCount(t *testing.T) {
+	tests := []struct {
+		base   int
+		factor float64
+		want   int
+	}{
+		{3, 1, 3},
+		{3, 2, 6},
+		{3, 0.5, 2},
+		{3, 0, 0},
+		{1, 0.25, 0},
+	}
+	for _, tt := range tests {
+		if got := ScaleCount(tt.base, tt.factor); got != tt.want {
+			t.Errorf("ScaleCount(%d, %g) = %d, want %d", tt.base, tt.factor, got, tt.want)
+		}
+	}
+}
+
+// roomContents totals enemies, items and hazards across a world
+func roomContents(w *World) (enemies, items, hazards int) {
+	for _, room := range w.Rooms {
+		if room.Type == CombatRoom {
+			enemies += len(room.Enemies)
+		}
+		items += len(room.Items)
+		hazards += len(room.Hazards)
+	}
+	return enemies, items, hazards
+}
+
+func TestDensityScalesRoomContents(t *testing.T) {
+	normal := NewWorldGenerator(15, 10, 80, 5).Generate(42, nil)
+
+	gen := NewWorldGenerator(15, 10, 80, 5)
+	gen.Density = Density{Enemies: 2, Items: 2, Hazards: 0}
+	packed := gen.Generate(42, nil)
+
+	if len(packed.Rooms) != len(normal.Rooms) {
+		t.Fatalf("density changed the room count: %d vs %d", len(packed.Rooms), len(normal.Rooms))
+	}
+	ne, ni, _ := roomContents(normal)
+	pe, pi, ph := roomContents(packed)
+	if pe < ne*3/2 {
+		t.Errorf("enemy density 2 gave %d combat-room enemies, normal gave %d", pe, ne)
+	}
+	if pi < ni*3/2 {
+		t.Errorf("item density 2 gave %d items, normal gave %d", pi, ni)
+	}
+	if ph != 0 {
+		t.Errorf("hazard density 0 still placed %d hazards", ph)
+	}
+	if *packed.Density != gen.Density {
+		t.Errorf("world records density %+v, want %+v", *packed.Density, gen.Density)
+	}
+}
+
+func TestDensityKeepsWorldCompletable(t *testing.T) {
+	for _, d := range []Density{
+		{Enemies: 0, Items: 0, Hazards: 0},
+		{Enemies: MaxDensity, Items: MaxDensity, Hazards: MaxDensity},
+	} {
+		gen := NewWorldGenerator(15, 10, 80, 5)
+		gen.Density = d
+		w := gen.Generate(42, nil)
+
+		path := BossPath(w)
+		if len(path) == 0 {
+			t.Errorf("density %+v: final boss unreachable", d)
+			continue
+		}
+		if len(w.BossRooms) == 0 || w.StartRoom == nil {
+			t.Errorf("density %+v: world is missing its start or boss rooms", d)
+		}
+	}
+}
@@ -22,6 +22,21 @@ type Room struct {
 	Hazards     []Hazard
 	Doors       []Door        // Exits to other rooms
 	Anchors     []AnchorPoint // Grapple hook anchor points
+	Puzzle      *Puzzle       // Interactive elements (puzzle rooms only)
+
+	HealthPickups int // Healing pickups placed by the resource balancing pass
+
+	// ItemSpots are where the room's treasure items and then its health
+	// pickups appear (see placement.go)
+	ItemSpots []ItemSpot
+
+	BossRole BossRole // Whether this room's boss must be beaten (see boss_roles.go)
+
+	Secret bool // A hidden dead end counted as a secret when found (see constraints.go)
+
+	TileLayers TileLayers // Biome tilesets for each drawing layer (see tile_layers.go)
+
+	Gravity GravityDirection // Which way gravity pulls (see gravity.go)
 }
 
 // RoomType defines room archetypes
@@ -35,8 +50,15 @@ const (
 	BossRoom
 	StartRoom
 	SaveRoom
+	HubRoom // Central nexus linking the major regions (see hub.go)
 )
 
+// IsSafeHaven reports whether the room is a save room or the hub, where no
+// enemies spawn so the player never loads into combat
+func (r *Room) IsSafeHaven() bool {
+	return r.Type == SaveRoom || r.Type == HubRoom
+}
+
 // Platform represents a platform in a room
 type Platform struct {
 	X, Y   int
@@ -61,6 +83,9 @@ type Door struct {
 	LeadsTo         *Room  // Connected room
 	Locked          bool   // Whether door requires ability/key
 	RequiredAbility string // Ability key needed to unlock (e.g., "double_jump", "dash")
+	PuzzleGated     bool   // Opened only by the room's puzzle, never by abilities
+	BossGated       bool   // Opened by defeating the room's boss
+	HubGated        bool   // A hub exit, opened once the room it leads to is visited
 }
 
 // AnchorPoint represents a grapple hook anchor point
@@ -73,10 +98,17 @@ type World struct {
 	Rooms     []*Room
 	StartRoom *Room
 	BossRooms []*Room
+	HubRoom   *Room // Central room linking the major regions; nil in small worlds
 	Biomes    []*Biome
 	Width     int // Number of rooms wide
 	Height    int // Number of rooms tall
 	Graph     *WorldGraph
+	Regions   []*BiomeRegion // Connected same-biome zones for the map
+	Density   *Density       // Density the world was generated with; nil means the default
+
+	// Multiplier the narrative danger level put on enemy density; 0 means
+	// none (see EnemyDensity)
+	dangerScale float64
 }
 
 // WorldGraph represents connectivity between rooms
@@ -108,7 +140,15 @@ type WorldGenerator struct {
 	Height     int
 	RoomCount  int
 	BiomeCount int
+	Density    Density          // Amount of enemies, items and hazards placed
+	Spacing    PlacementSpacing // Minimum distances between doors, items and the spawn
+	Layouts    LayoutWeights    // Platform layouts each biome's combat rooms choose between
 	rng        *rand.Rand
+
+	// Abilities the player starts with (New Game Plus); never used as gates
+	startingAbilities map[string]bool
+
+	layouts LayoutWeights // Layouts in use: Layouts, or the defaults if it is invalid
 }
 
 // NewWorldGenerator creates a new world generator
@@ -132,6 +172,9 @@ func NewWorldGenerator(width, height, roomCount, biomeCount int) *WorldGenerator
 		Height:     height,
 		RoomCount:  roomCount,
 		BiomeCount: biomeCount,
+		Density:    DefaultDensity(),
+		Spacing:    DefaultPlacementSpacing(),
+		Layouts:    DefaultLayoutWeights(),
 	}
 }
 
@@ -139,20 +182,37 @@ func NewWorldGenerator(width, height, roomCount, biomeCount int) *WorldGenerator
 func (wg *WorldGenerator) Generate(seed int64, constraints map[string]interface{}) *World {
 	wg.rng = rand.New(rand.NewSource(seed))
 
+	// A broken layout table would leave combat rooms without platforms
+	wg.layouts = wg.Layouts
+	if wg.layouts == nil || wg.layouts.Validate() != nil {
+		wg.layouts = DefaultLayoutWeights()
+	}
+
+	wg.startingAbilities = make(map[string]bool)
+	if owned, ok := constraints["starting_abilities"].([]string); ok {
+		for _, ability := range owned {
+			wg.startingAbilities[ability] = true
+		}
+	}
+
+	narrative := ParseNarrativeConstraints(constraints)
+	density := wg.Density
 	world := &World{
-		Rooms:  make([]*Room, 0, wg.RoomCount),
-		Biomes: make([]*Biome, wg.BiomeCount),
-		Width:  wg.Width,
-		Height: wg.Height,
+		Rooms:       make([]*Room, 0, wg.RoomCount),
+		Biomes:      make([]*Biome, wg.BiomeCount),
+		Width:       wg.Width,
+		Height:      wg.Height,
+		Density:     &density,
+		dangerScale: narrative.EnemyDensityScale(),
 		Graph: &WorldGraph{
 			Nodes: make(map[int]*GraphNode),
 			Edges: make([]GraphEdge, 0),
 		},
 	}
 
-	// Generate biomes first
-	for i := 0; i < wg.BiomeCount; i++ {
-		world.Biomes[i] = wg.generateBiome(i)
+	// Generate biomes first, chosen to suit the world's tech level
+	for i, name := range narrative.BiomeTypes(wg.BiomeCount) {
+		world.Biomes[i] = wg.generateBiome(i, name, narrative.DangerOffset())
 	}
 
 	// Generate world graph structure
@@ -161,6 +221,9 @@ func (wg *WorldGenerator) Generate(seed int64, constraints map[string]interface{
 	// Create rooms based on graph
 	wg.createRooms(world)
 
+	// Put optional bosses at the ends of side branches
+	wg.placeOptionalBosses(world)
+
 	// Generate room contents
 	for _, room := range world.Rooms {
 		wg.populateRoom(room)
@@ -169,6 +232,32 @@ func (wg *WorldGenerator) Generate(seed int64, constraints map[string]interface{
 	// Add shortcuts for backtracking
 	wg.addShortcuts(world)
 
+	// Decide which bosses are required and lock the way past them
+	AssignBossRoles(world)
+
+	// Pick the tilesets each room layers for depth
+	AssignTileLayers(world)
+
+	// Group rooms into labeled biome zones for the map
+	world.Regions = BuildBiomeRegions(world.Rooms)
+
+	// Link the major regions through a central hub
+	wg.addHubRoom(world)
+
+	// Spread healing along the critical path
+	BalanceHealthPickups(world, DefaultResourceBalanceConfig())
+
+	// Lay out items and keep doors, items and the spawn apart
+	for _, room := range world.Rooms {
+		PlaceRoomFeatures(room, wg.Spacing, wg.rng)
+	}
+
+	// Hide secret rooms at the ends of side branches
+	wg.markSecretRooms(world, narrative)
+
+	// Turn some corridors upside down
+	wg.assignGravity(world)
+
 	return world
 }
 
@@ -198,8 +287,10 @@ func (wg *WorldGenerator) generateGraph(world *World) {
 		// Determine if this edge requires an ability
 		requirement := ""
 		if i > 0 && i%5 == 0 {
-			abilities := []string{"double_jump", "dash", "wall_climb", "glide"}
-			requirement = abilities[wg.rng.Intn(len(abilities))]
+			abilities := wg.gatingAbilities()
+			if len(abilities) > 0 {
+				requirement = abilities[wg.rng.Intn(len(abilities))]
+			}
 		}
 
 		world.Graph.Edges = append(world.Graph.Edges, GraphEdge{
@@ -264,6 +355,18 @@ func (wg *WorldGenerator) generateGraph(world *World) {
 	}
 }
 
+// gatingAbilities returns the abilities that may gate progress, excluding
+// any the player already starts with
+func (wg *WorldGenerator) gatingAbilities() []string {
+	abilities := make([]string, 0, 4)
+	for _, ability := range []string{"double_jump", "dash", "wall_climb", "glide"} {
+		if !wg.startingAbilities[ability] {
+			abilities = append(abilities, ability)
+		}
+	}
+	return abilities
+}
+
 // createRooms instantiates rooms based on graph
 func (wg *WorldGenerator) createRooms(world *World) {
 	// Create a map to lookup rooms by ID
@@ -369,6 +472,7 @@ func (wg *WorldGenerator) assignBiome(world *World, depth int) *Biome {
 func (wg *WorldGenerator) populateRoom(room *Room) {
 	// Use procedural platform generator
 	platformGen := NewPlatformGenerator()
+	platformGen.Layouts = wg.layouts
 
 	// Create a seed based on room ID and world seed for consistency
 	roomSeed := wg.rng.Int63() + int64(room.ID*1000)
@@ -386,7 +490,7 @@ func (wg *WorldGenerator) populateRoom(room *Room) {
 
 	// Add hazards based on room type and biome
 	if room.Type == CombatRoom || room.Type == BossRoom {
-		hazardCount := 1 + wg.rng.Intn(3)
+		hazardCount := ScaleCount(1+wg.rng.Intn(3), wg.Density.Hazards)
 		room.Hazards = make([]Hazard, hazardCount)
 
 		for i := range room.Hazards {
@@ -406,10 +510,10 @@ func (wg *WorldGenerator) populateRoom(room *Room) {
 	switch room.Type {
 	case CombatRoom:
 		// Will be populated with enemies by entity generator
-		room.Enemies = make([]interface{}, 2+wg.rng.Intn(3))
+		room.Enemies = make([]interface{}, ScaleCount(2+wg.rng.Intn(3), wg.Density.Enemies))
 	case TreasureRoom:
 		// Will be populated with items
-		room.Items = make([]interface{}, 1+wg.rng.Intn(2))
+		room.Items = make([]interface{}, ScaleCount(1+wg.rng.Intn(2), wg.Density.Items))
 	case BossRoom:
 		// One boss enemy
 		room.Enemies = make([]interface{}, 1)
@@ -417,57 +521,67 @@ func (wg *WorldGenerator) populateRoom(room *Room) {
 
 	// Generate doors based on connections
 	wg.generateDoors(room)
+
+	// Puzzle rooms seal one door behind plates and switches
+	if room.Type == PuzzleRoom {
+		NewPuzzleGenerator().GeneratePuzzle(room, roomSeed)
+	}
 }
 
 // generateDoors creates doors for each room connection
 func (wg *WorldGenerator) generateDoors(room *Room) {
 	room.Doors = make([]Door, 0, len(room.Connections))
 
-	// Standard room dimensions in pixels (assuming 960x640 screen)
-	roomWidthPixels := 960
-	roomHeightPixels := 640
-	doorWidth := 64
-	doorHeight := 96
-
 	for i, connectedRoom := range room.Connections {
 		if connectedRoom == nil {
 			continue
 		}
+		room.Doors = append(room.Doors, newDoor(i, connectedRoom))
+	}
+}
 
-		var door Door
-		door.LeadsTo = connectedRoom
-		door.Width = doorWidth
-		door.Height = doorHeight
-		door.Locked = false // Will be set based on requirements later
-
-		// Determine door direction based on relative position
-		// For now, place doors evenly around the room perimeter
-		direction := i % 4 // Cycle through: east, west, north, south
-
-		switch direction {
-		case 0: // East (right side)
-			door.Direction = "east"
-			door.X = roomWidthPixels - doorWidth - 10
-			door.Y = roomHeightPixels/2 - doorHeight/2
-
-		case 1: // West (left side)
-			door.Direction = "west"
-			door.X = 10
-			door.Y = roomHeightPixels/2 - doorHeight/2
-
-		case 2: // North (top) - for vertical movement
-			door.Direction = "north"
-			door.X = roomWidthPixels/2 - doorWidth/2
-			door.Y = 10
-
-		case 3: // South (bottom)
-			door.Direction = "south"
-			door.X = roomWidthPixels/2 - doorWidth/2
-			door.Y = roomHeightPixels - doorHeight - 10
-		}
+// newDoor creates the door for a room's i-th connection, leading to
+// connectedRoom
+func newDoor(i int, connectedRoom *Room) Door {
+	// Standard room dimensions in pixels
+	roomWidthPixels := RoomPixelWidth
+	roomHeightPixels := RoomPixelHeight
+	doorWidth := 64
+	doorHeight := 96
+
+	var door Door
+	door.LeadsTo = connectedRoom
+	door.Width = doorWidth
+	door.Height = doorHeight
+	door.Locked = false // Will be set based on requirements later
+
+	// Determine door direction based on relative position
+	// For now, place doors evenly around the room perimeter
+	direction := i % 4 // Cycle through: east, west, north, south
 
-		room.Doors = append(room.Doors, door)
+	switch direction {
+	case 0: // East (right side)
+		door.Direction = "east"
+		door.X = roomWidthPixels - doorWidth - 10
+		door.Y = roomHeightPixels/2 - doorHeight/2
+
+	case 1: // West (left side)
+		door.Direction = "west"
+		door.X = 10
+		door.Y = roomHeightPixels/2 - doorHeight/2
+
+	case 2: // North (top) - for vertical movement
+		door.Direction = "north"
+		door.X = roomWidthPixels/2 - doorWidth/2
+		door.Y = 10
+
+	case 3: // South (bottom)
+		door.Direction = "south"
+		door.X = roomWidthPixels/2 - doorWidth/2
+		door.Y = roomHeightPixels - doorHeight - 10
 	}
+
+	return door
 }
 
 // addShortcuts creates backtracking shortcuts following these rules:
@@ -490,7 +604,7 @@ func (wg *WorldGenerator) addShortcuts(world *World) {
 	shortcutCount := 3 + wg.rng.Intn(3)
 
 	// Available abilities for gating shortcuts
-	abilities := []string{"double_jump", "dash", "wall_climb", "glide"}
+	abilities := wg.gatingAbilities()
 
 	addedShortcuts := 0
 	maxAttempts := shortcutCount * 3 // Try up to 3x to find valid shortcuts
@@ -522,8 +636,10 @@ func (wg *WorldGenerator) addShortcuts(world *World) {
 
 		// Select ability requirement based on destination depth
 		// Ability should be gained after destination but before source
-		abilityIdx := (destNode.Depth / 5) % len(abilities)
-		requirement := abilities[abilityIdx]
+		requirement := ""
+		if len(abilities) > 0 {
+			requirement = abilities[(destNode.Depth/5)%len(abilities)]
+		}
 
 		// Add the shortcut edge
 		world.Graph.Edges = append(world.Graph.Edges, GraphEdge{
@@ -561,10 +677,12 @@ func (wg *WorldGenerator) getCriticalPathNodes(world *World) []int {
 		}
 	}
 
-	// Sort by depth for deterministic ordering
+	// Sort by depth, then ID, for deterministic ordering (the boss shares
+	// the depth of the room before it)
 	for i := 0; i < len(criticalNodes)-1; i++ {
 		for j := i + 1; j < len(criticalNodes); j++ {
-			if world.Graph.Nodes[criticalNodes[i]].Depth > world.Graph.Nodes[criticalNodes[j]].Depth {
+			di, dj := world.Graph.Nodes[criticalNodes[i]].Depth, world.Graph.Nodes[criticalNodes[j]].Depth
+			if di > dj || (di == dj && criticalNodes[i] > criticalNodes[j]) {
 				criticalNodes[i], criticalNodes[j] = criticalNodes[j], criticalNodes[i]
 			}
 		}
@@ -593,15 +711,20 @@ func (wg *WorldGenerator) findRoomIndexByID(world *World, roomID int) int {
 	return -1
 }
 
-// generateBiome creates a biome
-func (wg *WorldGenerator) generateBiome(index int) *Biome {
-	biomeTypes := []string{"cave", "forest", "ruins", "crystal", "abyss", "sky"}
+// generateBiome creates the biome of the given type at position index in
+// the world, its danger shifted by dangerOffset
+func (wg *WorldGenerator) generateBiome(index int, name string, dangerOffset int) *Biome {
+	// Inhabitants, hazards and colours come from the biome template
+	template := NewBiomeGenerator().Generate(name, 0)
 
 	return &Biome{
-		Name:        biomeTypes[index%len(biomeTypes)],
+		Name:        name,
 		Temperature: -10 + wg.rng.Intn(40),
 		Moisture:    wg.rng.Intn(100),
-		DangerLevel: index + wg.rng.Intn(3),
-		Theme:       biomeTypes[index%len(biomeTypes)],
+		DangerLevel: max(0, index+wg.rng.Intn(3)+dangerOffset),
+		Theme:       name,
+		ColorScheme: template.ColorScheme,
+		EnemyTypes:  template.EnemyTypes,
+		Hazards:     template.Hazards,
 	}
 }
@@ -168,3 +168,39 @@ func TestNoRoomIndexOutOfBounds(t *testing.T) {
 		traverse(world.StartRoom)
 	}
 }
+
+// TestStartingAbilitiesNeverGate verifies that abilities passed in the
+// "starting_abilities" constraint are never used as edge requirements
+func TestStartingAbilitiesNeverGate(t *testing.T) {
+	owned := []string{"double_jump", "dash"}
+	constraints := map[string]interface{}{"starting_abilities": owned}
+
+	for _, seed := range []int64{12345, 67890, 42} {
+		world := NewWorldGenerator(15, 10, 50, 3).Generate(seed, constraints)
+		gated := 0
+		for _, edge := range world.Graph.Edges {
+			for _, ability := range owned {
+				if edge.Requirement == ability {
+					t.Errorf("seed %d: edge %d->%d gated on owned ability %q", seed, edge.From, edge.To, ability)
+				}
+			}
+			if edge.Requirement != "" {
+				gated++
+			}
+		}
+		if gated == 0 {
+			t.Errorf("seed %d: no gated edges left with two abilities still unowned", seed)
+		}
+	}
+}
+
+// TestWorldBiomesHaveEnemyTypes verifies world biomes carry the template
+// enemy types so rooms can spawn matching enemies
+func TestWorldBiomesHaveEnemyTypes(t *testing.T) {
+	world := NewWorldGenerator(15, 10, 50, 3).Generate(42, nil)
+	for _, biome := range world.Biomes {
+		if len(biome.EnemyTypes) == 0 {
+			t.Errorf("biome %q has no enemy types", biome.Name)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package world
+
+// GravityDirection is which way gravity pulls in a room
+type GravityDirection int
+
+const (
+	GravityDown GravityDirection = iota // Normal gravity, towards the floor
+	GravityUp                           // Inverted: the player walks on the ceiling
+)
+
+// Inverted gravity odds for a corridor room
+const (
+	InvertedGravityChance    = 0.2
+	SkyInvertedGravityChance = 0.6 // Sky corridors flip far more often
+)
+
+// Inverted reports whether the room's gravity pulls towards the ceiling
+func (r *Room) Inverted() bool {
+	return r.Gravity == GravityUp
+}
+
+// assignGravity inverts gravity in some corridor rooms. Corridors hold no
+// enemies, so only the player has to cope. An inverted room's layout is
+// mirrored top to bottom, so everything reachable with normal gravity is
+// reachable upside down.
+func (wg *WorldGenerator) assignGravity(world *World) {
+	for _, room := range world.Rooms {
+		if room.Type != CorridorRoom {
+			continue
+		}
+		chance := InvertedGravityChance
+		if room.Biome != nil && room.Biome.Name == "sky" {
+			chance = SkyInvertedGravityChance
+		}
+		if wg.rng.Float64() < chance {
+			invertRoom(room)
+		}
+	}
+}
+
+// invertRoom flips the room's gravity and mirrors its platforms, doors,
+// anchors and item spots top to bottom, swapping north and south doors
+func invertRoom(room *Room) {
+	room.Gravity = GravityUp
+	for i := range room.Platforms {
+		p := &room.Platforms[i]
+		p.Y = RoomPixelHeight - p.Y - p.Height
+	}
+	for i := range room.Doors {
+		d := &room.Doors[i]
+		d.Y = RoomPixelHeight - d.Y - d.Height
+		switch d.Direction {
+		case "north":
+			d.Direction = "south"
+		case "south":
+			d.Direction = "north"
+		}
+	}
+	for i := range room.Anchors {
+		room.Anchors[i].Y = RoomPixelHeight - room.Anchors[i].Y
+	}
+	for i := range room.ItemSpots {
+		room.ItemSpots[i].Y = RoomPixelHeight - room.ItemSpots[i].Y - ItemSize
+	}
+}
@@ -0,0 +1,49 @@
+package world
+
+import "testing"
+
+func TestInvertRoomMirrorsLayout(t *testing.T) {
+	room := &Room{
+		Type:      CorridorRoom,
+		Platforms: []Platform{{X: 0, Y: 608, Width: 960, Height: 32}},
+		Doors:     []Door{{X: 0, Y: 10, Width: 64, Height: 96, Direction: "north"}},
+		Anchors:   []AnchorPoint{{X: 100, Y: 200}},
+		ItemSpots: []ItemSpot{{X: 50, Y: 592}},
+	}
+	invertRoom(room)
+
+	if !room.Inverted() {
+		t.Fatal("room not inverted")
+	}
+	if y := room.Platforms[0].Y; y != 0 {
+		t.Errorf("ground platform Y = %d, want 0 (the ceiling)", y)
+	}
+	if d := room.Doors[0]; d.Y != RoomPixelHeight-106 || d.Direction != "south" {
+		t.Errorf("door at Y %d facing %s, want Y %d facing south", d.Y, d.Direction, RoomPixelHeight-106)
+	}
+	if y := room.Anchors[0].Y; y != RoomPixelHeight-200 {
+		t.Errorf("anchor Y = %v, want %d", y, RoomPixelHeight-200)
+	}
+	if y := room.ItemSpots[0].Y; y != 32 {
+		t.Errorf("item spot Y = %d, want 32 (just under the ceiling)", y)
+	}
+}
+
+func TestOnlyCorridorsInvertGravity(t *testing.T) {
+	inverted := 0
+	for seed := int64(1); seed <= 10; seed++ {
+		w := NewWorldGenerator(15, 10, 80, 5).Generate(seed, nil)
+		for _, room := range w.Rooms {
+			if !room.Inverted() {
+				continue
+			}
+			inverted++
+			if room.Type != CorridorRoom {
+				t.Errorf("seed %d: room %d of type %v has inverted gravity", seed, room.ID, room.Type)
+			}
+		}
+	}
+	if inverted == 0 {
+		t.Error("no generated room has inverted gravity")
+	}
+}
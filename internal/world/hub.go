@@ -0,0 +1,110 @@
+package world
+
+import "sort"
+
+// Hub tuning
+const (
+	// HubMinRegionRooms is how many rooms a region needs to count as a
+	// major region with its own way to the hub
+	HubMinRegionRooms = 3
+
+	// hubMinRegions is the fewest major regions worth linking with a hub
+	hubMinRegions = 2
+
+	hubWidth  = 25 // Hub size in tiles
+	hubHeight = 15
+)
+
+// addHubRoom builds a hub room linked both ways to the entrance of every
+// major region: the region's room nearest the start. The hub sits at the
+// median depth of those entrances, in the middle of the world grid. Its
+// exits are hub-gated, opening only once the player has reached the room
+// beyond, so the hub speeds up backtracking without skipping any gate.
+func (wg *WorldGenerator) addHubRoom(world *World) {
+	entrances := majorRegionEntrances(world)
+	if len(entrances) < hubMinRegions {
+		return
+	}
+
+	depths := make([]int, len(entrances))
+	for i, entrance := range entrances {
+		depths[i] = world.Graph.Nodes[entrance.ID].Depth
+	}
+	sort.Ints(depths)
+
+	id := len(world.Graph.Nodes)
+	for world.Graph.Nodes[id] != nil {
+		id++
+	}
+	world.Graph.Nodes[id] = &GraphNode{RoomID: id, Depth: depths[len(depths)/2]}
+
+	hub := &Room{
+		ID:          id,
+		Type:        HubRoom,
+		X:           world.Width / 2,
+		Y:           world.Height / 2,
+		Width:       hubWidth,
+		Height:      hubHeight,
+		Connections: entrances,
+		Biome:       world.StartRoom.Biome,
+	}
+	name := hub.Biome.Name
+	hub.TileLayers = TileLayers{Background: name, Midground: name, Foreground: name}
+	wg.populateRoom(hub)
+	for i := range hub.Doors {
+		hub.Doors[i].Locked = true
+		hub.Doors[i].HubGated = true
+	}
+
+	for _, entrance := range entrances {
+		entrance.Connections = append(entrance.Connections, hub)
+		entrance.Doors = append(entrance.Doors, newDoor(len(entrance.Connections)-1, hub))
+		world.Graph.Edges = append(world.Graph.Edges,
+			GraphEdge{From: hub.ID, To: entrance.ID},
+			GraphEdge{From: entrance.ID, To: hub.ID},
+		)
+	}
+
+	world.Rooms = append(world.Rooms, hub)
+	world.HubRoom = hub
+
+	// The hub shares the start's biome, so it joins the start's region
+	for _, region := range world.Regions {
+		for _, room := range region.Rooms {
+			if room == world.StartRoom {
+				region.Rooms = append(region.Rooms, hub)
+				return
+			}
+		}
+	}
+}
+
+// majorRegionEntrances returns, for each region of at least
+// HubMinRegionRooms rooms, its shallowest room, lowest ID first on ties
+func majorRegionEntrances(world *World) []*Room {
+	var entrances []*Room
+	for _, region := range world.Regions {
+		if len(region.Rooms) < HubMinRegionRooms {
+			continue
+		}
+		var entrance *Room
+		for _, room := range region.Rooms {
+			node := world.Graph.Nodes[room.ID]
+			if node == nil {
+				continue
+			}
+			if entrance == nil {
+				entrance = room
+				continue
+			}
+			best := world.Graph.Nodes[entrance.ID].Depth
+			if node.Depth < best || (node.Depth == best && room.ID < entrance.ID) {
+				entrance = room
+			}
+		}
+		if entrance != nil {
+			entrances = append(entrances, entrance)
+		}
+	}
+	return entrances
+}
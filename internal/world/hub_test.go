@@ -0,0 +1,80 @@
+package world
+
+import "testing"
+
+func TestHubConnectsEveryMajorRegion(t *testing.T) {
+	for _, seed := range []int64{1, 42, 999} {
+		w := NewWorldGenerator(15, 10, 80, 5).Generate(seed, nil)
+		hub := w.HubRoom
+		if hub == nil {
+			t.Fatalf("seed %d: no hub room generated", seed)
+		}
+		if hub.Type != HubRoom || !hub.IsSafeHaven() {
+			t.Errorf("seed %d: hub room type %v is not a safe haven hub", seed, hub.Type)
+		}
+
+		hubDoors := make(map[*Room]bool)
+		for _, door := range hub.Doors {
+			if !door.HubGated || !door.Locked {
+				t.Errorf("seed %d: hub door to room %d is not hub gated", seed, door.LeadsTo.ID)
+			}
+			hubDoors[door.LeadsTo] = true
+		}
+
+		for _, region := range w.Regions {
+			if len(region.Rooms) < HubMinRegionRooms {
+				continue
+			}
+			var entrance *Room
+			for _, room := range region.Rooms {
+				if hubDoors[room] {
+					entrance = room
+				}
+			}
+			if entrance == nil {
+				t.Errorf("seed %d: region %q has no door from the hub", seed, region.Label)
+				continue
+			}
+			back := false
+			for _, door := range entrance.Doors {
+				back = back || door.LeadsTo == hub
+			}
+			if !back {
+				t.Errorf("seed %d: region %q entrance %d has no door back to the hub", seed, region.Label, entrance.ID)
+			}
+		}
+	}
+}
+
+func TestAllRegionsReachableFromHub(t *testing.T) {
+	for _, seed := range []int64{1, 42, 999} {
+		w := NewWorldGenerator(15, 10, 80, 5).Generate(seed, nil)
+		if w.HubRoom == nil {
+			t.Fatalf("seed %d: no hub room generated", seed)
+		}
+
+		reached := map[*Room]bool{w.HubRoom: true}
+		queue := []*Room{w.HubRoom}
+		for len(queue) > 0 {
+			room := queue[0]
+			queue = queue[1:]
+			for _, door := range room.Doors {
+				if next := door.LeadsTo; next != nil && !reached[next] {
+					reached[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		for _, region := range w.Regions {
+			for _, room := range region.Rooms {
+				if !reached[room] {
+					t.Errorf("seed %d: room %d in region %q unreachable from the hub", seed, room.ID, region.Label)
+				}
+			}
+		}
+		if len(reached) != len(w.Rooms) {
+			t.Errorf("seed %d: hub reaches %d rooms, want %d", seed, len(reached), len(w.Rooms))
+		}
+	}
+}
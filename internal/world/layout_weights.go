@@ -0,0 +1,90 @@
+package world
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// LayoutWeight is a platform layout and how often it is picked relative to
+// the other layouts it is listed with
+type LayoutWeight struct {
+	Layout PlatformLayout
+	Weight float64
+}
+
+// LayoutWeights maps a biome name to the layouts its combat rooms choose
+// between. Biomes without an entry use defaultLayouts.
+type LayoutWeights map[string][]LayoutWeight
+
+// defaultLayouts is used for combat rooms of unlisted biomes and rooms with
+// no biome
+var defaultLayouts = []LayoutWeight{
+	{LinearLayout, 4},
+	{StaircaseLayout, 1},
+	{ScatteredLayout, 1},
+}
+
+// DefaultLayoutWeights returns the layouts used for generated worlds. Each
+// biome leans on its signature layout but mixes in a couple of others.
+func DefaultLayoutWeights() LayoutWeights {
+	return LayoutWeights{
+		"cave":    {{StaircaseLayout, 5}, {MazeLayout, 2}, {LinearLayout, 1}},
+		"forest":  {{LinearLayout, 4}, {BridgeLayout, 2}, {ScatteredLayout, 1}},
+		"crystal": {{TowerLayout, 5}, {ScatteredLayout, 2}, {StaircaseLayout, 1}},
+		"ruins":   {{MazeLayout, 5}, {BridgeLayout, 2}, {StaircaseLayout, 1}},
+		"abyss":   {{ScatteredLayout, 5}, {TowerLayout, 2}, {BridgeLayout, 1}},
+		"sky":     {{BridgeLayout, 4}, {ScatteredLayout, 2}, {LinearLayout, 1}},
+	}
+}
+
+// Validate checks that every biome lists at least one layout, that every
+// layout is known, and that no weight is negative or all weights zero
+func (lw LayoutWeights) Validate() error {
+	for biome, weights := range lw {
+		total := 0.0
+		for _, w := range weights {
+			if w.Layout < LinearLayout || w.Layout > MazeLayout {
+				return fmt.Errorf("biome %q has unknown layout %d", biome, w.Layout)
+			}
+			if math.IsNaN(w.Weight) || w.Weight < 0 {
+				return fmt.Errorf("biome %q has layout weight %g, want 0 or more", biome, w.Weight)
+			}
+			total += w.Weight
+		}
+		if total <= 0 {
+			return fmt.Errorf("biome %q has no weighted layouts", biome)
+		}
+	}
+	return nil
+}
+
+// forBiome returns the weights for a biome, falling back to the defaults
+func (lw LayoutWeights) forBiome(biome *Biome) []LayoutWeight {
+	if biome != nil {
+		if weights, ok := lw[biome.Name]; ok && len(weights) > 0 {
+			return weights
+		}
+	}
+	return defaultLayouts
+}
+
+// pickLayout draws a layout from weights, so the same rng state always
+// gives the same layout
+func pickLayout(weights []LayoutWeight, rng *rand.Rand) PlatformLayout {
+	total := 0.0
+	for _, w := range weights {
+		total += max(0, w.Weight)
+	}
+	if total <= 0 {
+		return LinearLayout
+	}
+	roll := rng.Float64() * total
+	for _, w := range weights {
+		roll -= max(0, w.Weight)
+		if roll < 0 {
+			return w.Layout
+		}
+	}
+	return weights[len(weights)-1].Layout
+}
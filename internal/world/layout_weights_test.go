@@ -0,0 +1,98 @@
+package world
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// combatLayout returns the layout the generator picks for a combat room of
+// the biome, seeded as GeneratePlatforms seeds it
+func combatLayout(pg *PlatformGenerator, biome string, seed int64) PlatformLayout {
+	pg.rng = rand.New(rand.NewSource(seed))
+	return pg.selectLayout(&Room{Type: CombatRoom, Biome: &Biome{Name: biome}})
+}
+
+func TestSelectLayoutDeterministicPerSeed(t *testing.T) {
+	for seed := int64(0); seed < 50; seed++ {
+		first := combatLayout(NewPlatformGenerator(), "cave", seed)
+		if again := combatLayout(NewPlatformGenerator(), "cave", seed); again != first {
+			t.Fatalf("seed %d picked layouts %d and %d", seed, first, again)
+		}
+	}
+}
+
+func TestSelectLayoutFollowsBiomeWeights(t *testing.T) {
+	for biome, weights := range DefaultLayoutWeights() {
+		counts := make(map[PlatformLayout]int)
+		for seed := int64(0); seed < 400; seed++ {
+			counts[combatLayout(NewPlatformGenerator(), biome, seed)]++
+		}
+
+		for _, w := range weights {
+			if counts[w.Layout] == 0 {
+				t.Errorf("%s never picked layout %d: %v", biome, w.Layout, counts)
+			}
+		}
+		if len(counts) != len(weights) {
+			t.Errorf("%s picked layouts %v, want only its weighted %v", biome, counts, weights)
+		}
+		// The heaviest layout is the biome's most common
+		favorite := weights[0]
+		for _, w := range weights[1:] {
+			if counts[w.Layout] >= counts[favorite.Layout] {
+				t.Errorf("%s picked layout %d %d times, at least its favorite %d (%d times)",
+					biome, w.Layout, counts[w.Layout], favorite.Layout, counts[favorite.Layout])
+			}
+		}
+	}
+}
+
+func TestSelectLayoutCustomWeights(t *testing.T) {
+	pg := NewPlatformGenerator()
+	pg.Layouts = LayoutWeights{"cave": {{BridgeLayout, 1}}}
+	for seed := int64(0); seed < 20; seed++ {
+		if layout := combatLayout(pg, "cave", seed); layout != BridgeLayout {
+			t.Fatalf("seed %d picked layout %d, want the only configured bridge layout", seed, layout)
+		}
+	}
+
+	// Biomes without weights fall back to the defaults
+	counts := make(map[PlatformLayout]int)
+	for seed := int64(0); seed < 100; seed++ {
+		counts[combatLayout(pg, "swamp", seed)]++
+	}
+	if len(counts) != len(defaultLayouts) {
+		t.Errorf("unlisted biome picked layouts %v, want the defaults %v", counts, defaultLayouts)
+	}
+}
+
+func TestLayoutWeightsValidate(t *testing.T) {
+	if err := DefaultLayoutWeights().Validate(); err != nil {
+		t.Fatalf("default weights invalid: %v", err)
+	}
+	for name, lw := range map[string]LayoutWeights{
+		"empty":    {"cave": {}},
+		"zero":     {"cave": {{MazeLayout, 0}}},
+		"negative": {"cave": {{MazeLayout, 2}, {LinearLayout, -1}}},
+		"unknown":  {"cave": {{PlatformLayout(99), 1}}},
+	} {
+		if lw.Validate() == nil {
+			t.Errorf("%s weights passed validation", name)
+		}
+	}
+}
+
+func TestGenerateFallsBackFromInvalidLayouts(t *testing.T) {
+	want := NewWorldGenerator(15, 10, 30, 3).Generate(42, map[string]interface{}{})
+
+	wg := NewWorldGenerator(15, 10, 30, 3)
+	wg.Layouts = LayoutWeights{"cave": {{MazeLayout, 0}}}
+	got := wg.Generate(42, map[string]interface{}{})
+
+	for i, room := range got.Rooms {
+		if len(room.Platforms) != len(want.Rooms[i].Platforms) {
+			t.Fatalf("room %d has %d platforms with invalid layouts, want the default %d",
+				room.ID, len(room.Platforms), len(want.Rooms[i].Platforms))
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package world
+
+import "testing"
+
+// perfSeed is the fixed seed the world benchmarks generate
+const perfSeed = 42
+
+func BenchmarkWorldGenerate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NewWorldGenerator(15, 10, 100, 5).Generate(perfSeed, nil)
+	}
+}
+
+func BenchmarkGeneratePlatforms(b *testing.B) {
+	w := NewWorldGenerator(15, 10, 100, 5).Generate(perfSeed, nil)
+	pg := NewPlatformGenerator()
+	abilities := map[string]bool{}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		room := w.Rooms[i%len(w.Rooms)]
+		pg.GeneratePlatforms(room, perfSeed+int64(i), abilities)
+	}
+}
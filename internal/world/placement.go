@@ -0,0 +1,155 @@
+package world
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Room pixel layout shared by generation and the game
+const (
+	RoomPixelWidth  = 960
+	RoomPixelHeight = 640
+
+	// StartSpawnX and StartSpawnY are where the player begins in the start room
+	StartSpawnX = 100
+	StartSpawnY = 500
+
+	ItemSize           = 16 // Side of an item pickup, in pixels
+	itemMargin         = 40 // Gap kept between items and the room's side walls
+	placementAttempts  = 16 // Random positions tried before keeping a crowded one
+	doorWallMargin     = 10
+	groundMinimumWidth = RoomPixelWidth / 2
+)
+
+// PlacementSpacing is the minimum distance, in pixels, kept between the
+// centers of a room's doors, items and the player spawn, so no exit or
+// pickup sits on top of another or right where the player appears
+type PlacementSpacing struct {
+	Door  float64 // Between two doors
+	Item  float64 // Between two items, and between an item and a door
+	Spawn float64 // Between the start room's spawn and any door or item
+}
+
+// DefaultPlacementSpacing returns the spacing used for generated worlds
+func DefaultPlacementSpacing() PlacementSpacing {
+	return PlacementSpacing{Door: 128, Item: 96, Spawn: 128}
+}
+
+// ItemSpot is the top-left corner of an item pickup in a room
+type ItemSpot struct {
+	X, Y int
+}
+
+// GroundY returns the top of the room's ground: the highest platform
+// spanning at least half the room, or the bottom of the room
+func (r *Room) GroundY() int {
+	groundY := RoomPixelHeight
+	for _, p := range r.Platforms {
+		if p.Width >= groundMinimumWidth && p.Y < groundY {
+			groundY = p.Y
+		}
+	}
+	return groundY
+}
+
+// PlaceRoomFeatures lays out the room's item spots, treasure first and then
+// health pickups, and moves any door or item closer than spacing allows to
+// a random free position along its wall or the ground. A feature with no
+// free position after several tries keeps its last one.
+func PlaceRoomFeatures(room *Room, spacing PlacementSpacing, rng *rand.Rand) {
+	itemY := room.GroundY() - ItemSize
+	room.ItemSpots = make([]ItemSpot, 0, len(room.Items)+room.HealthPickups)
+	for i := range room.Items {
+		room.ItemSpots = append(room.ItemSpots, ItemSpot{X: 200 + i*150, Y: itemY})
+	}
+	for i := 0; i < room.HealthPickups; i++ {
+		room.ItemSpots = append(room.ItemSpots, ItemSpot{X: 720 - i*60, Y: itemY})
+	}
+
+	for i := range room.Doors {
+		door := &room.Doors[i]
+		for attempt := 0; attempt < placementAttempts && doorCrowded(room, i, spacing); attempt++ {
+			moveDoorAlongWall(door, rng)
+		}
+	}
+
+	span := RoomPixelWidth - 2*itemMargin - ItemSize
+	for i := range room.ItemSpots {
+		for attempt := 0; attempt < placementAttempts && itemCrowded(room, i, spacing); attempt++ {
+			room.ItemSpots[i].X = itemMargin + rng.Intn(span)
+		}
+	}
+}
+
+// SpacingViolations counts the pairs of features in the room that are
+// closer than spacing allows
+func SpacingViolations(room *Room, spacing PlacementSpacing) int {
+	violations := 0
+	for i := range room.Doors {
+		if doorCrowded(room, i, spacing) {
+			violations++
+		}
+	}
+	for i := range room.ItemSpots {
+		if itemCrowded(room, i, spacing) {
+			violations++
+		}
+	}
+	return violations
+}
+
+// doorCrowded reports whether door i is too close to an earlier door or to
+// the spawn
+func doorCrowded(room *Room, i int, spacing PlacementSpacing) bool {
+	x, y := doorCenter(room.Doors[i])
+	for j := 0; j < i; j++ {
+		ox, oy := doorCenter(room.Doors[j])
+		if math.Hypot(x-ox, y-oy) < spacing.Door {
+			return true
+		}
+	}
+	return nearSpawn(room, x, y, spacing)
+}
+
+// itemCrowded reports whether item i is too close to an earlier item, any
+// door, or the spawn
+func itemCrowded(room *Room, i int, spacing PlacementSpacing) bool {
+	x, y := itemCenter(room.ItemSpots[i])
+	for j := 0; j < i; j++ {
+		ox, oy := itemCenter(room.ItemSpots[j])
+		if math.Hypot(x-ox, y-oy) < spacing.Item {
+			return true
+		}
+	}
+	for _, door := range room.Doors {
+		dx, dy := doorCenter(door)
+		if math.Hypot(x-dx, y-dy) < spacing.Item {
+			return true
+		}
+	}
+	return nearSpawn(room, x, y, spacing)
+}
+
+// nearSpawn reports whether a point is too close to the player spawn. Only
+// the start room has one.
+func nearSpawn(room *Room, x, y float64, spacing PlacementSpacing) bool {
+	return room.Type == StartRoom && math.Hypot(x-StartSpawnX, y-StartSpawnY) < spacing.Spawn
+}
+
+// moveDoorAlongWall puts a door at a random position on its own wall
+func moveDoorAlongWall(door *Door, rng *rand.Rand) {
+	switch door.Direction {
+	case "east", "west":
+		door.Y = doorWallMargin + rng.Intn(RoomPixelHeight-door.Height-2*doorWallMargin)
+	default:
+		door.X = doorWallMargin + rng.Intn(RoomPixelWidth-door.Width-2*doorWallMargin)
+	}
+}
+
+func doorCenter(door Door) (float64, float64) {
+	return float64(door.X) + float64(door.Width)/2, float64(door.Y) + float64(door.Height)/2
+}
+
+func itemCenter(spot ItemSpot) (float64, float64) {
+	return float64(spot.X) + ItemSize/2, float64(spot.Y) + ItemSize/2
+}
@@ -0,0 +1,61 @@
+package world
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestGeneratedPlacementSpacing verifies generated rooms keep doors, items
+// and the spawn apart
+func TestGeneratedPlacementSpacing(t *testing.T) {
+	for _, seed := range []int64{42, 12345, 67890} {
+		wg := NewWorldGenerator(15, 10, 40, 4)
+		world := wg.Generate(seed, nil)
+
+		items := 0
+		for _, room := range world.Rooms {
+			items += len(room.ItemSpots)
+			if n := SpacingViolations(room, wg.Spacing); n > 0 {
+				t.Errorf("seed %d room %d has %d spacing violations", seed, room.ID, n)
+			}
+			if len(room.ItemSpots) != len(room.Items)+room.HealthPickups {
+				t.Errorf("seed %d room %d has %d item spots for %d items and %d pickups",
+					seed, room.ID, len(room.ItemSpots), len(room.Items), room.HealthPickups)
+			}
+		}
+		if items == 0 {
+			t.Errorf("seed %d placed no items to check", seed)
+		}
+	}
+}
+
+// TestPlacementMovesCrowdedFeatures forces doors and items onto each other
+// and onto the spawn, and verifies placement moves them apart
+func TestPlacementMovesCrowdedFeatures(t *testing.T) {
+	room := &Room{
+		Type: StartRoom,
+		Doors: []Door{
+			{X: 886, Y: 272, Width: 64, Height: 96, Direction: "east"},
+			{X: 886, Y: 272, Width: 64, Height: 96, Direction: "east"},
+			{X: 60, Y: 440, Width: 64, Height: 96, Direction: "west"},
+		},
+		Items:         make([]interface{}, 2),
+		HealthPickups: 2,
+	}
+	spacing := DefaultPlacementSpacing()
+	if SpacingViolations(room, spacing) == 0 {
+		t.Fatal("the forced layout should start out crowded")
+	}
+
+	PlaceRoomFeatures(room, spacing, rand.New(rand.NewSource(1)))
+
+	if n := SpacingViolations(room, spacing); n != 0 {
+		t.Errorf("%d violations remain after placement: doors %+v, items %+v", n, room.Doors, room.ItemSpots)
+	}
+	if room.Doors[0].Y != 272 {
+		t.Errorf("the first door was moved to y=%d though nothing crowded it", room.Doors[0].Y)
+	}
+	if room.Doors[1].X != 886 {
+		t.Errorf("a moved east door left its wall for x=%d", room.Doors[1].X)
+	}
+}
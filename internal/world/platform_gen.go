@@ -10,12 +10,13 @@ import (
 
 // PlatformGenerator generates procedural platforms for rooms
 type PlatformGenerator struct {
-	rng *rand.Rand
+	Layouts LayoutWeights // Layouts each biome's combat rooms choose between
+	rng     *rand.Rand
 }
 
 // NewPlatformGenerator creates a new platform generator
 func NewPlatformGenerator() *PlatformGenerator {
-	return &PlatformGenerator{}
+	return &PlatformGenerator{Layouts: DefaultLayoutWeights()}
 }
 
 // PlatformLayout represents different platform arrangement patterns
@@ -77,34 +78,23 @@ func (pg *PlatformGenerator) GeneratePlatforms(room *Room, seed int64, playerAbi
 
 	// Validate that room is traversable
 	pg.validateTraversability(room, playerAbilities)
+
+	// Roof part of a corridor's ground into a crawl space
+	pg.addCrawlSpace(room)
 }
 
 // selectLayout chooses appropriate layout for room type and biome
 func (pg *PlatformGenerator) selectLayout(room *Room) PlatformLayout {
 	switch room.Type {
-	case StartRoom, SaveRoom:
+	case StartRoom, SaveRoom, HubRoom:
 		return LinearLayout // Simple layouts for safe rooms
 	case TreasureRoom:
 		return ScatteredLayout // Require skill to reach treasure
 	case BossRoom:
 		return TowerLayout // Vertical arena for boss fights
 	case CombatRoom:
-		// Vary based on biome
-		if room.Biome != nil {
-			switch room.Biome.Name {
-			case "cave":
-				return StaircaseLayout
-			case "crystal":
-				return TowerLayout
-			case "ruins":
-				return MazeLayout
-			case "abyss":
-				return ScatteredLayout
-			default:
-				return LinearLayout
-			}
-		}
-		return LinearLayout
+		// Vary based on biome, weighted toward its signature layouts
+		return pickLayout(pg.Layouts.forBiome(room.Biome), pg.rng)
 	default:
 		layouts := []PlatformLayout{LinearLayout, StaircaseLayout, ScatteredLayout}
 		return layouts[pg.rng.Intn(len(layouts))]
@@ -474,7 +464,7 @@ func (pg *PlatformGenerator) generateAnchors(room *Room, abilities map[string]bo
 		anchorCount += 2 // More anchors in boss rooms
 	case TreasureRoom, CombatRoom:
 		anchorCount += 1 // Extra anchors for treasure/combat rooms
-	case StartRoom, SaveRoom:
+	case StartRoom, SaveRoom, HubRoom:
 		anchorCount = 1 // Minimal anchors in safe rooms
 	}
 
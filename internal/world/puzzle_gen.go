@@ -0,0 +1,240 @@
+// Package world provides procedural puzzle generation for puzzle rooms.
+// Pressure plates, switches, and timed switches are placed on the ground
+// and linked to a door in the room that stays sealed until solved.
+package world
+
+import (
+	"math/rand"
+)
+
+// PuzzleElementType identifies an interactive puzzle element
+type PuzzleElementType int
+
+const (
+	PressurePlate PuzzleElementType = iota // Latches on when stood on
+	Switch                                 // Toggles when struck
+	TimedSwitch                            // Turns on when struck, reverts after Duration
+)
+
+// String returns the element type name
+func (t PuzzleElementType) String() string {
+	switch t {
+	case PressurePlate:
+		return "pressure_plate"
+	case Switch:
+		return "switch"
+	case TimedSwitch:
+		return "timed_switch"
+	default:
+		return "unknown"
+	}
+}
+
+// Puzzle element sizes in pixels
+const (
+	pressurePlateWidth  = 48
+	pressurePlateHeight = 8
+	switchWidth         = 16
+	switchHeight        = 32
+	puzzleGroundY       = 600 // Top of the ground platform from addGroundPlatform
+)
+
+// PuzzleElement is an interactive object linked to a door in the same room
+type PuzzleElement struct {
+	Type          PuzzleElementType
+	X, Y          int
+	Width, Height int
+	DoorIndex     int // Index into Room.Doors this element helps open
+	Duration      int // Frames a timed switch stays on (TimedSwitch only)
+}
+
+// Puzzle holds the interactive elements of a room. A linked door opens
+// only while every element linked to it is active.
+type Puzzle struct {
+	Elements []PuzzleElement
+}
+
+// PuzzleGenerator generates deterministic puzzles for puzzle rooms
+type PuzzleGenerator struct {
+	rng *rand.Rand
+}
+
+// NewPuzzleGenerator creates a new puzzle generator
+func NewPuzzleGenerator() *PuzzleGenerator {
+	return &PuzzleGenerator{}
+}
+
+// GeneratePuzzle places puzzle elements in a room and seals the door they
+// open. Rooms without doors get no puzzle.
+func (pg *PuzzleGenerator) GeneratePuzzle(room *Room, seed int64) {
+	pg.rng = rand.New(rand.NewSource(seed))
+
+	doorIndex := pg.selectGatedDoor(room)
+	if doorIndex < 0 {
+		return
+	}
+
+	puzzle := &Puzzle{}
+	switch pg.rng.Intn(4) {
+	case 0:
+		puzzle.Elements = append(puzzle.Elements, pg.newPlate(doorIndex))
+	case 1:
+		puzzle.Elements = append(puzzle.Elements, pg.newSwitch(Switch, doorIndex, 0))
+	case 2:
+		duration := 180 + pg.rng.Intn(181) // 3-6 seconds
+		puzzle.Elements = append(puzzle.Elements, pg.newSwitch(TimedSwitch, doorIndex, duration))
+	default:
+		// Timed sequence: two timed switches must be on at the same time
+		duration := 240 + pg.rng.Intn(121) // 4-6 seconds
+		first := pg.newSwitch(TimedSwitch, doorIndex, duration)
+		second := pg.newSwitch(TimedSwitch, doorIndex, duration)
+		first.X = 120 + pg.rng.Intn(160)
+		second.X = 640 + pg.rng.Intn(160)
+		puzzle.Elements = append(puzzle.Elements, first, second)
+	}
+
+	room.Puzzle = puzzle
+	room.Doors[doorIndex].Locked = true
+	room.Doors[doorIndex].PuzzleGated = true
+}
+
+// selectGatedDoor picks the door leading onward (to a later room) so the
+// way the player entered stays open. Falls back to the last door.
+func (pg *PuzzleGenerator) selectGatedDoor(room *Room) int {
+	if len(room.Doors) == 0 {
+		return -1
+	}
+	for i, door := range room.Doors {
+		if door.LeadsTo != nil && door.LeadsTo.ID > room.ID {
+			return i
+		}
+	}
+	return len(room.Doors) - 1
+}
+
+// randomGroundX picks an x position on the ground away from the south door
+func (pg *PuzzleGenerator) randomGroundX(width int) int {
+	// Left half 120-360 or right half 600-840, avoiding the centre doorway
+	if pg.rng.Intn(2) == 0 {
+		return 120 + pg.rng.Intn(240-width)
+	}
+	return 600 + pg.rng.Intn(240-width)
+}
+
+func (pg *PuzzleGenerator) newPlate(doorIndex int) PuzzleElement {
+	return PuzzleElement{
+		Type:      PressurePlate,
+		X:         pg.randomGroundX(pressurePlateWidth),
+		Y:         puzzleGroundY - pressurePlateHeight,
+		Width:     pressurePlateWidth,
+		Height:    pressurePlateHeight,
+		DoorIndex: doorIndex,
+	}
+}
+
+func (pg *PuzzleGenerator) newSwitch(kind PuzzleElementType, doorIndex, duration int) PuzzleElement {
+	return PuzzleElement{
+		Type:      kind,
+		X:         pg.randomGroundX(switchWidth),
+		Y:         puzzleGroundY - switchHeight,
+		Width:     switchWidth,
+		Height:    switchHeight,
+		DoorIndex: doorIndex,
+		Duration:  duration,
+	}
+}
+
+// PuzzleState tracks the runtime state of a room's puzzle
+type PuzzleState struct {
+	puzzle *Puzzle
+	active []bool
+	timers []int
+}
+
+// NewPuzzleState creates a fresh state with every element inactive
+func NewPuzzleState(puzzle *Puzzle) *PuzzleState {
+	ps := &PuzzleState{puzzle: puzzle}
+	if puzzle != nil {
+		ps.active = make([]bool, len(puzzle.Elements))
+		ps.timers = make([]int, len(puzzle.Elements))
+	}
+	return ps
+}
+
+// Update advances timers by one frame and presses any plate the player
+// is standing on. Call once per frame with the player's bounds.
+func (ps *PuzzleState) Update(playerX, playerY, playerW, playerH float64) {
+	if ps.puzzle == nil {
+		return
+	}
+	for i, el := range ps.puzzle.Elements {
+		switch el.Type {
+		case PressurePlate:
+			if el.overlaps(playerX, playerY, playerW, playerH) {
+				ps.active[i] = true
+			}
+		case TimedSwitch:
+			if ps.timers[i] > 0 {
+				ps.timers[i]--
+				if ps.timers[i] == 0 {
+					ps.active[i] = false
+				}
+			}
+		}
+	}
+}
+
+// Strike activates any switch overlapping the given attack area. Returns
+// true if at least one switch changed state.
+func (ps *PuzzleState) Strike(x, y, w, h float64) bool {
+	if ps.puzzle == nil || w <= 0 || h <= 0 {
+		return false
+	}
+	changed := false
+	for i, el := range ps.puzzle.Elements {
+		if !el.overlaps(x, y, w, h) {
+			continue
+		}
+		switch el.Type {
+		case Switch:
+			ps.active[i] = !ps.active[i]
+			changed = true
+		case TimedSwitch:
+			ps.active[i] = true
+			ps.timers[i] = el.Duration
+			changed = true
+		}
+	}
+	return changed
+}
+
+// IsActive reports whether element i is currently on
+func (ps *PuzzleState) IsActive(i int) bool {
+	return i >= 0 && i < len(ps.active) && ps.active[i]
+}
+
+// IsDoorOpen reports whether every element linked to the door is active.
+// Doors with no linked elements are not controlled by the puzzle.
+func (ps *PuzzleState) IsDoorOpen(doorIndex int) bool {
+	if ps.puzzle == nil {
+		return false
+	}
+	linked := false
+	for i, el := range ps.puzzle.Elements {
+		if el.DoorIndex != doorIndex {
+			continue
+		}
+		linked = true
+		if !ps.active[i] {
+			return false
+		}
+	}
+	return linked
+}
+
+func (el PuzzleElement) overlaps(x, y, w, h float64) bool {
+	return x < float64(el.X+el.Width) &&
+		x+w > float64(el.X) &&
+		y < float64(el.Y+el.Height) &&
+		y+h > float64(el.Y)
+}
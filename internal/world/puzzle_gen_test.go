@@ -0,0 +1,132 @@
+package world
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestPressurePlateOpensLinkedDoor verifies that standing on a plate opens
+// the door it is linked to and that the plate stays latched
+func TestPressurePlateOpensLinkedDoor(t *testing.T) {
+	puzzle := &Puzzle{Elements: []PuzzleElement{
+		{Type: PressurePlate, X: 200, Y: 592, Width: 48, Height: 8, DoorIndex: 1},
+	}}
+	state := NewPuzzleState(puzzle)
+
+	// Player standing away from the plate
+	state.Update(500, 568, 32, 32)
+	if state.IsDoorOpen(1) {
+		t.Fatal("door open before plate was pressed")
+	}
+
+	// Player standing on the plate (feet overlap its top)
+	state.Update(210, 568, 32, 32)
+	if !state.IsDoorOpen(1) {
+		t.Error("door closed after stepping on plate")
+	}
+	if state.IsDoorOpen(0) {
+		t.Error("unlinked door reported open")
+	}
+
+	// Stepping off keeps the plate latched
+	state.Update(500, 568, 32, 32)
+	if !state.IsDoorOpen(1) {
+		t.Error("door closed after stepping off latched plate")
+	}
+}
+
+// TestTimedDoorRecloses verifies a timed switch reverts after its duration
+func TestTimedDoorRecloses(t *testing.T) {
+	const duration = 30
+	puzzle := &Puzzle{Elements: []PuzzleElement{
+		{Type: TimedSwitch, X: 300, Y: 568, Width: 16, Height: 32, DoorIndex: 0, Duration: duration},
+	}}
+	state := NewPuzzleState(puzzle)
+
+	if !state.Strike(290, 570, 40, 32) {
+		t.Fatal("Strike() = false, want true")
+	}
+
+	for i := 0; i < duration-1; i++ {
+		state.Update(0, 0, 32, 32)
+		if !state.IsDoorOpen(0) {
+			t.Fatalf("door closed early on frame %d", i+1)
+		}
+	}
+
+	state.Update(0, 0, 32, 32)
+	if state.IsDoorOpen(0) {
+		t.Errorf("door still open after %d frames", duration)
+	}
+}
+
+// TestTimedSequenceNeedsAllSwitches verifies a door linked to several
+// elements opens only when all of them are active at once
+func TestTimedSequenceNeedsAllSwitches(t *testing.T) {
+	puzzle := &Puzzle{Elements: []PuzzleElement{
+		{Type: TimedSwitch, X: 100, Y: 568, Width: 16, Height: 32, DoorIndex: 0, Duration: 10},
+		{Type: TimedSwitch, X: 800, Y: 568, Width: 16, Height: 32, DoorIndex: 0, Duration: 10},
+	}}
+	state := NewPuzzleState(puzzle)
+
+	state.Strike(100, 568, 16, 32)
+	if state.IsDoorOpen(0) {
+		t.Error("door open with one of two switches active")
+	}
+
+	state.Strike(800, 568, 16, 32)
+	if !state.IsDoorOpen(0) {
+		t.Error("door closed with both switches active")
+	}
+}
+
+// TestSwitchToggles verifies a plain switch flips on each strike
+func TestSwitchToggles(t *testing.T) {
+	puzzle := &Puzzle{Elements: []PuzzleElement{
+		{Type: Switch, X: 300, Y: 568, Width: 16, Height: 32, DoorIndex: 0},
+	}}
+	state := NewPuzzleState(puzzle)
+
+	state.Strike(300, 568, 16, 32)
+	if !state.IsDoorOpen(0) {
+		t.Error("door closed after first strike")
+	}
+	state.Strike(300, 568, 16, 32)
+	if state.IsDoorOpen(0) {
+		t.Error("door open after second strike")
+	}
+}
+
+// TestPuzzleRoomsGetSealedDoor verifies puzzle rooms are generated
+// deterministically with a puzzle-gated door
+func TestPuzzleRoomsGetSealedDoor(t *testing.T) {
+	wg := NewWorldGenerator(15, 10, 50, 3)
+	world1 := wg.Generate(12345, nil)
+	world2 := NewWorldGenerator(15, 10, 50, 3).Generate(12345, nil)
+
+	found := 0
+	for i, room := range world1.Rooms {
+		if room.Type != PuzzleRoom || len(room.Doors) == 0 {
+			continue
+		}
+		found++
+
+		if room.Puzzle == nil || len(room.Puzzle.Elements) == 0 {
+			t.Errorf("puzzle room %d has no puzzle elements", room.ID)
+			continue
+		}
+		for _, el := range room.Puzzle.Elements {
+			door := room.Doors[el.DoorIndex]
+			if !door.Locked || !door.PuzzleGated {
+				t.Errorf("room %d door %d is not puzzle-gated", room.ID, el.DoorIndex)
+			}
+		}
+		if !reflect.DeepEqual(room.Puzzle, world2.Rooms[i].Puzzle) {
+			t.Errorf("room %d puzzle differs between identical seeds", room.ID)
+		}
+	}
+
+	if found == 0 {
+		t.Skip("seed produced no puzzle rooms with doors")
+	}
+}
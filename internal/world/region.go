@@ -0,0 +1,146 @@
+package world
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+)
+
+// BiomeRegion is a connected group of rooms sharing one biome, shown on the
+// map as a single color-coded, labeled zone
+type BiomeRegion struct {
+	ID    int
+	Biome *Biome
+	Label string // Display name, e.g. "Crystal Caverns"
+	Rooms []*Room
+}
+
+// regionNames are the display names of each biome's zones
+var regionNames = map[string]string{
+	"cave":    "Hollow Caves",
+	"forest":  "Verdant Woods",
+	"ruins":   "Sunken Ruins",
+	"crystal": "Crystal Caverns",
+	"abyss":   "The Abyss",
+	"sky":     "Sky Reaches",
+}
+
+// RegionName returns the display name of a biome's zones
+func RegionName(biomeName string) string {
+	if name, ok := regionNames[biomeName]; ok {
+		return name
+	}
+	if biomeName == "" {
+		return "Unknown Lands"
+	}
+	return strings.ToUpper(biomeName[:1]) + biomeName[1:]
+}
+
+// BuildBiomeRegions groups rooms into regions of connected same-biome rooms,
+// following connections in both directions. Regions are numbered in the
+// order their first room appears in rooms, and a biome split into several
+// regions gets numbered labels ("Sky Reaches II").
+func BuildBiomeRegions(rooms []*Room) []*BiomeRegion {
+	regions := make([]*BiomeRegion, 0)
+	assigned := make(map[*Room]bool, len(rooms))
+	perBiome := make(map[string]int)
+
+	neighbors := make(map[*Room][]*Room, len(rooms))
+	for _, room := range rooms {
+		for _, next := range room.Connections {
+			neighbors[room] = append(neighbors[room], next)
+			neighbors[next] = append(neighbors[next], room)
+		}
+	}
+
+	for _, start := range rooms {
+		if assigned[start] {
+			continue
+		}
+
+		region := &BiomeRegion{ID: len(regions), Biome: start.Biome}
+		assigned[start] = true
+		queue := []*Room{start}
+		for len(queue) > 0 {
+			room := queue[0]
+			queue = queue[1:]
+			region.Rooms = append(region.Rooms, room)
+			for _, next := range neighbors[room] {
+				if !assigned[next] && sameBiome(next.Biome, start.Biome) {
+					assigned[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		name := biomeName(start.Biome)
+		perBiome[name]++
+		region.Label = RegionName(name)
+		if n := perBiome[name]; n > 1 {
+			region.Label += " " + romanNumeral(n)
+		}
+		regions = append(regions, region)
+	}
+	return regions
+}
+
+// RegionOfRoom returns the region holding the room with roomID, or nil
+func RegionOfRoom(regions []*BiomeRegion, roomID int) *BiomeRegion {
+	for _, region := range regions {
+		for _, room := range region.Rooms {
+			if room.ID == roomID {
+				return region
+			}
+		}
+	}
+	return nil
+}
+
+// Color returns the color the region is drawn with on the map, taken from
+// the brightest entry of its biome's color scheme
+func (br *BiomeRegion) Color() color.RGBA {
+	if br.Biome == nil || len(br.Biome.ColorScheme) == 0 {
+		return color.RGBA{100, 100, 100, 255}
+	}
+	c, err := parseHexColor(br.Biome.ColorScheme[len(br.Biome.ColorScheme)-1])
+	if err != nil {
+		return color.RGBA{100, 100, 100, 255}
+	}
+	return c
+}
+
+// sameBiome reports whether two rooms' biomes count as the same zone
+func sameBiome(a, b *Biome) bool {
+	return a == b || (a != nil && b != nil && a.Name == b.Name)
+}
+
+// biomeName returns the name of biome, or "" for none
+func biomeName(biome *Biome) string {
+	if biome == nil {
+		return ""
+	}
+	return biome.Name
+}
+
+// romanNumeral formats small region counts as roman numerals
+func romanNumeral(n int) string {
+	numerals := []string{"I", "II", "III", "IV", "V", "VI", "VII", "VIII", "IX", "X"}
+	if n >= 1 && n <= len(numerals) {
+		return numerals[n-1]
+	}
+	return strconv.Itoa(n)
+}
+
+// parseHexColor parses a "#rrggbb" color
+func parseHexColor(hex string) (color.RGBA, error) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return color.RGBA{}, fmt.Errorf("invalid hex color %q", hex)
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("invalid hex color %q: %w", hex, err)
+	}
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 255}, nil
+}
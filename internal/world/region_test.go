@@ -0,0 +1,108 @@
+package world
+
+import "testing"
+
+// linkRooms connects two rooms in both directions
+func linkRooms(a, b *Room) {
+	a.Connections = append(a.Connections, b)
+	b.Connections = append(b.Connections, a)
+}
+
+func TestContiguousBiomeRoomsFormOneRegion(t *testing.T) {
+	crystal := &Biome{Name: "crystal", ColorScheme: []string{"#4a5a7a", "#6a7a9a"}}
+	cave := &Biome{Name: "cave"}
+
+	rooms := []*Room{
+		{ID: 0, Biome: crystal},
+		{ID: 1, Biome: crystal},
+		{ID: 2, Biome: crystal},
+		{ID: 3, Biome: cave},
+	}
+	linkRooms(rooms[0], rooms[1])
+	linkRooms(rooms[1], rooms[2])
+	linkRooms(rooms[2], rooms[3])
+
+	regions := BuildBiomeRegions(rooms)
+	if len(regions) != 2 {
+		t.Fatalf("got %d regions, want 2", len(regions))
+	}
+
+	crystalRegion := regions[0]
+	if len(crystalRegion.Rooms) != 3 {
+		t.Errorf("crystal region has %d rooms, want 3", len(crystalRegion.Rooms))
+	}
+	if crystalRegion.Label != "Crystal Caverns" {
+		t.Errorf("crystal region label = %q, want %q", crystalRegion.Label, "Crystal Caverns")
+	}
+	if got := crystalRegion.Color(); got.R != 0x6a || got.G != 0x7a || got.B != 0x9a {
+		t.Errorf("crystal region color = %v, want #6a7a9a", got)
+	}
+
+	caveRegion := regions[1]
+	if len(caveRegion.Rooms) != 1 || caveRegion.Rooms[0].ID != 3 {
+		t.Errorf("lone cave room should form its own region, got %d rooms", len(caveRegion.Rooms))
+	}
+	if RegionOfRoom(regions, 3) != caveRegion {
+		t.Error("RegionOfRoom(3) did not return the cave region")
+	}
+}
+
+func TestOneWayConnectionsJoinRegions(t *testing.T) {
+	forest := &Biome{Name: "forest"}
+	rooms := []*Room{
+		{ID: 0, Biome: forest},
+		{ID: 1, Biome: forest},
+		{ID: 2, Biome: forest},
+	}
+	// Both rooms lead into room 1, but neither is reachable from it
+	rooms[0].Connections = []*Room{rooms[1]}
+	rooms[2].Connections = []*Room{rooms[1]}
+
+	if regions := BuildBiomeRegions(rooms); len(regions) != 1 {
+		t.Errorf("got %d regions, want 1", len(regions))
+	}
+}
+
+func TestSeparatedBiomeZonesGetNumberedLabels(t *testing.T) {
+	sky := &Biome{Name: "sky"}
+	ruins := &Biome{Name: "ruins"}
+
+	rooms := []*Room{
+		{ID: 0, Biome: sky},
+		{ID: 1, Biome: ruins},
+		{ID: 2, Biome: sky},
+	}
+	linkRooms(rooms[0], rooms[1])
+	linkRooms(rooms[1], rooms[2])
+
+	regions := BuildBiomeRegions(rooms)
+	if len(regions) != 3 {
+		t.Fatalf("got %d regions, want 3 (sky zones are not connected)", len(regions))
+	}
+	if regions[0].Label != "Sky Reaches" || regions[2].Label != "Sky Reaches II" {
+		t.Errorf("labels = %q, %q; want numbered sky zones", regions[0].Label, regions[2].Label)
+	}
+}
+
+func TestGeneratedWorldRegionsCoverEveryRoom(t *testing.T) {
+	w := NewWorldGenerator(10, 10, 20, 5).Generate(12345, nil)
+	if len(w.Regions) == 0 {
+		t.Fatal("generated world has no regions")
+	}
+
+	seen := make(map[int]bool)
+	for _, region := range w.Regions {
+		for _, room := range region.Rooms {
+			if seen[room.ID] {
+				t.Errorf("room %d in more than one region", room.ID)
+			}
+			seen[room.ID] = true
+			if room.Biome.Name != region.Biome.Name {
+				t.Errorf("room %d biome %q in %q region", room.ID, room.Biome.Name, region.Biome.Name)
+			}
+		}
+	}
+	if len(seen) != len(w.Rooms) {
+		t.Errorf("regions cover %d rooms, want %d", len(seen), len(w.Rooms))
+	}
+}
@@ -0,0 +1,113 @@
+// Package world provides a resource-balancing pass that distributes healing
+// pickups along the critical path in proportion to the difficulty the
+// player has faced since the last pickup or save room.
+package world
+
+// ResourceBalanceConfig controls how healing pickups are spaced along the
+// critical path. Spacing is counted in rooms between consecutive pickups.
+type ResourceBalanceConfig struct {
+	MinSpacing        int     // Fewest rooms between two pickups
+	MaxSpacing        int     // Most rooms allowed without a pickup
+	PressureThreshold float64 // Accumulated difficulty that earns a pickup
+}
+
+// DefaultResourceBalanceConfig returns the spacing used for generated worlds
+func DefaultResourceBalanceConfig() ResourceBalanceConfig {
+	return ResourceBalanceConfig{
+		MinSpacing:        2,
+		MaxSpacing:        5,
+		PressureThreshold: 4.0,
+	}
+}
+
+// CriticalPath returns the rooms on the critical path ordered by depth
+func CriticalPath(world *World) []*Room {
+	if world == nil || world.Graph == nil {
+		return nil
+	}
+
+	rooms := make([]*Room, 0)
+	for _, room := range world.Rooms {
+		if node, ok := world.Graph.Nodes[room.ID]; ok && node.Required {
+			rooms = append(rooms, room)
+		}
+	}
+
+	// Insertion sort by depth keeps ties in room order
+	for i := 1; i < len(rooms); i++ {
+		for j := i; j > 0 && world.Graph.Nodes[rooms[j-1].ID].Depth > world.Graph.Nodes[rooms[j].ID].Depth; j-- {
+			rooms[j-1], rooms[j] = rooms[j], rooms[j-1]
+		}
+	}
+
+	return rooms
+}
+
+// BalanceHealthPickups assigns Room.HealthPickups along the critical path.
+// Each room adds pressure according to its type and danger, scaled up the
+// further the player is from a save room. A pickup is placed once pressure
+// crosses the threshold, before a boss, or when MaxSpacing is reached, but
+// never sooner than MinSpacing rooms after the previous one.
+func BalanceHealthPickups(world *World, cfg ResourceBalanceConfig) {
+	if cfg.MinSpacing < 1 {
+		cfg.MinSpacing = 1
+	}
+	if cfg.MaxSpacing < cfg.MinSpacing {
+		cfg.MaxSpacing = cfg.MinSpacing
+	}
+
+	path := CriticalPath(world)
+	sinceLast := 0
+	sinceSave := 0
+	pressure := 0.0
+
+	for i, room := range path {
+		room.HealthPickups = 0
+		if room.Type == StartRoom {
+			continue
+		}
+
+		sinceLast++
+		sinceSave++
+		if room.Type == SaveRoom {
+			// Saving restores the player, so difficulty starts over
+			pressure = 0
+			sinceSave = 0
+		} else {
+			pressure += roomPressure(room) * (1.0 + 0.1*float64(sinceSave))
+		}
+
+		if sinceLast < cfg.MinSpacing {
+			continue
+		}
+
+		nextIsBoss := i+1 < len(path) && path[i+1].Type == BossRoom
+		if pressure >= cfg.PressureThreshold || sinceLast >= cfg.MaxSpacing || nextIsBoss {
+			room.HealthPickups = 1
+			if pressure >= 2*cfg.PressureThreshold {
+				room.HealthPickups = 2
+			}
+			sinceLast = 0
+			pressure = 0
+		}
+	}
+}
+
+// roomPressure estimates how much health a room costs the player
+func roomPressure(room *Room) float64 {
+	danger := 1
+	if room.Biome != nil {
+		danger = room.Biome.DangerLevel
+	}
+
+	switch room.Type {
+	case CombatRoom:
+		return 1.0 + float64(danger)/5.0
+	case BossRoom:
+		return 3.0
+	case PuzzleRoom, TreasureRoom:
+		return 0.5
+	default:
+		return 0.25
+	}
+}
@@ -0,0 +1,92 @@
+package world
+
+import (
+	"testing"
+)
+
+// pickupGaps returns the number of rooms between consecutive pickups along
+// the critical path, counting from the start room
+func pickupGaps(path []*Room) []int {
+	gaps := make([]int, 0)
+	last := 0
+	for i, room := range path {
+		if room.HealthPickups > 0 {
+			gaps = append(gaps, i-last)
+			last = i
+		}
+	}
+	return gaps
+}
+
+// TestHealthPickupSpacing verifies pickups along the critical path stay
+// within the configured min/max spacing
+func TestHealthPickupSpacing(t *testing.T) {
+	configs := []ResourceBalanceConfig{
+		DefaultResourceBalanceConfig(),
+		{MinSpacing: 1, MaxSpacing: 3, PressureThreshold: 2.0},
+		{MinSpacing: 3, MaxSpacing: 6, PressureThreshold: 10.0},
+	}
+	seeds := []int64{12345, 67890, 11111, 99999, 42}
+
+	for _, cfg := range configs {
+		for _, seed := range seeds {
+			world := NewWorldGenerator(15, 10, 50, 3).Generate(seed, nil)
+			BalanceHealthPickups(world, cfg)
+
+			path := CriticalPath(world)
+			gaps := pickupGaps(path)
+			if len(gaps) == 0 {
+				t.Errorf("seed %d cfg %+v: no pickups on a %d-room critical path", seed, cfg, len(path))
+				continue
+			}
+
+			for _, gap := range gaps {
+				if gap < cfg.MinSpacing || gap > cfg.MaxSpacing {
+					t.Errorf("seed %d cfg %+v: pickup gap %d outside [%d, %d]",
+						seed, cfg, gap, cfg.MinSpacing, cfg.MaxSpacing)
+				}
+			}
+
+			// The tail after the last pickup must not exceed MaxSpacing either
+			last := 0
+			for i, room := range path {
+				if room.HealthPickups > 0 {
+					last = i
+				}
+			}
+			if tail := len(path) - 1 - last; tail > cfg.MaxSpacing {
+				t.Errorf("seed %d cfg %+v: %d rooms after last pickup, max %d", seed, cfg, tail, cfg.MaxSpacing)
+			}
+		}
+	}
+}
+
+// TestCriticalPathOrdered verifies critical path rooms are sorted by depth
+func TestCriticalPathOrdered(t *testing.T) {
+	world := NewWorldGenerator(15, 10, 50, 3).Generate(42, nil)
+	path := CriticalPath(world)
+
+	if len(path) == 0 || path[0] != world.StartRoom {
+		t.Fatal("critical path does not begin at the start room")
+	}
+	for i := 1; i < len(path); i++ {
+		prev := world.Graph.Nodes[path[i-1].ID].Depth
+		cur := world.Graph.Nodes[path[i].ID].Depth
+		if cur < prev {
+			t.Errorf("room %d depth %d after depth %d", path[i].ID, cur, prev)
+		}
+	}
+}
+
+// TestHealthPickupsDeterministic verifies the pass is stable for a seed
+func TestHealthPickupsDeterministic(t *testing.T) {
+	w1 := NewWorldGenerator(15, 10, 50, 3).Generate(777, nil)
+	w2 := NewWorldGenerator(15, 10, 50, 3).Generate(777, nil)
+
+	for i := range w1.Rooms {
+		if w1.Rooms[i].HealthPickups != w2.Rooms[i].HealthPickups {
+			t.Errorf("room %d pickups differ: %d vs %d",
+				w1.Rooms[i].ID, w1.Rooms[i].HealthPickups, w2.Rooms[i].HealthPickups)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package world
+
+// TileLayers names the biome tileset each drawing layer of a room uses.
+// The background is drawn first, the midground (platforms) next, then
+// entities, and the foreground overlay last, in front of everything. An
+// empty name leaves that layer undrawn.
+type TileLayers struct {
+	Background string
+	Midground  string
+	Foreground string
+}
+
+// AssignTileLayers picks the layered tilesets for every room. Platforms and
+// the overlay use the room's own biome. A room bordering another biome shows
+// that biome in its background, hinting at the zone ahead. Boss rooms get no
+// overlay so the fight stays readable.
+func AssignTileLayers(world *World) {
+	for _, room := range world.Rooms {
+		if room.Biome == nil {
+			continue
+		}
+		layers := TileLayers{
+			Background: room.Biome.Name,
+			Midground:  room.Biome.Name,
+			Foreground: room.Biome.Name,
+		}
+		for _, next := range room.Connections {
+			if next.Biome != nil && next.Biome.Name != room.Biome.Name {
+				layers.Background = next.Biome.Name
+				break
+			}
+		}
+		if room.Type == BossRoom {
+			layers.Foreground = ""
+		}
+		room.TileLayers = layers
+	}
+}
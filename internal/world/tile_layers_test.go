@@ -0,0 +1,48 @@
+package world
+
+import "testing"
+
+func TestAssignTileLayersUsesRoomBiome(t *testing.T) {
+	cave := &Biome{Name: "cave"}
+	room := &Room{ID: 1, Type: CombatRoom, Biome: cave}
+	AssignTileLayers(&World{Rooms: []*Room{room}})
+
+	want := TileLayers{Background: "cave", Midground: "cave", Foreground: "cave"}
+	if room.TileLayers != want {
+		t.Errorf("TileLayers = %+v, want %+v", room.TileLayers, want)
+	}
+}
+
+func TestAssignTileLayersShowsNeighbouringBiomeBehind(t *testing.T) {
+	cave := &Biome{Name: "cave"}
+	forest := &Biome{Name: "forest"}
+	border := &Room{ID: 1, Type: CombatRoom, Biome: cave}
+	ahead := &Room{ID: 2, Type: CombatRoom, Biome: forest}
+	border.Connections = []*Room{ahead}
+	AssignTileLayers(&World{Rooms: []*Room{border, ahead}})
+
+	if border.TileLayers.Background != "forest" {
+		t.Errorf("border background = %q, want the neighbouring biome", border.TileLayers.Background)
+	}
+	if border.TileLayers.Midground != "cave" || border.TileLayers.Foreground != "cave" {
+		t.Errorf("border midground/foreground = %+v, want the room's own biome", border.TileLayers)
+	}
+}
+
+func TestAssignTileLayersKeepsBossRoomsClear(t *testing.T) {
+	room := &Room{ID: 1, Type: BossRoom, Biome: &Biome{Name: "abyss"}}
+	AssignTileLayers(&World{Rooms: []*Room{room}})
+
+	if room.TileLayers.Foreground != "" {
+		t.Errorf("boss room foreground = %q, want none", room.TileLayers.Foreground)
+	}
+}
+
+func TestGenerateAssignsTileLayers(t *testing.T) {
+	w := NewWorldGenerator(15, 10, 50, 3).Generate(7, nil)
+	for _, room := range w.Rooms {
+		if room.Biome != nil && room.TileLayers.Midground != room.Biome.Name {
+			t.Fatalf("room %d midground = %q, want %q", room.ID, room.TileLayers.Midground, room.Biome.Name)
+		}
+	}
+}